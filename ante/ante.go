@@ -65,7 +65,7 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
-		photonante.NewValidateFeeDecorator(opts.PhotonKeeper),
+		photonante.NewValidateFeeDecorator(opts.PhotonKeeper, dynamicfeekeeper.NewFeeDenomResolver(opts.DynamicfeeKeeper)),
 		dynamicfeeante.NewDynamicfeeCheckDecorator(
 			opts.AccountKeeper,
 			opts.BankKeeper,
@@ -57,7 +57,9 @@ import (
 	"github.com/Hikari-Chain/hikari-chain/app/upgrades"
 	v3 "github.com/Hikari-Chain/hikari-chain/app/upgrades/v3"
 	v4 "github.com/Hikari-Chain/hikari-chain/app/upgrades/v4"
+	v5 "github.com/Hikari-Chain/hikari-chain/app/upgrades/v5"
 	"github.com/Hikari-Chain/hikari-chain/client/docs"
+	atomonemempool "github.com/Hikari-Chain/hikari-chain/mempool"
 	atomonepost "github.com/Hikari-Chain/hikari-chain/post"
 	"github.com/Hikari-Chain/hikari-chain/x/gov"
 	govclient "github.com/Hikari-Chain/hikari-chain/x/gov/client"
@@ -68,7 +70,7 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v3.Upgrade, v4.Upgrade}
+	Upgrades = []upgrades.Upgrade{v3.Upgrade, v4.Upgrade, v5.Upgrade}
 )
 
 var (
@@ -154,6 +156,23 @@ func NewHikariApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
+	// The app-side mempool sorts the txs into the lanes reserved by the
+	// dynamicfee params, ordered by the dynamicfee tx priority. It is enabled
+	// by the default app config of hikarid. A negative max-txs disables the
+	// app-side mempool, the default proposal handlers being used then, so the
+	// validator does not reserve the lanes in its block proposals. An unset
+	// max-txs disables it as well, as in the SDK config, instead of being read
+	// as 0, which means unbounded.
+	var mempool *atomonemempool.LaneMempool
+	maxTxs := -1
+	if v := appOpts.Get(server.FlagMempoolMaxTxs); v != nil {
+		maxTxs = cast.ToInt(v)
+	}
+	if maxTxs >= 0 {
+		mempool = atomonemempool.NewLaneMempool(maxTxs)
+		bApp.SetMempool(mempool)
+	}
+
 	app := &AtomOneApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -283,7 +302,10 @@ func NewHikariApp(
 	}
 
 	postHandlerOptions := atomonepost.HandlerOptions{
-		DynamicfeeKeeper: app.DynamicfeeKeeper,
+		DynamicfeeKeeper:   app.DynamicfeeKeeper,
+		BankKeeper:         app.BankKeeper,
+		AccountKeeper:      app.AccountKeeper,
+		DistributionKeeper: app.DistrKeeper,
 	}
 	postHandler, err := atomonepost.NewPostHandler(postHandlerOptions)
 	if err != nil {
@@ -292,6 +314,11 @@ func NewHikariApp(
 
 	app.SetAnteHandler(anteHandler)
 	app.SetPostHandler(postHandler)
+	if mempool != nil {
+		proposalHandler := atomonemempool.NewProposalHandler(mempool, bApp, app.DynamicfeeKeeper)
+		app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+		app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	}
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -8,10 +8,14 @@ import (
 	"cosmossdk.io/log"
 	dbm "github.com/cosmos/cosmos-db"
 
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	hikari "github.com/Hikari-Chain/hikari-chain/app"
 	hikarihelpers "github.com/Hikari-Chain/hikari-chain/app/helpers"
+	"github.com/Hikari-Chain/hikari-chain/mempool"
 	govtypes "github.com/Hikari-Chain/hikari-chain/x/gov/types"
 )
 
@@ -30,6 +34,47 @@ func TestAtomOneApp_BlockedModuleAccountAddrs(t *testing.T) {
 	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 }
 
+func TestAtomOneApp_Mempool(t *testing.T) {
+	tests := []struct {
+		name        string
+		appOpts     servertypes.AppOptions
+		laneMempool bool
+	}{
+		{
+			name:    "max txs unset",
+			appOpts: hikari.EmptyAppOptions{},
+		},
+		{
+			name:    "negative max txs",
+			appOpts: simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: -1},
+		},
+		{
+			name:        "unbounded max txs",
+			appOpts:     simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: 0},
+			laneMempool: true,
+		},
+		{
+			name:        "bounded max txs",
+			appOpts:     simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: 100},
+			laneMempool: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := hikari.NewHikariApp(
+				log.NewNopLogger(),
+				dbm.NewMemDB(),
+				nil,
+				true,
+				tt.appOpts,
+			)
+
+			_, ok := app.Mempool().(*mempool.LaneMempool)
+			require.Equal(t, tt.laneMempool, ok)
+		})
+	}
+}
+
 func TestAtomOneApp_Export(t *testing.T) {
 	app := hikarihelpers.Setup(t)
 	_, err := app.ExportAppStateAndValidators(true, []string{}, []string{})
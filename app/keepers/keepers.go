@@ -236,12 +236,16 @@ func NewAppKeeper(
 	govConfig := govtypes.DefaultConfig()
 	// set the MaxMetadataLen for proposals to the same value as it was pre-sdk v0.47.x
 	govConfig.MaxMetadataLen = 10200
+	// archive the votes of the proposals when they are tallied, so they can
+	// still be queried once the proposals are finished
+	govConfig.ArchiveVotes = true
 	appKeepers.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[govtypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
 		bApp.MsgServiceRouter(),
 		govConfig,
 		authorityStr,
@@ -261,6 +265,7 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		appKeepers.GovKeeper,
 		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
 	)
 
 	// register the staking hooks
@@ -270,6 +275,7 @@ func NewAppKeeper(
 			appKeepers.DistrKeeper.Hooks(),
 			appKeepers.SlashingKeeper.Hooks(),
 			appKeepers.CoreDaosKeeper.StakingHooks(),
+			appKeepers.GovKeeper.StakingHooks(),
 		),
 	)
 
@@ -313,8 +319,15 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[dynamicfeetypes.StoreKey],
 		appKeepers.PhotonKeeper,
+		appKeepers.AccountKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// fees can be paid with the denoms of the photon resolver and with the
+	// fee denoms whitelisted in the dynamicfee params.
+	appKeepers.DynamicfeeKeeper.SetDenomResolver(dynamicfeetypes.NewCompositeDenomResolver(
+		appKeepers.PhotonKeeper,
+		dynamicfeekeeper.NewFeeDenomResolver(appKeepers.DynamicfeeKeeper),
+	))
 
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(nil, &appKeepers.ICAHostKeeper)
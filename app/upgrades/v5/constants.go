@@ -0,0 +1,17 @@
+package v5
+
+import (
+	store "cosmossdk.io/store/types"
+
+	"github.com/Hikari-Chain/hikari-chain/app/upgrades"
+)
+
+const (
+	UpgradeName = "v5"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
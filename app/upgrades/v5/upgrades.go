@@ -0,0 +1,63 @@
+package v5
+
+import (
+	"context"
+	"fmt"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Hikari-Chain/hikari-chain/app/keepers"
+	dynamicfeetypes "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+// CreateUpgradeHandler returns a upgrade handler for AtomOne v5
+// which executes the following migrations:
+//   - the store migrations of the gov (v6), dynamicfee (v3) and coredaos (v2)
+//     modules.
+//   - grant the burner permission to the dynamicfee module account, so that
+//     it can burn the base fees.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+		sdkCtx.Logger().Info("Starting module migrations...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		if err := setDynamicfeeBurnerPermission(sdkCtx, keepers.AccountKeeper); err != nil {
+			return vm, err
+		}
+
+		sdkCtx.Logger().Info("Upgrade complete")
+		return vm, nil
+	}
+}
+
+// setDynamicfeeBurnerPermission updates the permissions of the dynamicfee
+// module account, which was created without any permission. The module
+// account permissions are stored with the account, so the permissions
+// declared in the app are not applied to an existing account.
+func setDynamicfeeBurnerPermission(ctx sdk.Context, ak authkeeper.AccountKeeper) error {
+	ctx.Logger().Info("Granting burner permission to the dynamicfee module account...")
+	acc, ok := ak.GetModuleAccount(ctx, dynamicfeetypes.ModuleName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected type for the %s module account", dynamicfeetypes.ModuleName)
+	}
+	if !acc.HasPermission(authtypes.Burner) {
+		acc.Permissions = append(acc.Permissions, authtypes.Burner)
+		ak.SetModuleAccount(ctx, acc)
+	}
+	ctx.Logger().Info("Burner permission granted to the dynamicfee module account")
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	appparams "github.com/Hikari-Chain/hikari-chain/app/params"
 	addressutil "github.com/Hikari-Chain/hikari-chain/pkg/address"
+	dynamicfeecli "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/client/cli"
 )
 
 var flagBech32Prefix = "prefix"
@@ -50,5 +51,6 @@ Example:
 // addDebugCommands injects custom debug commands into another command as children.
 func addDebugCommands(cmd *cobra.Command) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(dynamicfeecli.GetSimulateCmd())
 	return cmd
 }
@@ -119,6 +119,10 @@ func initTendermintConfig() *tmcfg.Config {
 	return cfg
 }
 
+// DefaultMempoolMaxTxs is the default max number of txs of the app-side
+// mempool.
+const DefaultMempoolMaxTxs = 5000
+
 func initAppConfig() (string, interface{}) {
 	// Embed additional configurations
 	type CustomAppConfig struct {
@@ -129,6 +133,9 @@ func initAppConfig() (string, interface{}) {
 	srvCfg := serverconfig.DefaultConfig()
 	srvCfg.StateSync.SnapshotInterval = 1000
 	srvCfg.StateSync.SnapshotKeepRecent = 10
+	// Enable the app-side mempool, which fills the block space lanes reserved
+	// by the dynamicfee params, the SDK default disabling it.
+	srvCfg.Mempool.MaxTxs = DefaultMempoolMaxTxs
 
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
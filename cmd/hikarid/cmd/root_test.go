@@ -1,6 +1,9 @@
 package cmd_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,3 +24,14 @@ func TestRootCmdConfig(t *testing.T) {
 
 	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
 }
+
+func TestInitAppConfigEnablesMempool(t *testing.T) {
+	home := t.TempDir()
+	rootCmd, _ := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{"init", "moniker", "--home", home})
+
+	require.NoError(t, svrcmd.Execute(rootCmd, "", home))
+	appConfig, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(appConfig), fmt.Sprintf("max-txs = %d", cmd.DefaultMempoolMaxTxs))
+}
@@ -0,0 +1,93 @@
+package mempool
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dynamicfeetypes "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+	govv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+	govv1beta1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
+)
+
+// Lane is a lane of the mempool, which is reserved a share of the block space
+// by the dynamicfee params.
+type Lane string
+
+const (
+	// LaneGovernance contains the txs that only contain governance votes.
+	LaneGovernance Lane = "governance"
+	// LaneIBC contains the txs that only contain IBC relayer messages.
+	LaneIBC Lane = "ibc"
+	// LaneDefault contains all the other txs.
+	LaneDefault Lane = "default"
+)
+
+// Lanes are the lanes of the mempool, in the order they are filled when
+// building block proposals.
+var Lanes = []Lane{LaneGovernance, LaneIBC, LaneDefault}
+
+// TxLane returns the lane of tx. A tx belongs to the governance or IBC lane
+// only if all its msgs belong to it, so that the reserved block space cannot
+// be used by bundling other msgs.
+func TxLane(tx sdk.Tx) Lane {
+	msgs := tx.GetMsgs()
+	switch {
+	case len(msgs) == 0:
+		return LaneDefault
+	case allMsgs(msgs, isGovVote):
+		return LaneGovernance
+	case allMsgs(msgs, isIBCRelayerMsg):
+		return LaneIBC
+	default:
+		return LaneDefault
+	}
+}
+
+// LaneShare returns the share of the block space reserved to lane by params.
+func LaneShare(params dynamicfeetypes.LaneParams, lane Lane) math.LegacyDec {
+	switch lane {
+	case LaneGovernance:
+		return params.Governance
+	case LaneIBC:
+		return params.IBC
+	default:
+		return params.Default()
+	}
+}
+
+func allMsgs(msgs []sdk.Msg, f func(sdk.Msg) bool) bool {
+	for _, msg := range msgs {
+		if !f(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+func isGovVote(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *govv1.MsgVote, *govv1.MsgVoteWeighted,
+		*govv1beta1.MsgVote, *govv1beta1.MsgVoteWeighted:
+		return true
+	default:
+		return false
+	}
+}
+
+func isIBCRelayerMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *clienttypes.MsgUpdateClient,
+		*channeltypes.MsgRecvPacket, *channeltypes.MsgAcknowledgement,
+		*channeltypes.MsgTimeout, *channeltypes.MsgTimeoutOnClose,
+		*channeltypesv2.MsgRecvPacket, *channeltypesv2.MsgAcknowledgement,
+		*channeltypesv2.MsgTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,69 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/mempool"
+	dynamicfeetypes "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+	govv1beta1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
+)
+
+func TestTxLane(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected mempool.Lane
+	}{
+		{
+			name:     "no msgs",
+			expected: mempool.LaneDefault,
+		},
+		{
+			name:     "gov votes",
+			msgs:     []sdk.Msg{govVote(), &govv1beta1.MsgVoteWeighted{}},
+			expected: mempool.LaneGovernance,
+		},
+		{
+			name:     "ibc relayer msgs",
+			msgs:     []sdk.Msg{&clienttypes.MsgUpdateClient{}, recvPacket()},
+			expected: mempool.LaneIBC,
+		},
+		{
+			name:     "bank send",
+			msgs:     []sdk.Msg{bankSend()},
+			expected: mempool.LaneDefault,
+		},
+		{
+			name:     "gov vote bundled with a bank send",
+			msgs:     []sdk.Msg{govVote(), bankSend()},
+			expected: mempool.LaneDefault,
+		},
+		{
+			name:     "gov vote bundled with an ibc relayer msg",
+			msgs:     []sdk.Msg{govVote(), recvPacket()},
+			expected: mempool.LaneDefault,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newTestTx("tx", 0, 0, tc.msgs...)
+			require.Equal(t, tc.expected, mempool.TxLane(tx))
+		})
+	}
+}
+
+func TestLaneShare(t *testing.T) {
+	params := dynamicfeetypes.NewLaneParams(math.LegacyMustNewDecFromStr("0.1"), math.LegacyMustNewDecFromStr("0.3"))
+
+	require.Equal(t, params.Governance, mempool.LaneShare(params, mempool.LaneGovernance))
+	require.Equal(t, params.IBC, mempool.LaneShare(params, mempool.LaneIBC))
+	require.True(t, math.LegacyMustNewDecFromStr("0.6").Equal(mempool.LaneShare(params, mempool.LaneDefault)))
+}
@@ -0,0 +1,108 @@
+package mempool
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Mempool = (*LaneMempool)(nil)
+
+// LaneMempool is an app-side mempool that sorts the txs into lanes. Each lane
+// is a priority nonce mempool, ordering the txs by the priority set by the
+// dynamicfee ante handler, i.e. by their normalized gas price.
+//
+// The txs of a sender are ordered by sequence within a lane, but not across
+// lanes: a vote with sequence N+1 may be selected before a tx of the default
+// lane with sequence N. The ProposalHandler retries such txs once the block
+// proposal contains the txs they depend on.
+type LaneMempool struct {
+	lanes map[Lane]sdkmempool.Mempool
+	maxTx int
+}
+
+// NewLaneMempool returns a LaneMempool holding at most maxTx txs across all
+// its lanes. A maxTx of 0 means the mempool is unbounded. A negative maxTx,
+// which disables the app-side mempool in the app config, must be handled by
+// the caller by not using a LaneMempool.
+func NewLaneMempool(maxTx int) *LaneMempool {
+	lanes := make(map[Lane]sdkmempool.Mempool, len(Lanes))
+	for _, lane := range Lanes {
+		// the lanes are unbounded, maxTx is enforced across all of them.
+		lanes[lane] = sdkmempool.NewPriorityMempool(sdkmempool.DefaultPriorityNonceMempoolConfig())
+	}
+	return &LaneMempool{lanes: lanes, maxTx: maxTx}
+}
+
+// Insert inserts tx into its lane. It returns ErrMempoolTxMaxCapacity if the
+// mempool already holds maxTx txs.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if mp.maxTx > 0 && mp.CountTx() >= mp.maxTx {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+	return mp.lanes[TxLane(tx)].Insert(ctx, tx)
+}
+
+// Select returns an iterator over the txs of all the lanes, in the order of
+// Lanes.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	iterators := make([]sdkmempool.Iterator, 0, len(Lanes))
+	for _, lane := range Lanes {
+		iterators = append(iterators, mp.lanes[lane].Select(ctx, txs))
+	}
+	return newLanesIterator(iterators)
+}
+
+// SelectLane returns an iterator over the txs of lane, ordered by priority.
+func (mp *LaneMempool) SelectLane(ctx context.Context, lane Lane) sdkmempool.Iterator {
+	return mp.lanes[lane].Select(ctx, nil)
+}
+
+// CountTx returns the number of txs of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.CountTx()
+	}
+	return count
+}
+
+// CountLaneTx returns the number of txs of lane.
+func (mp *LaneMempool) CountLaneTx(lane Lane) int {
+	return mp.lanes[lane].CountTx()
+}
+
+// Remove removes tx from its lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	return mp.lanes[TxLane(tx)].Remove(tx)
+}
+
+// lanesIterator chains the iterators of the lanes.
+type lanesIterator struct {
+	iterators []sdkmempool.Iterator
+}
+
+// newLanesIterator returns an iterator chaining iterators, skipping the nil
+// ones. It returns nil if there's no tx to iterate over.
+func newLanesIterator(iterators []sdkmempool.Iterator) sdkmempool.Iterator {
+	for len(iterators) > 0 && iterators[0] == nil {
+		iterators = iterators[1:]
+	}
+	if len(iterators) == 0 {
+		return nil
+	}
+	return &lanesIterator{iterators: iterators}
+}
+
+func (it *lanesIterator) Next() sdkmempool.Iterator {
+	if next := it.iterators[0].Next(); next != nil {
+		it.iterators[0] = next
+		return it
+	}
+	return newLanesIterator(it.iterators[1:])
+}
+
+func (it *lanesIterator) Tx() sdk.Tx {
+	return it.iterators[0].Tx()
+}
@@ -0,0 +1,149 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Hikari-Chain/hikari-chain/mempool"
+	govv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// testTx is a tx signed by a single account, whose encoding is size bytes
+// long.
+type testTx struct {
+	id     string
+	msgs   []sdk.Msg
+	pubKey cryptotypes.PubKey
+	gas    uint64
+	size   int
+}
+
+func newTestTx(id string, gas uint64, size int, msgs ...sdk.Msg) *testTx {
+	return &testTx{
+		id:     id,
+		msgs:   msgs,
+		pubKey: secp256k1.GenPrivKey().PubKey(),
+		gas:    gas,
+		size:   size,
+	}
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx *testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx *testTx) GetSigners() ([][]byte, error)         { return [][]byte{tx.pubKey.Address()}, nil }
+func (tx *testTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+func (tx *testTx) GetGas() uint64     { return tx.gas }
+func (tx *testTx) GetFee() sdk.Coins  { return nil }
+func (tx *testTx) FeePayer() []byte   { return tx.pubKey.Address() }
+func (tx *testTx) FeeGranter() []byte { return nil }
+
+func (tx *testTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{PubKey: tx.pubKey}}, nil
+}
+
+// bytes returns the encoding of tx.
+func (tx *testTx) bytes() []byte {
+	bz := make([]byte, tx.size)
+	copy(bz, tx.id)
+	return bz
+}
+
+func newContext() sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+}
+
+func govVote() sdk.Msg {
+	return &govv1.MsgVote{ProposalId: 1, Voter: "voter", Option: govv1.OptionYes}
+}
+
+func recvPacket() sdk.Msg {
+	return &channeltypes.MsgRecvPacket{Signer: "relayer"}
+}
+
+func bankSend() sdk.Msg {
+	return &banktypes.MsgSend{FromAddress: "from", ToAddress: "to"}
+}
+
+// iteratorIDs returns the ids of the txs of it.
+func iteratorIDs(it sdkmempool.Iterator) []string {
+	var ids []string
+	for ; it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(*testTx).id)
+	}
+	return ids
+}
+
+func TestLaneMempool(t *testing.T) {
+	require := require.New(t)
+	mp := mempool.NewLaneMempool(0)
+	ctx := newContext()
+
+	txs := []struct {
+		tx       *testTx
+		priority int64
+	}{
+		{newTestTx("send-low", 1000, 100, bankSend()), 1},
+		{newTestTx("vote-low", 1000, 100, govVote()), 1},
+		{newTestTx("send-high", 1000, 100, bankSend()), 10},
+		{newTestTx("recv", 1000, 100, recvPacket()), 5},
+		{newTestTx("vote-high", 1000, 100, govVote()), 10},
+		{newTestTx("vote-send", 1000, 100, govVote(), bankSend()), 20},
+	}
+	for _, tx := range txs {
+		require.NoError(mp.Insert(ctx.WithPriority(tx.priority), tx.tx))
+	}
+
+	require.Equal(len(txs), mp.CountTx())
+	require.Equal(2, mp.CountLaneTx(mempool.LaneGovernance))
+	require.Equal(1, mp.CountLaneTx(mempool.LaneIBC))
+	require.Equal(3, mp.CountLaneTx(mempool.LaneDefault))
+
+	require.Equal([]string{"vote-high", "vote-low"}, iteratorIDs(mp.SelectLane(ctx, mempool.LaneGovernance)))
+	require.Equal([]string{"vote-send", "send-high", "send-low"}, iteratorIDs(mp.SelectLane(ctx, mempool.LaneDefault)))
+	require.Equal([]string{
+		"vote-high", "vote-low", "recv", "vote-send", "send-high", "send-low",
+	}, iteratorIDs(mp.Select(ctx, nil)))
+
+	require.NoError(mp.Remove(txs[3].tx))
+	require.Equal(0, mp.CountLaneTx(mempool.LaneIBC))
+	require.Equal([]string{
+		"vote-high", "vote-low", "vote-send", "send-high", "send-low",
+	}, iteratorIDs(mp.Select(ctx, nil)))
+}
+
+func TestLaneMempoolEmpty(t *testing.T) {
+	mp := mempool.NewLaneMempool(0)
+	require.Nil(t, mp.Select(newContext(), nil))
+	require.Zero(t, mp.CountTx())
+}
+
+func TestLaneMempoolMaxTx(t *testing.T) {
+	require := require.New(t)
+	mp := mempool.NewLaneMempool(2)
+	ctx := newContext()
+
+	require.NoError(mp.Insert(ctx, newTestTx("vote", 1000, 100, govVote())))
+	require.NoError(mp.Insert(ctx, newTestTx("recv", 1000, 100, recvPacket())))
+
+	// max tx applies to the total of the lanes, not to each lane.
+	err := mp.Insert(ctx, newTestTx("send", 1000, 100, bankSend()))
+	require.ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+	require.Equal(2, mp.CountTx())
+	require.Zero(mp.CountLaneTx(mempool.LaneDefault))
+}
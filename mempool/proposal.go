@@ -0,0 +1,271 @@
+package mempool
+
+import (
+	"context"
+	stdmath "math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	dynamicfeetypes "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+// DynamicfeeKeeper defines the expected dynamicfee keeper.
+type DynamicfeeKeeper interface {
+	GetParams(ctx context.Context) (dynamicfeetypes.Params, error)
+}
+
+// TxVerifier verifies the txs of the block proposals. It is implemented by
+// baseapp.BaseApp.
+type TxVerifier interface {
+	PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error)
+	ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error)
+}
+
+// ProposalHandler builds and verifies the block proposals, reserving to each
+// lane the share of the block space set by the dynamicfee params.
+type ProposalHandler struct {
+	mempool          *LaneMempool
+	txVerifier       TxVerifier
+	dynamicfeeKeeper DynamicfeeKeeper
+}
+
+// NewProposalHandler returns a new ProposalHandler.
+func NewProposalHandler(mempool *LaneMempool, txVerifier TxVerifier, dynamicfeeKeeper DynamicfeeKeeper) *ProposalHandler {
+	return &ProposalHandler{
+		mempool:          mempool,
+		txVerifier:       txVerifier,
+		dynamicfeeKeeper: dynamicfeeKeeper,
+	}
+}
+
+// PrepareProposalHandler returns the PrepareProposal handler, which fills the
+// governance and IBC lanes up to their reserved share of the block space, then
+// gives the rest of the block space to the default lane. The block space the
+// default lane leaves unused then goes to the governance and IBC txs that did
+// not fit their share. The txs of each lane are selected by priority.
+//
+// The txs failing verification, e.g. because they follow by sequence a tx of
+// the same sender in another lane, are retried once the other txs are
+// selected.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		params, err := h.dynamicfeeKeeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		p := &proposal{
+			block:      newBlockSpace(req.MaxTxBytes, ctx.ConsensusParams().Block.GetMaxGas()),
+			txVerifier: h.txVerifier,
+		}
+
+		// fill the reserved lanes up to their share, keeping the rest of
+		// their txs for the block space left unused by the default lane.
+		overflows := make([]*laneOverflow, 0, len(Lanes))
+		for _, lane := range Lanes {
+			if lane == LaneDefault {
+				continue
+			}
+			space := p.block.share(LaneShare(params.Lanes, lane))
+			overflows = append(overflows, p.fill(h.mempool.SelectLane(ctx, lane), space, true))
+		}
+
+		p.fill(h.mempool.SelectLane(ctx, LaneDefault), p.block.remaining(), false)
+
+		for _, overflow := range overflows {
+			if overflow.tx != nil {
+				p.add(overflow.tx, overflow.bz)
+			}
+			p.fill(overflow.it, p.block.remaining(), false)
+		}
+
+		p.retryRejected()
+
+		return &abci.ResponsePrepareProposal{Txs: p.txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns the ProcessProposal handler, which rejects
+// the proposals containing invalid txs or exceeding the max block gas. The
+// lane shares are reservations for the block proposer, not limits, so they
+// are not enforced here.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		consensusParams := ctx.ConsensusParams()
+		block := newBlockSpace(consensusParams.Block.GetMaxBytes(), consensusParams.Block.GetMaxGas())
+
+		for _, bz := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(bz)
+			if err != nil {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			// the block size is already checked by CometBFT, only the gas is
+			// checked here.
+			gas := txGas(tx)
+			if !block.fitsGas(gas) {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			block.consume(int64(len(bz)), gas)
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// proposal is a block proposal being built.
+type proposal struct {
+	block      blockSpace
+	txVerifier TxVerifier
+	txs        [][]byte
+	// rejected are the txs that failed verification, in selection order.
+	rejected []sdk.Tx
+}
+
+// laneOverflow is what is left of a lane once its share of the block space is
+// full: the verified tx that did not fit, and the iterator over the next txs.
+type laneOverflow struct {
+	tx sdk.Tx
+	bz []byte
+	it sdkmempool.Iterator
+}
+
+// fill adds the txs of it to the proposal until space is full, skipping the
+// txs that do not fit. If overflow is true and a verified tx does not fit
+// space but fits the block, fill stops and returns it along with the iterator
+// over the next txs, so that it can use the block space left by the other
+// lanes.
+func (p *proposal) fill(it sdkmempool.Iterator, space blockSpace, overflow bool) *laneOverflow {
+	for ; it != nil && !space.isFull() && !p.block.isFull(); it = it.Next() {
+		tx := it.Tx()
+		bz, err := p.txVerifier.PrepareProposalVerifyTx(tx)
+		if err != nil {
+			p.rejected = append(p.rejected, tx)
+			continue
+		}
+
+		size, gas := int64(len(bz)), txGas(tx)
+		if !space.fits(size, gas) {
+			if overflow && p.block.fits(size, gas) {
+				return &laneOverflow{tx: tx, bz: bz, it: it.Next()}
+			}
+			continue
+		}
+		if p.add(tx, bz) {
+			space.consume(size, gas)
+		}
+	}
+	return &laneOverflow{it: it}
+}
+
+// add adds a verified tx to the proposal if it fits the block space.
+func (p *proposal) add(tx sdk.Tx, bz []byte) bool {
+	size, gas := int64(len(bz)), txGas(tx)
+	if !p.block.fits(size, gas) {
+		return false
+	}
+	p.block.consume(size, gas)
+	p.txs = append(p.txs, bz)
+	return true
+}
+
+// retryRejected verifies again the txs that failed verification, as long as
+// some of them get added to the proposal. A tx rejected because it follows
+// by sequence a tx of the same sender that was selected later, from another
+// lane, passes verification once that tx is in the proposal. The other
+// invalid txs are removed from the mempool when they are rechecked.
+func (p *proposal) retryRejected() {
+	for added := true; added && len(p.rejected) > 0 && !p.block.isFull(); {
+		added = false
+		rejected := p.rejected[:0]
+		for _, tx := range p.rejected {
+			bz, err := p.txVerifier.PrepareProposalVerifyTx(tx)
+			if err != nil {
+				rejected = append(rejected, tx)
+				continue
+			}
+			if p.add(tx, bz) {
+				added = true
+			}
+		}
+		p.rejected = rejected
+	}
+}
+
+// txGas returns the gas limit of tx, or 0 if tx is not a FeeTx.
+func txGas(tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+	return int64(feeTx.GetGas())
+}
+
+// blockSpace tracks the block space used out of a max number of bytes and
+// gas.
+type blockSpace struct {
+	maxBytes int64
+	maxGas   int64
+	bytes    int64
+	gas      int64
+}
+
+// newBlockSpace returns a blockSpace of maxBytes and maxGas. A max lower than
+// or equal to 0 means no limit, as for the consensus params.
+func newBlockSpace(maxBytes, maxGas int64) blockSpace {
+	if maxBytes <= 0 {
+		maxBytes = stdmath.MaxInt64
+	}
+	if maxGas <= 0 {
+		maxGas = stdmath.MaxInt64
+	}
+	return blockSpace{maxBytes: maxBytes, maxGas: maxGas}
+}
+
+// share returns the given share of the max block space, with nothing used.
+func (s blockSpace) share(share math.LegacyDec) blockSpace {
+	return blockSpace{maxBytes: shareOf(s.maxBytes, share), maxGas: shareOf(s.maxGas, share)}
+}
+
+// remaining returns the block space that is not used yet, with nothing used.
+func (s blockSpace) remaining() blockSpace {
+	return blockSpace{maxBytes: remainingOf(s.maxBytes, s.bytes), maxGas: remainingOf(s.maxGas, s.gas)}
+}
+
+func (s blockSpace) fits(bytes, gas int64) bool {
+	return bytes <= s.maxBytes-s.bytes && s.fitsGas(gas)
+}
+
+func (s blockSpace) fitsGas(gas int64) bool {
+	return gas <= s.maxGas-s.gas
+}
+
+func (s blockSpace) isFull() bool {
+	return s.bytes >= s.maxBytes || s.gas >= s.maxGas
+}
+
+func (s *blockSpace) consume(bytes, gas int64) {
+	s.bytes += bytes
+	s.gas += gas
+}
+
+// shareOf returns the share of max, an unlimited max staying unlimited.
+func shareOf(max int64, share math.LegacyDec) int64 {
+	if max == stdmath.MaxInt64 {
+		return max
+	}
+	return share.MulInt64(max).TruncateInt64()
+}
+
+// remainingOf returns max minus used, an unlimited max staying unlimited.
+func remainingOf(max, used int64) int64 {
+	if max == stdmath.MaxInt64 {
+		return max
+	}
+	return max - used
+}
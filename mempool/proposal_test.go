@@ -0,0 +1,283 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/mempool"
+	dynamicfeetypes "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+type testDynamicfeeKeeper struct {
+	params dynamicfeetypes.Params
+}
+
+func (k testDynamicfeeKeeper) GetParams(context.Context) (dynamicfeetypes.Params, error) {
+	return k.params, nil
+}
+
+// testTxVerifier verifies the testTxs, rejecting the ones listed in invalid,
+// and the ones listed in requires until the tx they require is verified, as
+// for txs of the same sender with consecutive sequences.
+type testTxVerifier struct {
+	txs      map[string]*testTx
+	verified map[string]bool
+	invalid  map[string]bool
+	requires map[string]string
+}
+
+func newTestTxVerifier() *testTxVerifier {
+	return &testTxVerifier{
+		txs:      map[string]*testTx{},
+		verified: map[string]bool{},
+		invalid:  map[string]bool{},
+		requires: map[string]string{},
+	}
+}
+
+func (v *testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	ttx := tx.(*testTx)
+	if v.invalid[ttx.id] {
+		return nil, errors.New("invalid tx")
+	}
+	if required, ok := v.requires[ttx.id]; ok && !v.verified[required] {
+		return nil, errors.New("account sequence mismatch")
+	}
+	v.verified[ttx.id] = true
+	bz := ttx.bytes()
+	v.txs[string(bz)] = ttx
+	return bz, nil
+}
+
+func (v *testTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	tx, ok := v.txs[string(txBz)]
+	if !ok || v.invalid[tx.id] {
+		return nil, errors.New("invalid tx")
+	}
+	return tx, nil
+}
+
+func setupProposalHandler(t *testing.T, maxGas int64) (*mempool.ProposalHandler, *mempool.LaneMempool, *testTxVerifier, sdk.Context) {
+	t.Helper()
+	params := dynamicfeetypes.DefaultParams()
+	params.Lanes = dynamicfeetypes.NewLaneParams(math.LegacyMustNewDecFromStr("0.1"), math.LegacyMustNewDecFromStr("0.2"))
+
+	mp := mempool.NewLaneMempool(0)
+	verifier := newTestTxVerifier()
+	handler := mempool.NewProposalHandler(mp, verifier, testDynamicfeeKeeper{params: params})
+	ctx := newContext().WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 1000, MaxGas: maxGas},
+	})
+	return handler, mp, verifier, ctx
+}
+
+// insertTxs inserts n txs of 100 bytes and 1000 gas containing msg into mp,
+// with decreasing priorities.
+func insertTxs(t *testing.T, ctx sdk.Context, mp *mempool.LaneMempool, prefix string, n int, msg sdk.Msg) []*testTx {
+	t.Helper()
+	txs := make([]*testTx, n)
+	for i := range txs {
+		txs[i] = newTestTx(fmt.Sprintf("%s-%d", prefix, i), 1000, 100, msg)
+		require.NoError(t, mp.Insert(ctx.WithPriority(int64(n-i)), txs[i]))
+	}
+	return txs
+}
+
+// proposalIDs returns the ids of the txs of a proposal.
+func proposalIDs(v *testTxVerifier, txs [][]byte) []string {
+	ids := make([]string, len(txs))
+	for i, bz := range txs {
+		ids[i] = v.txs[string(bz)].id
+	}
+	return ids
+}
+
+func TestPrepareProposal(t *testing.T) {
+	t.Run("fills the lanes up to their share of the block bytes", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, -1)
+		insertTxs(t, ctx, mp, "vote", 3, govVote())
+		insertTxs(t, ctx, mp, "recv", 3, recvPacket())
+		insertTxs(t, ctx, mp, "send", 10, bankSend())
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+		require.NoError(err)
+
+		require.Equal([]string{
+			"vote-0",
+			"recv-0", "recv-1",
+			"send-0", "send-1", "send-2", "send-3", "send-4", "send-5", "send-6",
+		}, proposalIDs(verifier, res.Txs))
+	})
+
+	t.Run("gives the unused reserved space to the default lane", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, -1)
+		insertTxs(t, ctx, mp, "send", 12, bankSend())
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+		require.NoError(err)
+
+		require.Len(res.Txs, 10)
+		for _, id := range proposalIDs(verifier, res.Txs) {
+			require.Contains(id, "send-")
+		}
+	})
+
+	t.Run("fills the lanes up to their share of the block gas", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, 10000)
+		insertTxs(t, ctx, mp, "vote", 3, govVote())
+		insertTxs(t, ctx, mp, "recv", 3, recvPacket())
+		insertTxs(t, ctx, mp, "send", 10, bankSend())
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 100000})
+		require.NoError(err)
+
+		require.Equal([]string{
+			"vote-0",
+			"recv-0", "recv-1",
+			"send-0", "send-1", "send-2", "send-3", "send-4", "send-5", "send-6",
+		}, proposalIDs(verifier, res.Txs))
+	})
+
+	t.Run("gives the space unused by the default lane to the other lanes", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, 20000)
+		insertTxs(t, ctx, mp, "vote", 4, govVote())
+		insertTxs(t, ctx, mp, "recv", 5, recvPacket())
+		insertTxs(t, ctx, mp, "send", 2, bankSend())
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+		require.NoError(err)
+
+		require.Equal([]string{
+			"vote-0",
+			"recv-0", "recv-1",
+			"send-0", "send-1",
+			"vote-1", "vote-2", "vote-3",
+			"recv-2", "recv-3",
+		}, proposalIDs(verifier, res.Txs))
+	})
+
+	t.Run("retries the txs following a tx of another lane", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, -1)
+		votes := insertTxs(t, ctx, mp, "vote", 1, govVote())
+		sends := insertTxs(t, ctx, mp, "send", 1, bankSend())
+		// the vote follows the send by sequence, but the governance lane is
+		// selected first.
+		verifier.requires[votes[0].id] = sends[0].id
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+		require.NoError(err)
+
+		require.Equal([]string{"send-0", "vote-0"}, proposalIDs(verifier, res.Txs))
+	})
+
+	t.Run("skips the invalid txs", func(t *testing.T) {
+		require := require.New(t)
+		handler, mp, verifier, ctx := setupProposalHandler(t, -1)
+		insertTxs(t, ctx, mp, "vote", 2, govVote())
+		verifier.invalid["vote-0"] = true
+
+		res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+		require.NoError(err)
+
+		require.Equal([]string{"vote-1"}, proposalIDs(verifier, res.Txs))
+		// the invalid txs are left to the recheck.
+		require.Equal(2, mp.CountTx())
+	})
+}
+
+func TestProcessProposal(t *testing.T) {
+	testCases := []struct {
+		name     string
+		maxGas   int64
+		txs      func(v *testTxVerifier) [][]byte
+		expected abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			name:   "accepts the txs within the lanes",
+			maxGas: -1,
+			txs: func(v *testTxVerifier) [][]byte {
+				return verifiedTxs(v,
+					newTestTx("vote", 1000, 100, govVote()),
+					newTestTx("recv", 1000, 200, recvPacket()),
+					newTestTx("send", 1000, 700, bankSend()),
+				)
+			},
+			expected: abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:   "accepts the txs exceeding the governance lane share",
+			maxGas: -1,
+			txs: func(v *testTxVerifier) [][]byte {
+				return verifiedTxs(v,
+					newTestTx("vote-0", 1000, 100, govVote()),
+					newTestTx("vote-1", 1000, 100, govVote()),
+				)
+			},
+			expected: abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:   "accepts the txs exceeding the ibc lane share of the gas",
+			maxGas: 10000,
+			txs: func(v *testTxVerifier) [][]byte {
+				return verifiedTxs(v,
+					newTestTx("recv-0", 1500, 100, recvPacket()),
+					newTestTx("recv-1", 1000, 100, recvPacket()),
+				)
+			},
+			expected: abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:   "rejects the txs exceeding the block gas",
+			maxGas: 10000,
+			txs: func(v *testTxVerifier) [][]byte {
+				return verifiedTxs(v,
+					newTestTx("send-0", 6000, 100, bankSend()),
+					newTestTx("send-1", 6000, 100, bankSend()),
+				)
+			},
+			expected: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name:   "rejects the invalid txs",
+			maxGas: -1,
+			txs: func(v *testTxVerifier) [][]byte {
+				return [][]byte{[]byte("unknown")}
+			},
+			expected: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, _, verifier, ctx := setupProposalHandler(t, tc.maxGas)
+
+			res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: tc.txs(verifier)})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res.Status)
+		})
+	}
+}
+
+// verifiedTxs returns the encoding of txs, registering them in v.
+func verifiedTxs(v *testTxVerifier, txs ...*testTx) [][]byte {
+	bzs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bzs[i], _ = v.PrepareProposalVerifyTx(tx)
+	}
+	return bzs
+}
@@ -11,7 +11,10 @@ import (
 
 // PostHandlerOptions are the options required for constructing a Dynamicfee PostHandler.
 type HandlerOptions struct {
-	DynamicfeeKeeper dynamicfeepost.DynamicfeeKeeper
+	DynamicfeeKeeper   dynamicfeepost.DynamicfeeKeeper
+	BankKeeper         dynamicfeepost.BankKeeper
+	AccountKeeper      dynamicfeepost.AccountKeeper
+	DistributionKeeper dynamicfeepost.DistributionKeeper
 }
 
 // NewPostHandler returns a PostHandler chain with the fee deduct decorator.
@@ -19,10 +22,22 @@ func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	if options.DynamicfeeKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "dynamicfee keeper is required for post builder")
 	}
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for post builder")
+	}
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for post builder")
+	}
+	if options.DistributionKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "distribution keeper is required for post builder")
+	}
 
 	postDecorators := []sdk.PostDecorator{
 		dynamicfeepost.NewDynamicfeeStateUpdateDecorator(
 			options.DynamicfeeKeeper,
+			options.BankKeeper,
+			options.AccountKeeper,
+			options.DistributionKeeper,
 		),
 	}
 
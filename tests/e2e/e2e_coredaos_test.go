@@ -123,6 +123,7 @@ func (s *IntegrationTestSuite) testCoreDAOs() {
 			"veto",
 			strconv.FormatInt(int64(proposalID), 10),
 			"false",
+			"Proposal Veto Reason",
 		}
 		s.executeMultiSigTxCommand(s.chainA, atomoneCommand, valIdx, oversiteDAOAccount, false)
 		proposalAfterVeto := s.queryGovV1Proposal(chainAAPIEndpoint, proposalID)
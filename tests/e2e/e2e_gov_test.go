@@ -662,7 +662,9 @@ func (s *IntegrationTestSuite) writeGovLawProposal(c *chain) {
 	 "messages": [
 		{
 		 "@type": "/hikari.gov.v1.MsgProposeLaw",
-		 "authority": "%s"
+		 "authority": "%s",
+		 "title": "New Law",
+		 "text": "This is the text of the new law"
 		}
 	 ],
 	 "deposit": "%s",
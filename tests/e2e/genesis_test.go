@@ -229,6 +229,14 @@ func modifyGenesis(cdc codec.Codec, path, moniker, amountStr string, addrAll []s
 			maxQuorum, minQuorum,
 			maxConstitutionAmendmentQuorum, minConstitutionAmendmentQuorum,
 			maxLawQuorum, minLawQuorum,
+			govv1.DefaultMinGovernorSelfDelegation.String(), govv1.DefaultGovernorStatusChangePeriod,
+			govv1.DefaultArchiveVotes, govv1.DefaultVoteArchiveRetentionPeriod,
+			govv1.DefaultRequireMetadataHash,
+			govv1.DefaultExecutionDelay, govv1.DefaultLawExecutionDelay, govv1.DefaultConstitutionAmendmentExecutionDelay,
+			govv1.DefaultProposalCancelRatio.String(), govv1.DefaultProposalCancelDestAddress,
+			govv1.DefaultExpeditedVotingPeriod, govv1.DefaultExpeditedThreshold.String(),
+			govv1.DefaultExpeditedMinDepositMultiplier.String(),
+			govv1.DefaultSnapshotVotingPower,
 		),
 	)
 	govGenState.Constitution = "This is a test constitution"
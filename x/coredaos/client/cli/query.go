@@ -2,15 +2,23 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
 )
 
+const (
+	flagProposalID = "proposal-id"
+	flagDao        = "dao"
+	flagActionType = "type"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group coredaos queries under a subcommand
@@ -24,6 +32,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetQueryParamsCmd(),
+		GetQueryActionsCmd(),
+		GetQueryProposalActionsCmd(),
+		GetQueryAnnotationsCmd(),
+		GetQueryVetoCmd(),
+		GetQueryDaoHandoversCmd(),
+		GetQueryBudgetCmd(),
+		GetQuerySpendsCmd(),
 	)
 	return cmd
 }
@@ -49,3 +64,293 @@ func GetQueryParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetQueryActionsCmd returns the command to query the actions taken by the
+// core DAOs
+func GetQueryActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "actions",
+		Short: "Query the actions taken by the core DAOs with optional filters",
+		Long: fmt.Sprintf(`Query the actions taken by the core DAOs, optionally filtered by proposal,
+core DAO and action type.
+
+Example:
+$ %s query coredaos actions
+$ %s query coredaos actions --proposal-id 1
+$ %s query coredaos actions --dao oversight --type veto
+`,
+			version.AppName, version.AppName, version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, _ := cmd.Flags().GetUint64(flagProposalID)
+			strDaoRole, _ := cmd.Flags().GetString(flagDao)
+			strActionType, _ := cmd.Flags().GetString(flagActionType)
+
+			var daoRole types.DaoRole
+			if len(strDaoRole) != 0 {
+				var err error
+				daoRole, err = types.DaoRoleFromString(strDaoRole)
+				if err != nil {
+					return err
+				}
+			}
+
+			var actionType types.ActionType
+			if len(strActionType) != 0 {
+				var err error
+				actionType, err = types.ActionTypeFromString(strActionType)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Actions(cmd.Context(), &types.QueryActionsRequest{
+				ProposalId: proposalID,
+				DaoRole:    daoRole,
+				ActionType: actionType,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagProposalID, 0, "(optional) filter actions by proposal ID")
+	cmd.Flags().String(flagDao, "", "(optional) filter actions by core DAO, dao: steering/oversight")
+	cmd.Flags().String(flagActionType, "", "(optional) filter actions by type, type: annotate/endorse/extend/veto")
+	flags.AddPaginationFlagsToCmd(cmd, "actions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryProposalActionsCmd returns the command to query the actions taken
+// by the core DAOs on a proposal
+func GetQueryProposalActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-actions [proposal-id]",
+		Short: "Query the actions taken by the core DAOs on a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalActions(cmd.Context(), &types.QueryProposalActionsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "proposal actions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryAnnotationsCmd returns the command to query the annotation history
+// of a proposal
+func GetQueryAnnotationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annotations [proposal-id]",
+		Short: "Query the annotation history of a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Annotations(cmd.Context(), &types.QueryAnnotationsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "annotations")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryVetoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto [proposal-id]",
+		Short: "Query the veto of a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Veto(cmd.Context(), &types.QueryVetoRequest{ProposalId: proposalID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetQueryDaoHandoversCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handovers",
+		Short: "Query the scheduled handovers of the core DAOs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DaoHandovers(cmd.Context(), &types.QueryDaoHandoversRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "handovers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryBudgetCmd returns the command to query the budget of a core DAO
+// for the current budget epoch
+func GetQueryBudgetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget [steering|oversight]",
+		Short: "Query the budget, spent and remaining amounts of a core DAO for the current budget epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			daoRole, err := types.DaoRoleFromString(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Budget(cmd.Context(), &types.QueryBudgetRequest{
+				DaoRole: daoRole,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQuerySpendsCmd returns the command to query the spend history of the
+// core DAOs
+func GetQuerySpendsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spends",
+		Short: "Query the spends from the budgets of the core DAOs",
+		Long: fmt.Sprintf(`Query the spends from the budgets of the core DAOs, optionally filtered by
+core DAO.
+
+Example:
+$ %s query coredaos spends
+$ %s query coredaos spends --dao steering
+`,
+			version.AppName, version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strDaoRole, _ := cmd.Flags().GetString(flagDao)
+
+			var daoRole types.DaoRole
+			if len(strDaoRole) != 0 {
+				var err error
+				daoRole, err = types.DaoRoleFromString(strDaoRole)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Spends(cmd.Context(), &types.QuerySpendsRequest{
+				DaoRole:    daoRole,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagDao, "", "(optional) filter spends by core DAO, dao: steering/oversight")
+	flags.AddPaginationFlagsToCmd(cmd, "spends")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,16 +3,26 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
 )
 
+const (
+	flagOverwrite  = "overwrite"
+	flagSupersedes = "supersedes"
+	flagMembers    = "members"
+	flagTermStart  = "term-start"
+	flagTermEnd    = "term-end"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +37,11 @@ func GetTxCmd() *cobra.Command {
 		GetTxEndorseProposalCmd(),
 		GetTxExtendVotingPeriodCmd(),
 		GetTxVetoProposalCmd(),
+		GetTxChallengeVetoCmd(),
+		GetTxVoteVetoOverrideCmd(),
+		GetTxScheduleDaoHandoverCmd(),
+		GetTxCancelDaoHandoverCmd(),
+		GetTxSpendFromBudgetCmd(),
 	)
 	return cmd
 }
@@ -51,12 +66,16 @@ func GetTxAnnotateProposalCmd() *cobra.Command {
 				proposalID,
 				args[1],
 			)
+			msg.Overwrite, _ = cmd.Flags().GetBool(flagOverwrite)
+			msg.Supersedes, _ = cmd.Flags().GetUint64(flagSupersedes)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Bool(flagOverwrite, false, "supersede the existing annotation of the proposal, required if the proposal is already annotated")
+	cmd.Flags().Uint64(flagSupersedes, 0, "(optional) index of the superseded annotation, defaults to the latest annotation")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -122,9 +141,9 @@ func GetTxExtendVotingPeriodCmd() *cobra.Command {
 // GetTxVetoProposalCmd returns the command to veto a proposal
 func GetTxVetoProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "veto [proposal-id] [burn-deposit]",
-		Short: "Broadcast a message to veto a proposal. Only available to the Oversight DAO.",
-		Args:  cobra.ExactArgs(2),
+		Use:   "veto [proposal-id] [burn-deposit] [reason]",
+		Short: "Broadcast a message to veto a proposal, giving the reason of the veto. Only available to the Oversight DAO.",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -142,6 +161,202 @@ func GetTxVetoProposalCmd() *cobra.Command {
 				clientCtx.GetFromAddress(),
 				proposalID,
 				burnDeposit,
+				args[2],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxChallengeVetoCmd returns the command to challenge the veto of a proposal
+func GetTxChallengeVetoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-veto [proposal-id]",
+		Short: "Broadcast a message to challenge the veto of a proposal, which starts a veto override vote. Only available to stakers.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			msg := types.NewMsgChallengeVeto(
+				clientCtx.GetFromAddress(),
+				proposalID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxVoteVetoOverrideCmd returns the command to vote on the override of the veto of a proposal
+func GetTxVoteVetoOverrideCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-veto-override [proposal-id] [override]",
+		Short: "Broadcast a message to vote on the override of the challenged veto of a proposal. Only available to stakers.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+			override, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("override %s not a valid boolean, please input a valid override", args[1])
+			}
+			msg := types.NewMsgVoteVetoOverride(
+				clientCtx.GetFromAddress(),
+				proposalID,
+				override,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxScheduleDaoHandoverCmd returns the command to schedule the handover of a core DAO
+func GetTxScheduleDaoHandoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-handover [steering|oversight] [height] [address]",
+		Short: "Broadcast a message to schedule the handover of a core DAO at a given height. Only available to the module authority.",
+		Long: `Broadcast a message to schedule the handover of a core DAO at a given height.
+An empty address disables the core DAO at the given height. The members and
+the term of the core DAO after the handover can be set with flags, the term
+start and end being RFC3339 timestamps.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			daoRole, err := types.DaoRoleFromString(args[0])
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[1])
+			}
+			members, _ := cmd.Flags().GetStringSlice(flagMembers)
+			termStart, err := parseTime(cmd, flagTermStart)
+			if err != nil {
+				return err
+			}
+			termEnd, err := parseTime(cmd, flagTermEnd)
+			if err != nil {
+				return err
+			}
+			var term *types.DaoTerm
+			if termStart != nil || termEnd != nil {
+				term = &types.DaoTerm{StartTime: termStart, EndTime: termEnd}
+			}
+			msg := types.NewMsgScheduleDaoHandover(
+				clientCtx.GetFromAddress(),
+				types.DaoHandover{
+					DaoRole: daoRole,
+					Height:  height,
+					Address: args[2],
+					Members: members,
+					Term:    term,
+				},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(flagMembers, nil, "(optional) comma-separated addresses of the members of the core DAO, which can sign in addition to its address")
+	cmd.Flags().String(flagTermStart, "", "(optional) RFC3339 start time of the term of the core DAO")
+	cmd.Flags().String(flagTermEnd, "", "(optional) RFC3339 end time of the term of the core DAO")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxCancelDaoHandoverCmd returns the command to cancel a scheduled handover of a core DAO
+func GetTxCancelDaoHandoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-handover [steering|oversight] [height]",
+		Short: "Broadcast a message to cancel a scheduled handover of a core DAO. Only available to the module authority.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			daoRole, err := types.DaoRoleFromString(args[0])
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[1])
+			}
+			msg := types.NewMsgCancelDaoHandover(
+				clientCtx.GetFromAddress(),
+				daoRole,
+				height,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTxSpendFromBudgetCmd returns the command to spend from the budget of a core DAO
+func GetTxSpendFromBudgetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend [recipient] [amount] [memo]",
+		Short: "Broadcast a message to send an amount from the community pool within the budget of a core DAO. Only available to the core DAOs.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			var memo string
+			if len(args) > 2 {
+				memo = args[2]
+			}
+			msg := types.NewMsgSpendFromBudget(
+				clientCtx.GetFromAddress(),
+				recipient,
+				amount,
+				memo,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -152,3 +367,16 @@ func GetTxVetoProposalCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// parseTime returns the RFC3339 time of a flag, or nil if the flag is not set.
+func parseTime(cmd *cobra.Command, flag string) (*time.Time, error) {
+	str, _ := cmd.Flags().GetString(flag)
+	if str == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s not a valid RFC3339 time: %w", flag, str, err)
+	}
+	return &t, nil
+}
@@ -3,6 +3,8 @@ package coredaos
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/keeper"
@@ -18,10 +20,108 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.Params.Set(ctx, genState.Params); err != nil {
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
+
+	var nextActionID uint64
+	for _, action := range genState.Actions {
+		if err := k.SetAction(ctx, action); err != nil {
+			panic(err)
+		}
+		if action.Id >= nextActionID {
+			nextActionID = action.Id + 1
+		}
+	}
+	if err := k.ActionID.Set(ctx, nextActionID); err != nil {
+		panic(err)
+	}
+
+	for _, annotation := range genState.Annotations {
+		if err := k.Annotations.Set(ctx, collections.Join(annotation.ProposalId, annotation.Index), annotation); err != nil {
+			panic(err)
+		}
+	}
+
+	// pending vetoes are queued back at the end of their challenge window or
+	// override vote
+	for _, veto := range genState.Vetoes {
+		if err := k.SetVeto(ctx, veto); err != nil {
+			panic(err)
+		}
+	}
+	for _, vote := range genState.VetoOverrideVotes {
+		if err := k.SetVetoOverrideVote(ctx, vote); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, handover := range genState.DaoHandovers {
+		if err := k.SetDaoHandover(ctx, handover); err != nil {
+			panic(err)
+		}
+	}
+
+	// without budget epoch, the first epoch starts at the first block
+	if genState.BudgetEpoch != nil {
+		if err := k.BudgetEpoch.Set(ctx, *genState.BudgetEpoch); err != nil {
+			panic(err)
+		}
+	}
+	for _, spent := range genState.BudgetsSpent {
+		if err := k.BudgetsSpent.Set(ctx, int32(spent.DaoRole), spent); err != nil {
+			panic(err)
+		}
+	}
+	var nextSpendID uint64
+	for _, spend := range genState.Spends {
+		if err := k.Spends.Set(ctx, spend.Id, spend); err != nil {
+			panic(err)
+		}
+		if spend.Id >= nextSpendID {
+			nextSpendID = spend.Id + 1
+		}
+	}
+	if err := k.SpendID.Set(ctx, nextSpendID); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+	actions, err := k.GetAllActions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	annotations, err := k.GetAllAnnotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	vetoes, err := k.GetAllVetoes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	vetoOverrideVotes, err := k.GetAllVetoOverrideVotes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	daoHandovers, err := k.GetAllDaoHandovers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	var budgetEpoch *types.BudgetEpoch
+	if epoch, err := k.GetBudgetEpoch(ctx); err != nil {
+		panic(err)
+	} else if epoch.Number != 0 {
+		budgetEpoch = &epoch
+	}
+	budgetsSpent, err := k.GetAllBudgetsSpent(ctx)
+	if err != nil {
+		panic(err)
+	}
+	spends, err := k.GetAllSpends(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(
+		params, actions, annotations, vetoes, vetoOverrideVotes, daoHandovers, budgetEpoch, budgetsSpent, spends,
+	)
 }
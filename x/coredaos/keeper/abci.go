@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+// BeginBlocker disables the core DAOs whose term has ended, then applies the
+// handovers of the core DAOs scheduled up to the current height. It also
+// starts a new budget epoch once the current one has ended.
+func (k Keeper) BeginBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ExpireDaoTerms(ctx); err != nil {
+		return err
+	}
+	if err := k.ApplyDaoHandovers(ctx); err != nil {
+		return err
+	}
+	return k.AdvanceBudgetEpoch(ctx)
+}
+
+// EndBlocker processes the vetoes whose challenge window or override vote
+// has ended. Unchallenged vetoes are upheld, and challenged vetoes are upheld
+// or overridden depending on the result of their override vote.
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime(), uint64(math.MaxUint64)))
+	var keys []collections.Pair[time.Time, uint64]
+	if err := k.VetoQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.VetoQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		veto, err := k.Vetoes.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		status := types.VetoStatusUpheld
+		switch veto.Status {
+		case types.VetoStatusChallengeable:
+			err = k.UpholdVeto(ctx, veto)
+		case types.VetoStatusChallenged:
+			params := k.GetParams(ctx)
+			yes, no, overridden, tallyErr := k.TallyVetoOverride(ctx, veto.ProposalId, params)
+			if tallyErr != nil {
+				// an override vote that can't be tallied doesn't override the veto
+				logger.Error("failed to tally veto override vote", "proposal", veto.ProposalId, "error", tallyErr)
+			}
+			veto.OverrideYesCount = yes.String()
+			veto.OverrideNoCount = no.String()
+			if overridden && tallyErr == nil {
+				status = types.VetoStatusOverridden
+				err = k.OverrideVeto(ctx, veto)
+			} else {
+				err = k.UpholdVeto(ctx, veto)
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		logger.Info(
+			"veto finalized",
+			"proposal", veto.ProposalId,
+			"status", status,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVetoFinalized,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", veto.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyVetoStatus, status.String()),
+			),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,379 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/testutil"
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+func TestEndBlockerVetoes(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(3)
+	oversightDAOAcc := testAcc[0].String()
+	yesVoter, noVoter := testAcc[1], testAcc[2]
+	remainingTime := 2 * time.Hour
+	tests := []struct {
+		name           string
+		veto           func(blockTime time.Time) types.Veto
+		votes          []types.VetoOverrideVote
+		setupMocks     func(sdk.Context, *testutil.Mocks)
+		expectedStatus types.VetoStatus
+		expectedYes    string
+		expectedNo     string
+	}{
+		{
+			name: "challenge window not ended",
+			veto: func(blockTime time.Time) types.Veto {
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+					Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+					RemainingTime: remainingTime, ChallengeEndTime: blockTime.Add(time.Minute),
+				}
+			},
+			setupMocks:     func(ctx sdk.Context, m *testutil.Mocks) {},
+			expectedStatus: types.VetoStatusChallengeable,
+		},
+		{
+			name: "unchallenged veto upheld",
+			veto: func(blockTime time.Time) types.Veto {
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason", BurnDeposit: true,
+					Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+					RemainingTime: remainingTime, ChallengeEndTime: blockTime,
+				}
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().DeleteVotes(ctx, uint64(1))
+				m.GovKeeper.EXPECT().DeleteAndBurnDeposits(ctx, uint64(1))
+			},
+			expectedStatus: types.VetoStatusUpheld,
+		},
+		{
+			name: "veto overridden",
+			veto: func(blockTime time.Time) types.Veto {
+				overrideVotingEndTime := blockTime.Add(-time.Second)
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+					Status: types.VetoStatusChallenged, ProposalStatus: govtypesv1.StatusVotingPeriod,
+					RemainingTime: remainingTime, Challenger: yesVoter.String(), OverrideVotingEndTime: &overrideVotingEndTime,
+				}
+			},
+			votes: []types.VetoOverrideVote{
+				{ProposalId: 1, Voter: yesVoter.String(), Override: true, VotingPower: "700"},
+				{ProposalId: 1, Voter: noVoter.String(), Override: false, VotingPower: "100"},
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(math.NewInt(1000), nil)
+				votingEndTime := ctx.BlockTime().Add(-time.Hour)
+				vetoedProposal := govtypesv1.Proposal{Id: 1, Status: govtypesv1.StatusVetoed, VotingEndTime: &votingEndTime}
+				restoredVotingEndTime := ctx.BlockTime().Add(remainingTime)
+				restoredProposal := govtypesv1.Proposal{Id: 1, Status: govtypesv1.StatusVotingPeriod, VotingEndTime: &restoredVotingEndTime}
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(vetoedProposal, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, restoredProposal)
+				m.GovKeeper.EXPECT().InsertActiveProposalQueue(ctx, uint64(1), restoredVotingEndTime)
+				m.GovKeeper.EXPECT().ResetQuorumCheck(ctx, restoredProposal)
+				m.GovKeeper.EXPECT().IncrementActiveProposalsNumber(ctx)
+			},
+			expectedStatus: types.VetoStatusOverridden,
+			expectedYes:    "700",
+			expectedNo:     "100",
+		},
+		{
+			name: "pending execution veto overridden",
+			veto: func(blockTime time.Time) types.Veto {
+				overrideVotingEndTime := blockTime
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+					Status: types.VetoStatusChallenged, ProposalStatus: govtypesv1.StatusPassedPendingExecution,
+					RemainingTime: remainingTime, Challenger: yesVoter.String(), OverrideVotingEndTime: &overrideVotingEndTime,
+				}
+			},
+			votes: []types.VetoOverrideVote{
+				{ProposalId: 1, Voter: yesVoter.String(), Override: true, VotingPower: "500"},
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(math.NewInt(1000), nil)
+				executionTime := ctx.BlockTime().Add(-time.Hour)
+				vetoedProposal := govtypesv1.Proposal{Id: 1, Status: govtypesv1.StatusVetoed, ExecutionTime: &executionTime}
+				restoredExecutionTime := ctx.BlockTime().Add(remainingTime)
+				restoredProposal := govtypesv1.Proposal{Id: 1, Status: govtypesv1.StatusPassedPendingExecution, ExecutionTime: &restoredExecutionTime}
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(vetoedProposal, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, restoredProposal)
+				m.GovKeeper.EXPECT().InsertExecutionQueue(ctx, uint64(1), restoredExecutionTime)
+			},
+			expectedStatus: types.VetoStatusOverridden,
+			expectedYes:    "500",
+			expectedNo:     "0",
+		},
+		{
+			name: "quorum not reached",
+			veto: func(blockTime time.Time) types.Veto {
+				overrideVotingEndTime := blockTime
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+					Status: types.VetoStatusChallenged, ProposalStatus: govtypesv1.StatusVotingPeriod,
+					RemainingTime: remainingTime, Challenger: yesVoter.String(), OverrideVotingEndTime: &overrideVotingEndTime,
+				}
+			},
+			votes: []types.VetoOverrideVote{
+				{ProposalId: 1, Voter: yesVoter.String(), Override: true, VotingPower: "700"},
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(math.NewInt(10000), nil)
+				m.GovKeeper.EXPECT().DeleteVotes(ctx, uint64(1))
+				m.GovKeeper.EXPECT().RefundAndDeleteDeposits(ctx, uint64(1))
+			},
+			expectedStatus: types.VetoStatusUpheld,
+			expectedYes:    "700",
+			expectedNo:     "0",
+		},
+		{
+			name: "threshold not reached",
+			veto: func(blockTime time.Time) types.Veto {
+				overrideVotingEndTime := blockTime
+				return types.Veto{
+					ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+					Status: types.VetoStatusChallenged, ProposalStatus: govtypesv1.StatusPassedPendingExecution,
+					RemainingTime: remainingTime, Challenger: yesVoter.String(), OverrideVotingEndTime: &overrideVotingEndTime,
+				}
+			},
+			votes: []types.VetoOverrideVote{
+				{ProposalId: 1, Voter: yesVoter.String(), Override: true, VotingPower: "400"},
+				{ProposalId: 1, Voter: noVoter.String(), Override: false, VotingPower: "400"},
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(math.NewInt(1000), nil)
+				m.GovKeeper.EXPECT().RefundAndDeleteDeposits(ctx, uint64(1))
+			},
+			expectedStatus: types.VetoStatusUpheld,
+			expectedYes:    "400",
+			expectedNo:     "400",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := testutil.SetupCoredaosKeeper(t)
+			tt.setupMocks(ctx, &m)
+			params := types.DefaultParams()
+			params.VetoChallengeWindow = time.Hour
+			k.Params.Set(ctx, params)
+			require.NoError(t, k.SetVeto(ctx, tt.veto(ctx.BlockTime())))
+			for _, vote := range tt.votes {
+				require.NoError(t, k.SetVetoOverrideVote(ctx, vote))
+			}
+
+			err := k.EndBlocker(ctx)
+
+			require.NoError(t, err)
+			veto, err := k.Vetoes.Get(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatus, veto.Status)
+			require.Equal(t, tt.expectedYes, veto.OverrideYesCount)
+			require.Equal(t, tt.expectedNo, veto.OverrideNoCount)
+			votes, err := k.GetAllVetoOverrideVotes(ctx)
+			require.NoError(t, err)
+			if tt.expectedStatus == types.VetoStatusChallengeable {
+				return
+			}
+			require.Empty(t, votes)
+		})
+	}
+}
+
+func TestBeginBlockerDaos(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(4)
+	steeringDAOAcc := testAcc[0].String()
+	oversightDAOAcc := testAcc[1].String()
+	newDAOAcc := testAcc[2].String()
+	memberAcc := testAcc[3].String()
+	tests := []struct {
+		name           string
+		updateParams   func(p *types.Params, blockTime time.Time)
+		handovers      []types.DaoHandover
+		expectedParams func(p *types.Params, blockTime time.Time)
+		expectedEvents []string
+		remaining      int
+	}{
+		{
+			name:      "nothing to do",
+			handovers: []types.DaoHandover{{DaoRole: types.DaoRoleSteering, Height: 11, Address: newDAOAcc}},
+			remaining: 1,
+		},
+		{
+			name: "term not ended",
+			updateParams: func(p *types.Params, blockTime time.Time) {
+				end := blockTime.Add(time.Hour)
+				p.SteeringDaoTerm = &types.DaoTerm{EndTime: &end}
+			},
+			expectedParams: func(p *types.Params, blockTime time.Time) {
+				end := blockTime.Add(time.Hour)
+				p.SteeringDaoTerm = &types.DaoTerm{EndTime: &end}
+			},
+		},
+		{
+			name: "term ended",
+			updateParams: func(p *types.Params, blockTime time.Time) {
+				p.SteeringDaoMembers = []string{memberAcc}
+				p.SteeringDaoTerm = &types.DaoTerm{EndTime: &blockTime}
+			},
+			expectedParams: func(p *types.Params, _ time.Time) {
+				p.SteeringDaoAddress = ""
+			},
+			expectedEvents: []string{types.EventTypeDaoTermExpired},
+		},
+		{
+			name: "handovers applied",
+			handovers: []types.DaoHandover{
+				{DaoRole: types.DaoRoleSteering, Height: 9, Address: newDAOAcc, Members: []string{memberAcc}},
+				{DaoRole: types.DaoRoleOversight, Height: 10},
+				{DaoRole: types.DaoRoleOversight, Height: 11, Address: newDAOAcc},
+			},
+			expectedParams: func(p *types.Params, _ time.Time) {
+				p.SteeringDaoAddress = newDAOAcc
+				p.SteeringDaoMembers = []string{memberAcc}
+				p.OversightDaoAddress = ""
+			},
+			expectedEvents: []string{types.EventTypeDaoHandover, types.EventTypeDaoHandover},
+			remaining:      1,
+		},
+		{
+			name: "handover after term ended",
+			updateParams: func(p *types.Params, blockTime time.Time) {
+				p.OversightDaoTerm = &types.DaoTerm{EndTime: &blockTime}
+			},
+			handovers: []types.DaoHandover{{DaoRole: types.DaoRoleOversight, Height: 10, Address: newDAOAcc}},
+			expectedParams: func(p *types.Params, _ time.Time) {
+				p.OversightDaoAddress = newDAOAcc
+			},
+			expectedEvents: []string{types.EventTypeDaoTermExpired, types.EventTypeDaoHandover},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _, ctx := testutil.SetupCoredaosKeeper(t)
+			ctx = ctx.WithBlockHeight(10)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc
+			params.OversightDaoAddress = oversightDAOAcc
+			if tt.updateParams != nil {
+				tt.updateParams(&params, ctx.BlockTime())
+			}
+			require.NoError(t, k.Params.Set(ctx, params))
+			require.NoError(t, k.BudgetEpoch.Set(ctx, types.BudgetEpoch{Number: 1, StartTime: ctx.BlockTime()}))
+			for _, handover := range tt.handovers {
+				require.NoError(t, k.SetDaoHandover(ctx, handover))
+			}
+
+			err := k.BeginBlocker(ctx)
+
+			require.NoError(t, err)
+			expected := types.DefaultParams()
+			expected.SteeringDaoAddress = steeringDAOAcc
+			expected.OversightDaoAddress = oversightDAOAcc
+			if tt.expectedParams != nil {
+				tt.expectedParams(&expected, ctx.BlockTime())
+			}
+			require.Equal(t, expected, k.GetParams(ctx))
+			var events []string
+			for _, event := range ctx.EventManager().Events() {
+				events = append(events, event.Type)
+			}
+			require.Equal(t, tt.expectedEvents, events)
+			handovers, err := k.GetAllDaoHandovers(ctx)
+			require.NoError(t, err)
+			require.Len(t, handovers, tt.remaining)
+		})
+	}
+}
+
+func TestBeginBlockerBudgetEpoch(t *testing.T) {
+	spent := types.BudgetSpent{
+		DaoRole: types.DaoRoleSteering,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}
+	tests := []struct {
+		name           string
+		epochDuration  time.Duration
+		epoch          func(blockTime time.Time) *types.BudgetEpoch
+		expectedEpoch  func(blockTime time.Time) types.BudgetEpoch
+		expectedEvents []string
+		expectedSpent  int
+	}{
+		{
+			name:          "first epoch",
+			epochDuration: time.Hour,
+			expectedEpoch: func(blockTime time.Time) types.BudgetEpoch {
+				return types.BudgetEpoch{Number: 1, StartTime: blockTime}
+			},
+			expectedEvents: []string{types.EventTypeBudgetEpoch},
+			expectedSpent:  1,
+		},
+		{
+			name:          "epoch not ended",
+			epochDuration: time.Hour,
+			epoch: func(blockTime time.Time) *types.BudgetEpoch {
+				return &types.BudgetEpoch{Number: 2, StartTime: blockTime.Add(-time.Minute)}
+			},
+			expectedEpoch: func(blockTime time.Time) types.BudgetEpoch {
+				return types.BudgetEpoch{Number: 2, StartTime: blockTime.Add(-time.Minute)}
+			},
+			expectedSpent: 1,
+		},
+		{
+			name:          "epoch ended",
+			epochDuration: time.Hour,
+			epoch: func(blockTime time.Time) *types.BudgetEpoch {
+				return &types.BudgetEpoch{Number: 2, StartTime: blockTime.Add(-time.Hour)}
+			},
+			expectedEpoch: func(blockTime time.Time) types.BudgetEpoch {
+				return types.BudgetEpoch{Number: 3, StartTime: blockTime}
+			},
+			expectedEvents: []string{types.EventTypeBudgetEpoch},
+		},
+		{
+			name: "epochs without reset",
+			epoch: func(blockTime time.Time) *types.BudgetEpoch {
+				return &types.BudgetEpoch{Number: 2, StartTime: blockTime.Add(-time.Hour)}
+			},
+			expectedEpoch: func(blockTime time.Time) types.BudgetEpoch {
+				return types.BudgetEpoch{Number: 2, StartTime: blockTime.Add(-time.Hour)}
+			},
+			expectedSpent: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _, ctx := testutil.SetupCoredaosKeeper(t)
+			params := types.DefaultParams()
+			params.BudgetEpochDuration = tt.epochDuration
+			require.NoError(t, k.Params.Set(ctx, params))
+			if tt.epoch != nil {
+				require.NoError(t, k.BudgetEpoch.Set(ctx, *tt.epoch(ctx.BlockTime())))
+			}
+			require.NoError(t, k.BudgetsSpent.Set(ctx, int32(spent.DaoRole), spent))
+
+			err := k.BeginBlocker(ctx)
+
+			require.NoError(t, err)
+			epoch, err := k.GetBudgetEpoch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedEpoch(ctx.BlockTime()), epoch)
+			var events []string
+			for _, event := range ctx.EventManager().Events() {
+				events = append(events, event.Type)
+			}
+			require.Equal(t, tt.expectedEvents, events)
+			budgetsSpent, err := k.GetAllBudgetsSpent(ctx)
+			require.NoError(t, err)
+			require.Len(t, budgetsSpent, tt.expectedSpent)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+// RecordAction assigns the next action ID to an action taken by a core DAO
+// and stores it.
+func (k Keeper) RecordAction(ctx context.Context, action types.Action) (uint64, error) {
+	id, err := k.ActionID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	action.Id = id
+	return id, k.SetAction(ctx, action)
+}
+
+// SetAction stores an action and indexes it by proposal ID.
+func (k Keeper) SetAction(ctx context.Context, action types.Action) error {
+	if err := k.Actions.Set(ctx, action.Id, action); err != nil {
+		return err
+	}
+	return k.ProposalActions.Set(ctx, collections.Join(action.ProposalId, action.Id))
+}
+
+// GetAllActions returns all the actions taken by the core DAOs.
+func (k Keeper) GetAllActions(ctx context.Context) ([]types.Action, error) {
+	var actions []types.Action
+	err := k.Actions.Walk(ctx, nil, func(_ uint64, action types.Action) (bool, error) {
+		actions = append(actions, action)
+		return false, nil
+	})
+	return actions, err
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+// AddAnnotation appends an annotation to the annotation history of its
+// proposal, and returns the index assigned to it.
+func (k Keeper) AddAnnotation(ctx context.Context, annotation types.Annotation) (uint64, error) {
+	latest, found, err := k.GetLatestAnnotation(ctx, annotation.ProposalId)
+	if err != nil {
+		return 0, err
+	}
+	annotation.Index = 1
+	if found {
+		annotation.Index = latest.Index + 1
+	}
+	return annotation.Index, k.Annotations.Set(ctx, collections.Join(annotation.ProposalId, annotation.Index), annotation)
+}
+
+// GetLatestAnnotation returns the latest annotation of a proposal.
+func (k Keeper) GetLatestAnnotation(ctx context.Context, proposalID uint64) (types.Annotation, bool, error) {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](proposalID).Descending()
+	iter, err := k.Annotations.Iterate(ctx, rng)
+	if err != nil {
+		return types.Annotation{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.Annotation{}, false, nil
+	}
+	annotation, err := iter.Value()
+	if err != nil {
+		return types.Annotation{}, false, err
+	}
+	return annotation, true, nil
+}
+
+// GetAllAnnotations returns the annotation history of all the proposals.
+func (k Keeper) GetAllAnnotations(ctx context.Context) ([]types.Annotation, error) {
+	var annotations []types.Annotation
+	err := k.Annotations.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], annotation types.Annotation) (bool, error) {
+		annotations = append(annotations, annotation)
+		return false, nil
+	})
+	return annotations, err
+}
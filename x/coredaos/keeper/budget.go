@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+// GetBudgetEpoch returns the current budget epoch, or an epoch numbered 0 if
+// the first epoch hasn't started yet.
+func (k Keeper) GetBudgetEpoch(ctx context.Context) (types.BudgetEpoch, error) {
+	epoch, err := k.BudgetEpoch.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.BudgetEpoch{}, nil
+	}
+	return epoch, err
+}
+
+// GetBudgetSpent returns the amount spent by a core DAO during the current
+// budget epoch.
+func (k Keeper) GetBudgetSpent(ctx context.Context, daoRole types.DaoRole) (sdk.Coins, error) {
+	spent, err := k.BudgetsSpent.Get(ctx, int32(daoRole))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	}
+	return spent.Amount, err
+}
+
+// GetRemainingBudget returns the amount a core DAO can still spend during the
+// current budget epoch.
+func (k Keeper) GetRemainingBudget(ctx context.Context, params types.Params, daoRole types.DaoRole) (sdk.Coins, error) {
+	spent, err := k.GetBudgetSpent(ctx, daoRole)
+	if err != nil {
+		return nil, err
+	}
+	return types.RemainingBudget(params.GetDaoBudget(daoRole), spent), nil
+}
+
+// SpendFromBudget sends an amount from the community pool to the recipient of
+// the spend, adds it to the amount spent by the core DAO during the current
+// budget epoch and records the spend. It returns the ID of the spend.
+func (k Keeper) SpendFromBudget(ctx context.Context, spend types.Spend) (uint64, error) {
+	recipient, err := sdk.AccAddressFromBech32(spend.Recipient)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, spend.Amount, recipient); err != nil {
+		return 0, err
+	}
+
+	spent, err := k.GetBudgetSpent(ctx, spend.DaoRole)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.BudgetsSpent.Set(ctx, int32(spend.DaoRole), types.BudgetSpent{
+		DaoRole: spend.DaoRole,
+		Amount:  spent.Add(spend.Amount...),
+	}); err != nil {
+		return 0, err
+	}
+
+	id, err := k.SpendID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	spend.Id = id
+	return id, k.Spends.Set(ctx, id, spend)
+}
+
+// GetAllBudgetsSpent returns the amounts spent by the core DAOs during the
+// current budget epoch.
+func (k Keeper) GetAllBudgetsSpent(ctx context.Context) ([]types.BudgetSpent, error) {
+	var budgetsSpent []types.BudgetSpent
+	err := k.BudgetsSpent.Walk(ctx, nil, func(_ int32, spent types.BudgetSpent) (bool, error) {
+		budgetsSpent = append(budgetsSpent, spent)
+		return false, nil
+	})
+	return budgetsSpent, err
+}
+
+// GetAllSpends returns the spend history of the core DAOs.
+func (k Keeper) GetAllSpends(ctx context.Context) ([]types.Spend, error) {
+	var spends []types.Spend
+	err := k.Spends.Walk(ctx, nil, func(_ uint64, spend types.Spend) (bool, error) {
+		spends = append(spends, spend)
+		return false, nil
+	})
+	return spends, err
+}
+
+// AdvanceBudgetEpoch starts the first budget epoch, or a new budget epoch once
+// the current one has ended, in which case the amounts spent by the core DAOs
+// are reset.
+func (k Keeper) AdvanceBudgetEpoch(ctx sdk.Context) error {
+	epoch, err := k.GetBudgetEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if epoch.Number != 0 {
+		endTime, resets := epoch.EndTime(k.GetParams(ctx).BudgetEpochDuration)
+		if !resets || ctx.BlockTime().Before(endTime) {
+			return nil
+		}
+		if err := k.BudgetsSpent.Clear(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	epoch = types.BudgetEpoch{Number: epoch.Number + 1, StartTime: ctx.BlockTime()}
+	if err := k.BudgetEpoch.Set(ctx, epoch); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("budget epoch started", "epoch", epoch.Number)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBudgetEpoch,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+		),
+	)
+	return nil
+}
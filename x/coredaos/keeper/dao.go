@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+// activeDaoSigners returns the signers of a core DAO if its term is active,
+// nil otherwise.
+func activeDaoSigners(ctx sdk.Context, params types.Params, daoRole types.DaoRole) []string {
+	if !params.GetDaoTerm(daoRole).IsActive(ctx.BlockTime()) {
+		return nil
+	}
+	return params.DaoSigners(daoRole)
+}
+
+// hasStake returns whether an address has bonded or unbonding tokens.
+func (k Keeper) hasStake(ctx context.Context, address string) (bool, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false, err
+	}
+	delegatorBonded, err := k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	delegatorUnbonding, err := k.stakingKeeper.GetDelegatorUnbonding(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	return delegatorBonded.IsPositive() || delegatorUnbonding.IsPositive(), nil
+}
+
+// SetDaoHandover stores a scheduled handover of a core DAO.
+func (k Keeper) SetDaoHandover(ctx context.Context, handover types.DaoHandover) error {
+	return k.DaoHandovers.Set(ctx, collections.Join(handover.Height, int32(handover.DaoRole)), handover)
+}
+
+// GetAllDaoHandovers returns the scheduled handovers of the core DAOs.
+func (k Keeper) GetAllDaoHandovers(ctx context.Context) ([]types.DaoHandover, error) {
+	var handovers []types.DaoHandover
+	err := k.DaoHandovers.Walk(ctx, nil, func(_ collections.Pair[int64, int32], handover types.DaoHandover) (bool, error) {
+		handovers = append(handovers, handover)
+		return false, nil
+	})
+	return handovers, err
+}
+
+// countDaoHandovers returns the number of scheduled handovers of a core DAO.
+func (k Keeper) countDaoHandovers(ctx context.Context, daoRole types.DaoRole) (int, error) {
+	count := 0
+	err := k.DaoHandovers.Walk(ctx, nil, func(_ collections.Pair[int64, int32], handover types.DaoHandover) (bool, error) {
+		if handover.DaoRole == daoRole {
+			count++
+		}
+		return false, nil
+	})
+	return count, err
+}
+
+// ExpireDaoTerms disables the core DAOs whose term has ended, removing their
+// address, members and term from the params.
+func (k Keeper) ExpireDaoTerms(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	var expired []types.DaoRole
+	for _, daoRole := range []types.DaoRole{types.DaoRoleSteering, types.DaoRoleOversight} {
+		if params.GetDaoTerm(daoRole).HasEnded(ctx.BlockTime()) {
+			params.SetDao(daoRole, "", nil, nil)
+			expired = append(expired, daoRole)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	for _, daoRole := range expired {
+		k.Logger(ctx).Info("core DAO term expired", "dao", daoRole)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDaoTermExpired,
+				sdk.NewAttribute(types.AttributeKeyDaoRole, daoRole.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// ApplyDaoHandovers hands the core DAOs over to the address, members and
+// term of their handovers scheduled up to the current height.
+func (k Keeper) ApplyDaoHandovers(ctx sdk.Context) error {
+	rng := new(collections.Range[collections.Pair[int64, int32]]).
+		EndExclusive(collections.Join(ctx.BlockHeight()+1, int32(0)))
+	var handovers []types.DaoHandover
+	if err := k.DaoHandovers.Walk(ctx, rng, func(_ collections.Pair[int64, int32], handover types.DaoHandover) (bool, error) {
+		handovers = append(handovers, handover)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if len(handovers) == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, handover := range handovers {
+		if err := k.DaoHandovers.Remove(ctx, collections.Join(handover.Height, int32(handover.DaoRole))); err != nil {
+			return err
+		}
+		params.SetDao(handover.DaoRole, handover.Address, handover.Members, handover.Term)
+
+		k.Logger(ctx).Info(
+			"core DAO handed over",
+			"dao", handover.DaoRole,
+			"address", handover.Address,
+			"members", strings.Join(handover.Members, ","),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDaoHandover,
+				sdk.NewAttribute(types.AttributeKeyDaoRole, handover.DaoRole.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", handover.Height)),
+				sdk.NewAttribute(types.AttributeKeyDaoAddress, handover.Address),
+			),
+		)
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// validateDelegation checks if the delegation is being performed by a signer
+// of a core DAO, or by a signer of a scheduled handover of a core DAO, and
+// returns an error if it is, since by Constitution, core DAOs cannot stake.
+func (k Keeper) validateDelegation(ctx context.Context, delAddr sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	address := delAddr.String()
+	if params.IsDaoSigner(types.DaoRoleSteering, address) {
+		return sdkerrors.Wrap(types.ErrCannotStake, "Steering DAO cannot stake")
+	}
+	if params.IsDaoSigner(types.DaoRoleOversight, address) {
+		return sdkerrors.Wrap(types.ErrCannotStake, "Oversight DAO cannot stake")
+	}
+
+	var scheduled bool
+	err := k.DaoHandovers.Walk(ctx, nil, func(_ collections.Pair[int64, int32], handover types.DaoHandover) (bool, error) {
+		for _, signer := range handover.Signers() {
+			if signer == address {
+				scheduled = true
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if scheduled {
+		return sdkerrors.Wrap(types.ErrCannotStake, "scheduled core DAO signer cannot stake")
+	}
+	return nil
+}
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
 )
@@ -29,3 +33,177 @@ func (k Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+// Actions queries the actions taken by the core DAOs, optionally filtered by
+// proposal, core DAO and action type.
+func (k Querier) Actions(goCtx context.Context, req *types.QueryActionsRequest) (*types.QueryActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actions, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.Actions, req.Pagination,
+		func(_ uint64, action types.Action) (bool, error) {
+			if req.ProposalId != 0 && action.ProposalId != req.ProposalId {
+				return false, nil
+			}
+			if req.DaoRole != types.DaoRoleUnspecified && action.DaoRole != req.DaoRole {
+				return false, nil
+			}
+			if req.ActionType != types.ActionTypeUnspecified && action.ActionType != req.ActionType {
+				return false, nil
+			}
+			return true, nil
+		},
+		func(_ uint64, action types.Action) (types.Action, error) {
+			return action, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// ProposalActions queries the actions taken by the core DAOs on a proposal.
+func (k Querier) ProposalActions(goCtx context.Context, req *types.QueryProposalActionsRequest) (*types.QueryProposalActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.ProposalActions, req.Pagination,
+		func(key collections.Pair[uint64, uint64], _ collections.NoValue) (types.Action, error) {
+			return k.Keeper.Actions.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ProposalId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// Annotations queries the annotation history of a proposal.
+func (k Querier) Annotations(goCtx context.Context, req *types.QueryAnnotationsRequest) (*types.QueryAnnotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	annotations, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Annotations, req.Pagination,
+		func(_ collections.Pair[uint64, uint64], annotation types.Annotation) (types.Annotation, error) {
+			return annotation, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ProposalId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAnnotationsResponse{Annotations: annotations, Pagination: pageRes}, nil
+}
+
+// Veto queries the veto of a proposal.
+func (k Querier) Veto(goCtx context.Context, req *types.QueryVetoRequest) (*types.QueryVetoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	veto, err := k.Keeper.Vetoes.Get(ctx, req.ProposalId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "veto of proposal %d doesn't exist", req.ProposalId)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVetoResponse{Veto: veto}, nil
+}
+
+// DaoHandovers queries the scheduled handovers of the core DAOs.
+func (k Querier) DaoHandovers(goCtx context.Context, req *types.QueryDaoHandoversRequest) (*types.QueryDaoHandoversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	handovers, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.DaoHandovers, req.Pagination,
+		func(_ collections.Pair[int64, int32], handover types.DaoHandover) (types.DaoHandover, error) {
+			return handover, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDaoHandoversResponse{Handovers: handovers, Pagination: pageRes}, nil
+}
+
+// Budget queries the budget of a core DAO for the current budget epoch, and
+// the amount remaining to spend.
+func (k Querier) Budget(goCtx context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.DaoRole != types.DaoRoleSteering && req.DaoRole != types.DaoRoleOversight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid DAO role: %s", req.DaoRole)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	epoch, err := k.GetBudgetEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	spent, err := k.GetBudgetSpent(ctx, req.DaoRole)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	budget := params.GetDaoBudget(req.DaoRole)
+
+	resp := &types.QueryBudgetResponse{
+		Budget:    budget,
+		Spent:     spent,
+		Remaining: types.RemainingBudget(budget, spent),
+		Epoch:     epoch,
+	}
+	if endTime, resets := epoch.EndTime(params.BudgetEpochDuration); resets && epoch.Number != 0 {
+		resp.EpochEndTime = &endTime
+	}
+	return resp, nil
+}
+
+// Spends queries the spend history of the core DAOs, optionally filtered by
+// core DAO.
+func (k Querier) Spends(goCtx context.Context, req *types.QuerySpendsRequest) (*types.QuerySpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spends, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.Spends, req.Pagination,
+		func(_ uint64, spend types.Spend) (bool, error) {
+			return req.DaoRole == types.DaoRoleUnspecified || spend.DaoRole == req.DaoRole, nil
+		},
+		func(_ uint64, spend types.Spend) (types.Spend, error) {
+			return spend, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendsResponse{Spends: spends, Pagination: pageRes}, nil
+}
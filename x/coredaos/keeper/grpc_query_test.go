@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/keeper"
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,3 +23,187 @@ func TestParamsQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, resp)
 }
+
+func TestActionsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(2)
+	steeringDAO, oversightDAO := addrs[0].String(), addrs[1].String()
+	recorded := []types.Action{
+		types.NewAction(1, steeringDAO, types.DaoRoleSteering, types.ActionTypeAnnotateProposal, 10, ctx.BlockTime()),
+		types.NewAction(1, oversightDAO, types.DaoRoleOversight, types.ActionTypeExtendVotingPeriod, 11, ctx.BlockTime()),
+		types.NewAction(2, steeringDAO, types.DaoRoleSteering, types.ActionTypeEndorseProposal, 12, ctx.BlockTime()),
+		types.NewAction(1, oversightDAO, types.DaoRoleOversight, types.ActionTypeVetoProposal, 13, ctx.BlockTime()),
+	}
+	for i := range recorded {
+		id, err := k.RecordAction(ctx, recorded[i])
+		require.NoError(t, err)
+		recorded[i].Id = id
+	}
+	q := keeper.NewQuerier(*k)
+
+	tests := []struct {
+		name       string
+		req        *types.QueryActionsRequest
+		expActions []types.Action
+	}{
+		{
+			name:       "all actions",
+			req:        &types.QueryActionsRequest{},
+			expActions: recorded,
+		},
+		{
+			name:       "filter by proposal",
+			req:        &types.QueryActionsRequest{ProposalId: 2},
+			expActions: []types.Action{recorded[2]},
+		},
+		{
+			name:       "filter by dao",
+			req:        &types.QueryActionsRequest{DaoRole: types.DaoRoleOversight},
+			expActions: []types.Action{recorded[1], recorded[3]},
+		},
+		{
+			name:       "filter by proposal, dao and action type",
+			req:        &types.QueryActionsRequest{ProposalId: 1, DaoRole: types.DaoRoleOversight, ActionType: types.ActionTypeVetoProposal},
+			expActions: []types.Action{recorded[3]},
+		},
+		{
+			name: "no match",
+			req:  &types.QueryActionsRequest{ProposalId: 2, ActionType: types.ActionTypeVetoProposal},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := q.Actions(ctx, tt.req)
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.expActions, resp.Actions)
+		})
+	}
+
+	resp, err := q.ProposalActions(ctx, &types.QueryProposalActionsRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.Action{recorded[0], recorded[1], recorded[3]}, resp.Actions)
+
+	_, err = q.ProposalActions(ctx, &types.QueryProposalActionsRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = proposal id can not be 0")
+}
+
+func TestAnnotationsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	steeringDAO := simtestutil.CreateRandomAccounts(1)[0].String()
+	var expAnnotations []types.Annotation
+	for i, annotation := range []types.Annotation{
+		types.NewAnnotation(1, steeringDAO, "First", ctx.BlockTime(), 0),
+		types.NewAnnotation(2, steeringDAO, "Other proposal", ctx.BlockTime(), 0),
+		types.NewAnnotation(1, steeringDAO, "Second", ctx.BlockTime(), 1),
+	} {
+		index, err := k.AddAnnotation(ctx, annotation)
+		require.NoError(t, err)
+		annotation.Index = index
+		if i != 1 {
+			expAnnotations = append(expAnnotations, annotation)
+		}
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.Annotations(ctx, &types.QueryAnnotationsRequest{ProposalId: 1})
+
+	require.NoError(t, err)
+	require.Equal(t, expAnnotations, resp.Annotations)
+
+	_, err = q.Annotations(ctx, &types.QueryAnnotationsRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = proposal id can not be 0")
+}
+
+func TestVetoQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	oversightDAO := simtestutil.CreateRandomAccounts(1)[0].String()
+	veto := types.NewVeto(1, oversightDAO, "reason", false, ctx.BlockTime(), types.VetoStatusUpheld, govtypesv1.StatusVotingPeriod, 0)
+	require.NoError(t, k.SetVeto(ctx, veto))
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.Veto(ctx, &types.QueryVetoRequest{ProposalId: 1})
+
+	require.NoError(t, err)
+	require.Equal(t, veto, resp.Veto)
+
+	_, err = q.Veto(ctx, &types.QueryVetoRequest{ProposalId: 2})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = veto of proposal 2 doesn't exist")
+	_, err = q.Veto(ctx, &types.QueryVetoRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = proposal id can not be 0")
+}
+
+func TestDaoHandoversQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(2)
+	handovers := []types.DaoHandover{
+		{DaoRole: types.DaoRoleSteering, Height: 10, Address: addrs[0].String(), Scheduler: addrs[1].String()},
+		{DaoRole: types.DaoRoleOversight, Height: 10, Scheduler: addrs[1].String()},
+		{DaoRole: types.DaoRoleSteering, Height: 20, Address: addrs[1].String(), Scheduler: addrs[0].String()},
+	}
+	// handovers are stored out of order and returned by activation height
+	for _, i := range []int{2, 1, 0} {
+		require.NoError(t, k.SetDaoHandover(ctx, handovers[i]))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.DaoHandovers(ctx, &types.QueryDaoHandoversRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, handovers, resp.Handovers)
+}
+
+func TestBudgetQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	params := types.DefaultParams()
+	params.SteeringDaoBudget = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, k.Params.Set(ctx, params))
+	epoch := types.BudgetEpoch{Number: 3, StartTime: ctx.BlockTime()}
+	require.NoError(t, k.BudgetEpoch.Set(ctx, epoch))
+	require.NoError(t, k.BudgetsSpent.Set(ctx, int32(types.DaoRoleSteering), types.BudgetSpent{
+		DaoRole: types.DaoRoleSteering,
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)),
+	}))
+	q := keeper.NewQuerier(*k)
+
+	_, err := q.Budget(ctx, &types.QueryBudgetRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid DAO role: DAO_ROLE_UNSPECIFIED")
+
+	resp, err := q.Budget(ctx, &types.QueryBudgetRequest{DaoRole: types.DaoRoleSteering})
+	require.NoError(t, err)
+	require.Equal(t, params.SteeringDaoBudget, resp.Budget)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)), resp.Spent)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), resp.Remaining)
+	require.Equal(t, epoch, resp.Epoch)
+	require.NotNil(t, resp.EpochEndTime)
+	require.Equal(t, ctx.BlockTime().Add(params.BudgetEpochDuration), *resp.EpochEndTime)
+
+	resp, err = q.Budget(ctx, &types.QueryBudgetRequest{DaoRole: types.DaoRoleOversight})
+	require.NoError(t, err)
+	require.True(t, resp.Budget.IsZero())
+	require.True(t, resp.Remaining.IsZero())
+}
+
+func TestSpendsQuery(t *testing.T) {
+	k, _, ctx := testutil.SetupCoredaosKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(3)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	spends := []types.Spend{
+		types.NewSpend(types.DaoRoleSteering, addrs[0].String(), addrs[2].String(), amount, "first", 1, ctx.BlockTime()),
+		types.NewSpend(types.DaoRoleOversight, addrs[1].String(), addrs[2].String(), amount, "", 1, ctx.BlockTime()),
+		types.NewSpend(types.DaoRoleSteering, addrs[0].String(), addrs[2].String(), amount, "second", 2, ctx.BlockTime()),
+	}
+	for i := range spends {
+		spends[i].Id = uint64(i + 1)
+		require.NoError(t, k.Spends.Set(ctx, spends[i].Id, spends[i]))
+	}
+	q := keeper.NewQuerier(*k)
+
+	resp, err := q.Spends(ctx, &types.QuerySpendsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, spends, resp.Spends)
+
+	resp, err = q.Spends(ctx, &types.QuerySpendsRequest{DaoRole: types.DaoRoleSteering})
+	require.NoError(t, err)
+	require.Equal(t, []types.Spend{spends[0], spends[2]}, resp.Spends)
+}
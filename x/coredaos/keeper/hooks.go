@@ -3,13 +3,10 @@ package keeper
 import (
 	"context"
 
-	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-
-	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
 )
 
 // Hooks wrapper struct for gov keeper
@@ -26,14 +23,12 @@ func (k Keeper) StakingHooks() Hooks {
 
 // BeforeDelegationSharesModified is called before a delegation's shares are modified
 func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
-	params := h.k.GetParams(ctx)
-	return validateDelegation(params, delAddr)
+	return h.k.validateDelegation(ctx, delAddr)
 }
 
 // BeforeDelegationCreated is called before a delegation is created
 func (h Hooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
-	params := h.k.GetParams(ctx)
-	return validateDelegation(params, delAddr)
+	return h.k.validateDelegation(ctx, delAddr)
 }
 
 func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
@@ -71,21 +66,3 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.Co
 func (h Hooks) AfterUnbondingInitiated(ctx context.Context, unbondingID uint64) error {
 	return nil
 }
-
-// validateDelegation checks if the delegation is being performed by a core DAO
-// and returns an error if it is, since by Constitution, core DAOs cannot stake.
-func validateDelegation(params types.Params, delAddr sdk.AccAddress) error {
-	if params.GetSteeringDaoAddress() != "" {
-		steeringDaoAddr := sdk.MustAccAddressFromBech32(params.GetSteeringDaoAddress())
-		if delAddr.Equals(steeringDaoAddr) {
-			return sdkerrors.Wrap(types.ErrCannotStake, "Steering DAO cannot stake")
-		}
-	}
-	if params.GetOversightDaoAddress() != "" {
-		oversightDaoAddr := sdk.MustAccAddressFromBech32(params.GetOversightDaoAddress())
-		if delAddr.Equals(oversightDaoAddr) {
-			return sdkerrors.Wrap(types.ErrCannotStake, "Oversight DAO cannot stake")
-		}
-	}
-	return nil
-}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -18,9 +20,39 @@ type Keeper struct {
 
 	govKeeper     types.GovKeeper
 	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// ActionID is the sequence of the action IDs
+	ActionID collections.Sequence
+	// Actions maps the action IDs to the actions taken by the core DAOs
+	Actions collections.Map[uint64, types.Action]
+	// ProposalActions indexes the action IDs by proposal ID
+	ProposalActions collections.KeySet[collections.Pair[uint64, uint64]]
+	// Annotations maps the proposal IDs and annotation indexes to the
+	// annotation history of the proposals
+	Annotations collections.Map[collections.Pair[uint64, uint64], types.Annotation]
+	// Vetoes maps the proposal IDs to the vetoes of the proposals
+	Vetoes collections.Map[uint64, types.Veto]
+	// VetoQueue indexes the proposal IDs of the pending vetoes by the end of
+	// their challenge window or override vote
+	VetoQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// VetoOverrideVotes maps the proposal IDs and voter addresses to the votes
+	// of the ongoing veto override votes
+	VetoOverrideVotes collections.Map[collections.Pair[uint64, sdk.AccAddress], types.VetoOverrideVote]
+	// DaoHandovers maps the heights and DAO roles to the scheduled handovers of
+	// the core DAOs
+	DaoHandovers collections.Map[collections.Pair[int64, int32], types.DaoHandover]
+	// BudgetEpoch is the current budget epoch of the core DAOs
+	BudgetEpoch collections.Item[types.BudgetEpoch]
+	// BudgetsSpent maps the DAO roles to the amounts spent by the core DAOs
+	// during the current budget epoch
+	BudgetsSpent collections.Map[int32, types.BudgetSpent]
+	// SpendID is the sequence of the spend IDs
+	SpendID collections.Sequence
+	// Spends maps the spend IDs to the spend history of the core DAOs
+	Spends collections.Map[uint64, types.Spend]
 }
 
 func NewKeeper(
@@ -29,6 +61,7 @@ func NewKeeper(
 	authority string,
 	govKeeper types.GovKeeper,
 	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(err)
@@ -41,7 +74,35 @@ func NewKeeper(
 		authority:     authority,
 		govKeeper:     govKeeper,
 		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ActionID:      collections.NewSequence(sb, types.ActionIDKey, "action_id"),
+		Actions:       collections.NewMap(sb, types.ActionsKey, "actions", collections.Uint64Key, codec.CollValue[types.Action](cdc)),
+		ProposalActions: collections.NewKeySet(
+			sb, types.ProposalActionsKey, "proposal_actions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		Annotations: collections.NewMap(
+			sb, types.AnnotationsKey, "annotations", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.Annotation](cdc),
+		),
+		Vetoes: collections.NewMap(sb, types.VetoesKey, "vetoes", collections.Uint64Key, codec.CollValue[types.Veto](cdc)),
+		VetoQueue: collections.NewKeySet(
+			sb, types.VetoQueueKey, "veto_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		VetoOverrideVotes: collections.NewMap(
+			sb, types.VetoOverrideVotesKey, "veto_override_votes", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+			codec.CollValue[types.VetoOverrideVote](cdc),
+		),
+		DaoHandovers: collections.NewMap(
+			sb, types.DaoHandoversKey, "dao_handovers", collections.PairKeyCodec(collections.Int64Key, collections.Int32Key),
+			codec.CollValue[types.DaoHandover](cdc),
+		),
+		BudgetEpoch: collections.NewItem(sb, types.BudgetEpochKey, "budget_epoch", codec.CollValue[types.BudgetEpoch](cdc)),
+		BudgetsSpent: collections.NewMap(
+			sb, types.BudgetsSpentKey, "budgets_spent", collections.Int32Key, codec.CollValue[types.BudgetSpent](cdc),
+		),
+		SpendID: collections.NewSequence(sb, types.SpendIDKey, "spend_id"),
+		Spends:  collections.NewMap(sb, types.SpendsKey, "spends", collections.Uint64Key, codec.CollValue[types.Spend](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -3,9 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
-	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -35,32 +37,22 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	}
 
 	params := msg.Params
-	// check if any of the core DAOs has bonded or unbonding tokens, and if so return an error
-	if params.SteeringDaoAddress != "" {
-		delegatorBonded, err := ms.k.stakingKeeper.GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(params.SteeringDaoAddress))
+	// check if any of the signers of the core DAOs has bonded or unbonding tokens, and if so return an error
+	for _, signer := range params.DaoSigners(types.DaoRoleSteering) {
+		staked, err := ms.k.hasStake(ctx, signer)
 		if err != nil {
 			return nil, err
 		}
-		delegatorUnbonded, err := ms.k.stakingKeeper.GetDelegatorUnbonding(ctx, sdk.MustAccAddressFromBech32(params.SteeringDaoAddress))
-		if err != nil {
-			return nil, err
-		}
-		if delegatorBonded.GT(math.ZeroInt()) ||
-			delegatorUnbonded.GT(math.ZeroInt()) {
+		if staked {
 			return nil, errors.Wrapf(types.ErrCannotStake, "cannot update params while Steering DAO have bonded or unbonding tokens")
 		}
 	}
-	if params.OversightDaoAddress != "" {
-		delegatorBonded, err := ms.k.stakingKeeper.GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(params.OversightDaoAddress))
-		if err != nil {
-			return nil, err
-		}
-		delegatorUnbonded, err := ms.k.stakingKeeper.GetDelegatorUnbonding(ctx, sdk.MustAccAddressFromBech32(params.OversightDaoAddress))
+	for _, signer := range params.DaoSigners(types.DaoRoleOversight) {
+		staked, err := ms.k.hasStake(ctx, signer)
 		if err != nil {
 			return nil, err
 		}
-		if delegatorBonded.GT(math.ZeroInt()) ||
-			delegatorUnbonded.GT(math.ZeroInt()) {
+		if staked {
 			return nil, errors.Wrapf(types.ErrCannotStake, "cannot update params while Oversight DAO have bonded or unbonding tokens")
 		}
 	}
@@ -74,6 +66,8 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 // AnnotateProposal adds an annotation to the proposal with the given ID.
 // The annotation is a string that can be used to provide additional context or information about the proposal.
 // The proposal must be in the voting period for the annotation to be added.
+// Annotations are appended to the annotation history of the proposal, and the latest annotation is also set
+// on the proposal.
 // It is only available to the Steering DAO.
 func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnotateProposal) (*types.MsgAnnotateProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -87,14 +81,21 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Steering DAO address is not set")
 	}
 
-	if msg.Annotator != params.SteeringDaoAddress {
+	if !params.SteeringDaoTerm.IsActive(ctx.BlockTime()) {
+		logger.Info("Steering DAO term is not active, function is disabled")
+
+		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Steering DAO term is not active")
+	}
+
+	if !params.IsDaoSigner(types.DaoRoleSteering, msg.Annotator) {
+		expected := strings.Join(params.DaoSigners(types.DaoRoleSteering), " or ")
 		logger.Error(
 			"invalid authority for annotating proposal",
-			"expected", params.SteeringDaoAddress,
+			"expected", expected,
 			"got", msg.Annotator,
 		)
 
-		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", params.SteeringDaoAddress, msg.Annotator)
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expected, msg.Annotator)
 	}
 
 	proposal, found := ms.k.govKeeper.GetProposal(ctx, msg.ProposalId)
@@ -118,8 +119,24 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 		return nil, errors.Wrapf(govtypes.ErrInactiveProposal, "proposal with ID %d is not in voting period", msg.ProposalId)
 	}
 
+	if params.MaxAnnotationLength != 0 && uint64(len(msg.Annotation)) > params.MaxAnnotationLength {
+		logger.Error(
+			"annotation exceeds the maximum annotation length",
+			"proposal", proposal.Id,
+			"length", len(msg.Annotation),
+			"authority", msg.Annotator,
+		)
+
+		return nil, errors.Wrapf(types.ErrAnnotationTooLong, "annotation length %d exceeds the maximum of %d", len(msg.Annotation), params.MaxAnnotationLength)
+	}
+
+	latest, found, err := ms.k.GetLatestAnnotation(ctx, proposal.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the proposal already has an annotation, if so, allow overwriting only if the `overwrite` flag is set to true.
-	if proposal.Annotation != "" && !msg.Overwrite {
+	if (proposal.Annotation != "" || found) && !msg.Overwrite {
 		logger.Error(
 			"proposal already has an annotation and overwrite is set to false",
 			"proposal", proposal.Id,
@@ -129,9 +146,42 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 		return nil, errors.Wrapf(types.ErrAnnotationAlreadyPresent, "proposal with ID %d already has an annotation", msg.ProposalId)
 	}
 
+	if params.MaxAnnotationsPerProposal != 0 && latest.Index >= uint64(params.MaxAnnotationsPerProposal) {
+		logger.Error(
+			"proposal has reached the maximum number of annotations",
+			"proposal", proposal.Id,
+			"authority", msg.Annotator,
+		)
+
+		return nil, errors.Wrapf(types.ErrTooManyAnnotations, "proposal with ID %d has reached the maximum of %d annotations", msg.ProposalId, params.MaxAnnotationsPerProposal)
+	}
+
+	// An overwriting annotation supersedes the latest annotation, unless another one is specified.
+	var supersedes uint64
+	if msg.Overwrite && found {
+		supersedes = latest.Index
+		if msg.Supersedes != 0 {
+			if msg.Supersedes > latest.Index {
+				return nil, errors.Wrapf(types.ErrUnknownAnnotation, "proposal with ID %d has no annotation %d", msg.ProposalId, msg.Supersedes)
+			}
+			supersedes = msg.Supersedes
+		}
+	}
+
+	index, err := ms.k.AddAnnotation(ctx, types.NewAnnotation(proposal.Id, msg.Annotator, msg.Annotation, ctx.BlockTime(), supersedes))
+	if err != nil {
+		return nil, err
+	}
+
 	proposal.Annotation = msg.Annotation
 	ms.k.govKeeper.SetProposal(ctx, proposal)
 
+	action := types.NewAction(proposal.Id, msg.Annotator, types.DaoRoleSteering, types.ActionTypeAnnotateProposal, ctx.BlockHeight(), ctx.BlockTime())
+	action.Annotation = msg.Annotation
+	if _, err := ms.k.RecordAction(ctx, action); err != nil {
+		return nil, err
+	}
+
 	logger.Info(
 		"proposal annotated",
 		"proposal", proposal.Id,
@@ -144,6 +194,8 @@ func (ms MsgServer) AnnotateProposal(goCtx context.Context, msg *types.MsgAnnota
 			types.EventTypeAnnotateProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
 			sdk.NewAttribute(types.AttributeKeySigner, msg.Annotator),
+			sdk.NewAttribute(types.AttributeKeyAnnotationIdx, fmt.Sprintf("%d", index)),
+			sdk.NewAttribute(types.AttributeKeySupersedes, fmt.Sprintf("%d", supersedes)),
 		),
 	})
 
@@ -165,14 +217,21 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Steering DAO address is not set")
 	}
 
-	if msg.Endorser != params.SteeringDaoAddress {
+	if !params.SteeringDaoTerm.IsActive(ctx.BlockTime()) {
+		logger.Info("Steering DAO term is not active, function is disabled")
+
+		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Steering DAO term is not active")
+	}
+
+	if !params.IsDaoSigner(types.DaoRoleSteering, msg.Endorser) {
+		expected := strings.Join(params.DaoSigners(types.DaoRoleSteering), " or ")
 		logger.Error(
 			"invalid authority for endorsing proposal",
-			"expected", params.SteeringDaoAddress,
+			"expected", expected,
 			"got", msg.Endorser,
 		)
 
-		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", params.SteeringDaoAddress, msg.Endorser)
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expected, msg.Endorser)
 	}
 
 	proposal, found := ms.k.govKeeper.GetProposal(ctx, msg.ProposalId)
@@ -208,6 +267,11 @@ func (ms MsgServer) EndorseProposal(goCtx context.Context, msg *types.MsgEndorse
 	proposal.Endorsed = true
 	ms.k.govKeeper.SetProposal(ctx, proposal)
 
+	action := types.NewAction(proposal.Id, msg.Endorser, types.DaoRoleSteering, types.ActionTypeEndorseProposal, ctx.BlockHeight(), ctx.BlockTime())
+	if _, err := ms.k.RecordAction(ctx, action); err != nil {
+		return nil, err
+	}
+
 	logger.Info(
 		"proposal endorsed",
 		"proposal", proposal.Id,
@@ -243,14 +307,12 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Steering DAO address and Oversight DAO address are not set")
 	}
 
-	if msg.Extender != params.SteeringDaoAddress && msg.Extender != params.OversightDaoAddress {
-		// one of the two addresses must be set otherwise it would have been caught earlier
-		addressesString := fmt.Sprintf("%s or %s", params.SteeringDaoAddress, params.OversightDaoAddress)
-		if params.SteeringDaoAddress == "" {
-			addressesString = params.OversightDaoAddress
-		} else if params.OversightDaoAddress == "" {
-			addressesString = params.SteeringDaoAddress
-		}
+	// only the core DAOs whose term is active can extend the voting period
+	steeringDaoSigners := activeDaoSigners(ctx, params, types.DaoRoleSteering)
+	oversightDaoSigners := activeDaoSigners(ctx, params, types.DaoRoleOversight)
+	isSteeringDaoSigner := slices.Contains(steeringDaoSigners, msg.Extender)
+	if !isSteeringDaoSigner && !slices.Contains(oversightDaoSigners, msg.Extender) {
+		addressesString := strings.Join(append(steeringDaoSigners, oversightDaoSigners...), " or ")
 
 		logger.Error(
 			"invalid authority for extending voting period",
@@ -302,6 +364,15 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 	proposal.TimesVotingPeriodExtended++
 	ms.k.govKeeper.SetProposal(ctx, proposal)
 
+	daoRole := types.DaoRoleSteering
+	if !isSteeringDaoSigner {
+		daoRole = types.DaoRoleOversight
+	}
+	action := types.NewAction(proposal.Id, msg.Extender, daoRole, types.ActionTypeExtendVotingPeriod, ctx.BlockHeight(), ctx.BlockTime())
+	if _, err := ms.k.RecordAction(ctx, action); err != nil {
+		return nil, err
+	}
+
 	logger.Info(
 		"voting period extended",
 		"proposal", proposal.Id,
@@ -325,8 +396,12 @@ func (ms MsgServer) ExtendVotingPeriod(goCtx context.Context, msg *types.MsgExte
 }
 
 // VetoProposal allows the signer to veto a proposal.
-// The proposal must be in the voting period, and the signer must be the designated Oversight DAO.
-// If the proposal is vetoed, it will be removed from the active proposals queue and rejected.
+// The proposal must be in the voting period or pending execution, and the signer must be the
+// designated Oversight DAO.
+// If the proposal is vetoed, it will be removed from the active proposals queue, or from the
+// execution queue, and its messages will never be executed.
+// The veto must give a reason. If the veto challenge window is set, the veto can be challenged
+// by the stakers during the window, and the proposal is restored if the veto is overridden.
 func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoProposal) (*types.MsgVetoProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := ms.k.GetParams(ctx)
@@ -339,14 +414,21 @@ func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoPropos
 		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Oversight DAO address is not set")
 	}
 
-	if msg.Vetoer != params.OversightDaoAddress {
+	if !params.OversightDaoTerm.IsActive(ctx.BlockTime()) {
+		logger.Info("Oversight DAO term is not active, function is disabled")
+
+		return nil, errors.Wrapf(types.ErrFunctionDisabled, "Oversight DAO term is not active")
+	}
+
+	if !params.IsDaoSigner(types.DaoRoleOversight, msg.Vetoer) {
+		expected := strings.Join(params.DaoSigners(types.DaoRoleOversight), " or ")
 		logger.Error(
 			"invalid authority for vetoing proposal",
-			"expected", params.OversightDaoAddress,
+			"expected", expected,
 			"got", msg.Vetoer,
 		)
 
-		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", params.OversightDaoAddress, msg.Vetoer)
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expected, msg.Vetoer)
 	}
 
 	proposal, found := ms.k.govKeeper.GetProposal(ctx, msg.ProposalId)
@@ -359,52 +441,498 @@ func (ms MsgServer) VetoProposal(goCtx context.Context, msg *types.MsgVetoPropos
 
 		return nil, errors.Wrapf(govtypes.ErrUnknownProposal, "proposal with ID %d not found", msg.ProposalId)
 	}
-	if proposal.Status != govtypesv1.StatusVotingPeriod {
+	if proposal.Status != govtypesv1.StatusVotingPeriod && proposal.Status != govtypesv1.StatusPassedPendingExecution {
 		logger.Error(
-			"proposal is not in voting period",
+			"proposal is neither in voting period nor pending execution",
 			"proposal", proposal.Id,
 			"status", proposal.Status,
 			"authority", msg.Vetoer,
 		)
 
-		return nil, errors.Wrapf(govtypes.ErrInactiveProposal, "proposal with ID %d is not in voting period", msg.ProposalId)
+		return nil, errors.Wrapf(govtypes.ErrInactiveProposal, "proposal with ID %d is neither in voting period nor pending execution", msg.ProposalId)
 	}
 
-	// follows the same logic as in x/gov/abci.go for rejected proposals
-	if msg.BurnDeposit {
-		ms.k.govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-	} else {
-		ms.k.govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+	// A proposal restored by the stakers cannot be vetoed again.
+	previousVeto, err := ms.k.Vetoes.Get(ctx, proposal.Id)
+	if err == nil && previousVeto.Status == types.VetoStatusOverridden {
+		logger.Error(
+			"veto of proposal was overridden",
+			"proposal", proposal.Id,
+			"authority", msg.Vetoer,
+		)
+
+		return nil, errors.Wrapf(types.ErrInvalidVetoStatus, "veto of proposal with ID %d was overridden", msg.ProposalId)
+	} else if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, err
 	}
-	proposal.Status = govtypesv1.StatusVetoed
 
-	// Since the proposal is veoted, we set the final tally result to an empty tally.
-	emptyTally := govtypesv1.EmptyTallyResult()
-	proposal.FinalTallyResult = &emptyTally
+	veto := types.NewVeto(
+		proposal.Id, msg.Vetoer, msg.Reason, msg.BurnDeposit, ctx.BlockTime(), types.VetoStatusUpheld, proposal.Status, 0,
+	)
+	if proposal.Status == govtypesv1.StatusPassedPendingExecution {
+		// The proposal has already been tallied, so we keep its final tally
+		// result and only prevent the execution of its messages.
+		veto.RemainingTime = max(proposal.ExecutionTime.Sub(ctx.BlockTime()), 0)
+		proposal.Status = govtypesv1.StatusVetoed
+		ms.k.govKeeper.SetProposal(ctx, proposal)
+		ms.k.govKeeper.RemoveFromExecutionQueue(ctx, proposal.Id, *proposal.ExecutionTime)
+	} else {
+		veto.RemainingTime = max(proposal.VotingEndTime.Sub(ctx.BlockTime()), 0)
+		proposal.Status = govtypesv1.StatusVetoed
 
-	ms.k.govKeeper.SetProposal(ctx, proposal)
-	ms.k.govKeeper.DeleteVotes(ctx, proposal.Id)
-	ms.k.govKeeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
-	ms.k.govKeeper.DecrementActiveProposalsNumber(ctx)
+		// Since the proposal is veoted, we set the final tally result to an empty tally.
+		emptyTally := govtypesv1.EmptyTallyResult()
+		proposal.FinalTallyResult = &emptyTally
+
+		ms.k.govKeeper.SetProposal(ctx, proposal)
+		ms.k.govKeeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		ms.k.govKeeper.DecrementActiveProposalsNumber(ctx)
+
+		ms.k.govKeeper.UpdateMinInitialDeposit(ctx, true)
+		ms.k.govKeeper.UpdateMinDeposit(ctx, true)
+	}
+
+	// If vetoes can be challenged, the votes and the deposit of the proposal
+	// are kept until the end of the challenge window, so that the proposal can
+	// be restored if the veto is overridden.
+	if params.VetoChallengeWindow > 0 {
+		veto.Status = types.VetoStatusChallengeable
+		veto.ChallengeEndTime = ctx.BlockTime().Add(params.VetoChallengeWindow)
+		err = ms.k.SetVeto(ctx, veto)
+	} else {
+		err = ms.k.UpholdVeto(ctx, veto)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	ms.k.govKeeper.UpdateMinInitialDeposit(ctx, true)
-	ms.k.govKeeper.UpdateMinDeposit(ctx, true)
+	action := types.NewAction(proposal.Id, msg.Vetoer, types.DaoRoleOversight, types.ActionTypeVetoProposal, ctx.BlockHeight(), ctx.BlockTime())
+	action.BurnDeposit = msg.BurnDeposit
+	action.VetoReason = msg.Reason
+	if _, err := ms.k.RecordAction(ctx, action); err != nil {
+		return nil, err
+	}
 
 	logger.Info(
 		"proposal vetoed",
 		"proposal", proposal.Id,
 		"authority", msg.Vetoer,
+		"reason", msg.Reason,
 	)
 
 	// Emit event for proposal veto
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.Vetoer),
+		sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		sdk.NewAttribute(govtypes.AttributeKeyProposalResult, types.AttributeValueProposalVetoed),
+	}
+	if veto.Status == types.VetoStatusChallengeable {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyChallengeEnd, veto.ChallengeEndTime.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeVetoProposal, attributes...),
+	})
+
+	return &types.MsgVetoProposalResponse{}, nil
+}
+
+// ChallengeVeto challenges the veto of the proposal with the given ID, which
+// starts a veto override vote. The veto must be in its challenge window, and
+// the challenger must have bonded tokens.
+func (ms MsgServer) ChallengeVeto(goCtx context.Context, msg *types.MsgChallengeVeto) (*types.MsgChallengeVetoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	if params.VetoChallengeWindow == 0 {
+		logger.Info("veto challenge window is not set, function is disabled")
+
+		return nil, errors.Wrapf(types.ErrFunctionDisabled, "veto challenge window is not set")
+	}
+
+	veto, err := ms.k.Vetoes.Get(ctx, msg.ProposalId)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		logger.Error(
+			"veto not found",
+			"proposal_id", msg.ProposalId,
+			"challenger", msg.Challenger,
+		)
+
+		return nil, errors.Wrapf(types.ErrUnknownVeto, "veto of proposal with ID %d not found", msg.ProposalId)
+	} else if err != nil {
+		return nil, err
+	}
+	if veto.Status != types.VetoStatusChallengeable || !ctx.BlockTime().Before(veto.ChallengeEndTime) {
+		logger.Error(
+			"veto is not challengeable",
+			"proposal", veto.ProposalId,
+			"status", veto.Status,
+			"challenger", msg.Challenger,
+		)
+
+		return nil, errors.Wrapf(types.ErrInvalidVetoStatus, "veto of proposal with ID %d is not challengeable", msg.ProposalId)
+	}
+
+	bonded, err := ms.k.stakingKeeper.GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(msg.Challenger))
+	if err != nil {
+		return nil, err
+	}
+	if !bonded.IsPositive() {
+		logger.Error(
+			"challenger has no bonded tokens",
+			"proposal", veto.ProposalId,
+			"challenger", msg.Challenger,
+		)
+
+		return nil, errors.Wrapf(types.ErrNoBondedTokens, "challenger %s has no bonded tokens", msg.Challenger)
+	}
+
+	if err := ms.k.VetoQueue.Remove(ctx, collections.Join(veto.ChallengeEndTime, veto.ProposalId)); err != nil {
+		return nil, err
+	}
+	overrideVotingEndTime := ctx.BlockTime().Add(params.VetoOverrideVotingPeriod)
+	veto.Status = types.VetoStatusChallenged
+	veto.Challenger = msg.Challenger
+	veto.OverrideVotingEndTime = &overrideVotingEndTime
+	if err := ms.k.SetVeto(ctx, veto); err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"veto challenged",
+		"proposal", veto.ProposalId,
+		"challenger", msg.Challenger,
+	)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeVetoProposal,
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
-			sdk.NewAttribute(types.AttributeKeySigner, msg.Vetoer),
-			sdk.NewAttribute(govtypes.AttributeKeyProposalResult, types.AttributeValueProposalVetoed),
+			types.EventTypeChallengeVeto,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", veto.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Challenger),
+			sdk.NewAttribute(types.AttributeKeyOverrideEnd, overrideVotingEndTime.String()),
 		),
 	})
 
-	return &types.MsgVetoProposalResponse{}, nil
+	return &types.MsgChallengeVetoResponse{}, nil
+}
+
+// VoteVetoOverride casts or replaces the vote of a staker on the override of
+// the veto of the proposal with the given ID. The veto must be challenged and
+// its override vote ongoing, and the voter must have bonded tokens. The vote
+// is weighted by the bonded tokens of the voter when the vote is cast.
+func (ms MsgServer) VoteVetoOverride(goCtx context.Context, msg *types.MsgVoteVetoOverride) (*types.MsgVoteVetoOverrideResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := ms.k.Logger(ctx)
+
+	veto, err := ms.k.Vetoes.Get(ctx, msg.ProposalId)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		logger.Error(
+			"veto not found",
+			"proposal_id", msg.ProposalId,
+			"voter", msg.Voter,
+		)
+
+		return nil, errors.Wrapf(types.ErrUnknownVeto, "veto of proposal with ID %d not found", msg.ProposalId)
+	} else if err != nil {
+		return nil, err
+	}
+	if veto.Status != types.VetoStatusChallenged || !ctx.BlockTime().Before(*veto.OverrideVotingEndTime) {
+		logger.Error(
+			"veto override vote is not ongoing",
+			"proposal", veto.ProposalId,
+			"status", veto.Status,
+			"voter", msg.Voter,
+		)
+
+		return nil, errors.Wrapf(types.ErrInvalidVetoStatus, "veto override vote of proposal with ID %d is not ongoing", msg.ProposalId)
+	}
+
+	bonded, err := ms.k.stakingKeeper.GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(msg.Voter))
+	if err != nil {
+		return nil, err
+	}
+	if !bonded.IsPositive() {
+		logger.Error(
+			"voter has no bonded tokens",
+			"proposal", veto.ProposalId,
+			"voter", msg.Voter,
+		)
+
+		return nil, errors.Wrapf(types.ErrNoBondedTokens, "voter %s has no bonded tokens", msg.Voter)
+	}
+
+	vote := types.VetoOverrideVote{
+		ProposalId:  veto.ProposalId,
+		Voter:       msg.Voter,
+		Override:    msg.Override,
+		VotingPower: bonded.String(),
+	}
+	if err := ms.k.SetVetoOverrideVote(ctx, vote); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVoteVetoOverride,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", veto.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Voter),
+			sdk.NewAttribute(types.AttributeKeyOverride, fmt.Sprintf("%t", msg.Override)),
+		),
+	})
+
+	return &types.MsgVoteVetoOverrideResponse{}, nil
+}
+
+// ScheduleDaoHandover schedules the handover of a core DAO to a new address,
+// members and term at the given height. The handover replaces any handover
+// of the same core DAO scheduled at the same height.
+// It is only available to the module authority, so that a core DAO cannot
+// extend its own term or appoint its successors.
+func (ms MsgServer) ScheduleDaoHandover(goCtx context.Context, msg *types.MsgScheduleDaoHandover) (*types.MsgScheduleDaoHandoverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := ms.k.Logger(ctx)
+
+	handover := msg.Handover
+	if err := ms.validateHandoverAuthority(ctx, handover.DaoRole, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if handover.Height <= ctx.BlockHeight() {
+		logger.Error(
+			"handover height is not in the future",
+			"height", handover.Height,
+			"block_height", ctx.BlockHeight(),
+			"signer", msg.Signer,
+		)
+
+		return nil, errors.Wrapf(types.ErrInvalidHandover, "handover height %d must be greater than the current height %d", handover.Height, ctx.BlockHeight())
+	}
+
+	// the pending handovers are walked by the staking hooks, so their number
+	// is capped. A handover at the same height replaces the existing one.
+	replaced, err := ms.k.DaoHandovers.Has(ctx, collections.Join(handover.Height, int32(handover.DaoRole)))
+	if err != nil {
+		return nil, err
+	}
+	if !replaced {
+		pending, err := ms.k.countDaoHandovers(ctx, handover.DaoRole)
+		if err != nil {
+			return nil, err
+		}
+		if pending >= types.MaxPendingHandoversPerDao {
+			logger.Error(
+				"too many pending handovers",
+				"dao", handover.DaoRole,
+				"pending", pending,
+				"signer", msg.Signer,
+			)
+
+			return nil, errors.Wrapf(types.ErrInvalidHandover, "%s already has %d pending handovers, the maximum", handover.DaoRole, pending)
+		}
+	}
+
+	// by Constitution, core DAOs cannot stake
+	for _, signer := range handover.Signers() {
+		staked, err := ms.k.hasStake(ctx, signer)
+		if err != nil {
+			return nil, err
+		}
+		if staked {
+			logger.Error(
+				"handover signer has bonded or unbonding tokens",
+				"dao", handover.DaoRole,
+				"address", signer,
+			)
+
+			return nil, errors.Wrapf(types.ErrCannotStake, "cannot schedule handover while %s has bonded or unbonding tokens", signer)
+		}
+	}
+
+	handover.Scheduler = msg.Signer
+	if err := ms.k.SetDaoHandover(ctx, handover); err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"core DAO handover scheduled",
+		"dao", handover.DaoRole,
+		"height", handover.Height,
+		"address", handover.Address,
+		"signer", msg.Signer,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleHandover,
+			sdk.NewAttribute(types.AttributeKeyDaoRole, handover.DaoRole.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", handover.Height)),
+			sdk.NewAttribute(types.AttributeKeyDaoAddress, handover.Address),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	return &types.MsgScheduleDaoHandoverResponse{}, nil
+}
+
+// CancelDaoHandover cancels the handover of a core DAO scheduled at the given
+// height.
+// It is only available to the module authority.
+func (ms MsgServer) CancelDaoHandover(goCtx context.Context, msg *types.MsgCancelDaoHandover) (*types.MsgCancelDaoHandoverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logger := ms.k.Logger(ctx)
+
+	if err := ms.validateHandoverAuthority(ctx, msg.DaoRole, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.Height, int32(msg.DaoRole))
+	has, err := ms.k.DaoHandovers.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		logger.Error(
+			"handover not found",
+			"dao", msg.DaoRole,
+			"height", msg.Height,
+			"signer", msg.Signer,
+		)
+
+		return nil, errors.Wrapf(types.ErrUnknownHandover, "handover of %s at height %d not found", msg.DaoRole, msg.Height)
+	}
+	if err := ms.k.DaoHandovers.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"core DAO handover cancelled",
+		"dao", msg.DaoRole,
+		"height", msg.Height,
+		"signer", msg.Signer,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelHandover,
+			sdk.NewAttribute(types.AttributeKeyDaoRole, msg.DaoRole.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", msg.Height)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	return &types.MsgCancelDaoHandoverResponse{}, nil
+}
+
+// SpendFromBudget sends funds from the community pool to a recipient, within
+// the budget of the core DAO of the spender for the current budget epoch.
+// A signer of both core DAOs spends from the budget of the Steering DAO.
+// It is available to the Steering DAO and the Oversight DAO.
+func (ms MsgServer) SpendFromBudget(goCtx context.Context, msg *types.MsgSpendFromBudget) (*types.MsgSpendFromBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	logger := ms.k.Logger(ctx)
+
+	// only the core DAOs whose term is active can spend
+	steeringDaoSigners := activeDaoSigners(ctx, params, types.DaoRoleSteering)
+	oversightDaoSigners := activeDaoSigners(ctx, params, types.DaoRoleOversight)
+	var daoRole types.DaoRole
+	switch {
+	case slices.Contains(steeringDaoSigners, msg.Spender):
+		daoRole = types.DaoRoleSteering
+	case slices.Contains(oversightDaoSigners, msg.Spender):
+		daoRole = types.DaoRoleOversight
+	default:
+		addressesString := strings.Join(append(steeringDaoSigners, oversightDaoSigners...), " or ")
+
+		logger.Error(
+			"invalid authority for spending from budget",
+			"expected", addressesString,
+			"got", msg.Spender,
+		)
+
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", addressesString, msg.Spender)
+	}
+
+	if params.GetDaoBudget(daoRole).IsZero() {
+		logger.Info("budget is not set, function is disabled", "dao", daoRole)
+
+		return nil, errors.Wrapf(types.ErrFunctionDisabled, "budget of %s is not set", daoRole)
+	}
+
+	remaining, err := ms.k.GetRemainingBudget(ctx, params, daoRole)
+	if err != nil {
+		return nil, err
+	}
+	if !msg.Amount.IsAllLTE(remaining) {
+		logger.Error(
+			"spend exceeds remaining budget",
+			"dao", daoRole,
+			"amount", msg.Amount,
+			"remaining", remaining,
+			"spender", msg.Spender,
+		)
+
+		return nil, errors.Wrapf(types.ErrBudgetExceeded, "amount %s exceeds the remaining budget %s of %s", msg.Amount, remaining, daoRole)
+	}
+
+	epoch, err := ms.k.GetBudgetEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spend := types.NewSpend(daoRole, msg.Spender, msg.Recipient, msg.Amount, msg.Memo, epoch.Number, ctx.BlockTime())
+	spendID, err := ms.k.SpendFromBudget(ctx, spend)
+	if err != nil {
+		logger.Error(
+			"failed to spend from community pool",
+			"dao", daoRole,
+			"amount", msg.Amount,
+			"error", err,
+		)
+
+		return nil, err
+	}
+
+	logger.Info(
+		"spent from budget",
+		"spend", spendID,
+		"dao", daoRole,
+		"recipient", msg.Recipient,
+		"amount", msg.Amount,
+		"spender", msg.Spender,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSpendFromBudget,
+			sdk.NewAttribute(types.AttributeKeySpendID, fmt.Sprintf("%d", spendID)),
+			sdk.NewAttribute(types.AttributeKeyDaoRole, daoRole.String()),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Spender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+		),
+	})
+
+	return &types.MsgSpendFromBudgetResponse{SpendId: spendID}, nil
+}
+
+// validateHandoverAuthority checks that the signer is the module authority.
+func (ms MsgServer) validateHandoverAuthority(ctx sdk.Context, daoRole types.DaoRole, signer string) error {
+	if signer == ms.k.GetAuthority() {
+		return nil
+	}
+	ms.k.Logger(ctx).Error(
+		"invalid authority for core DAO handover",
+		"dao", daoRole,
+		"expected", ms.k.GetAuthority(),
+		"got", signer,
+	)
+
+	return errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.GetAuthority(), signer)
 }
@@ -1,17 +1,23 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+	govtypes "github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 )
 
@@ -181,6 +187,24 @@ func TestMsgServerUpdateParams(t *testing.T) {
 				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, sdk.MustAccAddressFromBech32(unbondedAcc)).Return(math.NewInt(0), nil)
 			},
 		},
+		{
+			name: "steeringdao member bonded",
+			msg: &types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params: types.Params{
+					SteeringDaoAddress:            unbondedAcc,
+					SteeringDaoMembers:            []string{bondedAcc},
+					VotingPeriodExtensionDuration: &timeDuration,
+				},
+			},
+			expectedErr: "cannot update params while Steering DAO have bonded or unbonding tokens: core DAOs cannot stake",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(unbondedAcc)).Return(math.NewInt(0), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, sdk.MustAccAddressFromBech32(unbondedAcc)).Return(math.NewInt(0), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, sdk.MustAccAddressFromBech32(bondedAcc)).Return(math.NewInt(10), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, sdk.MustAccAddressFromBech32(bondedAcc)).Return(math.NewInt(0), nil)
+			},
+		},
 		{
 			name: "ok steeringdao and oversight",
 			msg: &types.MsgUpdateParams{
@@ -225,9 +249,11 @@ func TestMsgServerUpdateParams(t *testing.T) {
 }
 
 func TestMsgServerAnnotateProposal(t *testing.T) {
-	testAcc := simtestutil.CreateRandomAccounts(2)
+	testAcc := simtestutil.CreateRandomAccounts(3)
 	annotatorAcc := testAcc[0].String()
 	steeringDAOAcc := testAcc[1].String()
+	memberAcc := testAcc[2].String()
+	pastTime := time.Now().Add(-time.Hour)
 	votingPeriodProposal := govtypesv1.Proposal{
 		Title:   "Test Proposal",
 		Summary: "A proposal",
@@ -253,6 +279,9 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 		expectedErr    string
 		setupMocks     func(sdk.Context, *testutil.Mocks)
 		setSteeringDAO bool
+		updateParams   func(*types.Params)
+		history        []string
+		expSupersedes  uint64
 	}{
 		{
 			name:        "empty msg",
@@ -320,6 +349,43 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 			},
 			setSteeringDAO: true,
 		},
+		{
+			name: "ok member",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  memberAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				call1 := m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposal, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, votingPeriodProposalWithAnnotation).After(call1)
+			},
+			setSteeringDAO: true,
+			updateParams:   func(p *types.Params) { p.SteeringDaoMembers = []string{memberAcc} },
+		},
+		{
+			name: "wrong annotator account with members",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  annotatorAcc,
+				Annotation: "Something",
+			},
+			expectedErr:    "invalid authority; expected " + steeringDAOAcc + " or " + memberAcc + ", got " + annotatorAcc + ": expected core DAO account as only signer for this message",
+			setupMocks:     func(ctx sdk.Context, m *testutil.Mocks) {},
+			setSteeringDAO: true,
+			updateParams:   func(p *types.Params) { p.SteeringDaoMembers = []string{memberAcc} },
+		},
+		{
+			name: "steering DAO term ended",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+			},
+			expectedErr:    "Steering DAO term is not active: function is disabled",
+			setupMocks:     func(ctx sdk.Context, m *testutil.Mocks) {},
+			setSteeringDAO: true,
+			updateParams:   func(p *types.Params) { p.SteeringDaoTerm = &types.DaoTerm{EndTime: &pastTime} },
+		},
 		{
 			name: "proposal not in voting period",
 			msg: &types.MsgAnnotateProposal{
@@ -360,6 +426,85 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 			},
 			setSteeringDAO: true,
 		},
+		{
+			name: "overwrite supersedes the latest annotation",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+				Overwrite:  true,
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposalWithAnnotation, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, votingPeriodProposalWithAnnotation)
+			},
+			setSteeringDAO: true,
+			history:        []string{"First", "Second"},
+			expSupersedes:  2,
+		},
+		{
+			name: "overwrite supersedes an earlier annotation",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+				Overwrite:  true,
+				Supersedes: 1,
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposalWithAnnotation, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, votingPeriodProposalWithAnnotation)
+			},
+			setSteeringDAO: true,
+			history:        []string{"First", "Second"},
+			expSupersedes:  1,
+		},
+		{
+			name: "overwrite supersedes an unknown annotation",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+				Overwrite:  true,
+				Supersedes: 3,
+			},
+			expectedErr: "proposal with ID 1 has no annotation 3: unknown annotation",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposalWithAnnotation, true)
+			},
+			setSteeringDAO: true,
+			history:        []string{"First", "Second"},
+		},
+		{
+			name: "annotation too long",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+			},
+			expectedErr: "annotation length 9 exceeds the maximum of 5: annotation too long",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposal, true)
+			},
+			setSteeringDAO: true,
+			updateParams:   func(p *types.Params) { p.MaxAnnotationLength = 5 },
+		},
+		{
+			name: "too many annotations",
+			msg: &types.MsgAnnotateProposal{
+				Annotator:  steeringDAOAcc,
+				Annotation: "Something",
+				ProposalId: 1,
+				Overwrite:  true,
+			},
+			expectedErr: "proposal with ID 1 has reached the maximum of 2 annotations: too many annotations",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposalWithAnnotation, true)
+			},
+			setSteeringDAO: true,
+			updateParams:   func(p *types.Params) { p.MaxAnnotationsPerProposal = 2 },
+			history:        []string{"First", "Second"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -369,7 +514,14 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 			if tt.setSteeringDAO {
 				params.SteeringDaoAddress = steeringDAOAcc
 			}
+			if tt.updateParams != nil {
+				tt.updateParams(&params)
+			}
 			k.Params.Set(ctx, params)
+			for _, text := range tt.history {
+				_, err := k.AddAnnotation(ctx, types.NewAnnotation(1, steeringDAOAcc, text, ctx.BlockTime(), 0))
+				require.NoError(t, err)
+			}
 			if err := tt.msg.ValidateBasic(); err != nil {
 				if tt.expectedErr != "" {
 					require.EqualError(t, err, tt.expectedErr)
@@ -383,6 +535,12 @@ func TestMsgServerAnnotateProposal(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			latest, found, err := k.GetLatestAnnotation(ctx, 1)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, uint64(len(tt.history)+1), latest.Index)
+			require.Equal(t, tt.msg.Annotation, latest.Text)
+			require.Equal(t, tt.expSupersedes, latest.Supersedes)
 		})
 	}
 }
@@ -694,13 +852,28 @@ func TestMsgServerVetoProposal(t *testing.T) {
 		Id:      2,
 		Status:  govtypesv1.StatusDepositPeriod,
 	}
+	tally := govtypesv1.NewTallyResult(math.NewInt(10), math.ZeroInt(), math.NewInt(1))
+	executionTime := votingEndTime.Add(time.Hour)
+	pendingExecutionProposal := govtypesv1.Proposal{
+		Title:            "Test Proposal",
+		Summary:          "A proposal",
+		Id:               4,
+		Status:           govtypesv1.StatusPassedPendingExecution,
+		FinalTallyResult: &tally,
+		VotingEndTime:    &votingEndTime,
+		ExecutionTime:    &executionTime,
+	}
+	pendingExecutionProposalWithVeto := pendingExecutionProposal
+	pendingExecutionProposalWithVeto.Status = govtypesv1.StatusVetoed
 	tests := []struct {
-		name            string
-		msg             *types.MsgVetoProposal
-		expectedErr     string
-		setupMocks      func(sdk.Context, *testutil.Mocks)
-		setSteeringDAO  bool
-		setOversightDAO bool
+		name                string
+		msg                 *types.MsgVetoProposal
+		expectedErr         string
+		setupMocks          func(sdk.Context, *testutil.Mocks)
+		setSteeringDAO      bool
+		setOversightDAO     bool
+		vetoChallengeWindow time.Duration
+		expectedVetoStatus  types.VetoStatus
 	}{
 		{
 			name:        "empty msg",
@@ -720,6 +893,7 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			name: "function disabled",
 			msg: &types.MsgVetoProposal{
 				Vetoer: vetoerAcc,
+				Reason: "reason",
 			},
 			expectedErr: "Oversight DAO address is not set: function is disabled",
 			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
@@ -728,15 +902,36 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			name: "wrong vetoer account",
 			msg: &types.MsgVetoProposal{
 				Vetoer: vetoerAcc,
+				Reason: "reason",
 			},
 			expectedErr:     "invalid authority; expected " + oversightDAOAcc + ", got " + vetoerAcc + ": expected core DAO account as only signer for this message",
 			setupMocks:      func(ctx sdk.Context, m *testutil.Mocks) {},
 			setOversightDAO: true,
 		},
+		{
+			name: "missing reason",
+			msg: &types.MsgVetoProposal{
+				Vetoer: oversightDAOAcc,
+			},
+			expectedErr:     "veto reason cannot be empty: invalid request",
+			setupMocks:      func(ctx sdk.Context, m *testutil.Mocks) {},
+			setOversightDAO: true,
+		},
+		{
+			name: "reason too long",
+			msg: &types.MsgVetoProposal{
+				Vetoer: oversightDAOAcc,
+				Reason: strings.Repeat("a", types.MaxVetoReasonLength+1),
+			},
+			expectedErr:     fmt.Sprintf("veto reason length %d exceeds the maximum of %d: invalid request", types.MaxVetoReasonLength+1, types.MaxVetoReasonLength),
+			setupMocks:      func(ctx sdk.Context, m *testutil.Mocks) {},
+			setOversightDAO: true,
+		},
 		{
 			name: "non existing proposal",
 			msg: &types.MsgVetoProposal{
 				Vetoer: oversightDAOAcc,
+				Reason: "reason",
 			},
 			expectedErr: "proposal with ID 0 not found: unknown proposal",
 			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
@@ -748,6 +943,7 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			name: "ok",
 			msg: &types.MsgVetoProposal{
 				Vetoer:     oversightDAOAcc,
+				Reason:     "reason",
 				ProposalId: 1,
 			},
 			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
@@ -760,12 +956,14 @@ func TestMsgServerVetoProposal(t *testing.T) {
 				m.GovKeeper.EXPECT().UpdateMinInitialDeposit(ctx, true).After(call3)
 				m.GovKeeper.EXPECT().UpdateMinDeposit(ctx, true).After(call3)
 			},
-			setOversightDAO: true,
+			setOversightDAO:    true,
+			expectedVetoStatus: types.VetoStatusUpheld,
 		},
 		{
 			name: "ok burn deposit",
 			msg: &types.MsgVetoProposal{
 				Vetoer:      oversightDAOAcc,
+				Reason:      "reason",
 				ProposalId:  1,
 				BurnDeposit: true,
 			},
@@ -779,15 +977,54 @@ func TestMsgServerVetoProposal(t *testing.T) {
 				m.GovKeeper.EXPECT().UpdateMinInitialDeposit(ctx, true).After(call3)
 				m.GovKeeper.EXPECT().UpdateMinDeposit(ctx, true).After(call3)
 			},
-			setOversightDAO: true,
+			setOversightDAO:    true,
+			expectedVetoStatus: types.VetoStatusUpheld,
+		},
+		{
+			name: "ok pending execution",
+			msg: &types.MsgVetoProposal{
+				Vetoer:      oversightDAOAcc,
+				Reason:      "reason",
+				ProposalId:  4,
+				BurnDeposit: true,
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				call1 := m.GovKeeper.EXPECT().GetProposal(ctx, uint64(4)).Return(pendingExecutionProposal, true)
+				m.GovKeeper.EXPECT().DeleteAndBurnDeposits(ctx, uint64(4)).After(call1)
+				m.GovKeeper.EXPECT().SetProposal(ctx, pendingExecutionProposalWithVeto).After(call1)
+				m.GovKeeper.EXPECT().RemoveFromExecutionQueue(ctx, uint64(4), executionTime).After(call1)
+			},
+			setOversightDAO:    true,
+			expectedVetoStatus: types.VetoStatusUpheld,
+		},
+		{
+			name: "ok challengeable",
+			msg: &types.MsgVetoProposal{
+				Vetoer:     oversightDAOAcc,
+				ProposalId: 1,
+				Reason:     "reason",
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				// the votes and the deposit are kept until the veto is final
+				call1 := m.GovKeeper.EXPECT().GetProposal(ctx, uint64(1)).Return(votingPeriodProposal, true)
+				m.GovKeeper.EXPECT().SetProposal(ctx, proposalWithVeto).After(call1)
+				call2 := m.GovKeeper.EXPECT().RemoveFromActiveProposalQueue(ctx, uint64(1), votingEndTime).After(call1)
+				call3 := m.GovKeeper.EXPECT().DecrementActiveProposalsNumber(ctx).After(call2)
+				m.GovKeeper.EXPECT().UpdateMinInitialDeposit(ctx, true).After(call3)
+				m.GovKeeper.EXPECT().UpdateMinDeposit(ctx, true).After(call3)
+			},
+			setOversightDAO:     true,
+			vetoChallengeWindow: time.Hour,
+			expectedVetoStatus:  types.VetoStatusChallengeable,
 		},
 		{
 			name: "proposal not in voting period",
 			msg: &types.MsgVetoProposal{
 				Vetoer:     oversightDAOAcc,
+				Reason:     "reason",
 				ProposalId: 2,
 			},
-			expectedErr: "proposal with ID 2 is not in voting period: inactive proposal",
+			expectedErr: "proposal with ID 2 is neither in voting period nor pending execution: inactive proposal",
 			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
 				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(2)).Return(depositPeriodProposal, true)
 			},
@@ -797,9 +1034,10 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			name: "proposal already vetoed",
 			msg: &types.MsgVetoProposal{
 				Vetoer:     oversightDAOAcc,
+				Reason:     "reason",
 				ProposalId: 3,
 			},
-			expectedErr: "proposal with ID 3 is not in voting period: inactive proposal",
+			expectedErr: "proposal with ID 3 is neither in voting period nor pending execution: inactive proposal",
 			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
 				m.GovKeeper.EXPECT().GetProposal(ctx, uint64(3)).Return(proposalWithVeto, true)
 			},
@@ -814,6 +1052,7 @@ func TestMsgServerVetoProposal(t *testing.T) {
 			if tt.setOversightDAO {
 				params.OversightDaoAddress = oversightDAOAcc
 			}
+			params.VetoChallengeWindow = tt.vetoChallengeWindow
 			k.Params.Set(ctx, params)
 			if err := tt.msg.ValidateBasic(); err != nil {
 				if tt.expectedErr != "" {
@@ -828,6 +1067,594 @@ func TestMsgServerVetoProposal(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			actions, err := k.GetAllActions(ctx)
+			require.NoError(t, err)
+			require.Len(t, actions, 1)
+			require.Equal(t, types.DaoRoleOversight, actions[0].DaoRole)
+			require.Equal(t, types.ActionTypeVetoProposal, actions[0].ActionType)
+			require.Equal(t, tt.msg.ProposalId, actions[0].ProposalId)
+			require.Equal(t, tt.msg.BurnDeposit, actions[0].BurnDeposit)
+			require.Equal(t, tt.msg.Reason, actions[0].VetoReason)
+			veto, err := k.Vetoes.Get(ctx, tt.msg.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedVetoStatus, veto.Status)
+			require.Equal(t, tt.msg.Reason, veto.Reason)
+			queued, err := k.VetoQueue.Has(ctx, collections.Join(veto.ChallengeEndTime, veto.ProposalId))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedVetoStatus == types.VetoStatusChallengeable, queued)
+		})
+	}
+}
+
+func TestMsgServerChallengeVeto(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	oversightDAOAcc := testAcc[0].String()
+	challengerAcc := testAcc[1]
+	tests := []struct {
+		name        string
+		msg         *types.MsgChallengeVeto
+		veto        *types.Veto
+		window      time.Duration
+		expectedErr string
+		setupMocks  func(sdk.Context, *testutil.Mocks)
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgChallengeVeto{},
+			window:      time.Hour,
+			expectedErr: "invalid challenger address: empty address string is not allowed: invalid address",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "function disabled",
+			msg:         &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			expectedErr: "veto challenge window is not set: function is disabled",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "unknown veto",
+			msg:         &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			window:      time.Hour,
+			expectedErr: "veto of proposal with ID 1 not found: unknown veto",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "veto upheld",
+			msg:  &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			veto: &types.Veto{
+				ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+				Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod,
+			},
+			window:      time.Hour,
+			expectedErr: "veto of proposal with ID 1 is not challengeable: invalid veto status",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "challenge window ended",
+			msg:  &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			veto: &types.Veto{
+				ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+				Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+				ChallengeEndTime: time.Now().Add(-time.Minute),
+			},
+			window:      time.Hour,
+			expectedErr: "veto of proposal with ID 1 is not challengeable: invalid veto status",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "challenger without bonded tokens",
+			msg:  &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			veto: &types.Veto{
+				ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+				Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+				ChallengeEndTime: time.Now().Add(time.Hour),
+			},
+			window:      time.Hour,
+			expectedErr: "challenger " + challengerAcc.String() + " has no bonded tokens: signer has no bonded tokens",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, challengerAcc).Return(math.ZeroInt(), nil)
+			},
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgChallengeVeto{Challenger: challengerAcc.String(), ProposalId: 1},
+			veto: &types.Veto{
+				ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+				Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+				ChallengeEndTime: time.Now().Add(time.Hour),
+			},
+			window: time.Hour,
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, challengerAcc).Return(math.NewInt(100), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			tt.setupMocks(ctx, &m)
+			params := types.DefaultParams()
+			params.OversightDaoAddress = oversightDAOAcc
+			params.VetoChallengeWindow = tt.window
+			k.Params.Set(ctx, params)
+			if tt.veto != nil {
+				require.NoError(t, k.SetVeto(ctx, *tt.veto))
+			}
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			_, err := ms.ChallengeVeto(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			veto, err := k.Vetoes.Get(ctx, tt.msg.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, types.VetoStatusChallenged, veto.Status)
+			require.Equal(t, tt.msg.Challenger, veto.Challenger)
+			overrideVotingEndTime := ctx.BlockTime().Add(params.VetoOverrideVotingPeriod)
+			require.Equal(t, overrideVotingEndTime, *veto.OverrideVotingEndTime)
+			// the veto is queued at the end of its override vote instead of its
+			// challenge window
+			queued, err := k.VetoQueue.Has(ctx, collections.Join(tt.veto.ChallengeEndTime, veto.ProposalId))
+			require.NoError(t, err)
+			require.False(t, queued)
+			queued, err = k.VetoQueue.Has(ctx, collections.Join(overrideVotingEndTime, veto.ProposalId))
+			require.NoError(t, err)
+			require.True(t, queued)
+		})
+	}
+}
+
+func TestMsgServerVoteVetoOverride(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(2)
+	oversightDAOAcc := testAcc[0].String()
+	voterAcc := testAcc[1]
+	overrideVotingEndTime := time.Now().Add(time.Hour)
+	overrideVotingEnded := time.Now().Add(-time.Minute)
+	challengedVeto := types.Veto{
+		ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+		Status: types.VetoStatusChallenged, ProposalStatus: govtypesv1.StatusVotingPeriod,
+		Challenger: voterAcc.String(), OverrideVotingEndTime: &overrideVotingEndTime,
+	}
+	endedVeto := challengedVeto
+	endedVeto.OverrideVotingEndTime = &overrideVotingEnded
+	tests := []struct {
+		name        string
+		msg         *types.MsgVoteVetoOverride
+		veto        *types.Veto
+		expectedErr string
+		setupMocks  func(sdk.Context, *testutil.Mocks)
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgVoteVetoOverride{},
+			expectedErr: "invalid voter address: empty address string is not allowed: invalid address",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "unknown veto",
+			msg:         &types.MsgVoteVetoOverride{Voter: voterAcc.String(), ProposalId: 1, Override: true},
+			expectedErr: "veto of proposal with ID 1 not found: unknown veto",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "veto not challenged",
+			msg:  &types.MsgVoteVetoOverride{Voter: voterAcc.String(), ProposalId: 1, Override: true},
+			veto: &types.Veto{
+				ProposalId: 1, Vetoer: oversightDAOAcc, Reason: "reason",
+				Status: types.VetoStatusChallengeable, ProposalStatus: govtypesv1.StatusVotingPeriod,
+				ChallengeEndTime: time.Now().Add(time.Hour),
+			},
+			expectedErr: "veto override vote of proposal with ID 1 is not ongoing: invalid veto status",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "override vote ended",
+			msg:         &types.MsgVoteVetoOverride{Voter: voterAcc.String(), ProposalId: 1, Override: true},
+			veto:        &endedVeto,
+			expectedErr: "veto override vote of proposal with ID 1 is not ongoing: invalid veto status",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "voter without bonded tokens",
+			msg:         &types.MsgVoteVetoOverride{Voter: voterAcc.String(), ProposalId: 1, Override: true},
+			veto:        &challengedVeto,
+			expectedErr: "voter " + voterAcc.String() + " has no bonded tokens: signer has no bonded tokens",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, voterAcc).Return(math.ZeroInt(), nil)
+			},
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgVoteVetoOverride{Voter: voterAcc.String(), ProposalId: 1, Override: true},
+			veto: &challengedVeto,
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, voterAcc).Return(math.NewInt(100), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			tt.setupMocks(ctx, &m)
+			if tt.veto != nil {
+				require.NoError(t, k.SetVeto(ctx, *tt.veto))
+			}
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			_, err := ms.VoteVetoOverride(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			vote, err := k.VetoOverrideVotes.Get(ctx, collections.Join(tt.msg.ProposalId, voterAcc))
+			require.NoError(t, err)
+			require.Equal(t, tt.msg.Override, vote.Override)
+			require.Equal(t, "100", vote.VotingPower)
+		})
+	}
+}
+
+func TestMsgServerScheduleDaoHandover(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(4)
+	steeringDAOAcc := testAcc[0].String()
+	newDAOAcc := testAcc[1]
+	memberAcc := testAcc[2]
+	otherAcc := testAcc[3].String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	futureTime := time.Now().Add(time.Hour)
+	handover := types.DaoHandover{
+		DaoRole: types.DaoRoleSteering,
+		Height:  20,
+		Address: newDAOAcc.String(),
+		Members: []string{memberAcc.String()},
+	}
+	tests := []struct {
+		name        string
+		msg         *types.MsgScheduleDaoHandover
+		handovers   []types.DaoHandover
+		expectedErr string
+		setupMocks  func(sdk.Context, *testutil.Mocks)
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgScheduleDaoHandover{},
+			expectedErr: "invalid signer address: empty address string is not allowed: invalid address",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "invalid DAO role",
+			msg: &types.MsgScheduleDaoHandover{
+				Signer:   authority,
+				Handover: types.DaoHandover{Height: 20},
+			},
+			expectedErr: "invalid DAO role: DAO_ROLE_UNSPECIFIED: invalid core DAO handover",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "wrong signer",
+			msg:         &types.MsgScheduleDaoHandover{Signer: otherAcc, Handover: handover},
+			expectedErr: "invalid authority; expected " + authority + ", got " + otherAcc + ": expected core DAO account as only signer for this message",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "DAO extending its own term",
+			msg: &types.MsgScheduleDaoHandover{
+				Signer: steeringDAOAcc,
+				Handover: types.DaoHandover{
+					DaoRole: types.DaoRoleSteering,
+					Height:  20,
+					Address: steeringDAOAcc,
+					Term:    &types.DaoTerm{EndTime: &futureTime},
+				},
+			},
+			expectedErr: "invalid authority; expected " + authority + ", got " + steeringDAOAcc + ": expected core DAO account as only signer for this message",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "DAO handing itself over",
+			msg:         &types.MsgScheduleDaoHandover{Signer: steeringDAOAcc, Handover: handover},
+			expectedErr: "invalid authority; expected " + authority + ", got " + steeringDAOAcc + ": expected core DAO account as only signer for this message",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "height not in the future",
+			msg: &types.MsgScheduleDaoHandover{
+				Signer:   authority,
+				Handover: types.DaoHandover{DaoRole: types.DaoRoleSteering, Height: 10, Address: newDAOAcc.String()},
+			},
+			expectedErr: "handover height 10 must be greater than the current height 10: invalid core DAO handover",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "too many pending handovers",
+			msg:         &types.MsgScheduleDaoHandover{Signer: authority, Handover: handover},
+			handovers:   pendingHandovers(types.DaoRoleSteering, 30, types.MaxPendingHandoversPerDao),
+			expectedErr: "DAO_ROLE_STEERING already has 5 pending handovers, the maximum: invalid core DAO handover",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "member has stake",
+			msg:         &types.MsgScheduleDaoHandover{Signer: authority, Handover: handover},
+			expectedErr: "cannot schedule handover while " + memberAcc.String() + " has bonded or unbonding tokens: core DAOs cannot stake",
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, newDAOAcc).Return(math.ZeroInt(), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, newDAOAcc).Return(math.ZeroInt(), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, memberAcc).Return(math.ZeroInt(), nil)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, memberAcc).Return(math.NewInt(10), nil)
+			},
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgScheduleDaoHandover{Signer: authority, Handover: handover},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+			},
+		},
+		{
+			name:      "ok other DAO with pending handovers",
+			msg:       &types.MsgScheduleDaoHandover{Signer: authority, Handover: handover},
+			handovers: pendingHandovers(types.DaoRoleOversight, 30, types.MaxPendingHandoversPerDao),
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+			},
+		},
+		{
+			name:      "ok replacing a pending handover",
+			msg:       &types.MsgScheduleDaoHandover{Signer: authority, Handover: handover},
+			handovers: pendingHandovers(types.DaoRoleSteering, 20, types.MaxPendingHandoversPerDao),
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.StakingKeeper.EXPECT().GetDelegatorBonded(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+				m.StakingKeeper.EXPECT().GetDelegatorUnbonding(ctx, gomock.Any()).Return(math.ZeroInt(), nil).Times(2)
+			},
+		},
+		{
+			name: "ok authority disabling the DAO",
+			msg: &types.MsgScheduleDaoHandover{
+				Signer:   authority,
+				Handover: types.DaoHandover{DaoRole: types.DaoRoleOversight, Height: 20},
+			},
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			ctx = ctx.WithBlockHeight(10)
+			tt.setupMocks(ctx, &m)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc
+			k.Params.Set(ctx, params)
+			for _, h := range tt.handovers {
+				require.NoError(t, k.SetDaoHandover(ctx, h))
+			}
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			_, err := ms.ScheduleDaoHandover(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			got, err := k.DaoHandovers.Get(ctx, collections.Join(tt.msg.Handover.Height, int32(tt.msg.Handover.DaoRole)))
+			require.NoError(t, err)
+			expected := tt.msg.Handover
+			expected.Scheduler = tt.msg.Signer
+			require.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestMsgServerCancelDaoHandover(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(3)
+	oversightDAOAcc := testAcc[0].String()
+	newDAOAcc := testAcc[1].String()
+	otherAcc := testAcc[2].String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	handover := types.DaoHandover{
+		DaoRole:   types.DaoRoleOversight,
+		Height:    20,
+		Address:   newDAOAcc,
+		Scheduler: authority,
+	}
+	tests := []struct {
+		name        string
+		msg         *types.MsgCancelDaoHandover
+		expectedErr string
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgCancelDaoHandover{},
+			expectedErr: "invalid signer address: empty address string is not allowed: invalid address",
+		},
+		{
+			name:        "invalid DAO role",
+			msg:         &types.MsgCancelDaoHandover{Signer: authority, Height: 20},
+			expectedErr: "invalid DAO role: DAO_ROLE_UNSPECIFIED: invalid core DAO handover",
+		},
+		{
+			name:        "wrong signer",
+			msg:         &types.MsgCancelDaoHandover{Signer: otherAcc, DaoRole: types.DaoRoleOversight, Height: 20},
+			expectedErr: "invalid authority; expected " + authority + ", got " + otherAcc + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name:        "DAO cancelling the handover scheduled by the authority",
+			msg:         &types.MsgCancelDaoHandover{Signer: oversightDAOAcc, DaoRole: types.DaoRoleOversight, Height: 20},
+			expectedErr: "invalid authority; expected " + authority + ", got " + oversightDAOAcc + ": expected core DAO account as only signer for this message",
+		},
+		{
+			name:        "unknown handover",
+			msg:         &types.MsgCancelDaoHandover{Signer: authority, DaoRole: types.DaoRoleOversight, Height: 30},
+			expectedErr: "handover of DAO_ROLE_OVERSIGHT at height 30 not found: unknown core DAO handover",
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgCancelDaoHandover{Signer: authority, DaoRole: types.DaoRoleOversight, Height: 20},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, _, ctx := testutil.SetupMsgServer(t)
+			params := types.DefaultParams()
+			params.OversightDaoAddress = oversightDAOAcc
+			k.Params.Set(ctx, params)
+			require.NoError(t, k.SetDaoHandover(ctx, handover))
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			_, err := ms.CancelDaoHandover(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				got, err := k.DaoHandovers.Get(ctx, collections.Join(handover.Height, int32(handover.DaoRole)))
+				require.NoError(t, err)
+				require.Equal(t, handover, got)
+				return
+			}
+			require.NoError(t, err)
+			handovers, err := k.GetAllDaoHandovers(ctx)
+			require.NoError(t, err)
+			require.Empty(t, handovers)
+		})
+	}
+}
+
+// pendingHandovers returns n handovers of a core DAO disabling it, scheduled
+// by the module authority from the given height.
+func pendingHandovers(daoRole types.DaoRole, height int64, n int) []types.DaoHandover {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	handovers := make([]types.DaoHandover, n)
+	for i := range handovers {
+		handovers[i] = types.DaoHandover{DaoRole: daoRole, Height: height + int64(i), Scheduler: authority}
+	}
+	return handovers
+}
+
+func TestMsgServerSpendFromBudget(t *testing.T) {
+	testAcc := simtestutil.CreateRandomAccounts(4)
+	steeringDAOAcc := testAcc[0].String()
+	oversightDAOAcc := testAcc[1].String()
+	recipientAcc := testAcc[2]
+	otherAcc := testAcc[3].String()
+	budget := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	spent := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	tests := []struct {
+		name          string
+		msg           *types.MsgSpendFromBudget
+		expectedErr   string
+		expectedSpent sdk.Coins
+		setupMocks    func(sdk.Context, *testutil.Mocks)
+	}{
+		{
+			name:        "empty msg",
+			msg:         &types.MsgSpendFromBudget{},
+			expectedErr: "invalid spender address: empty address string is not allowed: invalid address",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "empty amount",
+			msg:         &types.MsgSpendFromBudget{Spender: steeringDAOAcc, Recipient: recipientAcc.String()},
+			expectedErr: ": invalid coins",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "wrong signer",
+			msg:         &types.MsgSpendFromBudget{Spender: otherAcc, Recipient: recipientAcc.String(), Amount: amount},
+			expectedErr: "invalid authority; expected " + steeringDAOAcc + " or " + oversightDAOAcc + ", got " + otherAcc + ": expected core DAO account as only signer for this message",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name:        "budget not set",
+			msg:         &types.MsgSpendFromBudget{Spender: oversightDAOAcc, Recipient: recipientAcc.String(), Amount: amount},
+			expectedErr: "budget of DAO_ROLE_OVERSIGHT is not set: function is disabled",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "amount exceeds remaining budget",
+			msg: &types.MsgSpendFromBudget{
+				Spender: steeringDAOAcc, Recipient: recipientAcc.String(),
+				Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700)),
+			},
+			expectedErr: "amount 700stake exceeds the remaining budget 600stake of DAO_ROLE_STEERING: core DAO budget exceeded",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "denom not in budget",
+			msg: &types.MsgSpendFromBudget{
+				Spender: steeringDAOAcc, Recipient: recipientAcc.String(),
+				Amount: sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+			},
+			expectedErr: "amount 1other exceeds the remaining budget 600stake of DAO_ROLE_STEERING: core DAO budget exceeded",
+			setupMocks:  func(ctx sdk.Context, m *testutil.Mocks) {},
+		},
+		{
+			name: "ok",
+			msg: &types.MsgSpendFromBudget{
+				Spender: steeringDAOAcc, Recipient: recipientAcc.String(), Amount: amount, Memo: "grant",
+			},
+			expectedSpent: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900)),
+			setupMocks: func(ctx sdk.Context, m *testutil.Mocks) {
+				m.DistributionKeeper.EXPECT().DistributeFromFeePool(ctx, amount, recipientAcc).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, k, m, ctx := testutil.SetupMsgServer(t)
+			tt.setupMocks(ctx, &m)
+			params := types.DefaultParams()
+			params.SteeringDaoAddress = steeringDAOAcc
+			params.OversightDaoAddress = oversightDAOAcc
+			params.SteeringDaoBudget = budget
+			k.Params.Set(ctx, params)
+			require.NoError(t, k.BudgetEpoch.Set(ctx, types.BudgetEpoch{Number: 2, StartTime: ctx.BlockTime()}))
+			require.NoError(t, k.BudgetsSpent.Set(ctx, int32(types.DaoRoleSteering), types.BudgetSpent{
+				DaoRole: types.DaoRoleSteering, Amount: spent,
+			}))
+			if err := tt.msg.ValidateBasic(); err != nil {
+				if tt.expectedErr != "" {
+					require.EqualError(t, err, tt.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+			}
+			res, err := ms.SpendFromBudget(ctx, tt.msg)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			spentAmount, err := k.GetBudgetSpent(ctx, types.DaoRoleSteering)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSpent, spentAmount)
+			spend, err := k.Spends.Get(ctx, res.SpendId)
+			require.NoError(t, err)
+			expectedSpend := types.NewSpend(types.DaoRoleSteering, steeringDAOAcc, recipientAcc.String(), amount, "grant", 2, ctx.BlockTime())
+			expectedSpend.Id = res.SpendId
+			require.Equal(t, expectedSpend, spend)
 		})
 	}
 }
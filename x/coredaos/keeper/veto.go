@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// SetVeto stores a veto and, if it is pending, queues it at the end of its
+// challenge window or override vote.
+func (k Keeper) SetVeto(ctx context.Context, veto types.Veto) error {
+	if err := k.Vetoes.Set(ctx, veto.ProposalId, veto); err != nil {
+		return err
+	}
+	switch veto.Status {
+	case types.VetoStatusChallengeable:
+		return k.VetoQueue.Set(ctx, collections.Join(veto.ChallengeEndTime, veto.ProposalId))
+	case types.VetoStatusChallenged:
+		return k.VetoQueue.Set(ctx, collections.Join(*veto.OverrideVotingEndTime, veto.ProposalId))
+	}
+	return nil
+}
+
+// GetAllVetoes returns all the vetoes of proposals.
+func (k Keeper) GetAllVetoes(ctx context.Context) ([]types.Veto, error) {
+	var vetoes []types.Veto
+	err := k.Vetoes.Walk(ctx, nil, func(_ uint64, veto types.Veto) (bool, error) {
+		vetoes = append(vetoes, veto)
+		return false, nil
+	})
+	return vetoes, err
+}
+
+// SetVetoOverrideVote stores a vote on the override of a veto.
+func (k Keeper) SetVetoOverrideVote(ctx context.Context, vote types.VetoOverrideVote) error {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return err
+	}
+	return k.VetoOverrideVotes.Set(ctx, collections.Join(vote.ProposalId, voter), vote)
+}
+
+// GetAllVetoOverrideVotes returns the votes of all the ongoing veto override
+// votes.
+func (k Keeper) GetAllVetoOverrideVotes(ctx context.Context) ([]types.VetoOverrideVote, error) {
+	var votes []types.VetoOverrideVote
+	err := k.VetoOverrideVotes.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], vote types.VetoOverrideVote) (bool, error) {
+		votes = append(votes, vote)
+		return false, nil
+	})
+	return votes, err
+}
+
+// UpholdVeto makes a veto final. The votes of the vetoed proposal are
+// deleted and its deposit is burned or refunded.
+func (k Keeper) UpholdVeto(ctx sdk.Context, veto types.Veto) error {
+	// the votes of a proposal pending execution were deleted when tallied
+	if veto.ProposalStatus == govtypesv1.StatusVotingPeriod {
+		k.govKeeper.DeleteVotes(ctx, veto.ProposalId)
+	}
+	// follows the same logic as in x/gov/abci.go for rejected proposals
+	if veto.BurnDeposit {
+		k.govKeeper.DeleteAndBurnDeposits(ctx, veto.ProposalId)
+	} else {
+		k.govKeeper.RefundAndDeleteDeposits(ctx, veto.ProposalId)
+	}
+	veto.Status = types.VetoStatusUpheld
+	return k.SetVeto(ctx, veto)
+}
+
+// OverrideVeto restores a vetoed proposal to the status it had when vetoed,
+// with the voting period or execution delay it had left. The quorum checks of
+// a proposal restored to its voting period are scheduled again.
+func (k Keeper) OverrideVeto(ctx sdk.Context, veto types.Veto) error {
+	proposal, found := k.govKeeper.GetProposal(ctx, veto.ProposalId)
+	if !found {
+		return fmt.Errorf("vetoed proposal %d not found", veto.ProposalId)
+	}
+	endTime := ctx.BlockTime().Add(veto.RemainingTime)
+	proposal.Status = veto.ProposalStatus
+	if veto.ProposalStatus == govtypesv1.StatusPassedPendingExecution {
+		proposal.ExecutionTime = &endTime
+		k.govKeeper.SetProposal(ctx, proposal)
+		k.govKeeper.InsertExecutionQueue(ctx, proposal.Id, endTime)
+	} else {
+		proposal.VotingEndTime = &endTime
+		k.govKeeper.SetProposal(ctx, proposal)
+		k.govKeeper.InsertActiveProposalQueue(ctx, proposal.Id, endTime)
+		k.govKeeper.ResetQuorumCheck(ctx, proposal)
+		k.govKeeper.IncrementActiveProposalsNumber(ctx)
+	}
+	veto.Status = types.VetoStatusOverridden
+	return k.SetVeto(ctx, veto)
+}
+
+// TallyVetoOverride tallies the override vote of a challenged veto and
+// deletes its votes. The voting power of a voter is the amount of tokens it
+// had bonded when it cast its vote. It returns whether the veto is
+// overridden, i.e. whether the quorum and the threshold are reached.
+func (k Keeper) TallyVetoOverride(ctx sdk.Context, proposalID uint64, params types.Params) (yes, no math.Int, overridden bool, err error) {
+	yes, no = math.ZeroInt(), math.ZeroInt()
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	var (
+		keys  []collections.Pair[uint64, sdk.AccAddress]
+		votes []types.VetoOverrideVote
+	)
+	err = k.VetoOverrideVotes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote types.VetoOverrideVote) (bool, error) {
+		keys = append(keys, key)
+		votes = append(votes, vote)
+		return false, nil
+	})
+	if err != nil {
+		return yes, no, false, err
+	}
+	for _, key := range keys {
+		if err := k.VetoOverrideVotes.Remove(ctx, key); err != nil {
+			return yes, no, false, err
+		}
+	}
+
+	for _, vote := range votes {
+		votingPower, ok := math.NewIntFromString(vote.VotingPower)
+		if !ok {
+			return yes, no, false, fmt.Errorf("invalid voting power %q of veto override vote of %s", vote.VotingPower, vote.Voter)
+		}
+		if vote.Override {
+			yes = yes.Add(votingPower)
+		} else {
+			no = no.Add(votingPower)
+		}
+	}
+
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return yes, no, false, err
+	}
+	total := yes.Add(no)
+	if !totalBonded.IsPositive() || !total.IsPositive() {
+		return yes, no, false, nil
+	}
+	quorum, err := math.LegacyNewDecFromStr(params.VetoOverrideQuorum)
+	if err != nil {
+		return yes, no, false, err
+	}
+	threshold, err := math.LegacyNewDecFromStr(params.VetoOverrideThreshold)
+	if err != nil {
+		return yes, no, false, err
+	}
+	if math.LegacyNewDecFromInt(total).Quo(math.LegacyNewDecFromInt(totalBonded)).LT(quorum) {
+		return yes, no, false, nil
+	}
+	return yes, no, math.LegacyNewDecFromInt(yes).Quo(math.LegacyNewDecFromInt(total)).GTE(threshold), nil
+}
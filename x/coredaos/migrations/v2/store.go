@@ -0,0 +1,44 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+var ParamsKey = []byte{0x00}
+
+// Addition of the annotation history, the veto challenges and the budget
+// epochs. The new params, whose zero values would disable their limits or make
+// the veto override votes pass without any vote, are set to their default
+// values. The DAO members, terms and budgets are left empty, i.e. disabled.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	params.MaxAnnotationLength = defaultParams.MaxAnnotationLength
+	params.MaxAnnotationsPerProposal = defaultParams.MaxAnnotationsPerProposal
+	params.VetoChallengeWindow = defaultParams.VetoChallengeWindow
+	params.VetoOverrideVotingPeriod = defaultParams.VetoOverrideVotingPeriod
+	params.VetoOverrideQuorum = defaultParams.VetoOverrideQuorum
+	params.VetoOverrideThreshold = defaultParams.VetoOverrideThreshold
+	params.BudgetEpochDuration = defaultParams.BudgetEpochDuration
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return store.Set(ParamsKey, bz)
+}
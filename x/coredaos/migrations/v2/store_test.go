@@ -0,0 +1,53 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v2 "github.com/Hikari-Chain/hikari-chain/x/coredaos/migrations/v2"
+	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	// Store params without the annotation, veto challenge and budget fields.
+	votingPeriodExtensionDuration := time.Hour
+	params := types.Params{
+		SteeringDaoAddress:            "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r",
+		VotingPeriodExtensionsLimit:   5,
+		VotingPeriodExtensionDuration: &votingPeriodExtensionDuration,
+	}
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&params))
+
+	// Run migrations.
+	err := v2.MigrateStore(ctx, runtime.NewKVStoreService(key), cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var migratedParams types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v2.ParamsKey), &migratedParams))
+	defaultParams := types.DefaultParams()
+	require.Equal(t, params.SteeringDaoAddress, migratedParams.SteeringDaoAddress)
+	require.Equal(t, params.VotingPeriodExtensionsLimit, migratedParams.VotingPeriodExtensionsLimit)
+	require.Equal(t, params.VotingPeriodExtensionDuration, migratedParams.VotingPeriodExtensionDuration)
+	require.Equal(t, defaultParams.MaxAnnotationLength, migratedParams.MaxAnnotationLength)
+	require.Equal(t, defaultParams.MaxAnnotationsPerProposal, migratedParams.MaxAnnotationsPerProposal)
+	require.Equal(t, defaultParams.VetoChallengeWindow, migratedParams.VetoChallengeWindow)
+	require.Equal(t, defaultParams.VetoOverrideVotingPeriod, migratedParams.VetoOverrideVotingPeriod)
+	require.Equal(t, defaultParams.VetoOverrideQuorum, migratedParams.VetoOverrideQuorum)
+	require.Equal(t, defaultParams.VetoOverrideThreshold, migratedParams.VetoOverrideThreshold)
+	require.Equal(t, defaultParams.BudgetEpochDuration, migratedParams.BudgetEpochDuration)
+	require.NoError(t, migratedParams.ValidateBasic())
+}
@@ -27,14 +27,16 @@ import (
 )
 
 // ConsensusVersion is the x/coredaos module's consensus version identifier.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasServices    = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -107,6 +109,7 @@ type AppModule struct {
 	keeper        keeper.Keeper
 	govKeeper     types.GovKeeper
 	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 }
@@ -116,6 +119,7 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	gk types.GovKeeper,
 	sk types.StakingKeeper,
+	dk types.DistributionKeeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 ) AppModule {
@@ -124,6 +128,7 @@ func NewAppModule(
 		keeper:         keeper,
 		govKeeper:      gk,
 		stakingKeeper:  sk,
+		distrKeeper:    dk,
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 	}
@@ -133,6 +138,11 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	m := keeper.NewMigrator(&am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/coredaos from version 1 to version 2: %v", err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -156,6 +166,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// BeginBlock returns the begin blocker for the coredaos module.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlocker(ctx)
+}
+
+// EndBlock returns the end blocker for the coredaos module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
+
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
@@ -176,10 +196,11 @@ type Inputs struct {
 	StoreService store.KVStoreService
 	Cdc          codec.Codec
 
-	GovKeeper     types.GovKeeper
-	StakingKeeper types.StakingKeeper
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	GovKeeper          types.GovKeeper
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
 }
 
 type Outputs struct {
@@ -209,9 +230,10 @@ func ProvideModule(in Inputs) Outputs {
 		authority.String(),
 		in.GovKeeper,
 		in.StakingKeeper,
+		in.DistributionKeeper,
 	)
 
-	m := NewAppModule(in.Cdc, *Keeper, in.GovKeeper, in.StakingKeeper, in.AccountKeeper, in.BankKeeper)
+	m := NewAppModule(in.Cdc, *Keeper, in.GovKeeper, in.StakingKeeper, in.DistributionKeeper, in.AccountKeeper, in.BankKeeper)
 
 	return Outputs{Keeper: *Keeper, Module: m}
 }
@@ -6,6 +6,9 @@ import (
 	"math/rand"
 	"time"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -17,6 +20,15 @@ const (
 	VotingPeriodExtensionDuration = "voting_period_extension_duration"
 	SteeringDaoAddress            = "steering_dao_address"
 	OversightDaoAddress           = "steering_dao_address"
+	MaxAnnotationLength           = "max_annotation_length"
+	MaxAnnotationsPerProposal     = "max_annotations_per_proposal"
+	VetoChallengeWindow           = "veto_challenge_window"
+	VetoOverrideVotingPeriod      = "veto_override_voting_period"
+	VetoOverrideQuorum            = "veto_override_quorum"
+	VetoOverrideThreshold         = "veto_override_threshold"
+	SteeringDaoBudget             = "steering_dao_budget"
+	OversightDaoBudget            = "oversight_dao_budget"
+	BudgetEpochDuration           = "budget_epoch_duration"
 	DAOAccountsNumber             = 10
 )
 
@@ -39,6 +51,59 @@ func GenVotingPeriodExtensionDuration(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*6)) * time.Second
 }
 
+// GenMaxAnnotationLength generates a random maximum annotation length
+// The length is between 100 and 10000 bytes
+func GenMaxAnnotationLength(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 100, 10001))
+}
+
+// GenMaxAnnotationsPerProposal generates a random maximum number of annotations per proposal
+func GenMaxAnnotationsPerProposal(r *rand.Rand) uint32 {
+	return uint32(r.Intn(20)) // Random limit between 0 (no limit) and 19
+}
+
+// GenVetoChallengeWindow generates a random veto challenge window
+// The window is zero (vetoes cannot be challenged) with a probability of 50%,
+// otherwise it is between 1 second and 6 hours
+func GenVetoChallengeWindow(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*6)) * time.Second
+}
+
+// GenVetoOverrideVotingPeriod generates a random veto override voting period
+// The duration is between 1 second and 6 hours
+func GenVetoOverrideVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*6)) * time.Second
+}
+
+// GenVetoOverrideQuorum generates a random veto override quorum
+func GenVetoOverrideQuorum(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 200, 500)), 3)
+}
+
+// GenVetoOverrideThreshold generates a random veto override threshold
+func GenVetoOverrideThreshold(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 500, 800)), 3)
+}
+
+// GenDaoBudget generates a random budget of a DAO in the bond denom
+// The budget is empty (spending disabled) with a probability of 50%,
+// otherwise it is between 1 and 1000000
+func GenDaoBudget(r *rand.Rand, bondDenom string) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, int64(simulation.RandIntBetween(r, 1, 1000001))))
+}
+
+// GenBudgetEpochDuration generates a random budget epoch duration
+// The duration is between 1 second and 6 hours
+func GenBudgetEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*6)) * time.Second
+}
+
 // GenSteeringDaoAddress picks a random address to be used for a DAO
 // with a probability of 50%, otherwise returns an empty string account (meaning that
 // the Dao is disabled
@@ -80,6 +145,54 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { votingPeriodExtensionDuration = GenVotingPeriodExtensionDuration(r) },
 	)
 
+	var maxAnnotationLength uint64
+	simState.AppParams.GetOrGenerate(
+		MaxAnnotationLength, &maxAnnotationLength, simState.Rand,
+		func(r *rand.Rand) { maxAnnotationLength = GenMaxAnnotationLength(r) },
+	)
+	var maxAnnotationsPerProposal uint32
+	simState.AppParams.GetOrGenerate(
+		MaxAnnotationsPerProposal, &maxAnnotationsPerProposal, simState.Rand,
+		func(r *rand.Rand) { maxAnnotationsPerProposal = GenMaxAnnotationsPerProposal(r) },
+	)
+
+	var vetoChallengeWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		VetoChallengeWindow, &vetoChallengeWindow, simState.Rand,
+		func(r *rand.Rand) { vetoChallengeWindow = GenVetoChallengeWindow(r) },
+	)
+	var vetoOverrideVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		VetoOverrideVotingPeriod, &vetoOverrideVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { vetoOverrideVotingPeriod = GenVetoOverrideVotingPeriod(r) },
+	)
+	var vetoOverrideQuorum math.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		VetoOverrideQuorum, &vetoOverrideQuorum, simState.Rand,
+		func(r *rand.Rand) { vetoOverrideQuorum = GenVetoOverrideQuorum(r) },
+	)
+	var vetoOverrideThreshold math.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		VetoOverrideThreshold, &vetoOverrideThreshold, simState.Rand,
+		func(r *rand.Rand) { vetoOverrideThreshold = GenVetoOverrideThreshold(r) },
+	)
+
+	var steeringDaoBudget sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		SteeringDaoBudget, &steeringDaoBudget, simState.Rand,
+		func(r *rand.Rand) { steeringDaoBudget = GenDaoBudget(r, simState.BondDenom) },
+	)
+	var oversightDaoBudget sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		OversightDaoBudget, &oversightDaoBudget, simState.Rand,
+		func(r *rand.Rand) { oversightDaoBudget = GenDaoBudget(r, simState.BondDenom) },
+	)
+	var budgetEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		BudgetEpochDuration, &budgetEpochDuration, simState.Rand,
+		func(r *rand.Rand) { budgetEpochDuration = GenBudgetEpochDuration(r) },
+	)
+
 	var steeringDaoAddress string
 	simState.AppParams.GetOrGenerate(
 		SteeringDaoAddress, &steeringDaoAddress, simState.Rand,
@@ -92,13 +205,31 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { oversightDaoAddress = GenOversightDaoAddress(r, simState) },
 	)
 
+	params := types.NewParams(
+		steeringDaoAddress,
+		oversightDaoAddress,
+		votingPeriodExtensionsLimit,
+		votingPeriodExtensionDuration,
+		maxAnnotationLength,
+		maxAnnotationsPerProposal,
+		vetoChallengeWindow,
+		vetoOverrideVotingPeriod,
+		vetoOverrideQuorum.String(),
+		vetoOverrideThreshold.String(),
+		budgetEpochDuration,
+	)
+	params.SteeringDaoBudget = steeringDaoBudget
+	params.OversightDaoBudget = oversightDaoBudget
 	coredaosGenesis := types.NewGenesisState(
-		types.NewParams(
-			steeringDaoAddress,
-			oversightDaoAddress,
-			votingPeriodExtensionsLimit,
-			votingPeriodExtensionDuration,
-		),
+		params,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	bz, err := json.MarshalIndent(&coredaosGenesis, "", " ")
 	if err != nil {
@@ -23,6 +23,9 @@ var (
 	TypeMsgEndorseProposal    = sdk.MsgTypeURL(&types.MsgEndorseProposal{})
 	TypeMsgExtendVotingPeriod = sdk.MsgTypeURL(&types.MsgExtendVotingPeriod{})
 	TypeMsgVetoProposal       = sdk.MsgTypeURL(&types.MsgVetoProposal{})
+	TypeMsgChallengeVeto      = sdk.MsgTypeURL(&types.MsgChallengeVeto{})
+	TypeMsgVoteVetoOverride   = sdk.MsgTypeURL(&types.MsgVoteVetoOverride{})
+	TypeMsgSpendFromBudget    = sdk.MsgTypeURL(&types.MsgSpendFromBudget{})
 )
 
 // Simulation operation weights for CoreDaos module
@@ -37,10 +40,16 @@ const (
 	DefaultWeightMsgExtendVotingPeriod = 100
 	OpWeightMsgVetoProposal            = "op_weight_msg_veto_proposal"
 	DefaultWeightMsgVetoProposal       = 100
+	OpWeightMsgChallengeVeto           = "op_weight_msg_challenge_veto"
+	DefaultWeightMsgChallengeVeto      = 50
+	OpWeightMsgVoteVetoOverride        = "op_weight_msg_vote_veto_override"
+	DefaultWeightMsgVoteVetoOverride   = 100
+	OpWeightMsgSpendFromBudget         = "op_weight_msg_spend_from_budget"
+	DefaultWeightMsgSpendFromBudget    = 50
 )
 
 // WeightedOperations returns all the operations from the CoreDaos module with their respective weights
-func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk types.GovKeeper, sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk types.GovKeeper, sk types.StakingKeeper, dk types.DistributionKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
 	var weightMsgAnnotateProposal int
 	appParams.GetOrGenerate(OpWeightMsgAnnotateProposal, &weightMsgAnnotateProposal, nil,
 		func(_ *rand.Rand) {
@@ -69,6 +78,27 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk ty
 		},
 	)
 
+	var weightMsgChallengeVeto int
+	appParams.GetOrGenerate(OpWeightMsgChallengeVeto, &weightMsgChallengeVeto, nil,
+		func(_ *rand.Rand) {
+			weightMsgChallengeVeto = DefaultWeightMsgChallengeVeto
+		},
+	)
+
+	var weightMsgVoteVetoOverride int
+	appParams.GetOrGenerate(OpWeightMsgVoteVetoOverride, &weightMsgVoteVetoOverride, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteVetoOverride = DefaultWeightMsgVoteVetoOverride
+		},
+	)
+
+	var weightMsgSpendFromBudget int
+	appParams.GetOrGenerate(OpWeightMsgSpendFromBudget, &weightMsgSpendFromBudget, nil,
+		func(_ *rand.Rand) {
+			weightMsgSpendFromBudget = DefaultWeightMsgSpendFromBudget
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgAnnotateProposal,
@@ -86,6 +116,18 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, gk ty
 			weightMsgVetoProposal,
 			SimulateMsgVetoProposal(gk, sk, ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgChallengeVeto,
+			SimulateMsgChallengeVeto(gk, sk, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteVetoOverride,
+			SimulateMsgVoteVetoOverride(gk, sk, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSpendFromBudget,
+			SimulateMsgSpendFromBudget(dk, ak, bk, k),
+		),
 	}
 }
 
@@ -102,8 +144,12 @@ func SimulateMsgAnnotateProposal(gk types.GovKeeper, sk types.StakingKeeper, ak
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "unable to generate proposalID"), nil, nil
 		}
 
-		if proposal.Annotation != "" {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "proposal already annotated"), nil, nil
+		latest, found, err := k.GetLatestAnnotation(ctx, proposal.Id)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "unable to get latest annotation"), nil, err
+		}
+		if params.MaxAnnotationsPerProposal != 0 && latest.Index >= uint64(params.MaxAnnotationsPerProposal) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAnnotateProposal, "proposal has reached the maximum number of annotations"), nil, nil
 		}
 
 		msg := types.NewMsgAnnotateProposal(
@@ -111,6 +157,13 @@ func SimulateMsgAnnotateProposal(gk types.GovKeeper, sk types.StakingKeeper, ak
 			proposal.GetId(),
 			simtypes.RandStringOfLength(r, 100),
 		)
+		// supersede the existing annotation, or sometimes an earlier one
+		if proposal.Annotation != "" || found {
+			msg.Overwrite = true
+			if found && r.Intn(2) == 0 {
+				msg.Supersedes = uint64(simtypes.RandIntBetween(r, 1, int(latest.Index)+1))
+			}
+		}
 		txCtx := simulation.OperationInput{
 			R:             r,
 			App:           app,
@@ -246,6 +299,7 @@ func SimulateMsgVetoProposal(gk types.GovKeeper, sk types.StakingKeeper, ak type
 			OversightDaoAccount.Address,
 			proposal.GetId(),
 			burnDeposit,
+			simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxVetoReasonLength+1)),
 		)
 
 		txCtx := simulation.OperationInput{
@@ -265,6 +319,178 @@ func SimulateMsgVetoProposal(gk types.GovKeeper, sk types.StakingKeeper, ak type
 	}
 }
 
+func SimulateMsgChallengeVeto(gk types.GovKeeper, sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if params.VetoChallengeWindow == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgChallengeVeto, "Veto challenges are disabled"), nil, nil
+		}
+
+		veto, ok := randomVeto(r, k, ctx, types.VetoStatusChallengeable)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgChallengeVeto, "no challengeable veto"), nil, nil
+		}
+		simAccount, ok := randomStaker(r, sk, ctx, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgChallengeVeto, "unable to find an account with bonded tokens"), nil, nil
+		}
+
+		msg := types.NewMsgChallengeVeto(simAccount.Address, veto.ProposalId)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgVoteVetoOverride(gk types.GovKeeper, sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		veto, ok := randomVeto(r, k, ctx, types.VetoStatusChallenged)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteVetoOverride, "no challenged veto"), nil, nil
+		}
+		simAccount, ok := randomStaker(r, sk, ctx, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteVetoOverride, "unable to find an account with bonded tokens"), nil, nil
+		}
+
+		msg := types.NewMsgVoteVetoOverride(simAccount.Address, veto.ProposalId, r.Intn(2) == 0)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+func SimulateMsgSpendFromBudget(dk types.DistributionKeeper, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		var (
+			fromAccounts []simtypes.Account
+			daoRoles     []types.DaoRole
+		)
+		if params.SteeringDaoAddress != "" && !params.SteeringDaoBudget.IsZero() {
+			fromAccounts = append(fromAccounts, SteeringDaoAccount)
+			daoRoles = append(daoRoles, types.DaoRoleSteering)
+		}
+		if params.OversightDaoAddress != "" && !params.OversightDaoBudget.IsZero() {
+			fromAccounts = append(fromAccounts, OversightDaoAccount)
+			daoRoles = append(daoRoles, types.DaoRoleOversight)
+		}
+		if len(fromAccounts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSpendFromBudget, "Budgets are disabled"), nil, nil
+		}
+		i := r.Intn(len(fromAccounts))
+		fromAccount, daoRole := fromAccounts[i], daoRoles[i]
+
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, fromAccount.Address))
+		remaining, err := k.GetRemainingBudget(ctx, params, daoRole)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSpendFromBudget, "unable to get remaining budget"), nil, err
+		}
+
+		// spend a random amount of each denom of the budget that is remaining
+		var amount sdk.Coins
+		for _, coin := range remaining {
+			if !coin.Amount.IsPositive() {
+				continue
+			}
+			spendAmount, err := simtypes.RandPositiveInt(r, coin.Amount)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgSpendFromBudget, "unable to generate amount"), nil, err
+			}
+			amount = amount.Add(sdk.NewCoin(coin.Denom, spendAmount))
+		}
+		if amount.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSpendFromBudget, "no budget remaining"), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		// the community pool may not hold the amount, check it on a cached
+		// context to not fail the simulation
+		cacheCtx, _ := ctx.CacheContext()
+		if err := dk.DistributeFromFeePool(cacheCtx, amount, recipient.Address); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSpendFromBudget, "community pool has insufficient funds"), nil, nil
+		}
+		msg := types.NewMsgSpendFromBudget(
+			fromAccount.Address,
+			recipient.Address,
+			amount,
+			simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, types.MaxSpendMemoLength+1)),
+		)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    fromAccount,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomVeto picks a random veto with the given status whose challenge window
+// or override vote is still ongoing.
+func randomVeto(r *rand.Rand, k keeper.Keeper, ctx sdk.Context, status types.VetoStatus) (veto types.Veto, found bool) {
+	var vetoes []types.Veto
+	err := k.Vetoes.Walk(ctx, nil, func(_ uint64, veto types.Veto) (bool, error) {
+		switch {
+		case veto.Status != status:
+		case status == types.VetoStatusChallengeable && ctx.BlockTime().Before(veto.ChallengeEndTime),
+			status == types.VetoStatusChallenged && ctx.BlockTime().Before(*veto.OverrideVotingEndTime):
+			vetoes = append(vetoes, veto)
+		}
+		return false, nil
+	})
+	if err != nil || len(vetoes) == 0 {
+		return veto, false
+	}
+	return vetoes[r.Intn(len(vetoes))], true
+}
+
+// randomStaker picks a random account with bonded tokens.
+func randomStaker(r *rand.Rand, sk types.StakingKeeper, ctx sdk.Context, accs []simtypes.Account) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		bonded, err := sk.GetDelegatorBonded(ctx, accs[i].Address)
+		if err == nil && bonded.IsPositive() {
+			return accs[i], true
+		}
+	}
+	return simtypes.Account{}, false
+}
+
 // Pick a random proposal between the initial proposal ID
 // (defined in gov GenesisState) and the latest proposal ID
 // that has voting period status
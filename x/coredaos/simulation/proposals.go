@@ -40,6 +40,14 @@ func SimulateMsgUpdateParams(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account
 	params.VotingPeriodExtensionsLimit = uint32(simtypes.RandIntBetween(r, 0, 10))                       // Random limit between 0 and 9
 	votingPeriodExtensionDuration := time.Duration(simtypes.RandIntBetween(r, 1, 60*60*6)) * time.Second // Random duration between 1 second and 6 hours
 	params.VotingPeriodExtensionDuration = &votingPeriodExtensionDuration
+	params.MaxAnnotationLength = uint64(simtypes.RandIntBetween(r, 100, 10001))  // Random length between 100 and 10000 bytes
+	params.MaxAnnotationsPerProposal = uint32(simtypes.RandIntBetween(r, 0, 20)) // Random limit between 0 (no limit) and 19
+	if r.Intn(2) == 0 {
+		params.VetoChallengeWindow = time.Duration(simtypes.RandIntBetween(r, 1, 60*60*6)) * time.Second // Random window between 1 second and 6 hours
+	} else {
+		params.VetoChallengeWindow = 0
+	}
+	params.VetoOverrideVotingPeriod = time.Duration(simtypes.RandIntBetween(r, 1, 60*60*6)) * time.Second // Random duration between 1 second and 6 hours
 
 	randInt := r.Intn(2)
 	if randInt%2 == 0 {
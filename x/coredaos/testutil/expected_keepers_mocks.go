@@ -104,6 +104,18 @@ func (mr *MockGovKeeperMockRecorder) GetProposalID(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalID", reflect.TypeOf((*MockGovKeeper)(nil).GetProposalID), ctx)
 }
 
+// IncrementActiveProposalsNumber mocks base method.
+func (m *MockGovKeeper) IncrementActiveProposalsNumber(ctx types.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementActiveProposalsNumber", ctx)
+}
+
+// IncrementActiveProposalsNumber indicates an expected call of IncrementActiveProposalsNumber.
+func (mr *MockGovKeeperMockRecorder) IncrementActiveProposalsNumber(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementActiveProposalsNumber", reflect.TypeOf((*MockGovKeeper)(nil).IncrementActiveProposalsNumber), ctx)
+}
+
 // InsertActiveProposalQueue mocks base method.
 func (m *MockGovKeeper) InsertActiveProposalQueue(ctx types.Context, proposalID uint64, endTime time.Time) {
 	m.ctrl.T.Helper()
@@ -116,6 +128,18 @@ func (mr *MockGovKeeperMockRecorder) InsertActiveProposalQueue(ctx, proposalID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertActiveProposalQueue", reflect.TypeOf((*MockGovKeeper)(nil).InsertActiveProposalQueue), ctx, proposalID, endTime)
 }
 
+// InsertExecutionQueue mocks base method.
+func (m *MockGovKeeper) InsertExecutionQueue(ctx types.Context, proposalID uint64, executionTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InsertExecutionQueue", ctx, proposalID, executionTime)
+}
+
+// InsertExecutionQueue indicates an expected call of InsertExecutionQueue.
+func (mr *MockGovKeeperMockRecorder) InsertExecutionQueue(ctx, proposalID, executionTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertExecutionQueue", reflect.TypeOf((*MockGovKeeper)(nil).InsertExecutionQueue), ctx, proposalID, executionTime)
+}
+
 // ProposalKinds mocks base method.
 func (m *MockGovKeeper) ProposalKinds(proposal v1.Proposal) v1.ProposalKinds {
 	m.ctrl.T.Helper()
@@ -154,6 +178,30 @@ func (mr *MockGovKeeperMockRecorder) RemoveFromActiveProposalQueue(ctx, proposal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromActiveProposalQueue", reflect.TypeOf((*MockGovKeeper)(nil).RemoveFromActiveProposalQueue), ctx, proposalID, endTime)
 }
 
+// RemoveFromExecutionQueue mocks base method.
+func (m *MockGovKeeper) RemoveFromExecutionQueue(ctx types.Context, proposalID uint64, executionTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveFromExecutionQueue", ctx, proposalID, executionTime)
+}
+
+// RemoveFromExecutionQueue indicates an expected call of RemoveFromExecutionQueue.
+func (mr *MockGovKeeperMockRecorder) RemoveFromExecutionQueue(ctx, proposalID, executionTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromExecutionQueue", reflect.TypeOf((*MockGovKeeper)(nil).RemoveFromExecutionQueue), ctx, proposalID, executionTime)
+}
+
+// ResetQuorumCheck mocks base method.
+func (m *MockGovKeeper) ResetQuorumCheck(ctx types.Context, proposal v1.Proposal) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetQuorumCheck", ctx, proposal)
+}
+
+// ResetQuorumCheck indicates an expected call of ResetQuorumCheck.
+func (mr *MockGovKeeperMockRecorder) ResetQuorumCheck(ctx, proposal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQuorumCheck", reflect.TypeOf((*MockGovKeeper)(nil).ResetQuorumCheck), ctx, proposal)
+}
+
 // SetProposal mocks base method.
 func (m *MockGovKeeper) SetProposal(ctx types.Context, proposal v1.Proposal) {
 	m.ctrl.T.Helper()
@@ -243,6 +291,58 @@ func (mr *MockStakingKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
 }
 
+// TotalBondedTokens mocks base method.
+func (m *MockStakingKeeper) TotalBondedTokens(arg0 context.Context) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalBondedTokens", arg0)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalBondedTokens indicates an expected call of TotalBondedTokens.
+func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
+}
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// DistributeFromFeePool mocks base method.
+func (m *MockDistributionKeeper) DistributeFromFeePool(ctx context.Context, amount types.Coins, receiveAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeFromFeePool", ctx, amount, receiveAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeFromFeePool indicates an expected call of DistributeFromFeePool.
+func (mr *MockDistributionKeeperMockRecorder) DistributeFromFeePool(ctx, amount, receiveAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeFromFeePool", reflect.TypeOf((*MockDistributionKeeper)(nil).DistributeFromFeePool), ctx, amount, receiveAddr)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
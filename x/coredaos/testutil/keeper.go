@@ -22,8 +22,9 @@ import (
 )
 
 type Mocks struct {
-	GovKeeper     *MockGovKeeper
-	StakingKeeper *MockStakingKeeper
+	GovKeeper          *MockGovKeeper
+	StakingKeeper      *MockStakingKeeper
+	DistributionKeeper *MockDistributionKeeper
 }
 
 func SetupMsgServer(t *testing.T) (types.MsgServer, *keeper.Keeper, Mocks, sdk.Context) {
@@ -40,8 +41,9 @@ func SetupCoredaosKeeper(t *testing.T) (
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	m := Mocks{
-		GovKeeper:     NewMockGovKeeper(ctrl),
-		StakingKeeper: NewMockStakingKeeper(ctrl),
+		GovKeeper:          NewMockGovKeeper(ctrl),
+		StakingKeeper:      NewMockStakingKeeper(ctrl),
+		DistributionKeeper: NewMockDistributionKeeper(ctrl),
 	}
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -51,5 +53,5 @@ func SetupCoredaosKeeper(t *testing.T) (
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 	types.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
-	return keeper.NewKeeper(encCfg.Codec, storeService, authority, m.GovKeeper, m.StakingKeeper), m, ctx
+	return keeper.NewKeeper(encCfg.Codec, storeService, authority, m.GovKeeper, m.StakingKeeper, m.DistributionKeeper), m, ctx
 }
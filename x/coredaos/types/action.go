@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAction creates a new Action instance
+func NewAction(proposalID uint64, signer string, daoRole DaoRole, actionType ActionType, height int64, blockTime time.Time) Action {
+	return Action{
+		ProposalId: proposalID,
+		Signer:     signer,
+		DaoRole:    daoRole,
+		ActionType: actionType,
+		Height:     height,
+		Time:       blockTime,
+	}
+}
+
+// ValidateBasic performs basic validation of the action
+func (a Action) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %s: %w", a.Signer, err)
+	}
+	if a.DaoRole != DaoRoleSteering && a.DaoRole != DaoRoleOversight {
+		return fmt.Errorf("invalid DAO role: %s", a.DaoRole)
+	}
+	if _, ok := ActionType_name[int32(a.ActionType)]; !ok || a.ActionType == ActionTypeUnspecified {
+		return fmt.Errorf("invalid action type: %s", a.ActionType)
+	}
+	return nil
+}
+
+// DaoRoleFromString returns a DaoRole from a string. It returns an error
+// if the string is invalid.
+func DaoRoleFromString(str string) (DaoRole, error) {
+	switch strings.ToLower(str) {
+	case "steering", "dao_role_steering":
+		return DaoRoleSteering, nil
+	case "oversight", "dao_role_oversight":
+		return DaoRoleOversight, nil
+	default:
+		return DaoRoleUnspecified, fmt.Errorf("'%s' is not a valid DAO role, available roles: steering/oversight", str)
+	}
+}
+
+// ActionTypeFromString returns an ActionType from a string. It returns an
+// error if the string is invalid.
+func ActionTypeFromString(str string) (ActionType, error) {
+	switch strings.ToLower(str) {
+	case "annotate", "action_type_annotate_proposal":
+		return ActionTypeAnnotateProposal, nil
+	case "endorse", "action_type_endorse_proposal":
+		return ActionTypeEndorseProposal, nil
+	case "extend", "action_type_extend_voting_period":
+		return ActionTypeExtendVotingPeriod, nil
+	case "veto", "action_type_veto_proposal":
+		return ActionTypeVetoProposal, nil
+	default:
+		return ActionTypeUnspecified, fmt.Errorf("'%s' is not a valid action type, available types: annotate/endorse/extend/veto", str)
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAnnotation creates a new Annotation instance
+func NewAnnotation(proposalID uint64, signer, text string, blockTime time.Time, supersedes uint64) Annotation {
+	return Annotation{
+		ProposalId: proposalID,
+		Signer:     signer,
+		Text:       text,
+		Time:       blockTime,
+		Supersedes: supersedes,
+	}
+}
+
+// ValidateBasic performs basic validation of the annotation
+func (a Annotation) ValidateBasic() error {
+	if a.Index == 0 {
+		return fmt.Errorf("annotation index must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %s: %w", a.Signer, err)
+	}
+	if len(a.Text) == 0 {
+		return fmt.Errorf("annotation text cannot be empty")
+	}
+	if a.Supersedes >= a.Index {
+		return fmt.Errorf("annotation %d cannot supersede annotation %d", a.Index, a.Supersedes)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSpendMemoLength is the maximum length in bytes of the memo of a spend.
+const MaxSpendMemoLength = 256
+
+// NewSpend creates a new Spend instance
+func NewSpend(
+	daoRole DaoRole, spender, recipient string, amount sdk.Coins, memo string, epoch uint64, blockTime time.Time,
+) Spend {
+	return Spend{
+		DaoRole:   daoRole,
+		Spender:   spender,
+		Recipient: recipient,
+		Amount:    amount,
+		Memo:      memo,
+		Epoch:     epoch,
+		Time:      blockTime,
+	}
+}
+
+// ValidateBasic performs basic validation of the spend
+func (s Spend) ValidateBasic() error {
+	if s.DaoRole != DaoRoleSteering && s.DaoRole != DaoRoleOversight {
+		return fmt.Errorf("invalid DAO role: %s", s.DaoRole)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Spender); err != nil {
+		return fmt.Errorf("invalid spender address: %s: %w", s.Spender, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient address: %s: %w", s.Recipient, err)
+	}
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return fmt.Errorf("invalid spend amount: %s", s.Amount)
+	}
+	if len(s.Memo) > MaxSpendMemoLength {
+		return fmt.Errorf("spend memo length %d exceeds the maximum of %d", len(s.Memo), MaxSpendMemoLength)
+	}
+	if s.Epoch == 0 {
+		return fmt.Errorf("spend epoch must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the amount spent by a core DAO
+func (b BudgetSpent) ValidateBasic() error {
+	if b.DaoRole != DaoRoleSteering && b.DaoRole != DaoRoleOversight {
+		return fmt.Errorf("invalid DAO role: %s", b.DaoRole)
+	}
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount spent: %w", err)
+	}
+	return nil
+}
+
+// EndTime returns the time at which the budget epoch ends given the budget
+// epoch duration, and false if the budgets are never reset.
+func (e BudgetEpoch) EndTime(epochDuration time.Duration) (time.Time, bool) {
+	if epochDuration <= 0 {
+		return time.Time{}, false
+	}
+	return e.StartTime.Add(epochDuration), true
+}
+
+// RemainingBudget returns the amount of a budget that remains once the spent
+// amount is deducted. A denom spent beyond its budget, which can happen if
+// the budget is lowered during an epoch, has nothing remaining.
+func RemainingBudget(budget, spent sdk.Coins) sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, coin := range budget {
+		if amount := coin.Amount.Sub(spent.AmountOf(coin.Denom)); amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return remaining
+}
@@ -13,13 +13,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEndorseProposal{}, "hikari/v1/MsgEndorseProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgExtendVotingPeriod{}, "hikari/v1/MsgExtendVotingPeriod")
 	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "hikari/v1/MsgVetoProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgChallengeVeto{}, "hikari/v1/MsgChallengeVeto")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteVetoOverride{}, "hikari/v1/MsgVoteVetoOverride")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleDaoHandover{}, "hikari/v1/MsgScheduleDaoHandover")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelDaoHandover{}, "hikari/v1/MsgCancelDaoHandover")
+	legacy.RegisterAminoMsg(cdc, &MsgSpendFromBudget{}, "hikari/v1/MsgSpendFromBudget")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hikari/x/coredaos/v1/MsgUpdateParams")
 	cdc.RegisterConcrete(&Params{}, "hikari/coredaos/v1/Params", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgUpdateParams{},
+		&MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{},
+		&MsgChallengeVeto{}, &MsgVoteVetoOverride{}, &MsgScheduleDaoHandover{}, &MsgCancelDaoHandover{},
+		&MsgSpendFromBudget{}, &MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,11 +5,16 @@ package types
 
 import (
 	fmt "fmt"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -28,6 +33,122 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DaoRole enumerates the core DAOs.
+type DaoRole int32
+
+const (
+	// DAO_ROLE_UNSPECIFIED defines an unspecified core DAO.
+	DaoRoleUnspecified DaoRole = 0
+	// DAO_ROLE_STEERING defines the Steering DAO.
+	DaoRoleSteering DaoRole = 1
+	// DAO_ROLE_OVERSIGHT defines the Oversight DAO.
+	DaoRoleOversight DaoRole = 2
+)
+
+var DaoRole_name = map[int32]string{
+	0: "DAO_ROLE_UNSPECIFIED",
+	1: "DAO_ROLE_STEERING",
+	2: "DAO_ROLE_OVERSIGHT",
+}
+
+var DaoRole_value = map[string]int32{
+	"DAO_ROLE_UNSPECIFIED": 0,
+	"DAO_ROLE_STEERING":    1,
+	"DAO_ROLE_OVERSIGHT":   2,
+}
+
+func (x DaoRole) String() string {
+	return proto.EnumName(DaoRole_name, int32(x))
+}
+
+func (DaoRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{0}
+}
+
+// ActionType enumerates the actions a core DAO can take on a proposal.
+type ActionType int32
+
+const (
+	// ACTION_TYPE_UNSPECIFIED defines an unspecified action.
+	ActionTypeUnspecified ActionType = 0
+	// ACTION_TYPE_ANNOTATE_PROPOSAL defines the annotation of a proposal.
+	ActionTypeAnnotateProposal ActionType = 1
+	// ACTION_TYPE_ENDORSE_PROPOSAL defines the endorsement of a proposal.
+	ActionTypeEndorseProposal ActionType = 2
+	// ACTION_TYPE_EXTEND_VOTING_PERIOD defines the extension of the voting
+	// period of a proposal.
+	ActionTypeExtendVotingPeriod ActionType = 3
+	// ACTION_TYPE_VETO_PROPOSAL defines the veto of a proposal.
+	ActionTypeVetoProposal ActionType = 4
+)
+
+var ActionType_name = map[int32]string{
+	0: "ACTION_TYPE_UNSPECIFIED",
+	1: "ACTION_TYPE_ANNOTATE_PROPOSAL",
+	2: "ACTION_TYPE_ENDORSE_PROPOSAL",
+	3: "ACTION_TYPE_EXTEND_VOTING_PERIOD",
+	4: "ACTION_TYPE_VETO_PROPOSAL",
+}
+
+var ActionType_value = map[string]int32{
+	"ACTION_TYPE_UNSPECIFIED":          0,
+	"ACTION_TYPE_ANNOTATE_PROPOSAL":    1,
+	"ACTION_TYPE_ENDORSE_PROPOSAL":     2,
+	"ACTION_TYPE_EXTEND_VOTING_PERIOD": 3,
+	"ACTION_TYPE_VETO_PROPOSAL":        4,
+}
+
+func (x ActionType) String() string {
+	return proto.EnumName(ActionType_name, int32(x))
+}
+
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{1}
+}
+
+// VetoStatus enumerates the statuses of a veto.
+type VetoStatus int32
+
+const (
+	// VETO_STATUS_UNSPECIFIED defines an unspecified veto status.
+	VetoStatusUnspecified VetoStatus = 0
+	// VETO_STATUS_CHALLENGEABLE defines a veto that can be challenged by the
+	// stakers until the end of the challenge window.
+	VetoStatusChallengeable VetoStatus = 1
+	// VETO_STATUS_CHALLENGED defines a challenged veto, whose override vote is
+	// ongoing.
+	VetoStatusChallenged VetoStatus = 2
+	// VETO_STATUS_UPHELD defines a final veto.
+	VetoStatusUpheld VetoStatus = 3
+	// VETO_STATUS_OVERRIDDEN defines a veto overridden by the stakers, whose
+	// proposal was restored.
+	VetoStatusOverridden VetoStatus = 4
+)
+
+var VetoStatus_name = map[int32]string{
+	0: "VETO_STATUS_UNSPECIFIED",
+	1: "VETO_STATUS_CHALLENGEABLE",
+	2: "VETO_STATUS_CHALLENGED",
+	3: "VETO_STATUS_UPHELD",
+	4: "VETO_STATUS_OVERRIDDEN",
+}
+
+var VetoStatus_value = map[string]int32{
+	"VETO_STATUS_UNSPECIFIED":   0,
+	"VETO_STATUS_CHALLENGEABLE": 1,
+	"VETO_STATUS_CHALLENGED":    2,
+	"VETO_STATUS_UPHELD":        3,
+	"VETO_STATUS_OVERRIDDEN":    4,
+}
+
+func (x VetoStatus) String() string {
+	return proto.EnumName(VetoStatus_name, int32(x))
+}
+
+func (VetoStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{2}
+}
+
 // Params defines the parameters for the x/coredaos module.
 type Params struct {
 	// steering_dao_address defines the address which has authority
@@ -42,6 +163,50 @@ type Params struct {
 	// voting_period_extension_duration defines the duration for which
 	// a proposal's voting period can be extended.
 	VotingPeriodExtensionDuration *time.Duration `protobuf:"bytes,4,opt,name=voting_period_extension_duration,json=votingPeriodExtensionDuration,proto3,stdduration" json:"voting_period_extension_duration,omitempty"`
+	// max_annotation_length defines the maximum length in bytes of an
+	// annotation. Zero means no limit.
+	MaxAnnotationLength uint64 `protobuf:"varint,5,opt,name=max_annotation_length,json=maxAnnotationLength,proto3" json:"max_annotation_length,omitempty"`
+	// max_annotations_per_proposal defines the maximum number of annotations
+	// that can be added to a proposal. Zero means no limit.
+	MaxAnnotationsPerProposal uint32 `protobuf:"varint,6,opt,name=max_annotations_per_proposal,json=maxAnnotationsPerProposal,proto3" json:"max_annotations_per_proposal,omitempty"`
+	// veto_challenge_window defines the duration after a veto during which
+	// stakers can challenge it and trigger a veto override vote. Zero disables
+	// veto challenges, vetoes being final immediately.
+	VetoChallengeWindow time.Duration `protobuf:"bytes,7,opt,name=veto_challenge_window,json=vetoChallengeWindow,proto3,stdduration" json:"veto_challenge_window"`
+	// veto_override_voting_period defines the duration of the override vote
+	// of a challenged veto.
+	VetoOverrideVotingPeriod time.Duration `protobuf:"bytes,8,opt,name=veto_override_voting_period,json=vetoOverrideVotingPeriod,proto3,stdduration" json:"veto_override_voting_period"`
+	// veto_override_quorum defines the minimum fraction of the bonded tokens
+	// that must take part in a veto override vote for its result to be valid.
+	VetoOverrideQuorum string `protobuf:"bytes,9,opt,name=veto_override_quorum,json=vetoOverrideQuorum,proto3" json:"veto_override_quorum,omitempty"`
+	// veto_override_threshold defines the minimum fraction of the voting power
+	// of a veto override vote in favor of the override for the vetoed proposal
+	// to be restored.
+	VetoOverrideThreshold string `protobuf:"bytes,10,opt,name=veto_override_threshold,json=vetoOverrideThreshold,proto3" json:"veto_override_threshold,omitempty"`
+	// steering_dao_members defines the addresses, in addition to
+	// steering_dao_address, which have authority to execute messages as
+	// Steering DAO, e.g. a backup address.
+	SteeringDaoMembers []string `protobuf:"bytes,11,rep,name=steering_dao_members,json=steeringDaoMembers,proto3" json:"steering_dao_members,omitempty"`
+	// oversight_dao_members defines the addresses, in addition to
+	// oversight_dao_address, which have authority to execute messages as
+	// Oversight DAO, e.g. a backup address.
+	OversightDaoMembers []string `protobuf:"bytes,12,rep,name=oversight_dao_members,json=oversightDaoMembers,proto3" json:"oversight_dao_members,omitempty"`
+	// steering_dao_term defines the term of the Steering DAO. If not set, the
+	// term is unlimited.
+	SteeringDaoTerm *DaoTerm `protobuf:"bytes,13,opt,name=steering_dao_term,json=steeringDaoTerm,proto3" json:"steering_dao_term,omitempty"`
+	// oversight_dao_term defines the term of the Oversight DAO. If not set,
+	// the term is unlimited.
+	OversightDaoTerm *DaoTerm `protobuf:"bytes,14,opt,name=oversight_dao_term,json=oversightDaoTerm,proto3" json:"oversight_dao_term,omitempty"`
+	// steering_dao_budget defines the amount per denom the Steering DAO can
+	// spend from the community pool per budget epoch. Empty disables spending.
+	SteeringDaoBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=steering_dao_budget,json=steeringDaoBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"steering_dao_budget"`
+	// oversight_dao_budget defines the amount per denom the Oversight DAO can
+	// spend from the community pool per budget epoch. Empty disables spending.
+	OversightDaoBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=oversight_dao_budget,json=oversightDaoBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"oversight_dao_budget"`
+	// budget_epoch_duration defines the duration of a budget epoch, at the end
+	// of which the spent budgets of the core DAOs are reset. Zero means the
+	// budgets are never reset.
+	BudgetEpochDuration time.Duration `protobuf:"bytes,17,opt,name=budget_epoch_duration,json=budgetEpochDuration,proto3,stdduration" json:"budget_epoch_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,133 +270,4377 @@ func (m *Params) GetVotingPeriodExtensionDuration() *time.Duration {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "hikari.coredaos.v1.Params")
+func (m *Params) GetMaxAnnotationLength() uint64 {
+	if m != nil {
+		return m.MaxAnnotationLength
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("hikari/coredaos/v1/coredaos.proto", fileDescriptor_358b333c33cd46d1) }
+func (m *Params) GetMaxAnnotationsPerProposal() uint32 {
+	if m != nil {
+		return m.MaxAnnotationsPerProposal
+	}
+	return 0
+}
 
-var fileDescriptor_358b333c33cd46d1 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x3b, 0xbd, 0xa5, 0x70, 0x73, 0xb9, 0x9b, 0x58, 0x21, 0xad, 0x38, 0x46, 0x57, 0xdd,
-	0x34, 0x43, 0x15, 0x1f, 0xc0, 0xb6, 0x82, 0x48, 0xc1, 0x52, 0x77, 0x6e, 0xc2, 0x34, 0x19, 0x27,
-	0x83, 0x4d, 0x4e, 0x99, 0x99, 0x86, 0xfa, 0x16, 0x2e, 0x7d, 0x10, 0x1f, 0xc2, 0x65, 0x75, 0xe5,
-	0x4e, 0x69, 0x5f, 0x44, 0x92, 0x49, 0xa3, 0x82, 0xe2, 0xee, 0x9c, 0xfc, 0xdf, 0xff, 0xe7, 0x70,
-	0xce, 0x58, 0xfb, 0x91, 0xb8, 0xa1, 0x52, 0x90, 0x00, 0x24, 0x0b, 0x29, 0x28, 0x92, 0x76, 0xcb,
-	0xda, 0x9b, 0x49, 0xd0, 0x60, 0xdb, 0x06, 0xf1, 0xca, 0xcf, 0x69, 0xb7, 0xd5, 0xe0, 0xc0, 0x21,
-	0x97, 0x49, 0x56, 0x19, 0xb2, 0x85, 0x39, 0x00, 0x9f, 0x32, 0x92, 0x77, 0x93, 0xf9, 0x35, 0x09,
-	0xe7, 0x92, 0x6a, 0x01, 0x49, 0xa1, 0x37, 0x03, 0x50, 0x31, 0x28, 0xdf, 0x18, 0x4d, 0x63, 0xa4,
-	0x83, 0xa7, 0xaa, 0x55, 0x1f, 0x51, 0x49, 0x63, 0x65, 0x9f, 0x5b, 0x0d, 0xa5, 0x19, 0x93, 0x22,
-	0xe1, 0x7e, 0x48, 0xc1, 0xa7, 0x61, 0x28, 0x99, 0x52, 0x0e, 0x72, 0x51, 0xfb, 0x6f, 0xcf, 0x79,
-	0x7e, 0xe8, 0x34, 0x0a, 0xeb, 0x89, 0x51, 0x2e, 0x75, 0xc6, 0x8e, 0xed, 0x8d, 0x6b, 0x40, 0xa1,
-	0x50, 0xec, 0xa1, 0xb5, 0x0d, 0x29, 0x93, 0x4a, 0xf0, 0x48, 0x7f, 0x09, 0xab, 0xfe, 0x12, 0xb6,
-	0x55, 0xda, 0x3e, 0xa5, 0xf5, 0x2d, 0x9c, 0x82, 0xce, 0xe6, 0x9a, 0x31, 0x29, 0x20, 0xf4, 0xd9,
-	0x42, 0xb3, 0x44, 0x09, 0x48, 0x94, 0x3f, 0x15, 0xb1, 0xd0, 0xce, 0x1f, 0x17, 0xb5, 0xff, 0x8f,
-	0x77, 0x0c, 0x35, 0xca, 0xa1, 0xd3, 0x92, 0x19, 0x66, 0x88, 0x1d, 0x59, 0xee, 0x0f, 0x21, 0xfe,
-	0x66, 0x5d, 0x4e, 0xcd, 0x45, 0xed, 0x7f, 0x87, 0x4d, 0xcf, 0xec, 0xd3, 0xdb, 0xec, 0xd3, 0x1b,
-	0x14, 0x40, 0xaf, 0x76, 0xff, 0xba, 0x87, 0xc6, 0xbb, 0xdf, 0xfe, 0xa7, 0x84, 0x2e, 0x1e, 0x57,
-	0x18, 0x2d, 0x57, 0x18, 0xbd, 0xad, 0x30, 0xba, 0x5b, 0xe3, 0xca, 0x72, 0x8d, 0x2b, 0x2f, 0x6b,
-	0x5c, 0xb9, 0x3a, 0xe6, 0x42, 0x47, 0xf3, 0x89, 0x17, 0x40, 0x4c, 0xce, 0xf2, 0xeb, 0x76, 0xfa,
-	0x11, 0x15, 0x09, 0x31, 0xa7, 0xee, 0x04, 0x79, 0xb3, 0xf8, 0x78, 0x15, 0xfa, 0x76, 0xc6, 0xd4,
-	0xa4, 0x9e, 0x0f, 0x72, 0xf4, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x42, 0x65, 0x6f, 0x35, 0x02,
-	0x00, 0x00,
+func (m *Params) GetVetoChallengeWindow() time.Duration {
+	if m != nil {
+		return m.VetoChallengeWindow
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetVetoOverrideVotingPeriod() time.Duration {
+	if m != nil {
+		return m.VetoOverrideVotingPeriod
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetVetoOverrideQuorum() string {
+	if m != nil {
+		return m.VetoOverrideQuorum
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriodExtensionDuration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriodExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration):])
-		if err1 != nil {
-			return 0, err1
+func (m *Params) GetVetoOverrideThreshold() string {
+	if m != nil {
+		return m.VetoOverrideThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetSteeringDaoMembers() []string {
+	if m != nil {
+		return m.SteeringDaoMembers
+	}
+	return nil
+}
+
+func (m *Params) GetOversightDaoMembers() []string {
+	if m != nil {
+		return m.OversightDaoMembers
+	}
+	return nil
+}
+
+func (m *Params) GetSteeringDaoTerm() *DaoTerm {
+	if m != nil {
+		return m.SteeringDaoTerm
+	}
+	return nil
+}
+
+func (m *Params) GetOversightDaoTerm() *DaoTerm {
+	if m != nil {
+		return m.OversightDaoTerm
+	}
+	return nil
+}
+
+func (m *Params) GetSteeringDaoBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SteeringDaoBudget
+	}
+	return nil
+}
+
+func (m *Params) GetOversightDaoBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OversightDaoBudget
+	}
+	return nil
+}
+
+func (m *Params) GetBudgetEpochDuration() time.Duration {
+	if m != nil {
+		return m.BudgetEpochDuration
+	}
+	return 0
+}
+
+// DaoTerm defines the term of a core DAO. The DAO can only act during its
+// term, and is disabled once its term has ended, its address and members
+// being removed from the params.
+type DaoTerm struct {
+	// start_time is the start of the term. If not set, the term has already
+	// started.
+	StartTime *time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time is the end of the term. If not set, the term doesn't end.
+	EndTime *time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *DaoTerm) Reset()         { *m = DaoTerm{} }
+func (m *DaoTerm) String() string { return proto.CompactTextString(m) }
+func (*DaoTerm) ProtoMessage()    {}
+func (*DaoTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{1}
+}
+func (m *DaoTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintCoredaos(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.VotingPeriodExtensionsLimit != 0 {
-		i = encodeVarintCoredaos(dAtA, i, uint64(m.VotingPeriodExtensionsLimit))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *DaoTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoTerm.Merge(m, src)
+}
+func (m *DaoTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoTerm proto.InternalMessageInfo
+
+func (m *DaoTerm) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.OversightDaoAddress) > 0 {
-		i -= len(m.OversightDaoAddress)
-		copy(dAtA[i:], m.OversightDaoAddress)
-		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OversightDaoAddress)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *DaoTerm) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	if len(m.SteeringDaoAddress) > 0 {
-		i -= len(m.SteeringDaoAddress)
-		copy(dAtA[i:], m.SteeringDaoAddress)
-		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringDaoAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// DaoHandover defines the scheduled handover of a core DAO to a new address
+// and members, which activates at the beginning of the block at the given
+// height.
+type DaoHandover struct {
+	// dao_role is the core DAO handed over.
+	DaoRole DaoRole `protobuf:"varint,1,opt,name=dao_role,json=daoRole,proto3,enum=hikari.coredaos.v1.DaoRole" json:"dao_role,omitempty"`
+	// height is the block height at which the handover activates.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// address is the new address of the core DAO. An empty address disables
+	// the core DAO.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// members are the new members of the core DAO.
+	Members []string `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	// term is the term of the new core DAO. If not set, the term is
+	// unlimited.
+	Term *DaoTerm `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	// scheduler is the address that scheduled the handover.
+	Scheduler string `protobuf:"bytes,6,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+}
+
+func (m *DaoHandover) Reset()         { *m = DaoHandover{} }
+func (m *DaoHandover) String() string { return proto.CompactTextString(m) }
+func (*DaoHandover) ProtoMessage()    {}
+func (*DaoHandover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{2}
+}
+func (m *DaoHandover) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaoHandover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DaoHandover.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DaoHandover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaoHandover.Merge(m, src)
+}
+func (m *DaoHandover) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaoHandover) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaoHandover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaoHandover proto.InternalMessageInfo
+
+func (m *DaoHandover) GetDaoRole() DaoRole {
+	if m != nil {
+		return m.DaoRole
+	}
+	return DaoRoleUnspecified
+}
+
+func (m *DaoHandover) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DaoHandover) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DaoHandover) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DaoHandover) GetTerm() *DaoTerm {
+	if m != nil {
+		return m.Term
+	}
+	return nil
+}
+
+func (m *DaoHandover) GetScheduler() string {
+	if m != nil {
+		return m.Scheduler
+	}
+	return ""
+}
+
+// Action records an action taken by a core DAO on a proposal.
+type Action struct {
+	// id is the unique identifier of the action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposal_id is the ID of the proposal the action was taken on.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// signer is the address of the core DAO that signed the action.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// dao_role is the core DAO the signer acted as.
+	DaoRole DaoRole `protobuf:"varint,4,opt,name=dao_role,json=daoRole,proto3,enum=hikari.coredaos.v1.DaoRole" json:"dao_role,omitempty"`
+	// action_type is the type of the action.
+	ActionType ActionType `protobuf:"varint,5,opt,name=action_type,json=actionType,proto3,enum=hikari.coredaos.v1.ActionType" json:"action_type,omitempty"`
+	// height is the block height at which the action was taken.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the action was taken.
+	Time time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+	// annotation is the annotation added to the proposal, only set for
+	// ACTION_TYPE_ANNOTATE_PROPOSAL.
+	Annotation string `protobuf:"bytes,8,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	// burn_deposit indicates whether the deposit of the proposal was burned,
+	// only set for ACTION_TYPE_VETO_PROPOSAL.
+	BurnDeposit bool `protobuf:"varint,9,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// veto_reason is the reason given for the veto of the proposal, only set
+	// for ACTION_TYPE_VETO_PROPOSAL.
+	VetoReason string `protobuf:"bytes,10,opt,name=veto_reason,json=vetoReason,proto3" json:"veto_reason,omitempty"`
+}
+
+func (m *Action) Reset()         { *m = Action{} }
+func (m *Action) String() string { return proto.CompactTextString(m) }
+func (*Action) ProtoMessage()    {}
+func (*Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{3}
+}
+func (m *Action) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Action.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Action.Merge(m, src)
+}
+func (m *Action) XXX_Size() int {
+	return m.Size()
+}
+func (m *Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_Action.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Action proto.InternalMessageInfo
+
+func (m *Action) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Action) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Action) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *Action) GetDaoRole() DaoRole {
+	if m != nil {
+		return m.DaoRole
+	}
+	return DaoRoleUnspecified
+}
+
+func (m *Action) GetActionType() ActionType {
+	if m != nil {
+		return m.ActionType
+	}
+	return ActionTypeUnspecified
+}
+
+func (m *Action) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Action) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Action) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *Action) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+func (m *Action) GetVetoReason() string {
+	if m != nil {
+		return m.VetoReason
+	}
+	return ""
+}
+
+// Annotation defines an entry of the annotation history of a proposal.
+type Annotation struct {
+	// proposal_id is the ID of the annotated proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// index is the position of the annotation in the annotation history of the
+	// proposal, starting at 1.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// signer is the address of the Steering DAO that added the annotation.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// text is the text of the annotation.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// time is the block time at which the annotation was added.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// supersedes is the index of the annotation superseded by this annotation,
+	// zero if it doesn't supersede any annotation.
+	Supersedes uint64 `protobuf:"varint,6,opt,name=supersedes,proto3" json:"supersedes,omitempty"`
+}
+
+func (m *Annotation) Reset()         { *m = Annotation{} }
+func (m *Annotation) String() string { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()    {}
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{4}
+}
+func (m *Annotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Annotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Annotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Annotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Annotation.Merge(m, src)
+}
+func (m *Annotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Annotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Annotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Annotation proto.InternalMessageInfo
+
+func (m *Annotation) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Annotation) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Annotation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *Annotation) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Annotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Annotation) GetSupersedes() uint64 {
+	if m != nil {
+		return m.Supersedes
+	}
+	return 0
+}
+
+// Veto records the veto of a proposal by the Oversight DAO.
+type Veto struct {
+	// proposal_id is the ID of the vetoed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vetoer is the address of the Oversight DAO that vetoed the proposal.
+	Vetoer string `protobuf:"bytes,2,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+	// reason is the reason given for the veto.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// burn_deposit indicates whether the deposit of the proposal is burned.
+	BurnDeposit bool `protobuf:"varint,4,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// time is the block time at which the proposal was vetoed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// status is the status of the veto.
+	Status VetoStatus `protobuf:"varint,6,opt,name=status,proto3,enum=hikari.coredaos.v1.VetoStatus" json:"status,omitempty"`
+	// proposal_status is the status of the proposal when it was vetoed, which
+	// it is restored to if the veto is overridden.
+	ProposalStatus v1.ProposalStatus `protobuf:"varint,7,opt,name=proposal_status,json=proposalStatus,proto3,enum=hikari.gov.v1.ProposalStatus" json:"proposal_status,omitempty"`
+	// remaining_time is the remaining voting period or execution delay of the
+	// proposal when it was vetoed, which it is restored with if the veto is
+	// overridden.
+	RemainingTime time.Duration `protobuf:"bytes,8,opt,name=remaining_time,json=remainingTime,proto3,stdduration" json:"remaining_time"`
+	// challenge_end_time is the end of the challenge window of the veto.
+	ChallengeEndTime time.Time `protobuf:"bytes,9,opt,name=challenge_end_time,json=challengeEndTime,proto3,stdtime" json:"challenge_end_time"`
+	// challenger is the address of the staker that challenged the veto.
+	Challenger string `protobuf:"bytes,10,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// override_voting_end_time is the end of the override vote of a
+	// challenged veto.
+	OverrideVotingEndTime *time.Time `protobuf:"bytes,11,opt,name=override_voting_end_time,json=overrideVotingEndTime,proto3,stdtime" json:"override_voting_end_time,omitempty"`
+	// override_yes_count is the voting power in favor of the override, set
+	// once the override vote is tallied.
+	OverrideYesCount string `protobuf:"bytes,12,opt,name=override_yes_count,json=overrideYesCount,proto3" json:"override_yes_count,omitempty"`
+	// override_no_count is the voting power against the override, set once
+	// the override vote is tallied.
+	OverrideNoCount string `protobuf:"bytes,13,opt,name=override_no_count,json=overrideNoCount,proto3" json:"override_no_count,omitempty"`
+}
+
+func (m *Veto) Reset()         { *m = Veto{} }
+func (m *Veto) String() string { return proto.CompactTextString(m) }
+func (*Veto) ProtoMessage()    {}
+func (*Veto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{5}
+}
+func (m *Veto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Veto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Veto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Veto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Veto.Merge(m, src)
+}
+func (m *Veto) XXX_Size() int {
+	return m.Size()
+}
+func (m *Veto) XXX_DiscardUnknown() {
+	xxx_messageInfo_Veto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Veto proto.InternalMessageInfo
+
+func (m *Veto) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Veto) GetVetoer() string {
+	if m != nil {
+		return m.Vetoer
+	}
+	return ""
+}
+
+func (m *Veto) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Veto) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+func (m *Veto) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Veto) GetStatus() VetoStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VetoStatusUnspecified
+}
+
+func (m *Veto) GetProposalStatus() v1.ProposalStatus {
+	if m != nil {
+		return m.ProposalStatus
+	}
+	return v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *Veto) GetRemainingTime() time.Duration {
+	if m != nil {
+		return m.RemainingTime
+	}
+	return 0
+}
+
+func (m *Veto) GetChallengeEndTime() time.Time {
+	if m != nil {
+		return m.ChallengeEndTime
+	}
+	return time.Time{}
+}
+
+func (m *Veto) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *Veto) GetOverrideVotingEndTime() *time.Time {
+	if m != nil {
+		return m.OverrideVotingEndTime
+	}
+	return nil
+}
+
+func (m *Veto) GetOverrideYesCount() string {
+	if m != nil {
+		return m.OverrideYesCount
+	}
+	return ""
+}
+
+func (m *Veto) GetOverrideNoCount() string {
+	if m != nil {
+		return m.OverrideNoCount
+	}
+	return ""
+}
+
+// VetoOverrideVote defines a vote of a staker on the override of a veto.
+type VetoOverrideVote struct {
+	// proposal_id is the ID of the vetoed proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the staker.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// override indicates whether the voter is in favor of the override.
+	Override bool `protobuf:"varint,3,opt,name=override,proto3" json:"override,omitempty"`
+	// voting_power is the amount of tokens bonded by the voter when the vote
+	// was cast.
+	VotingPower string `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *VetoOverrideVote) Reset()         { *m = VetoOverrideVote{} }
+func (m *VetoOverrideVote) String() string { return proto.CompactTextString(m) }
+func (*VetoOverrideVote) ProtoMessage()    {}
+func (*VetoOverrideVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{6}
+}
+func (m *VetoOverrideVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoOverrideVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoOverrideVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoOverrideVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoOverrideVote.Merge(m, src)
+}
+func (m *VetoOverrideVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoOverrideVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoOverrideVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoOverrideVote proto.InternalMessageInfo
+
+func (m *VetoOverrideVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VetoOverrideVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VetoOverrideVote) GetOverride() bool {
+	if m != nil {
+		return m.Override
+	}
+	return false
+}
+
+func (m *VetoOverrideVote) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+// BudgetEpoch defines the current budget epoch of the core DAOs.
+type BudgetEpoch struct {
+	// number is the number of the epoch, starting at 1.
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// start_time is the block time at which the epoch started.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *BudgetEpoch) Reset()         { *m = BudgetEpoch{} }
+func (m *BudgetEpoch) String() string { return proto.CompactTextString(m) }
+func (*BudgetEpoch) ProtoMessage()    {}
+func (*BudgetEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{7}
+}
+func (m *BudgetEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetEpoch.Merge(m, src)
+}
+func (m *BudgetEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetEpoch proto.InternalMessageInfo
+
+func (m *BudgetEpoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *BudgetEpoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// BudgetSpent defines the amount a core DAO has spent from its budget during
+// the current budget epoch.
+type BudgetSpent struct {
+	// dao_role is the core DAO which spent the amount.
+	DaoRole DaoRole `protobuf:"varint,1,opt,name=dao_role,json=daoRole,proto3,enum=hikari.coredaos.v1.DaoRole" json:"dao_role,omitempty"`
+	// amount is the amount spent.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *BudgetSpent) Reset()         { *m = BudgetSpent{} }
+func (m *BudgetSpent) String() string { return proto.CompactTextString(m) }
+func (*BudgetSpent) ProtoMessage()    {}
+func (*BudgetSpent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{8}
+}
+func (m *BudgetSpent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetSpent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetSpent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetSpent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetSpent.Merge(m, src)
+}
+func (m *BudgetSpent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetSpent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetSpent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetSpent proto.InternalMessageInfo
+
+func (m *BudgetSpent) GetDaoRole() DaoRole {
+	if m != nil {
+		return m.DaoRole
+	}
+	return DaoRoleUnspecified
+}
+
+func (m *BudgetSpent) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Spend records a spend of a core DAO from its budget.
+type Spend struct {
+	// id is the unique ID of the spend.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// dao_role is the core DAO which spent the amount.
+	DaoRole DaoRole `protobuf:"varint,2,opt,name=dao_role,json=daoRole,proto3,enum=hikari.coredaos.v1.DaoRole" json:"dao_role,omitempty"`
+	// spender is the address of the core DAO signer that spent the amount.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// recipient is the address that received the amount.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount spent.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo is the memo given for the spend.
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// epoch is the number of the budget epoch the spend was made in.
+	Epoch uint64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// time is the block time at which the spend was made.
+	Time time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Spend) Reset()         { *m = Spend{} }
+func (m *Spend) String() string { return proto.CompactTextString(m) }
+func (*Spend) ProtoMessage()    {}
+func (*Spend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358b333c33cd46d1, []int{9}
+}
+func (m *Spend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Spend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Spend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Spend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spend.Merge(m, src)
+}
+func (m *Spend) XXX_Size() int {
+	return m.Size()
+}
+func (m *Spend) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spend proto.InternalMessageInfo
+
+func (m *Spend) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Spend) GetDaoRole() DaoRole {
+	if m != nil {
+		return m.DaoRole
+	}
+	return DaoRoleUnspecified
+}
+
+func (m *Spend) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *Spend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Spend) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Spend) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Spend) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Spend) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("hikari.coredaos.v1.DaoRole", DaoRole_name, DaoRole_value)
+	proto.RegisterEnum("hikari.coredaos.v1.ActionType", ActionType_name, ActionType_value)
+	proto.RegisterEnum("hikari.coredaos.v1.VetoStatus", VetoStatus_name, VetoStatus_value)
+	proto.RegisterType((*Params)(nil), "hikari.coredaos.v1.Params")
+	proto.RegisterType((*DaoTerm)(nil), "hikari.coredaos.v1.DaoTerm")
+	proto.RegisterType((*DaoHandover)(nil), "hikari.coredaos.v1.DaoHandover")
+	proto.RegisterType((*Action)(nil), "hikari.coredaos.v1.Action")
+	proto.RegisterType((*Annotation)(nil), "hikari.coredaos.v1.Annotation")
+	proto.RegisterType((*Veto)(nil), "hikari.coredaos.v1.Veto")
+	proto.RegisterType((*VetoOverrideVote)(nil), "hikari.coredaos.v1.VetoOverrideVote")
+	proto.RegisterType((*BudgetEpoch)(nil), "hikari.coredaos.v1.BudgetEpoch")
+	proto.RegisterType((*BudgetSpent)(nil), "hikari.coredaos.v1.BudgetSpent")
+	proto.RegisterType((*Spend)(nil), "hikari.coredaos.v1.Spend")
+}
+
+func init() { proto.RegisterFile("hikari/coredaos/v1/coredaos.proto", fileDescriptor_358b333c33cd46d1) }
+
+var fileDescriptor_358b333c33cd46d1 = []byte{
+	// 1865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x65, 0x59, 0xb6, 0x9f, 0x12, 0x5b, 0x1e, 0xcb, 0x09, 0xad, 0x24, 0x32, 0x57, 0x27,
+	0x23, 0xa8, 0xa5, 0xd8, 0xed, 0x06, 0xdb, 0x6d, 0x81, 0x54, 0x96, 0x18, 0x5b, 0x0b, 0x55, 0x52,
+	0x29, 0xc5, 0x69, 0x7a, 0x21, 0x28, 0x71, 0x56, 0x62, 0x57, 0xe4, 0xa8, 0x24, 0xa5, 0x38, 0xb7,
+	0xa2, 0x97, 0xb6, 0x3a, 0xed, 0xb1, 0x68, 0x2b, 0xa0, 0x40, 0x2f, 0x45, 0x0f, 0x6d, 0x51, 0xec,
+	0x87, 0xd8, 0xe3, 0x76, 0x4f, 0x3d, 0x75, 0x8b, 0xe4, 0xd0, 0x4f, 0x51, 0x60, 0x31, 0xc3, 0xe1,
+	0x3f, 0xcb, 0x89, 0x6c, 0x03, 0xb9, 0x24, 0x9c, 0x99, 0xdf, 0xfb, 0xcd, 0x9b, 0xf7, 0x5f, 0x86,
+	0x0f, 0x06, 0xc6, 0x67, 0x9a, 0x6d, 0x94, 0x7a, 0xc4, 0xc6, 0xba, 0x46, 0x9c, 0xd2, 0xe4, 0x30,
+	0xf8, 0x2e, 0x8e, 0x6c, 0xe2, 0x12, 0x84, 0x3c, 0x48, 0x31, 0xd8, 0x9e, 0x1c, 0xe6, 0xb2, 0x7d,
+	0xd2, 0x27, 0xec, 0xb8, 0x44, 0xbf, 0x3c, 0x64, 0x2e, 0xdf, 0x27, 0xa4, 0x3f, 0xc4, 0x25, 0xb6,
+	0xea, 0x8e, 0x3f, 0x2d, 0xe9, 0x63, 0x5b, 0x73, 0x0d, 0x62, 0xf1, 0xf3, 0xbd, 0x8b, 0xe7, 0xae,
+	0x61, 0x62, 0xc7, 0xd5, 0xcc, 0x11, 0x07, 0x6c, 0x69, 0xa6, 0x61, 0x91, 0x12, 0xfb, 0x97, 0x6f,
+	0xed, 0xf6, 0x88, 0x63, 0x12, 0x47, 0xf5, 0x2e, 0xf3, 0x16, 0xfe, 0x75, 0xde, 0xaa, 0xd4, 0xd5,
+	0x1c, 0x5c, 0x9a, 0x1c, 0x76, 0xb1, 0xab, 0x51, 0xe5, 0x0d, 0xff, 0xba, 0xbb, 0xfc, 0x6d, 0x7d,
+	0x32, 0xa1, 0xcf, 0xea, 0x93, 0x89, 0x77, 0x50, 0xf8, 0x63, 0x1a, 0x52, 0x2d, 0xcd, 0xd6, 0x4c,
+	0x07, 0x7d, 0x02, 0x59, 0xc7, 0xc5, 0xd8, 0x36, 0xac, 0xbe, 0xaa, 0x6b, 0x44, 0xd5, 0x74, 0xdd,
+	0xc6, 0x8e, 0x23, 0x0a, 0x92, 0xb0, 0xbf, 0x7e, 0x2c, 0x7e, 0xfd, 0xc5, 0x41, 0x96, 0xdf, 0x59,
+	0xf6, 0x4e, 0xda, 0x2e, 0xc5, 0x2a, 0xc8, 0x97, 0xaa, 0x6a, 0x84, 0x9f, 0xa0, 0x3a, 0xec, 0x90,
+	0x09, 0xb6, 0x1d, 0xa3, 0x3f, 0x70, 0x63, 0x64, 0x89, 0x05, 0x64, 0xdb, 0x81, 0x58, 0x84, 0xad,
+	0x02, 0xf9, 0x09, 0x71, 0xa9, 0x5e, 0x23, 0x6c, 0x1b, 0x44, 0x57, 0xf1, 0xb9, 0x8b, 0x2d, 0xc7,
+	0x20, 0x96, 0xa3, 0x0e, 0x0d, 0xd3, 0x70, 0xc5, 0x65, 0x49, 0xd8, 0xbf, 0xad, 0xdc, 0xf3, 0x50,
+	0x2d, 0x06, 0x92, 0x03, 0x4c, 0x9d, 0x42, 0xd0, 0x00, 0xa4, 0xb7, 0x90, 0xa8, 0xbe, 0x6f, 0xc4,
+	0xa4, 0x24, 0xec, 0xa7, 0x8f, 0x76, 0x8b, 0x9e, 0x73, 0x8a, 0xbe, 0x73, 0x8a, 0x55, 0x0e, 0x38,
+	0x4e, 0xfe, 0xee, 0x9b, 0x3d, 0x41, 0x79, 0x70, 0xe9, 0x3d, 0x3e, 0x08, 0x1d, 0xc1, 0x8e, 0xa9,
+	0x9d, 0xab, 0x9a, 0x65, 0x11, 0x97, 0xed, 0xa8, 0x43, 0x6c, 0xf5, 0xdd, 0x81, 0xb8, 0x22, 0x09,
+	0xfb, 0x49, 0x65, 0xdb, 0xd4, 0xce, 0xcb, 0xc1, 0x59, 0x9d, 0x1d, 0xa1, 0x27, 0x70, 0x3f, 0x2e,
+	0xe3, 0x50, 0x35, 0xa9, 0xab, 0x47, 0xc4, 0xd1, 0x86, 0x62, 0x8a, 0x3d, 0x70, 0x37, 0x26, 0xea,
+	0xb4, 0xb0, 0xdd, 0xe2, 0x00, 0xf4, 0x1c, 0x76, 0x26, 0xd8, 0x25, 0x6a, 0x6f, 0xa0, 0x0d, 0xe9,
+	0x75, 0x58, 0x7d, 0x69, 0x58, 0x3a, 0x79, 0x29, 0xae, 0x2e, 0x7a, 0xd3, 0xda, 0x97, 0xff, 0xd9,
+	0x5b, 0x62, 0xef, 0xda, 0xa6, 0x0c, 0x15, 0x9f, 0xe0, 0x39, 0x93, 0x47, 0x5d, 0xb8, 0xc7, 0x88,
+	0xa9, 0x63, 0x6c, 0x43, 0xc7, 0x6a, 0xcc, 0x8a, 0xe2, 0xda, 0xd5, 0xe9, 0x45, 0xca, 0xd3, 0xe4,
+	0x34, 0x67, 0x11, 0x13, 0xa2, 0x1f, 0x41, 0x36, 0x7e, 0xc7, 0x2f, 0xc6, 0xc4, 0x1e, 0x9b, 0xe2,
+	0x3a, 0x8b, 0x96, 0x8d, 0xaf, 0xbf, 0x38, 0x00, 0x1e, 0x2d, 0x55, 0xdc, 0x53, 0x50, 0x94, 0xe7,
+	0x27, 0x0c, 0x89, 0x9e, 0xc2, 0xdd, 0x38, 0x83, 0x3b, 0xb0, 0xb1, 0x33, 0x20, 0x43, 0x5d, 0x84,
+	0x4b, 0x49, 0x76, 0xa2, 0x24, 0x1d, 0x1f, 0x3c, 0x97, 0x04, 0x26, 0x36, 0xbb, 0xd8, 0x76, 0xc4,
+	0xb4, 0xb4, 0x7c, 0xe5, 0x24, 0xf8, 0xb1, 0x27, 0x33, 0x9f, 0x04, 0x3e, 0xd9, 0xad, 0x05, 0x64,
+	0xb1, 0x24, 0xf0, 0xd9, 0x4e, 0x60, 0x2b, 0xa6, 0x99, 0x8b, 0x6d, 0x53, 0xbc, 0xcd, 0xac, 0x7f,
+	0xaf, 0x38, 0x5f, 0x97, 0x8a, 0x55, 0x8d, 0x74, 0xb0, 0x6d, 0x2a, 0x9b, 0x11, 0xcd, 0xe8, 0x06,
+	0xaa, 0x01, 0x8a, 0xab, 0xc5, 0x98, 0x36, 0x16, 0x33, 0x65, 0xa2, 0x6a, 0x31, 0xaa, 0x5f, 0x0a,
+	0xb0, 0x1d, 0x53, 0xaa, 0x3b, 0xd6, 0xfb, 0xd8, 0x15, 0x37, 0xa5, 0x65, 0x16, 0x14, 0xfc, 0x75,
+	0xb4, 0x2a, 0x15, 0x79, 0x55, 0x2a, 0x56, 0x88, 0x61, 0x1d, 0x7f, 0x48, 0x83, 0xe2, 0xaf, 0xdf,
+	0xec, 0xed, 0xf7, 0x0d, 0x77, 0x30, 0xee, 0x16, 0x7b, 0xc4, 0xe4, 0x05, 0x8d, 0xff, 0x77, 0xe0,
+	0xe8, 0x9f, 0x95, 0xdc, 0x57, 0x23, 0xec, 0x30, 0x01, 0xe7, 0x2f, 0xff, 0xfb, 0xc7, 0x43, 0x41,
+	0xd9, 0x8a, 0xbc, 0xe5, 0x98, 0x5d, 0x85, 0x7e, 0x25, 0x40, 0x36, 0xfe, 0x1c, 0xae, 0x43, 0xe6,
+	0x3d, 0xe9, 0x80, 0xa2, 0x56, 0xe0, 0x4a, 0x3c, 0x87, 0x1d, 0xef, 0x56, 0x15, 0x8f, 0x48, 0x6f,
+	0x10, 0x16, 0x94, 0xad, 0x6b, 0x24, 0x9f, 0xc7, 0x20, 0x53, 0x02, 0xff, 0xb8, 0xf0, 0x6b, 0x01,
+	0x56, 0x7d, 0x63, 0x3f, 0x01, 0x70, 0x5c, 0xcd, 0x76, 0x55, 0xda, 0x2a, 0x58, 0x55, 0x4e, 0x1f,
+	0xe5, 0xe6, 0x98, 0x3b, 0x7e, 0x1f, 0x39, 0x4e, 0x7e, 0x4e, 0x69, 0xd7, 0x99, 0x0c, 0xdd, 0x45,
+	0x3f, 0x80, 0x35, 0x6c, 0xe9, 0x9e, 0x78, 0xe2, 0x8a, 0xe2, 0xab, 0xd8, 0xd2, 0xe9, 0x5e, 0xe1,
+	0x6f, 0x09, 0x48, 0x57, 0x35, 0x72, 0xaa, 0x59, 0x3a, 0x35, 0x00, 0x7a, 0x0c, 0x6b, 0xd4, 0xd8,
+	0x36, 0x19, 0x7a, 0xba, 0x6c, 0xbc, 0x35, 0x76, 0x14, 0x32, 0xc4, 0xca, 0xaa, 0xee, 0x7d, 0xa0,
+	0x3b, 0x90, 0x1a, 0x60, 0x6a, 0x3d, 0xa6, 0xc2, 0xb2, 0xc2, 0x57, 0xe8, 0x08, 0x56, 0xfd, 0x1e,
+	0xb1, 0xbc, 0xa0, 0x47, 0xf8, 0x40, 0x2a, 0xe3, 0xa7, 0x54, 0x72, 0x41, 0x4a, 0xf9, 0x40, 0x54,
+	0x82, 0x24, 0x8b, 0xf7, 0x95, 0xc5, 0xf1, 0xce, 0x80, 0xe8, 0x31, 0xac, 0x3b, 0xbd, 0x01, 0xd6,
+	0xc7, 0x43, 0x6c, 0xb3, 0x32, 0xfc, 0xae, 0x6b, 0x42, 0x68, 0xe1, 0xf7, 0xcb, 0x90, 0x2a, 0xf7,
+	0x58, 0x43, 0xd8, 0x80, 0x84, 0xa1, 0x33, 0x2b, 0x25, 0x95, 0x84, 0xa1, 0xa3, 0x3d, 0x48, 0xfb,
+	0x85, 0x5d, 0x35, 0x74, 0x66, 0x88, 0xa4, 0x02, 0xfe, 0x56, 0x4d, 0x47, 0x8f, 0x20, 0xe5, 0x18,
+	0x7d, 0x0b, 0xdb, 0x0b, 0x6d, 0xc1, 0x71, 0x31, 0x77, 0x24, 0xaf, 0xe1, 0x8e, 0x27, 0x90, 0xd6,
+	0x98, 0x92, 0x2a, 0x0d, 0x74, 0x66, 0x95, 0x8d, 0xa3, 0xfc, 0x65, 0xa2, 0xde, 0x5b, 0x3a, 0xaf,
+	0x46, 0x58, 0x01, 0x2d, 0xf8, 0x8e, 0xf8, 0x33, 0x15, 0xf3, 0xe7, 0x47, 0x90, 0x64, 0x81, 0xb6,
+	0xba, 0x30, 0xd0, 0x58, 0x0a, 0xb0, 0x60, 0x63, 0x12, 0x28, 0x0f, 0x10, 0xb6, 0x41, 0xd6, 0x5f,
+	0xd6, 0x95, 0xc8, 0x0e, 0xfa, 0x00, 0x6e, 0x75, 0xc7, 0xb6, 0xa5, 0xea, 0x78, 0x44, 0x1c, 0xc3,
+	0x65, 0x4d, 0x62, 0x4d, 0x49, 0xd3, 0xbd, 0xaa, 0xb7, 0x45, 0x0d, 0xcc, 0xba, 0x81, 0x8d, 0x35,
+	0x87, 0x58, 0x5e, 0x07, 0x50, 0x80, 0x6e, 0x29, 0x6c, 0xa7, 0xf0, 0x46, 0x00, 0x08, 0x1b, 0xe9,
+	0x45, 0x87, 0x08, 0x73, 0x0e, 0xc9, 0xc2, 0x8a, 0x61, 0xe9, 0xf8, 0x9c, 0xfb, 0xca, 0x5b, 0xdc,
+	0xc0, 0x4d, 0x88, 0x46, 0xdf, 0xb9, 0xcb, 0x5c, 0xb4, 0xae, 0xb0, 0xef, 0xc0, 0x52, 0x2b, 0x37,
+	0xb1, 0x94, 0x33, 0x1e, 0x61, 0xdb, 0xc1, 0x3a, 0x76, 0x98, 0xfd, 0x93, 0x4a, 0x64, 0xa7, 0xf0,
+	0xff, 0x15, 0x48, 0x9e, 0x61, 0x97, 0x2c, 0x7e, 0xdf, 0x23, 0x48, 0x51, 0xeb, 0x60, 0x7b, 0xe1,
+	0x80, 0xc6, 0x71, 0xd4, 0xef, 0xdc, 0xba, 0xec, 0xed, 0x0a, 0x5f, 0xcd, 0x79, 0x27, 0x39, 0xef,
+	0x9d, 0x9b, 0x3f, 0xf8, 0x31, 0xa4, 0x1c, 0x57, 0x73, 0xc7, 0xde, 0x63, 0xdf, 0x12, 0xa8, 0xf4,
+	0xc5, 0x6d, 0x86, 0x52, 0x38, 0x1a, 0x3d, 0x85, 0xcd, 0xe0, 0xfd, 0x9c, 0x60, 0x95, 0x11, 0x3c,
+	0xf0, 0x09, 0xe8, 0x44, 0x3c, 0x39, 0x2c, 0xfa, 0xe3, 0x14, 0x97, 0xdf, 0x18, 0xc5, 0xd6, 0xe8,
+	0x13, 0xd8, 0xb0, 0xb1, 0xa9, 0x19, 0x16, 0xed, 0x77, 0xec, 0x0d, 0xd7, 0x18, 0x7f, 0x6e, 0x07,
+	0xa2, 0xac, 0x1a, 0x2b, 0x80, 0xc2, 0x59, 0x2d, 0xa8, 0xcb, 0xeb, 0xd7, 0xb0, 0x49, 0x26, 0x90,
+	0x97, 0xbd, 0x22, 0x8d, 0x3e, 0x02, 0x08, 0xf6, 0x6c, 0x3e, 0xf8, 0xbc, 0xdd, 0x95, 0x11, 0x2c,
+	0x7a, 0x01, 0xe2, 0xc5, 0x01, 0x2f, 0xd0, 0x29, 0x7d, 0xc5, 0x5e, 0xb1, 0x43, 0x62, 0xb3, 0x9d,
+	0xaf, 0xd4, 0x0f, 0xbd, 0x79, 0x83, 0x51, 0xbf, 0xc2, 0x8e, 0xda, 0x23, 0x63, 0xcb, 0x15, 0x6f,
+	0xcd, 0x4d, 0x65, 0x35, 0xcb, 0xf5, 0x46, 0x0c, 0x8a, 0x7c, 0x81, 0x9d, 0x0a, 0xc5, 0xa1, 0x8f,
+	0x61, 0x2b, 0x90, 0xb6, 0x08, 0x17, 0xbe, 0x7d, 0xa9, 0xf0, 0xa6, 0x0f, 0x6c, 0x10, 0x26, 0x5b,
+	0xf8, 0xa7, 0x00, 0x99, 0xb3, 0xf8, 0xcc, 0x89, 0x17, 0xe7, 0x42, 0x11, 0x56, 0x26, 0xc4, 0xbd,
+	0x42, 0x2a, 0x78, 0x30, 0x94, 0x83, 0x35, 0xff, 0x62, 0x96, 0x0b, 0x6b, 0x4a, 0xb0, 0x46, 0x87,
+	0x70, 0xcb, 0x1f, 0x97, 0xc9, 0x4b, 0x6c, 0x7b, 0x79, 0x3f, 0xa7, 0x78, 0x9a, 0xff, 0x9e, 0xa0,
+	0x90, 0xc2, 0xcf, 0x21, 0x7d, 0x1c, 0x4e, 0x02, 0x34, 0xcf, 0xac, 0x31, 0x6d, 0x5d, 0x5c, 0x53,
+	0xbe, 0x42, 0x95, 0xd8, 0x34, 0x90, 0xb8, 0x46, 0xd8, 0x84, 0x13, 0x41, 0xe1, 0xef, 0x82, 0x7f,
+	0x59, 0x7b, 0x84, 0x2d, 0xf7, 0xc6, 0x4d, 0x7d, 0x00, 0x29, 0xcd, 0x64, 0x9e, 0x49, 0xbc, 0xa7,
+	0xa9, 0x8b, 0xf3, 0x17, 0x7e, 0xbb, 0x0c, 0x2b, 0x54, 0x57, 0x7d, 0xae, 0xa9, 0x46, 0x75, 0x4f,
+	0x5c, 0x43, 0xf7, 0x23, 0x58, 0x75, 0x28, 0xe1, 0x15, 0xaa, 0xb8, 0x0f, 0xa4, 0x33, 0x81, 0x8d,
+	0x7b, 0xc6, 0xc8, 0xc0, 0x16, 0xaf, 0xe5, 0xef, 0x9a, 0x09, 0x02, 0x68, 0xc4, 0x4e, 0x2b, 0xef,
+	0xd7, 0x4e, 0xb4, 0xd1, 0x98, 0xd8, 0x24, 0xde, 0xc0, 0xa2, 0xb0, 0x6f, 0xda, 0xc4, 0xd8, 0x78,
+	0xca, 0x6a, 0x5f, 0x52, 0xf1, 0x16, 0x41, 0x35, 0x5e, 0xbb, 0x6e, 0x35, 0x7e, 0xf8, 0x07, 0x6f,
+	0x38, 0x65, 0x56, 0x7c, 0x04, 0xd9, 0x6a, 0xb9, 0xa9, 0x2a, 0xcd, 0xba, 0xac, 0x3e, 0x6b, 0xb4,
+	0x5b, 0x72, 0xa5, 0xf6, 0xb4, 0x26, 0x57, 0x33, 0x4b, 0xb9, 0x3b, 0xd3, 0x99, 0x84, 0x38, 0xec,
+	0x99, 0xe5, 0x8c, 0x70, 0xcf, 0xf8, 0xd4, 0xc0, 0x3a, 0x7a, 0x08, 0x5b, 0x81, 0x44, 0xbb, 0x23,
+	0xcb, 0x4a, 0xad, 0x71, 0x92, 0x11, 0x72, 0xdb, 0xd3, 0x99, 0xb4, 0xc9, 0xe1, 0x6d, 0x3e, 0xed,
+	0xa3, 0xef, 0x00, 0x0a, 0xb0, 0xcd, 0x33, 0x59, 0x69, 0xd7, 0x4e, 0x4e, 0x3b, 0x99, 0x44, 0x2e,
+	0x3b, 0x9d, 0x49, 0x19, 0x0e, 0x6e, 0xfa, 0x63, 0x79, 0x2e, 0xf9, 0x9b, 0x3f, 0xe7, 0x97, 0x1e,
+	0xfe, 0x2b, 0x01, 0x10, 0xce, 0x2c, 0xe8, 0x31, 0xdc, 0x2d, 0x57, 0x3a, 0xb5, 0x66, 0x43, 0xed,
+	0xbc, 0x68, 0x5d, 0xd4, 0x71, 0x77, 0x3a, 0x93, 0x76, 0x42, 0x70, 0x54, 0xcd, 0x32, 0x3c, 0x88,
+	0xca, 0x95, 0x1b, 0x8d, 0x66, 0xa7, 0xdc, 0x91, 0xd5, 0x96, 0xd2, 0x6c, 0x35, 0xdb, 0xe5, 0x7a,
+	0x46, 0xc8, 0xe5, 0xa7, 0x33, 0x29, 0x17, 0x4a, 0xf3, 0xb9, 0x02, 0x07, 0x3f, 0xcd, 0x9f, 0xc0,
+	0xfd, 0x28, 0x85, 0xdc, 0xa8, 0x36, 0x95, 0x76, 0x84, 0x21, 0x91, 0x7b, 0x30, 0x9d, 0x49, 0xbb,
+	0x21, 0x83, 0x6c, 0xe9, 0xc4, 0x76, 0x42, 0x82, 0xa7, 0x20, 0xc5, 0x08, 0x7e, 0xda, 0x91, 0x1b,
+	0x55, 0xf5, 0xac, 0xd9, 0xa9, 0x35, 0x4e, 0xd4, 0x96, 0xac, 0xd4, 0x9a, 0xd5, 0xcc, 0x72, 0x4e,
+	0x9a, 0xce, 0xa4, 0xfb, 0x11, 0x92, 0x73, 0x17, 0x5b, 0x7a, 0xec, 0x67, 0xf6, 0xf7, 0x61, 0x37,
+	0xca, 0x73, 0x26, 0x77, 0x9a, 0xa1, 0x16, 0xc9, 0x5c, 0x6e, 0x3a, 0x93, 0xee, 0x84, 0x04, 0xb4,
+	0x72, 0xfa, 0x2a, 0x70, 0x9b, 0xfe, 0x29, 0x01, 0x10, 0xb6, 0x57, 0x6a, 0x53, 0xc6, 0xd1, 0xee,
+	0x94, 0x3b, 0xcf, 0xda, 0x97, 0xd9, 0x34, 0x04, 0x47, 0x6d, 0xfa, 0x31, 0xec, 0x46, 0xe5, 0x2a,
+	0xa7, 0xe5, 0x7a, 0x5d, 0x6e, 0x9c, 0xc8, 0xe5, 0xe3, 0xba, 0x9c, 0x11, 0x72, 0xf7, 0xa6, 0x33,
+	0xe9, 0x6e, 0x28, 0x19, 0xfc, 0x41, 0x42, 0xeb, 0x0e, 0x31, 0xfa, 0x1e, 0xdc, 0xb9, 0x54, 0xb6,
+	0x9a, 0x49, 0xe4, 0xc4, 0xe9, 0x4c, 0xca, 0x5e, 0x22, 0xa8, 0xd3, 0x00, 0x8a, 0x69, 0xda, 0x3a,
+	0x95, 0xeb, 0xd4, 0x66, 0x2c, 0x80, 0x22, 0x4a, 0x8e, 0x06, 0x78, 0xa8, 0x5f, 0xbc, 0x83, 0x46,
+	0x9c, 0x52, 0xab, 0x56, 0xe5, 0x46, 0x26, 0x79, 0xf1, 0x0e, 0xde, 0x5a, 0x74, 0x6c, 0x79, 0x26,
+	0x3a, 0x6e, 0x7e, 0xf9, 0x3a, 0x2f, 0x7c, 0xf5, 0x3a, 0x2f, 0xfc, 0xf7, 0x75, 0x5e, 0xf8, 0xfc,
+	0x4d, 0x7e, 0xe9, 0xab, 0x37, 0xf9, 0xa5, 0x7f, 0xbf, 0xc9, 0x2f, 0xfd, 0xec, 0xc3, 0x48, 0x26,
+	0x9f, 0xb2, 0xc2, 0x74, 0x50, 0x19, 0x68, 0x86, 0x55, 0xf2, 0xaa, 0xd4, 0x41, 0x8f, 0x2d, 0xce,
+	0xc3, 0xbf, 0x3f, 0xb2, 0xe4, 0xee, 0xa6, 0x58, 0x26, 0x7e, 0xf7, 0xdb, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x2a, 0x51, 0x17, 0x01, 0x9f, 0x14, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BudgetEpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BudgetEpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCoredaos(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.OversightDaoBudget) > 0 {
+		for iNdEx := len(m.OversightDaoBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OversightDaoBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SteeringDaoBudget) > 0 {
+		for iNdEx := len(m.SteeringDaoBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SteeringDaoBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.OversightDaoTerm != nil {
+		{
+			size, err := m.OversightDaoTerm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCoredaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SteeringDaoTerm != nil {
+		{
+			size, err := m.SteeringDaoTerm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCoredaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.OversightDaoMembers) > 0 {
+		for iNdEx := len(m.OversightDaoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OversightDaoMembers[iNdEx])
+			copy(dAtA[i:], m.OversightDaoMembers[iNdEx])
+			i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OversightDaoMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SteeringDaoMembers) > 0 {
+		for iNdEx := len(m.SteeringDaoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SteeringDaoMembers[iNdEx])
+			copy(dAtA[i:], m.SteeringDaoMembers[iNdEx])
+			i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringDaoMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VetoOverrideThreshold) > 0 {
+		i -= len(m.VetoOverrideThreshold)
+		copy(dAtA[i:], m.VetoOverrideThreshold)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.VetoOverrideThreshold)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.VetoOverrideQuorum) > 0 {
+		i -= len(m.VetoOverrideQuorum)
+		copy(dAtA[i:], m.VetoOverrideQuorum)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.VetoOverrideQuorum)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VetoOverrideVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoOverrideVotingPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCoredaos(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VetoChallengeWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoChallengeWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCoredaos(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxAnnotationsPerProposal != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.MaxAnnotationsPerProposal))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxAnnotationLength != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.MaxAnnotationLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotingPeriodExtensionDuration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriodExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintCoredaos(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriodExtensionsLimit != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.VotingPeriodExtensionsLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OversightDaoAddress) > 0 {
+		i -= len(m.OversightDaoAddress)
+		copy(dAtA[i:], m.OversightDaoAddress)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OversightDaoAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SteeringDaoAddress) > 0 {
+		i -= len(m.SteeringDaoAddress)
+		copy(dAtA[i:], m.SteeringDaoAddress)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.SteeringDaoAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintCoredaos(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintCoredaos(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaoHandover) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaoHandover) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaoHandover) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Scheduler)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Term != nil {
+		{
+			size, err := m.Term.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCoredaos(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DaoRole != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.DaoRole))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Action) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Action) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoReason) > 0 {
+		i -= len(m.VetoReason)
+		copy(dAtA[i:], m.VetoReason)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.VetoReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintCoredaos(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActionType != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DaoRole != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.DaoRole))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Annotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Annotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Annotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Supersedes != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Supersedes))
+		i--
+		dAtA[i] = 0x30
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintCoredaos(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Veto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Veto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Veto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OverrideNoCount) > 0 {
+		i -= len(m.OverrideNoCount)
+		copy(dAtA[i:], m.OverrideNoCount)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OverrideNoCount)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.OverrideYesCount) > 0 {
+		i -= len(m.OverrideYesCount)
+		copy(dAtA[i:], m.OverrideYesCount)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.OverrideYesCount)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OverrideVotingEndTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.OverrideVotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OverrideVotingEndTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintCoredaos(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ChallengeEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChallengeEndTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintCoredaos(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x4a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RemainingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintCoredaos(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x42
+	if m.ProposalStatus != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalStatus))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintCoredaos(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VetoOverrideVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoOverrideVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoOverrideVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Override {
+		i--
+		if m.Override {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintCoredaos(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	if m.Number != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetSpent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetSpent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetSpent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DaoRole != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.DaoRole))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Spend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Spend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Spend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintCoredaos(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x42
+	if m.Epoch != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoredaos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintCoredaos(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DaoRole != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.DaoRole))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoredaos(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCoredaos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCoredaos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SteeringDaoAddress)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.OversightDaoAddress)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.VotingPeriodExtensionsLimit != 0 {
+		n += 1 + sovCoredaos(uint64(m.VotingPeriodExtensionsLimit))
+	}
+	if m.VotingPeriodExtensionDuration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.MaxAnnotationLength != 0 {
+		n += 1 + sovCoredaos(uint64(m.MaxAnnotationLength))
+	}
+	if m.MaxAnnotationsPerProposal != 0 {
+		n += 1 + sovCoredaos(uint64(m.MaxAnnotationsPerProposal))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoChallengeWindow)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VetoOverrideVotingPeriod)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = len(m.VetoOverrideQuorum)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.VetoOverrideThreshold)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.SteeringDaoMembers) > 0 {
+		for _, s := range m.SteeringDaoMembers {
+			l = len(s)
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if len(m.OversightDaoMembers) > 0 {
+		for _, s := range m.OversightDaoMembers {
+			l = len(s)
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if m.SteeringDaoTerm != nil {
+		l = m.SteeringDaoTerm.Size()
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.OversightDaoTerm != nil {
+		l = m.OversightDaoTerm.Size()
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.SteeringDaoBudget) > 0 {
+		for _, e := range m.SteeringDaoBudget {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if len(m.OversightDaoBudget) > 0 {
+		for _, e := range m.OversightDaoBudget {
+			l = e.Size()
+			n += 2 + l + sovCoredaos(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BudgetEpochDuration)
+	n += 2 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func (m *DaoTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *DaoHandover) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DaoRole != 0 {
+		n += 1 + sovCoredaos(uint64(m.DaoRole))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoredaos(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	if m.Term != nil {
+		l = m.Term.Size()
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.Scheduler)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *Action) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoredaos(uint64(m.Id))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.DaoRole != 0 {
+		n += 1 + sovCoredaos(uint64(m.DaoRole))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovCoredaos(uint64(m.ActionType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoredaos(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	l = len(m.VetoReason)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *Annotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovCoredaos(uint64(m.Index))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	if m.Supersedes != 0 {
+		n += 1 + sovCoredaos(uint64(m.Supersedes))
+	}
+	return n
+}
+
+func (m *Veto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovCoredaos(uint64(m.Status))
+	}
+	if m.ProposalStatus != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalStatus))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChallengeEndTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.OverrideVotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.OverrideVotingEndTime)
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.OverrideYesCount)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.OverrideNoCount)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *VetoOverrideVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovCoredaos(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Override {
+		n += 2
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	return n
+}
+
+func (m *BudgetEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovCoredaos(uint64(m.Number))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func (m *BudgetSpent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DaoRole != 0 {
+		n += 1 + sovCoredaos(uint64(m.DaoRole))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Spend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoredaos(uint64(m.Id))
+	}
+	if m.DaoRole != 0 {
+		n += 1 + sovCoredaos(uint64(m.DaoRole))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCoredaos(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCoredaos(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovCoredaos(uint64(m.Epoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoredaos(uint64(l))
+	return n
+}
+
+func sovCoredaos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCoredaos(x uint64) (n int) {
+	return sovCoredaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SteeringDaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OversightDaoAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionsLimit", wireType)
+			}
+			m.VotingPeriodExtensionsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriodExtensionsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodExtensionDuration == nil {
+				m.VotingPeriodExtensionDuration = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriodExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnnotationLength", wireType)
+			}
+			m.MaxAnnotationLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAnnotationLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnnotationsPerProposal", wireType)
+			}
+			m.MaxAnnotationsPerProposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAnnotationsPerProposal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoChallengeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VetoChallengeWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoOverrideVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VetoOverrideVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoOverrideQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoOverrideQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoOverrideThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoOverrideThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SteeringDaoMembers = append(m.SteeringDaoMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OversightDaoMembers = append(m.OversightDaoMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoTerm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SteeringDaoTerm == nil {
+				m.SteeringDaoTerm = &DaoTerm{}
+			}
+			if err := m.SteeringDaoTerm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoTerm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OversightDaoTerm == nil {
+				m.OversightDaoTerm = &DaoTerm{}
+			}
+			if err := m.OversightDaoTerm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SteeringDaoBudget = append(m.SteeringDaoBudget, types.Coin{})
+			if err := m.SteeringDaoBudget[len(m.SteeringDaoBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OversightDaoBudget = append(m.OversightDaoBudget, types.Coin{})
+			if err := m.OversightDaoBudget[len(m.OversightDaoBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BudgetEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaoHandover) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaoHandover: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaoHandover: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRole", wireType)
+			}
+			m.DaoRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaoRole |= DaoRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Term == nil {
+				m.Term = &DaoTerm{}
+			}
+			if err := m.Term.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Action) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Action: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Action: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRole", wireType)
+			}
+			m.DaoRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaoRole |= DaoRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Annotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Annotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Annotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+			m.Supersedes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supersedes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Veto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Veto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Veto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VetoStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= v1.ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RemainingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ChallengeEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideVotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OverrideVotingEndTime == nil {
+				m.OverrideVotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.OverrideVotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideYesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverrideYesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideNoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverrideNoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoOverrideVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoOverrideVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoOverrideVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Override = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintCoredaos(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCoredaos(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SteeringDaoAddress)
-	if l > 0 {
-		n += 1 + l + sovCoredaos(uint64(l))
-	}
-	l = len(m.OversightDaoAddress)
-	if l > 0 {
-		n += 1 + l + sovCoredaos(uint64(l))
-	}
-	if m.VotingPeriodExtensionsLimit != 0 {
-		n += 1 + sovCoredaos(uint64(m.VotingPeriodExtensionsLimit))
-	}
-	if m.VotingPeriodExtensionDuration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriodExtensionDuration)
-		n += 1 + l + sovCoredaos(uint64(l))
+func (m *BudgetSpent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoredaos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetSpent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetSpent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRole", wireType)
+			}
+			m.DaoRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaoRole |= DaoRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoredaos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCoredaos(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCoredaos(x uint64) (n int) {
-	return sovCoredaos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Spend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -254,15 +4663,53 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Spend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Spend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRole", wireType)
+			}
+			m.DaoRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaoRole |= DaoRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SteeringDaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -290,11 +4737,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SteeringDaoAddress = string(dAtA[iNdEx:postIndex])
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OversightDaoAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -322,13 +4769,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OversightDaoAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoredaos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoredaos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionsLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.VotingPeriodExtensionsLimit = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoredaos
@@ -338,14 +4851,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPeriodExtensionsLimit |= uint32(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodExtensionDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -372,10 +4885,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriodExtensionDuration == nil {
-				m.VotingPeriodExtensionDuration = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriodExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,4 +11,13 @@ var (
 	ErrProposalAlreadyEndorsed  = errorsmod.Register(ModuleName, 3, "proposal already endorsed")
 	ErrFunctionDisabled         = errorsmod.Register(ModuleName, 4, "function is disabled")
 	ErrCannotStake              = errorsmod.Register(ModuleName, 5, "core DAOs cannot stake")
+	ErrAnnotationTooLong        = errorsmod.Register(ModuleName, 6, "annotation too long")
+	ErrTooManyAnnotations       = errorsmod.Register(ModuleName, 7, "too many annotations")
+	ErrUnknownAnnotation        = errorsmod.Register(ModuleName, 8, "unknown annotation")
+	ErrUnknownVeto              = errorsmod.Register(ModuleName, 9, "unknown veto")
+	ErrInvalidVetoStatus        = errorsmod.Register(ModuleName, 10, "invalid veto status")
+	ErrNoBondedTokens           = errorsmod.Register(ModuleName, 11, "signer has no bonded tokens")
+	ErrInvalidHandover          = errorsmod.Register(ModuleName, 12, "invalid core DAO handover")
+	ErrUnknownHandover          = errorsmod.Register(ModuleName, 13, "unknown core DAO handover")
+	ErrBudgetExceeded           = errorsmod.Register(ModuleName, 14, "core DAO budget exceeded")
 )
@@ -6,11 +6,34 @@ const (
 	EventTypeEndorseProposal    = "endorse_proposal"
 	EventTypeExtendVotingPeriod = "extend_voting_period"
 	EventTypeVetoProposal       = "veto_proposal"
+	EventTypeChallengeVeto      = "challenge_veto"
+	EventTypeVoteVetoOverride   = "vote_veto_override"
+	EventTypeVetoFinalized      = "veto_finalized"
+	EventTypeScheduleHandover   = "schedule_dao_handover"
+	EventTypeCancelHandover     = "cancel_dao_handover"
+	EventTypeDaoHandover        = "dao_handover"
+	EventTypeDaoTermExpired     = "dao_term_expired"
+	EventTypeSpendFromBudget    = "spend_from_budget"
+	EventTypeBudgetEpoch        = "budget_epoch"
 
 	AttributeKeyProposalID    = "proposal_id"
 	AttributeKeySigner        = "signer"
 	AttributeKeyNewEndTime    = "new_end_time"
 	AttributeKeyTimesExtended = "times_extended"
+	AttributeKeyAnnotationIdx = "annotation_index"
+	AttributeKeySupersedes    = "supersedes"
+	AttributeKeyReason        = "reason"
+	AttributeKeyChallengeEnd  = "challenge_end_time"
+	AttributeKeyOverrideEnd   = "override_voting_end_time"
+	AttributeKeyOverride      = "override"
+	AttributeKeyVetoStatus    = "veto_status"
+	AttributeKeyDaoRole       = "dao_role"
+	AttributeKeyHeight        = "height"
+	AttributeKeyDaoAddress    = "dao_address"
+	AttributeKeySpendID       = "spend_id"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyEpoch         = "epoch"
 
 	AttributeValueProposalVetoed = "proposal_vetoed"
 )
@@ -35,6 +35,17 @@ type GovKeeper interface {
 	// RemoveFromActiveProposalQueue removes a proposalID from the Active
 	// Proposal Queue
 	RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
+	// InsertExecutionQueue inserts a proposalID into the execution queue at
+	// executionTime
+	InsertExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time)
+	// RemoveFromExecutionQueue removes a proposalID from the execution queue
+	RemoveFromExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time)
+	// ResetQuorumCheck schedules again the quorum checks of a proposal in
+	// voting period
+	ResetQuorumCheck(ctx sdk.Context, proposal govtypesv1.Proposal)
+	// IncrementActiveProposalsNumber increments the number of active proposals
+	// by one
+	IncrementActiveProposalsNumber(ctx sdk.Context)
 	// DecrementActiveProposalsNumber decrements the number of active proposals
 	// by one
 	DecrementActiveProposalsNumber(ctx sdk.Context)
@@ -55,6 +66,16 @@ type StakingKeeper interface {
 	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
 	// GetDelegatorUnbonding returns the total amount a delegator has unbonding.
 	GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
+	// TotalBondedTokens returns the total amount of bonded tokens.
+	TotalBondedTokens(context.Context) (math.Int, error)
+}
+
+// DistributionKeeper defines the expected interface needed to interact with
+// the distribution module.
+type DistributionKeeper interface {
+	// DistributeFromFeePool distributes funds from the community pool to a
+	// receiver address.
+	DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -1,19 +1,138 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(
+	params Params, actions []Action, annotations []Annotation, vetoes []Veto, vetoOverrideVotes []VetoOverrideVote,
+	daoHandovers []DaoHandover, budgetEpoch *BudgetEpoch, budgetsSpent []BudgetSpent, spends []Spend,
+) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:            params,
+		Actions:           actions,
+		Annotations:       annotations,
+		Vetoes:            vetoes,
+		VetoOverrideVotes: vetoOverrideVotes,
+		DaoHandovers:      daoHandovers,
+		BudgetEpoch:       budgetEpoch,
+		BudgetsSpent:      budgetsSpent,
+		Spends:            spends,
 	}
 }
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), nil, nil, nil, nil, nil, nil, nil, nil)
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	actionIDs := make(map[uint64]bool, len(gs.Actions))
+	for _, action := range gs.Actions {
+		if actionIDs[action.Id] {
+			return fmt.Errorf("duplicate action id %d", action.Id)
+		}
+		actionIDs[action.Id] = true
+		if err := action.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid action %d: %w", action.Id, err)
+		}
+	}
+
+	type annotationKey struct{ proposalID, index uint64 }
+	annotationKeys := make(map[annotationKey]bool, len(gs.Annotations))
+	for _, annotation := range gs.Annotations {
+		key := annotationKey{annotation.ProposalId, annotation.Index}
+		if annotationKeys[key] {
+			return fmt.Errorf("duplicate annotation %d of proposal %d", annotation.Index, annotation.ProposalId)
+		}
+		annotationKeys[key] = true
+		if err := annotation.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid annotation %d of proposal %d: %w", annotation.Index, annotation.ProposalId, err)
+		}
+	}
+
+	challengedVetoes := make(map[uint64]bool)
+	vetoIDs := make(map[uint64]bool, len(gs.Vetoes))
+	for _, veto := range gs.Vetoes {
+		if vetoIDs[veto.ProposalId] {
+			return fmt.Errorf("duplicate veto of proposal %d", veto.ProposalId)
+		}
+		vetoIDs[veto.ProposalId] = true
+		if err := veto.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid veto of proposal %d: %w", veto.ProposalId, err)
+		}
+		if veto.Status == VetoStatusChallenged {
+			challengedVetoes[veto.ProposalId] = true
+		}
+	}
+
+	type voteKey struct {
+		proposalID uint64
+		voter      string
+	}
+	voteKeys := make(map[voteKey]bool, len(gs.VetoOverrideVotes))
+	for _, vote := range gs.VetoOverrideVotes {
+		key := voteKey{vote.ProposalId, vote.Voter}
+		if voteKeys[key] {
+			return fmt.Errorf("duplicate veto override vote of %s on proposal %d", vote.Voter, vote.ProposalId)
+		}
+		voteKeys[key] = true
+		if err := vote.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid veto override vote on proposal %d: %w", vote.ProposalId, err)
+		}
+		if !challengedVetoes[vote.ProposalId] {
+			return fmt.Errorf("veto override vote on proposal %d without challenged veto", vote.ProposalId)
+		}
+	}
+
+	type handoverKey struct {
+		daoRole DaoRole
+		height  int64
+	}
+	handoverKeys := make(map[handoverKey]bool, len(gs.DaoHandovers))
+	pendingHandovers := make(map[DaoRole]int)
+	for _, handover := range gs.DaoHandovers {
+		key := handoverKey{handover.DaoRole, handover.Height}
+		if handoverKeys[key] {
+			return fmt.Errorf("duplicate %s handover at height %d", handover.DaoRole, handover.Height)
+		}
+		handoverKeys[key] = true
+		pendingHandovers[handover.DaoRole]++
+		if pendingHandovers[handover.DaoRole] > MaxPendingHandoversPerDao {
+			return fmt.Errorf("more than %d pending %s handovers", MaxPendingHandoversPerDao, handover.DaoRole)
+		}
+		if err := handover.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid %s handover at height %d: %w", handover.DaoRole, handover.Height, err)
+		}
+	}
+
+	if gs.BudgetEpoch != nil && gs.BudgetEpoch.Number == 0 {
+		return fmt.Errorf("budget epoch number must be positive")
+	}
+	budgetsSpent := make(map[DaoRole]bool, len(gs.BudgetsSpent))
+	for _, spent := range gs.BudgetsSpent {
+		if budgetsSpent[spent.DaoRole] {
+			return fmt.Errorf("duplicate budget spent by %s", spent.DaoRole)
+		}
+		budgetsSpent[spent.DaoRole] = true
+		if err := spent.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid budget spent by %s: %w", spent.DaoRole, err)
+		}
+	}
+	spendIDs := make(map[uint64]bool, len(gs.Spends))
+	for _, spend := range gs.Spends {
+		if spendIDs[spend.Id] {
+			return fmt.Errorf("duplicate spend id %d", spend.Id)
+		}
+		spendIDs[spend.Id] = true
+		if err := spend.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid spend %d: %w", spend.Id, err)
+		}
+	}
+	return nil
 }
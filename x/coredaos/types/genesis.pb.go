@@ -27,6 +27,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the x/coredaos module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// actions defines the actions taken by the core DAOs on proposals.
+	Actions []Action `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+	// annotations defines the annotation history of the proposals.
+	Annotations []Annotation `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations"`
+	// vetoes defines the vetoes of proposals.
+	Vetoes []Veto `protobuf:"bytes,4,rep,name=vetoes,proto3" json:"vetoes"`
+	// veto_override_votes defines the votes of the ongoing veto override votes.
+	VetoOverrideVotes []VetoOverrideVote `protobuf:"bytes,5,rep,name=veto_override_votes,json=vetoOverrideVotes,proto3" json:"veto_override_votes"`
+	// dao_handovers defines the scheduled handovers of the core DAOs.
+	DaoHandovers []DaoHandover `protobuf:"bytes,6,rep,name=dao_handovers,json=daoHandovers,proto3" json:"dao_handovers"`
+	// budget_epoch defines the current budget epoch. If not set, the first
+	// epoch starts at the first block.
+	BudgetEpoch *BudgetEpoch `protobuf:"bytes,7,opt,name=budget_epoch,json=budgetEpoch,proto3" json:"budget_epoch,omitempty"`
+	// budgets_spent defines the amounts spent by the core DAOs during the
+	// current budget epoch.
+	BudgetsSpent []BudgetSpent `protobuf:"bytes,8,rep,name=budgets_spent,json=budgetsSpent,proto3" json:"budgets_spent"`
+	// spends defines the spend history of the core DAOs.
+	Spends []Spend `protobuf:"bytes,9,rep,name=spends,proto3" json:"spends"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +87,62 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetActions() []Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAnnotations() []Annotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVetoes() []Veto {
+	if m != nil {
+		return m.Vetoes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVetoOverrideVotes() []VetoOverrideVote {
+	if m != nil {
+		return m.VetoOverrideVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDaoHandovers() []DaoHandover {
+	if m != nil {
+		return m.DaoHandovers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBudgetEpoch() *BudgetEpoch {
+	if m != nil {
+		return m.BudgetEpoch
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBudgetsSpent() []BudgetSpent {
+	if m != nil {
+		return m.BudgetsSpent
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpends() []Spend {
+	if m != nil {
+		return m.Spends
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hikari.coredaos.v1.GenesisState")
 }
@@ -76,21 +150,35 @@ func init() {
 func init() { proto.RegisterFile("hikari/coredaos/v1/genesis.proto", fileDescriptor_c35edf80505f3ead) }
 
 var fileDescriptor_c35edf80505f3ead = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xc8, 0xcc, 0x4e,
-	0x2c, 0xca, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0x4d, 0x49, 0xcc, 0x2f, 0xd6, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0x8a, 0x58, 0xcc, 0x82, 0xeb, 0x82, 0x28, 0x11, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0x21, 0x25, 0x5f, 0x2e, 0x1e, 0x77, 0x88, 0x85, 0xc1, 0x25, 0x89,
-	0x25, 0xa9, 0x42, 0xb6, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x98, 0x0e, 0xd0, 0x0b, 0x00, 0xab, 0x70, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x4d, 0x4e, 0xfe, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x01, 0x36, 0x52, 0xd7, 0x39, 0x23, 0x31, 0x33, 0x4f, 0x1f, 0x62, 0xbe, 0x6e,
-	0x32, 0x98, 0x53, 0x81, 0x70, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x99, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x78, 0xe2, 0x59, 0x2a, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x6e, 0xd4, 0x40,
+	0x10, 0xc7, 0x6d, 0x72, 0x38, 0x64, 0xef, 0x28, 0xb2, 0x50, 0x2c, 0x57, 0x38, 0x07, 0xa2, 0x40,
+	0x48, 0xb1, 0x15, 0x10, 0x20, 0x21, 0x51, 0x60, 0xbe, 0x22, 0x9a, 0xa0, 0x8b, 0x94, 0x22, 0x8d,
+	0xb5, 0xf6, 0xae, 0xec, 0x15, 0xba, 0x1d, 0xcb, 0xbb, 0xb1, 0xe0, 0x2d, 0x78, 0x0c, 0x4a, 0x1e,
+	0x23, 0x65, 0x4a, 0x2a, 0x84, 0xee, 0x0a, 0xde, 0x81, 0x0a, 0xed, 0x87, 0xef, 0x10, 0xf8, 0x1a,
+	0x6b, 0x67, 0xfc, 0xfb, 0xff, 0x3c, 0xf2, 0x0e, 0x9a, 0xd5, 0xe2, 0x23, 0x6d, 0x45, 0x5a, 0x42,
+	0xcb, 0x19, 0x05, 0x95, 0x76, 0x47, 0x69, 0xc5, 0x25, 0x57, 0x42, 0x25, 0x4d, 0x0b, 0x1a, 0x30,
+	0x76, 0x44, 0xd2, 0x13, 0x49, 0x77, 0x34, 0xbd, 0x5d, 0x41, 0x05, 0xf6, 0x75, 0x6a, 0x4e, 0x8e,
+	0x9c, 0xde, 0x1d, 0x70, 0xad, 0x53, 0x0e, 0xd9, 0xa7, 0x0b, 0x21, 0x21, 0xb5, 0x4f, 0xd7, 0xba,
+	0xf7, 0x7b, 0x84, 0x26, 0xef, 0xdc, 0x17, 0x4f, 0x35, 0xd5, 0x1c, 0xbf, 0x40, 0x51, 0x43, 0x5b,
+	0xba, 0x50, 0x24, 0x9c, 0x85, 0x0f, 0xc6, 0x8f, 0xa6, 0xc9, 0xff, 0x13, 0x24, 0x1f, 0x2c, 0x91,
+	0xed, 0x5d, 0xfe, 0x38, 0x08, 0xbe, 0xfe, 0xfa, 0xf6, 0x30, 0x9c, 0xfb, 0x10, 0x7e, 0x8e, 0x76,
+	0x69, 0xa9, 0x05, 0x48, 0x45, 0xae, 0xcd, 0x76, 0xb6, 0xe5, 0x5f, 0x5a, 0x24, 0x1b, 0x99, 0xfc,
+	0xbc, 0x0f, 0xe0, 0xb7, 0x68, 0x4c, 0xa5, 0x04, 0x4d, 0x5d, 0x7e, 0xc7, 0xe6, 0xe3, 0xc1, 0xfc,
+	0x1a, 0xf3, 0x8e, 0xbf, 0x83, 0xf8, 0x29, 0x8a, 0x3a, 0xae, 0x81, 0x2b, 0x32, 0xb2, 0x0a, 0x32,
+	0xa4, 0x38, 0xe3, 0x1a, 0x7c, 0xd8, 0xd3, 0xf8, 0x1c, 0xdd, 0x32, 0xa7, 0x1c, 0x3a, 0xde, 0xb6,
+	0x82, 0xf1, 0xbc, 0x03, 0xcd, 0x15, 0xb9, 0x6e, 0x25, 0xf7, 0xb7, 0x49, 0x4e, 0x3c, 0x7d, 0x06,
+	0x9a, 0x7b, 0xe1, 0x7e, 0xf7, 0x4f, 0x5f, 0xe1, 0xf7, 0xe8, 0x26, 0xa3, 0x90, 0xd7, 0x54, 0x32,
+	0xa3, 0x57, 0x24, 0xb2, 0xd6, 0x83, 0x21, 0xeb, 0x6b, 0x0a, 0xc7, 0x9e, 0xf3, 0xc2, 0x09, 0xdb,
+	0xb4, 0x14, 0xce, 0xd0, 0xa4, 0xb8, 0x60, 0x15, 0xd7, 0x39, 0x6f, 0xa0, 0xac, 0xc9, 0xae, 0xbd,
+	0xa8, 0x41, 0x55, 0x66, 0xb9, 0x37, 0x06, 0x9b, 0x8f, 0x8b, 0x4d, 0x61, 0xe6, 0x71, 0xa5, 0xca,
+	0x55, 0xc3, 0xa5, 0x26, 0x37, 0xb6, 0xcf, 0xe3, 0x24, 0xa7, 0x06, 0xeb, 0xe7, 0xf1, 0x59, 0xdb,
+	0xc3, 0xcf, 0x50, 0x64, 0x1c, 0x4c, 0x91, 0x3d, 0x2b, 0xb9, 0x33, 0x24, 0x31, 0x28, 0xeb, 0x7f,
+	0xb8, 0xc3, 0xb3, 0x93, 0xcb, 0x65, 0x1c, 0x5e, 0x2d, 0xe3, 0xf0, 0xe7, 0x32, 0x0e, 0xbf, 0xac,
+	0xe2, 0xe0, 0x6a, 0x15, 0x07, 0xdf, 0x57, 0x71, 0x70, 0xfe, 0xa4, 0x12, 0xba, 0xbe, 0x28, 0x92,
+	0x12, 0x16, 0xe9, 0xb1, 0x95, 0x1d, 0xbe, 0xaa, 0xa9, 0x90, 0xa9, 0x33, 0x1f, 0x96, 0xb6, 0xf8,
+	0xb4, 0x59, 0x76, 0xfd, 0xb9, 0xe1, 0xaa, 0x88, 0xec, 0x52, 0x3f, 0xfe, 0x13, 0x00, 0x00, 0xff,
+	0xff, 0xd6, 0x5f, 0x9b, 0x8f, 0x58, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +201,116 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Spends) > 0 {
+		for iNdEx := len(m.Spends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BudgetsSpent) > 0 {
+		for iNdEx := len(m.BudgetsSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BudgetsSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.BudgetEpoch != nil {
+		{
+			size, err := m.BudgetEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DaoHandovers) > 0 {
+		for iNdEx := len(m.DaoHandovers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DaoHandovers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VetoOverrideVotes) > 0 {
+		for iNdEx := len(m.VetoOverrideVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VetoOverrideVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Vetoes) > 0 {
+		for iNdEx := len(m.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vetoes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for iNdEx := len(m.Annotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Annotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +343,52 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for _, e := range m.Annotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Vetoes) > 0 {
+		for _, e := range m.Vetoes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VetoOverrideVotes) > 0 {
+		for _, e := range m.VetoOverrideVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DaoHandovers) > 0 {
+		for _, e := range m.DaoHandovers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BudgetEpoch != nil {
+		l = m.BudgetEpoch.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BudgetsSpent) > 0 {
+		for _, e := range m.BudgetsSpent {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Spends) > 0 {
+		for _, e := range m.Spends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +460,280 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, Action{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, Annotation{})
+			if err := m.Annotations[len(m.Annotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoes = append(m.Vetoes, Veto{})
+			if err := m.Vetoes[len(m.Vetoes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoOverrideVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoOverrideVotes = append(m.VetoOverrideVotes, VetoOverrideVote{})
+			if err := m.VetoOverrideVotes[len(m.VetoOverrideVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoHandovers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoHandovers = append(m.DaoHandovers, DaoHandover{})
+			if err := m.DaoHandovers[len(m.DaoHandovers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BudgetEpoch == nil {
+				m.BudgetEpoch = &BudgetEpoch{}
+			}
+			if err := m.BudgetEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetsSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BudgetsSpent = append(m.BudgetsSpent, BudgetSpent{})
+			if err := m.BudgetsSpent[len(m.BudgetsSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spends = append(m.Spends, Spend{})
+			if err := m.Spends[len(m.Spends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,11 +4,19 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
+	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	addrs := simtestutil.CreateRandomAccounts(2)
+	signer, member := addrs[0].String(), addrs[1].String()
 	tests := []struct {
 		desc     string
 		genState func() *types.GenesisState
@@ -54,6 +62,276 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid genesis state with actions",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), []types.Action{
+					{Id: 0, ProposalId: 1, Signer: signer, DaoRole: types.DaoRoleSteering, ActionType: types.ActionTypeAnnotateProposal},
+					{Id: 1, ProposalId: 1, Signer: signer, DaoRole: types.DaoRoleOversight, ActionType: types.ActionTypeVetoProposal},
+				}, nil, nil, nil, nil, nil, nil, nil)
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate action id",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), []types.Action{
+					{Id: 1, ProposalId: 1, Signer: signer, DaoRole: types.DaoRoleSteering, ActionType: types.ActionTypeAnnotateProposal},
+					{Id: 1, ProposalId: 2, Signer: signer, DaoRole: types.DaoRoleSteering, ActionType: types.ActionTypeEndorseProposal},
+				}, nil, nil, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state action without type",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), []types.Action{
+					{Id: 0, ProposalId: 1, Signer: signer, DaoRole: types.DaoRoleSteering},
+				}, nil, nil, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state action with wrong signer",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), []types.Action{
+					{Id: 0, ProposalId: 1, Signer: "cosmosincorrectaddress", DaoRole: types.DaoRoleSteering, ActionType: types.ActionTypeAnnotateProposal},
+				}, nil, nil, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with annotations",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, []types.Annotation{
+					{ProposalId: 1, Index: 1, Signer: signer, Text: "First"},
+					{ProposalId: 1, Index: 2, Signer: signer, Text: "Second", Supersedes: 1},
+					{ProposalId: 2, Index: 1, Signer: signer, Text: "First"},
+				}, nil, nil, nil, nil, nil, nil)
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate annotation",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, []types.Annotation{
+					{ProposalId: 1, Index: 1, Signer: signer, Text: "First"},
+					{ProposalId: 1, Index: 1, Signer: signer, Text: "Second"},
+				}, nil, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state annotation superseding a later annotation",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, []types.Annotation{
+					{ProposalId: 1, Index: 1, Signer: signer, Text: "First", Supersedes: 2},
+				}, nil, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with vetoes",
+			genState: func() *types.GenesisState {
+				overrideEnd := time.Now().Add(time.Hour)
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, []types.Veto{
+					{ProposalId: 1, Vetoer: signer, Reason: "reason", Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod},
+					{
+						ProposalId: 2, Vetoer: signer, Reason: "reason", Status: types.VetoStatusChallenged,
+						ProposalStatus: govtypesv1.StatusPassedPendingExecution, Challenger: signer, OverrideVotingEndTime: &overrideEnd,
+					},
+				}, []types.VetoOverrideVote{
+					{ProposalId: 2, Voter: signer, Override: true, VotingPower: "10"},
+				}, nil, nil, nil, nil)
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate veto",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, []types.Veto{
+					{ProposalId: 1, Vetoer: signer, Reason: "reason", Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod},
+					{ProposalId: 1, Vetoer: signer, Reason: "reason", Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod},
+				}, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state veto without reason",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, []types.Veto{
+					{ProposalId: 1, Vetoer: signer, Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod},
+				}, nil, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state veto override vote without challenged veto",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, []types.Veto{
+					{ProposalId: 1, Vetoer: signer, Reason: "reason", Status: types.VetoStatusUpheld, ProposalStatus: govtypesv1.StatusVotingPeriod},
+				}, []types.VetoOverrideVote{
+					{ProposalId: 1, Voter: signer, Override: true, VotingPower: "10"},
+				}, nil, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state veto override threshold too large",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.VetoChallengeWindow = time.Hour
+				params.VetoOverrideThreshold = "1.5"
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with DAO members and terms",
+			genState: func() *types.GenesisState {
+				start := time.Now()
+				end := start.Add(time.Hour)
+				params := types.DefaultParams()
+				params.SteeringDaoAddress = signer
+				params.SteeringDaoMembers = []string{member}
+				params.SteeringDaoTerm = &types.DaoTerm{StartTime: &start, EndTime: &end}
+				return &types.GenesisState{Params: params}
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state DAO members without DAO address",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.OversightDaoMembers = []string{member}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate DAO member",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.OversightDaoAddress = signer
+				params.OversightDaoMembers = []string{member, member}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state DAO term ending before its start",
+			genState: func() *types.GenesisState {
+				start := time.Now()
+				end := start.Add(-time.Hour)
+				params := types.DefaultParams()
+				params.OversightDaoTerm = &types.DaoTerm{StartTime: &start, EndTime: &end}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with DAO handovers",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, []types.DaoHandover{
+					{DaoRole: types.DaoRoleSteering, Height: 10, Address: signer, Members: []string{member}, Scheduler: signer},
+					{DaoRole: types.DaoRoleOversight, Height: 10, Scheduler: signer},
+				}, nil, nil, nil)
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state duplicate DAO handover",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, []types.DaoHandover{
+					{DaoRole: types.DaoRoleSteering, Height: 10, Address: signer, Scheduler: signer},
+					{DaoRole: types.DaoRoleSteering, Height: 10, Address: member, Scheduler: signer},
+				}, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state too many pending DAO handovers",
+			genState: func() *types.GenesisState {
+				handovers := make([]types.DaoHandover, types.MaxPendingHandoversPerDao+1)
+				for i := range handovers {
+					handovers[i] = types.DaoHandover{DaoRole: types.DaoRoleSteering, Height: int64(10 + i), Scheduler: signer}
+				}
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, handovers, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state DAO handover without role",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, []types.DaoHandover{
+					{Height: 10, Address: signer, Scheduler: signer},
+				}, nil, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with budgets and spends",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.SteeringDaoBudget = sdk.NewCoins(sdk.NewInt64Coin("uphoton", 1000))
+				amount := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 100))
+				return types.NewGenesisState(params, nil, nil, nil, nil, nil,
+					&types.BudgetEpoch{Number: 2, StartTime: time.Now()},
+					[]types.BudgetSpent{{DaoRole: types.DaoRoleSteering, Amount: amount}},
+					[]types.Spend{
+						{Id: 0, DaoRole: types.DaoRoleSteering, Spender: signer, Recipient: member, Amount: amount, Epoch: 1},
+						{Id: 1, DaoRole: types.DaoRoleSteering, Spender: signer, Recipient: member, Amount: amount, Epoch: 2},
+					})
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state invalid budget",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.OversightDaoBudget = sdk.Coins{sdk.Coin{Denom: "uphoton", Amount: math.NewInt(-1)}}
+				return &types.GenesisState{Params: params}
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state budget epoch zero",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, &types.BudgetEpoch{}, nil, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate budget spent",
+			genState: func() *types.GenesisState {
+				amount := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 100))
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, nil, []types.BudgetSpent{
+					{DaoRole: types.DaoRoleOversight, Amount: amount},
+					{DaoRole: types.DaoRoleOversight, Amount: amount},
+				}, nil)
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state duplicate spend",
+			genState: func() *types.GenesisState {
+				amount := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 100))
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, nil, nil, []types.Spend{
+					{Id: 1, DaoRole: types.DaoRoleSteering, Spender: signer, Recipient: member, Amount: amount, Epoch: 1},
+					{Id: 1, DaoRole: types.DaoRoleSteering, Spender: signer, Recipient: member, Amount: amount, Epoch: 1},
+				})
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state spend without amount",
+			genState: func() *types.GenesisState {
+				return types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, nil, nil, []types.Spend{
+					{Id: 1, DaoRole: types.DaoRoleSteering, Spender: signer, Recipient: member, Epoch: 1},
+				})
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid genesis state negative extension duration",
 			genState: func() *types.GenesisState {
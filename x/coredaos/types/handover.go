@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPendingHandoversPerDao is the maximum number of scheduled handovers of
+// each core DAO.
+const MaxPendingHandoversPerDao = 5
+
+// ValidateBasic performs basic validation of the handover
+func (h DaoHandover) ValidateBasic() error {
+	if h.DaoRole != DaoRoleSteering && h.DaoRole != DaoRoleOversight {
+		return fmt.Errorf("invalid DAO role: %s", h.DaoRole)
+	}
+	if h.Height <= 0 {
+		return fmt.Errorf("handover height must be positive: %d", h.Height)
+	}
+	if h.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+			return fmt.Errorf("invalid DAO address: %s: %w", h.Address, err)
+		}
+	}
+	if err := validateDaoMembers("DAO", h.Address, h.Members); err != nil {
+		return err
+	}
+	if err := h.Term.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid DAO term: %w", err)
+	}
+	if h.Scheduler != "" {
+		if _, err := sdk.AccAddressFromBech32(h.Scheduler); err != nil {
+			return fmt.Errorf("invalid scheduler address: %s: %w", h.Scheduler, err)
+		}
+	}
+	return nil
+}
+
+// Signers returns the addresses which have authority to execute messages as
+// the core DAO once handed over.
+func (h DaoHandover) Signers() []string {
+	if h.Address == "" {
+		return nil
+	}
+	return append([]string{h.Address}, h.Members...)
+}
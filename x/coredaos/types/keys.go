@@ -13,4 +13,18 @@ const (
 	RouterKey = ModuleName
 )
 
-var ParamsKey = collections.NewPrefix(0)
+var (
+	ParamsKey            = collections.NewPrefix(0)
+	ActionIDKey          = collections.NewPrefix(1)
+	ActionsKey           = collections.NewPrefix(2)
+	ProposalActionsKey   = collections.NewPrefix(3)
+	AnnotationsKey       = collections.NewPrefix(4)
+	VetoesKey            = collections.NewPrefix(5)
+	VetoQueueKey         = collections.NewPrefix(6)
+	VetoOverrideVotesKey = collections.NewPrefix(7)
+	DaoHandoversKey      = collections.NewPrefix(8)
+	BudgetEpochKey       = collections.NewPrefix(9)
+	BudgetsSpentKey      = collections.NewPrefix(10)
+	SpendIDKey           = collections.NewPrefix(11)
+	SpendsKey            = collections.NewPrefix(12)
+)
@@ -7,7 +7,12 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _, _, _, _, _ sdk.Msg = &MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{}, &MsgUpdateParams{}
+var _, _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgAnnotateProposal{}, &MsgEndorseProposal{}, &MsgExtendVotingPeriod{}, &MsgVetoProposal{},
+	&MsgChallengeVeto{}, &MsgVoteVetoOverride{}, &MsgScheduleDaoHandover{}, &MsgCancelDaoHandover{}, &MsgSpendFromBudget{},
+	&MsgUpdateParams{}
+
+// MaxVetoReasonLength is the maximum length in bytes of the reason of a veto
+const MaxVetoReasonLength = 1000
 
 // NewMsgAnnotateProposal creates a new MsgAnnotateProposal instance
 func NewMsgAnnotateProposal(signer sdk.AccAddress, proposalID uint64, annotation string) *MsgAnnotateProposal {
@@ -92,11 +97,12 @@ func (msg *MsgExtendVotingPeriod) ValidateBasic() error {
 }
 
 // NewMsgVetoProposal creates a new MsgVetoProposal instance
-func NewMsgVetoProposal(signer sdk.AccAddress, proposalID uint64, burnDeposit bool) *MsgVetoProposal {
+func NewMsgVetoProposal(signer sdk.AccAddress, proposalID uint64, burnDeposit bool, reason string) *MsgVetoProposal {
 	return &MsgVetoProposal{
 		Vetoer:      signer.String(),
 		ProposalId:  proposalID,
 		BurnDeposit: burnDeposit,
+		Reason:      reason,
 	}
 }
 
@@ -115,6 +121,161 @@ func (msg *MsgVetoProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Vetoer); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid vetoer address: %s", err)
 	}
+	if len(msg.Reason) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "veto reason cannot be empty")
+	}
+	if len(msg.Reason) > MaxVetoReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "veto reason length %d exceeds the maximum of %d", len(msg.Reason), MaxVetoReasonLength)
+	}
+	return nil
+}
+
+// NewMsgChallengeVeto creates a new MsgChallengeVeto instance
+func NewMsgChallengeVeto(signer sdk.AccAddress, proposalID uint64) *MsgChallengeVeto {
+	return &MsgChallengeVeto{
+		Challenger: signer.String(),
+		ProposalId: proposalID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgChallengeVeto) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgChallengeVeto) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgChallengeVeto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid challenger address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgVoteVetoOverride creates a new MsgVoteVetoOverride instance
+func NewMsgVoteVetoOverride(signer sdk.AccAddress, proposalID uint64, override bool) *MsgVoteVetoOverride {
+	return &MsgVoteVetoOverride{
+		Voter:      signer.String(),
+		ProposalId: proposalID,
+		Override:   override,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgVoteVetoOverride) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgVoteVetoOverride) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgVoteVetoOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgScheduleDaoHandover creates a new MsgScheduleDaoHandover instance
+func NewMsgScheduleDaoHandover(signer sdk.AccAddress, handover DaoHandover) *MsgScheduleDaoHandover {
+	return &MsgScheduleDaoHandover{
+		Signer:   signer.String(),
+		Handover: handover,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgScheduleDaoHandover) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgScheduleDaoHandover) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgScheduleDaoHandover) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+	if err := msg.Handover.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidHandover, err.Error())
+	}
+	return nil
+}
+
+// NewMsgCancelDaoHandover creates a new MsgCancelDaoHandover instance
+func NewMsgCancelDaoHandover(signer sdk.AccAddress, daoRole DaoRole, height int64) *MsgCancelDaoHandover {
+	return &MsgCancelDaoHandover{
+		Signer:  signer.String(),
+		DaoRole: daoRole,
+		Height:  height,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgCancelDaoHandover) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgCancelDaoHandover) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgCancelDaoHandover) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+	if msg.DaoRole != DaoRoleSteering && msg.DaoRole != DaoRoleOversight {
+		return errorsmod.Wrapf(ErrInvalidHandover, "invalid DAO role: %s", msg.DaoRole)
+	}
+	return nil
+}
+
+// NewMsgSpendFromBudget creates a new MsgSpendFromBudget instance
+func NewMsgSpendFromBudget(signer, recipient sdk.AccAddress, amount sdk.Coins, memo string) *MsgSpendFromBudget {
+	return &MsgSpendFromBudget{
+		Spender:   signer.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Memo:      memo,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgSpendFromBudget) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgSpendFromBudget) Type() string {
+	return sdk.MsgTypeURL(msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgSpendFromBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if len(msg.Memo) > MaxSpendMemoLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "spend memo length %d exceeds the maximum of %d", len(msg.Memo), MaxSpendMemoLength)
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Hikari-Chain/hikari-chain/x/coredaos/types"
@@ -77,15 +78,45 @@ func TestMsgVetoProposal_ValidateBasic(t *testing.T) {
 		vetoer      sdk.AccAddress
 		proposalId  uint64
 		burnDeposit bool
+		reason      string
 		expectPass  bool
 	}{
-		{sdk.AccAddress{}, 0, true, false},
-		{sdk.AccAddress{}, 0, false, false},
-		{addrs[0], 0, true, true},
-		{addrs[0], 0, false, true},
+		{sdk.AccAddress{}, 0, true, "reason", false},
+		{sdk.AccAddress{}, 0, false, "reason", false},
+		{addrs[0], 0, true, "reason", true},
+		{addrs[0], 0, false, "reason", true},
+		{addrs[0], 0, false, "", false},
+		{addrs[0], 0, false, strings.Repeat("a", types.MaxVetoReasonLength), true},
+		{addrs[0], 0, false, strings.Repeat("a", types.MaxVetoReasonLength+1), false},
 	}
 	for i, tc := range tests {
-		msg := types.NewMsgEndorseProposal(tc.vetoer, tc.proposalId)
+		msg := types.NewMsgVetoProposal(tc.vetoer, tc.proposalId, tc.burnDeposit, tc.reason)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSpendFromBudget_ValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 100))
+	tests := []struct {
+		spender    sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		memo       string
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, addrs[1], amount, "memo", false},
+		{addrs[0], sdk.AccAddress{}, amount, "memo", false},
+		{addrs[0], addrs[1], nil, "memo", false},
+		{addrs[0], addrs[1], amount, strings.Repeat("a", types.MaxSpendMemoLength+1), false},
+		{addrs[0], addrs[1], amount, "", true},
+		{addrs[0], addrs[1], amount, "memo", true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgSpendFromBudget(tc.spender, tc.recipient, tc.amount, tc.memo)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
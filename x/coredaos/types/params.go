@@ -2,18 +2,33 @@ package types
 
 import (
 	fmt "fmt"
+	"slices"
 	time "time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewParams creates a new Params instance
-func NewParams(steeringDaoAddress, oversightDaoAddress string, votingPeriodExtensionsLimit uint32, votingPeriodExtensionDuration time.Duration) Params {
+func NewParams(
+	steeringDaoAddress, oversightDaoAddress string, votingPeriodExtensionsLimit uint32, votingPeriodExtensionDuration time.Duration,
+	maxAnnotationLength uint64, maxAnnotationsPerProposal uint32,
+	vetoChallengeWindow, vetoOverrideVotingPeriod time.Duration, vetoOverrideQuorum, vetoOverrideThreshold string,
+	budgetEpochDuration time.Duration,
+) Params {
 	return Params{
 		SteeringDaoAddress:            steeringDaoAddress,
 		OversightDaoAddress:           oversightDaoAddress,
 		VotingPeriodExtensionsLimit:   votingPeriodExtensionsLimit,
 		VotingPeriodExtensionDuration: &votingPeriodExtensionDuration,
+		MaxAnnotationLength:           maxAnnotationLength,
+		MaxAnnotationsPerProposal:     maxAnnotationsPerProposal,
+		VetoChallengeWindow:           vetoChallengeWindow,
+		VetoOverrideVotingPeriod:      vetoOverrideVotingPeriod,
+		VetoOverrideQuorum:            vetoOverrideQuorum,
+		VetoOverrideThreshold:         vetoOverrideThreshold,
+		BudgetEpochDuration:           budgetEpochDuration,
 	}
 }
 
@@ -28,6 +43,21 @@ const (
 	DefaultVotingPeriodExtensionsLimit = 3
 	// DefaultVotingPeriodExtensionDuration is the default duration for voting period extensions
 	DefaultVotingPeriodExtensionDuration = time.Hour * 24 * 7 // 7 days
+	// DefaultMaxAnnotationLength is the default maximum length in bytes of an annotation
+	DefaultMaxAnnotationLength = 10000
+	// DefaultMaxAnnotationsPerProposal is the default maximum number of annotations of a proposal
+	DefaultMaxAnnotationsPerProposal = 20
+	// DefaultVetoChallengeWindow is the default veto challenge window
+	// A zero duration indicates that vetoes cannot be challenged (disabled)
+	DefaultVetoChallengeWindow time.Duration = 0
+	// DefaultVetoOverrideVotingPeriod is the default duration of veto override votes
+	DefaultVetoOverrideVotingPeriod = time.Hour * 24 * 7 // 7 days
+	// DefaultVetoOverrideQuorum is the default quorum of veto override votes
+	DefaultVetoOverrideQuorum = "0.334"
+	// DefaultVetoOverrideThreshold is the default threshold of veto override votes
+	DefaultVetoOverrideThreshold = "0.667"
+	// DefaultBudgetEpochDuration is the default duration of budget epochs
+	DefaultBudgetEpochDuration = time.Hour * 24 * 30 // 30 days
 )
 
 // DefaultParams returns a default set of parameters
@@ -37,6 +67,13 @@ func DefaultParams() Params {
 		DefaultOversightDaoAddress,
 		DefaultVotingPeriodExtensionsLimit,
 		DefaultVotingPeriodExtensionDuration,
+		DefaultMaxAnnotationLength,
+		DefaultMaxAnnotationsPerProposal,
+		DefaultVetoChallengeWindow,
+		DefaultVetoOverrideVotingPeriod,
+		DefaultVetoOverrideQuorum,
+		DefaultVetoOverrideThreshold,
+		DefaultBudgetEpochDuration,
 	)
 }
 
@@ -56,6 +93,19 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	if err := validateDaoMembers("steering DAO", p.SteeringDaoAddress, p.SteeringDaoMembers); err != nil {
+		return err
+	}
+	if err := validateDaoMembers("oversight DAO", p.OversightDaoAddress, p.OversightDaoMembers); err != nil {
+		return err
+	}
+	if err := p.SteeringDaoTerm.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid steering DAO term: %w", err)
+	}
+	if err := p.OversightDaoTerm.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid oversight DAO term: %w", err)
+	}
+
 	// VotingPeriodExtensionDuration must be a positive duration
 	if p.VotingPeriodExtensionDuration == nil {
 		return fmt.Errorf("voting period extension duration must not be nil")
@@ -63,5 +113,151 @@ func (p Params) ValidateBasic() error {
 	if p.VotingPeriodExtensionDuration.Seconds() <= 0 {
 		return fmt.Errorf("voting period extension duration must be positive: %s", p.VotingPeriodExtensionDuration)
 	}
+
+	// The veto override params are only used if vetoes can be challenged
+	if p.VetoChallengeWindow < 0 {
+		return fmt.Errorf("veto challenge window must not be negative: %s", p.VetoChallengeWindow)
+	}
+	if p.VetoChallengeWindow > 0 {
+		if p.VetoOverrideVotingPeriod <= 0 {
+			return fmt.Errorf("veto override voting period must be positive: %s", p.VetoOverrideVotingPeriod)
+		}
+		if err := validateFraction("veto override quorum", p.VetoOverrideQuorum); err != nil {
+			return err
+		}
+		if err := validateFraction("veto override threshold", p.VetoOverrideThreshold); err != nil {
+			return err
+		}
+	}
+
+	// Budgets can only be empty (disabled) or valid coins
+	if err := p.SteeringDaoBudget.Validate(); err != nil {
+		return fmt.Errorf("invalid steering DAO budget: %w", err)
+	}
+	if err := p.OversightDaoBudget.Validate(); err != nil {
+		return fmt.Errorf("invalid oversight DAO budget: %w", err)
+	}
+	if p.BudgetEpochDuration < 0 {
+		return fmt.Errorf("budget epoch duration must not be negative: %s", p.BudgetEpochDuration)
+	}
+	return nil
+}
+
+// validateFraction checks that a decimal string is in the ]0, 1] range
+func validateFraction(name, str string) error {
+	dec, err := math.LegacyNewDecFromStr(str)
+	if err != nil {
+		return fmt.Errorf("invalid %s string: %w", name, err)
+	}
+	if !dec.IsPositive() {
+		return fmt.Errorf("%s must be positive: %s", name, dec)
+	}
+	if dec.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s too large: %s", name, dec)
+	}
+	return nil
+}
+
+// DaoSigners returns the addresses which have authority to execute messages
+// as the given core DAO, its address followed by its members.
+func (p Params) DaoSigners(daoRole DaoRole) []string {
+	var address string
+	var members []string
+	switch daoRole {
+	case DaoRoleSteering:
+		address, members = p.SteeringDaoAddress, p.SteeringDaoMembers
+	case DaoRoleOversight:
+		address, members = p.OversightDaoAddress, p.OversightDaoMembers
+	}
+	if address == "" {
+		return nil
+	}
+	return append([]string{address}, members...)
+}
+
+// GetDaoTerm returns the term of the given core DAO, nil if unlimited.
+func (p Params) GetDaoTerm(daoRole DaoRole) *DaoTerm {
+	switch daoRole {
+	case DaoRoleSteering:
+		return p.SteeringDaoTerm
+	case DaoRoleOversight:
+		return p.OversightDaoTerm
+	}
+	return nil
+}
+
+// GetDaoBudget returns the budget per budget epoch of the given core DAO,
+// empty if the core DAO cannot spend.
+func (p Params) GetDaoBudget(daoRole DaoRole) sdk.Coins {
+	switch daoRole {
+	case DaoRoleSteering:
+		return p.SteeringDaoBudget
+	case DaoRoleOversight:
+		return p.OversightDaoBudget
+	}
+	return nil
+}
+
+// IsDaoSigner returns whether the address has authority to execute messages
+// as the given core DAO.
+func (p Params) IsDaoSigner(daoRole DaoRole, address string) bool {
+	return slices.Contains(p.DaoSigners(daoRole), address)
+}
+
+// SetDao sets the address, the members and the term of the given core DAO.
+func (p *Params) SetDao(daoRole DaoRole, address string, members []string, term *DaoTerm) {
+	switch daoRole {
+	case DaoRoleSteering:
+		p.SteeringDaoAddress, p.SteeringDaoMembers, p.SteeringDaoTerm = address, members, term
+	case DaoRoleOversight:
+		p.OversightDaoAddress, p.OversightDaoMembers, p.OversightDaoTerm = address, members, term
+	}
+}
+
+// validateDaoMembers checks that the members of a core DAO are valid and
+// unique Bech32 addresses, and that the core DAO has an address if it has
+// members
+func validateDaoMembers(name, address string, members []string) error {
+	if len(members) > 0 && address == "" {
+		return fmt.Errorf("%s members require a %s address", name, name)
+	}
+	seen := map[string]bool{address: true}
+	for _, member := range members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return fmt.Errorf("invalid %s member: %s: %w", name, member, err)
+		}
+		if seen[member] {
+			return fmt.Errorf("duplicate %s member: %s", name, member)
+		}
+		seen[member] = true
+	}
+	return nil
+}
+
+// IsActive returns whether the term is active at the given time. A nil term
+// is unlimited and always active.
+func (t *DaoTerm) IsActive(blockTime time.Time) bool {
+	if t == nil {
+		return true
+	}
+	if t.StartTime != nil && blockTime.Before(*t.StartTime) {
+		return false
+	}
+	return !t.HasEnded(blockTime)
+}
+
+// HasEnded returns whether the term has ended at the given time.
+func (t *DaoTerm) HasEnded(blockTime time.Time) bool {
+	return t != nil && t.EndTime != nil && !blockTime.Before(*t.EndTime)
+}
+
+// ValidateBasic performs basic validation of the term. A nil term is valid.
+func (t *DaoTerm) ValidateBasic() error {
+	if t == nil {
+		return nil
+	}
+	if t.StartTime != nil && t.EndTime != nil && !t.StartTime.Before(*t.EndTime) {
+		return fmt.Errorf("term start %s must be before term end %s", t.StartTime, t.EndTime)
+	}
 	return nil
 }
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -39,10 +41,16 @@ type MsgAnnotateProposal struct {
 	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	// annotation is the annotation to add to the proposal.
 	Annotation string `protobuf:"bytes,3,opt,name=annotation,proto3" json:"annotation,omitempty"`
-	// overwrite is a boolean indicating whether to overwrite the existing
-	// annotation. Must be set to true if the proposal already has an annotation.
-	// Ignored if the proposal does not have yet an annotation.
+	// overwrite is a boolean indicating whether the annotation supersedes an
+	// existing annotation. Must be set to true if the proposal already has an
+	// annotation. Ignored if the proposal does not have yet an annotation.
+	// Superseded annotations are kept in the annotation history of the
+	// proposal.
 	Overwrite bool `protobuf:"varint,4,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// supersedes is the index of the annotation superseded by this annotation.
+	// Defaults to the latest annotation of the proposal if zero. Ignored if
+	// overwrite is false.
+	Supersedes uint64 `protobuf:"varint,5,opt,name=supersedes,proto3" json:"supersedes,omitempty"`
 }
 
 func (m *MsgAnnotateProposal) Reset()         { *m = MsgAnnotateProposal{} }
@@ -106,6 +114,13 @@ func (m *MsgAnnotateProposal) GetOverwrite() bool {
 	return false
 }
 
+func (m *MsgAnnotateProposal) GetSupersedes() uint64 {
+	if m != nil {
+		return m.Supersedes
+	}
+	return 0
+}
+
 // MsgAnnotateProposalResponse defines the response for MsgAnnotateProposal.
 type MsgAnnotateProposalResponse struct {
 }
@@ -337,6 +352,8 @@ type MsgVetoProposal struct {
 	// burn_deposit is a boolean indicating whether to burn the deposit of the
 	// proposal. If true, the deposit is burned and not refunded.
 	BurnDeposit bool `protobuf:"varint,3,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+	// reason is the reason for the veto. It is mandatory.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
@@ -393,6 +410,13 @@ func (m *MsgVetoProposal) GetBurnDeposit() bool {
 	return false
 }
 
+func (m *MsgVetoProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgVetoProposalResponse defines the response for MsgVetoProposal.
 type MsgVetoProposalResponse struct {
 }
@@ -430,6 +454,397 @@ func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
 
+// MsgChallengeVeto defines a message for challenging a veto.
+type MsgChallengeVeto struct {
+	// challenger is the address of the staker challenging the veto.
+	Challenger string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// proposal_id is the ID of the vetoed proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgChallengeVeto) Reset()         { *m = MsgChallengeVeto{} }
+func (m *MsgChallengeVeto) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeVeto) ProtoMessage()    {}
+func (*MsgChallengeVeto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{8}
+}
+func (m *MsgChallengeVeto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeVeto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeVeto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChallengeVeto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeVeto.Merge(m, src)
+}
+func (m *MsgChallengeVeto) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeVeto) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeVeto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChallengeVeto proto.InternalMessageInfo
+
+func (m *MsgChallengeVeto) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *MsgChallengeVeto) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgChallengeVetoResponse defines the response for MsgChallengeVeto.
+type MsgChallengeVetoResponse struct {
+}
+
+func (m *MsgChallengeVetoResponse) Reset()         { *m = MsgChallengeVetoResponse{} }
+func (m *MsgChallengeVetoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeVetoResponse) ProtoMessage()    {}
+func (*MsgChallengeVetoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{9}
+}
+func (m *MsgChallengeVetoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeVetoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeVetoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChallengeVetoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeVetoResponse.Merge(m, src)
+}
+func (m *MsgChallengeVetoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeVetoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeVetoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChallengeVetoResponse proto.InternalMessageInfo
+
+// MsgVoteVetoOverride defines a message for voting on the override of a
+// veto.
+type MsgVoteVetoOverride struct {
+	// voter is the address of the staker voting.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// proposal_id is the ID of the vetoed proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// override indicates whether the voter is in favor of the override.
+	Override bool `protobuf:"varint,3,opt,name=override,proto3" json:"override,omitempty"`
+}
+
+func (m *MsgVoteVetoOverride) Reset()         { *m = MsgVoteVetoOverride{} }
+func (m *MsgVoteVetoOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteVetoOverride) ProtoMessage()    {}
+func (*MsgVoteVetoOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{10}
+}
+func (m *MsgVoteVetoOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteVetoOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteVetoOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteVetoOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteVetoOverride.Merge(m, src)
+}
+func (m *MsgVoteVetoOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteVetoOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteVetoOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteVetoOverride proto.InternalMessageInfo
+
+func (m *MsgVoteVetoOverride) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteVetoOverride) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVoteVetoOverride) GetOverride() bool {
+	if m != nil {
+		return m.Override
+	}
+	return false
+}
+
+// MsgVoteVetoOverrideResponse defines the response for MsgVoteVetoOverride.
+type MsgVoteVetoOverrideResponse struct {
+}
+
+func (m *MsgVoteVetoOverrideResponse) Reset()         { *m = MsgVoteVetoOverrideResponse{} }
+func (m *MsgVoteVetoOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteVetoOverrideResponse) ProtoMessage()    {}
+func (*MsgVoteVetoOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{11}
+}
+func (m *MsgVoteVetoOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteVetoOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteVetoOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteVetoOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteVetoOverrideResponse.Merge(m, src)
+}
+func (m *MsgVoteVetoOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteVetoOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteVetoOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteVetoOverrideResponse proto.InternalMessageInfo
+
+// MsgScheduleDaoHandover defines a message for scheduling the handover of a
+// core DAO.
+type MsgScheduleDaoHandover struct {
+	// signer is the address of the module authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// handover is the scheduled handover. Its scheduler is set to the signer.
+	Handover DaoHandover `protobuf:"bytes,2,opt,name=handover,proto3" json:"handover"`
+}
+
+func (m *MsgScheduleDaoHandover) Reset()         { *m = MsgScheduleDaoHandover{} }
+func (m *MsgScheduleDaoHandover) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDaoHandover) ProtoMessage()    {}
+func (*MsgScheduleDaoHandover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{12}
+}
+func (m *MsgScheduleDaoHandover) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDaoHandover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDaoHandover.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleDaoHandover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDaoHandover.Merge(m, src)
+}
+func (m *MsgScheduleDaoHandover) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDaoHandover) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDaoHandover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleDaoHandover proto.InternalMessageInfo
+
+func (m *MsgScheduleDaoHandover) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgScheduleDaoHandover) GetHandover() DaoHandover {
+	if m != nil {
+		return m.Handover
+	}
+	return DaoHandover{}
+}
+
+// MsgScheduleDaoHandoverResponse defines the response for
+// MsgScheduleDaoHandover.
+type MsgScheduleDaoHandoverResponse struct {
+}
+
+func (m *MsgScheduleDaoHandoverResponse) Reset()         { *m = MsgScheduleDaoHandoverResponse{} }
+func (m *MsgScheduleDaoHandoverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDaoHandoverResponse) ProtoMessage()    {}
+func (*MsgScheduleDaoHandoverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{13}
+}
+func (m *MsgScheduleDaoHandoverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDaoHandoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDaoHandoverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleDaoHandoverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDaoHandoverResponse.Merge(m, src)
+}
+func (m *MsgScheduleDaoHandoverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDaoHandoverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDaoHandoverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleDaoHandoverResponse proto.InternalMessageInfo
+
+// MsgCancelDaoHandover defines a message for cancelling a scheduled handover
+// of a core DAO.
+type MsgCancelDaoHandover struct {
+	// signer is the address of the module authority.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// dao_role is the core DAO of the handover.
+	DaoRole DaoRole `protobuf:"varint,2,opt,name=dao_role,json=daoRole,proto3,enum=hikari.coredaos.v1.DaoRole" json:"dao_role,omitempty"`
+	// height is the activation height of the handover.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgCancelDaoHandover) Reset()         { *m = MsgCancelDaoHandover{} }
+func (m *MsgCancelDaoHandover) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDaoHandover) ProtoMessage()    {}
+func (*MsgCancelDaoHandover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{14}
+}
+func (m *MsgCancelDaoHandover) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDaoHandover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDaoHandover.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDaoHandover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDaoHandover.Merge(m, src)
+}
+func (m *MsgCancelDaoHandover) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDaoHandover) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDaoHandover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDaoHandover proto.InternalMessageInfo
+
+func (m *MsgCancelDaoHandover) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelDaoHandover) GetDaoRole() DaoRole {
+	if m != nil {
+		return m.DaoRole
+	}
+	return DaoRoleUnspecified
+}
+
+func (m *MsgCancelDaoHandover) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgCancelDaoHandoverResponse defines the response for
+// MsgCancelDaoHandover.
+type MsgCancelDaoHandoverResponse struct {
+}
+
+func (m *MsgCancelDaoHandoverResponse) Reset()         { *m = MsgCancelDaoHandoverResponse{} }
+func (m *MsgCancelDaoHandoverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDaoHandoverResponse) ProtoMessage()    {}
+func (*MsgCancelDaoHandoverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{15}
+}
+func (m *MsgCancelDaoHandoverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDaoHandoverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDaoHandoverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDaoHandoverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDaoHandoverResponse.Merge(m, src)
+}
+func (m *MsgCancelDaoHandoverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDaoHandoverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDaoHandoverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDaoHandoverResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless
@@ -445,7 +860,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_18283c9e9e835f4a, []int{8}
+	return fileDescriptor_18283c9e9e835f4a, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +912,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_18283c9e9e835f4a, []int{9}
+	return fileDescriptor_18283c9e9e835f4a, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,75 +941,236 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAnnotateProposal)(nil), "hikari.coredaos.v1.MsgAnnotateProposal")
-	proto.RegisterType((*MsgAnnotateProposalResponse)(nil), "hikari.coredaos.v1.MsgAnnotateProposalResponse")
-	proto.RegisterType((*MsgEndorseProposal)(nil), "hikari.coredaos.v1.MsgEndorseProposal")
-	proto.RegisterType((*MsgEndorseProposalResponse)(nil), "hikari.coredaos.v1.MsgEndorseProposalResponse")
-	proto.RegisterType((*MsgExtendVotingPeriod)(nil), "hikari.coredaos.v1.MsgExtendVotingPeriod")
-	proto.RegisterType((*MsgExtendVotingPeriodResponse)(nil), "hikari.coredaos.v1.MsgExtendVotingPeriodResponse")
-	proto.RegisterType((*MsgVetoProposal)(nil), "hikari.coredaos.v1.MsgVetoProposal")
-	proto.RegisterType((*MsgVetoProposalResponse)(nil), "hikari.coredaos.v1.MsgVetoProposalResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "hikari.coredaos.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "hikari.coredaos.v1.MsgUpdateParamsResponse")
+// MsgSpendFromBudget defines a message for spending from the budget of a
+// core DAO.
+type MsgSpendFromBudget struct {
+	// spender is the address of the core DAO signer spending the amount.
+	Spender string `protobuf:"bytes,1,opt,name=spender,proto3" json:"spender,omitempty"`
+	// recipient is the address receiving the amount.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount sent from the community pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// memo is the purpose of the spend.
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func init() { proto.RegisterFile("hikari/coredaos/v1/tx.proto", fileDescriptor_18283c9e9e835f4a) }
-
-var fileDescriptor_18283c9e9e835f4a = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x0a, 0x12, 0xfa, 0x20, 0xa2, 0x2b, 0x4a, 0x59, 0x60, 0x81, 0x35, 0x62, 0xc5, 0xb0,
-	0x1b, 0x30, 0x6a, 0x6c, 0xe2, 0x01, 0xd4, 0x44, 0x0f, 0x8d, 0x64, 0x8d, 0x1c, 0xbc, 0xe0, 0xd2,
-	0x9d, 0x6c, 0x27, 0xd2, 0x7d, 0x9b, 0x99, 0xa1, 0xc2, 0xcd, 0x78, 0xe4, 0xc4, 0xd1, 0x8b, 0x07,
-	0x6f, 0x1e, 0x39, 0xf8, 0x23, 0xb8, 0x98, 0x10, 0x4f, 0x9e, 0x8c, 0x81, 0x03, 0x7f, 0xc3, 0xec,
-	0xce, 0x74, 0xbb, 0xb4, 0xdb, 0xb4, 0x97, 0xa6, 0xef, 0x7b, 0xdf, 0xbc, 0xef, 0xfb, 0x9a, 0xf7,
-	0x52, 0x98, 0xa9, 0xd3, 0x8f, 0x1e, 0xa3, 0x4e, 0x0d, 0x19, 0xf1, 0x3d, 0xe4, 0x4e, 0x73, 0xd5,
-	0x11, 0xfb, 0x76, 0xc4, 0x50, 0xa0, 0xae, 0xcb, 0xa6, 0xdd, 0x6a, 0xda, 0xcd, 0x55, 0x63, 0x32,
-	0xc0, 0x00, 0x93, 0xb6, 0x13, 0x7f, 0x93, 0x4c, 0x63, 0xba, 0x86, 0xbc, 0x81, 0x7c, 0x5b, 0x36,
-	0x64, 0xa1, 0x5a, 0x53, 0xb2, 0x72, 0x1a, 0x3c, 0x88, 0x87, 0x37, 0x78, 0xa0, 0x1a, 0x37, 0xbc,
-	0x06, 0x0d, 0xd1, 0x49, 0x3e, 0x15, 0xb4, 0x98, 0xe3, 0x26, 0x15, 0x4f, 0x28, 0xd6, 0x2f, 0x0d,
-	0x6e, 0x56, 0x79, 0xb0, 0x1e, 0x86, 0x28, 0x3c, 0x41, 0x36, 0x19, 0x46, 0xc8, 0xbd, 0x5d, 0x7d,
-	0x16, 0x8a, 0x9e, 0xc4, 0x90, 0x95, 0xb4, 0x05, 0xad, 0x5c, 0x74, 0xdb, 0x80, 0x3e, 0x0f, 0x63,
-	0x91, 0x62, 0x6e, 0x53, 0xbf, 0x74, 0x65, 0x41, 0x2b, 0x0f, 0xbb, 0xd0, 0x82, 0x5e, 0xfb, 0xba,
-	0x09, 0xa0, 0xd8, 0x14, 0xc3, 0xd2, 0x50, 0xf2, 0x3e, 0x83, 0xc4, 0xe3, 0xb1, 0x49, 0xd8, 0x27,
-	0x46, 0x05, 0x29, 0x0d, 0x2f, 0x68, 0xe5, 0x51, 0xb7, 0x0d, 0x54, 0x9e, 0x7e, 0xb9, 0x38, 0x5e,
-	0x6e, 0xcb, 0x1d, 0x5e, 0x1c, 0x2f, 0x2f, 0xe5, 0x44, 0xc9, 0xf1, 0x6d, 0xcd, 0xc1, 0x4c, 0x0e,
-	0xec, 0x12, 0x1e, 0x61, 0xc8, 0x89, 0x75, 0xa8, 0x81, 0x5e, 0xe5, 0xc1, 0xcb, 0xd0, 0x47, 0xc6,
-	0xdb, 0x69, 0x0d, 0x18, 0x25, 0x12, 0x6a, 0x85, 0x4d, 0xeb, 0xbe, 0x59, 0x2b, 0x4f, 0x62, 0xb7,
-	0x29, 0x3f, 0x36, 0x7b, 0x37, 0xdf, 0x6c, 0x87, 0xaa, 0x35, 0x0b, 0x46, 0x37, 0x9a, 0x5a, 0x3d,
-	0xd2, 0xe0, 0x56, 0xdc, 0xde, 0x17, 0x24, 0xf4, 0xb7, 0x50, 0xd0, 0x30, 0xd8, 0x24, 0x8c, 0xa2,
-	0x9f, 0xb8, 0x4d, 0xd0, 0x8c, 0x5b, 0x55, 0xf7, 0x77, 0x5b, 0x91, 0x6e, 0x15, 0x3f, 0x76, 0x5b,
-	0xee, 0xe1, 0xb6, 0x4b, 0xd8, 0x9a, 0x87, 0xb9, 0xdc, 0x46, 0xea, 0xf9, 0xbb, 0x06, 0x13, 0x55,
-	0x1e, 0x6c, 0x11, 0x81, 0xe9, 0x6f, 0x7b, 0x1b, 0x46, 0x9a, 0x44, 0x60, 0xea, 0x55, 0x55, 0xfd,
-	0x77, 0x68, 0x11, 0xc6, 0x77, 0xf6, 0x58, 0xb8, 0xed, 0x93, 0x08, 0x39, 0x15, 0xc9, 0x16, 0x8d,
-	0xba, 0x63, 0x31, 0xf6, 0x42, 0x42, 0x95, 0xb5, 0x38, 0x8c, 0x1a, 0x18, 0x47, 0xb1, 0xf2, 0xa3,
-	0x64, 0xfd, 0x58, 0xd3, 0x30, 0xd5, 0x01, 0xa5, 0xf6, 0xbf, 0x4a, 0xfb, 0xef, 0x22, 0x3f, 0xde,
-	0x1d, 0x8f, 0x79, 0x0d, 0xae, 0x3f, 0x86, 0xa2, 0xb7, 0x27, 0xea, 0xc8, 0xa8, 0x38, 0x90, 0x09,
-	0x36, 0x4a, 0xbf, 0x7f, 0xae, 0x4c, 0xaa, 0xa3, 0x5c, 0xf7, 0x7d, 0x46, 0x38, 0x7f, 0x2b, 0x18,
-	0x0d, 0x03, 0xb7, 0x4d, 0xd5, 0x9f, 0xc1, 0x48, 0x94, 0x4c, 0x48, 0x92, 0x8d, 0xad, 0x19, 0x76,
-	0xf7, 0xf5, 0xdb, 0x52, 0x63, 0xa3, 0x78, 0xf2, 0x77, 0xbe, 0xf0, 0xe3, 0xe2, 0x78, 0x59, 0x73,
-	0xd5, 0xa3, 0xca, 0x35, 0x79, 0x02, 0xad, 0x71, 0xca, 0x75, 0xd6, 0x59, 0xcb, 0xf5, 0xda, 0xb7,
-	0x61, 0x18, 0xaa, 0xf2, 0x40, 0xdf, 0x85, 0xeb, 0x5d, 0x67, 0x7c, 0x2f, 0x4f, 0x35, 0xe7, 0x40,
-	0x0c, 0x67, 0x40, 0x62, 0x4b, 0x55, 0xa7, 0x30, 0xd1, 0x79, 0x45, 0x4b, 0x3d, 0x66, 0x74, 0xf0,
-	0x0c, 0x7b, 0x30, 0x5e, 0x2a, 0xc5, 0x40, 0xcf, 0xb9, 0x82, 0xfb, 0xbd, 0xa6, 0x74, 0x51, 0x8d,
-	0xd5, 0x81, 0xa9, 0xa9, 0xe6, 0x07, 0x18, 0xbf, 0xb4, 0xc5, 0x77, 0x7a, 0x8c, 0xc8, 0x92, 0x8c,
-	0x07, 0x03, 0x90, 0xb2, 0x0a, 0x97, 0x16, 0xad, 0x97, 0x42, 0x96, 0xd4, 0x53, 0x21, 0x6f, 0x31,
-	0x8c, 0xab, 0x9f, 0xe3, 0x95, 0xda, 0x78, 0x73, 0x72, 0x66, 0x6a, 0xa7, 0x67, 0xa6, 0xf6, 0xef,
-	0xcc, 0xd4, 0x8e, 0xce, 0xcd, 0xc2, 0xe9, 0xb9, 0x59, 0xf8, 0x73, 0x6e, 0x16, 0xde, 0x3f, 0x0a,
-	0xa8, 0xa8, 0xef, 0xed, 0xd8, 0x35, 0x6c, 0x38, 0xaf, 0x92, 0xb9, 0x2b, 0xcf, 0xeb, 0x1e, 0x0d,
-	0x1d, 0x29, 0xb2, 0x52, 0x4b, 0x8a, 0xfd, 0xf6, 0x39, 0x89, 0x83, 0x88, 0xf0, 0x9d, 0x91, 0xe4,
-	0xaf, 0xe3, 0xe1, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xc9, 0xa0, 0x49, 0xed, 0x06, 0x00,
-	0x00,
+func (m *MsgSpendFromBudget) Reset()         { *m = MsgSpendFromBudget{} }
+func (m *MsgSpendFromBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendFromBudget) ProtoMessage()    {}
+func (*MsgSpendFromBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSpendFromBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendFromBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendFromBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendFromBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendFromBudget.Merge(m, src)
+}
+func (m *MsgSpendFromBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendFromBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendFromBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendFromBudget proto.InternalMessageInfo
+
+func (m *MsgSpendFromBudget) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *MsgSpendFromBudget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgSpendFromBudget) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgSpendFromBudget) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// MsgSpendFromBudgetResponse defines the response for MsgSpendFromBudget.
+type MsgSpendFromBudgetResponse struct {
+	// spend_id is the ID of the recorded spend.
+	SpendId uint64 `protobuf:"varint,1,opt,name=spend_id,json=spendId,proto3" json:"spend_id,omitempty"`
+}
+
+func (m *MsgSpendFromBudgetResponse) Reset()         { *m = MsgSpendFromBudgetResponse{} }
+func (m *MsgSpendFromBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendFromBudgetResponse) ProtoMessage()    {}
+func (*MsgSpendFromBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18283c9e9e835f4a, []int{19}
+}
+func (m *MsgSpendFromBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendFromBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendFromBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendFromBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendFromBudgetResponse.Merge(m, src)
+}
+func (m *MsgSpendFromBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendFromBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendFromBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendFromBudgetResponse proto.InternalMessageInfo
+
+func (m *MsgSpendFromBudgetResponse) GetSpendId() uint64 {
+	if m != nil {
+		return m.SpendId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgAnnotateProposal)(nil), "hikari.coredaos.v1.MsgAnnotateProposal")
+	proto.RegisterType((*MsgAnnotateProposalResponse)(nil), "hikari.coredaos.v1.MsgAnnotateProposalResponse")
+	proto.RegisterType((*MsgEndorseProposal)(nil), "hikari.coredaos.v1.MsgEndorseProposal")
+	proto.RegisterType((*MsgEndorseProposalResponse)(nil), "hikari.coredaos.v1.MsgEndorseProposalResponse")
+	proto.RegisterType((*MsgExtendVotingPeriod)(nil), "hikari.coredaos.v1.MsgExtendVotingPeriod")
+	proto.RegisterType((*MsgExtendVotingPeriodResponse)(nil), "hikari.coredaos.v1.MsgExtendVotingPeriodResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "hikari.coredaos.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "hikari.coredaos.v1.MsgVetoProposalResponse")
+	proto.RegisterType((*MsgChallengeVeto)(nil), "hikari.coredaos.v1.MsgChallengeVeto")
+	proto.RegisterType((*MsgChallengeVetoResponse)(nil), "hikari.coredaos.v1.MsgChallengeVetoResponse")
+	proto.RegisterType((*MsgVoteVetoOverride)(nil), "hikari.coredaos.v1.MsgVoteVetoOverride")
+	proto.RegisterType((*MsgVoteVetoOverrideResponse)(nil), "hikari.coredaos.v1.MsgVoteVetoOverrideResponse")
+	proto.RegisterType((*MsgScheduleDaoHandover)(nil), "hikari.coredaos.v1.MsgScheduleDaoHandover")
+	proto.RegisterType((*MsgScheduleDaoHandoverResponse)(nil), "hikari.coredaos.v1.MsgScheduleDaoHandoverResponse")
+	proto.RegisterType((*MsgCancelDaoHandover)(nil), "hikari.coredaos.v1.MsgCancelDaoHandover")
+	proto.RegisterType((*MsgCancelDaoHandoverResponse)(nil), "hikari.coredaos.v1.MsgCancelDaoHandoverResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "hikari.coredaos.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "hikari.coredaos.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSpendFromBudget)(nil), "hikari.coredaos.v1.MsgSpendFromBudget")
+	proto.RegisterType((*MsgSpendFromBudgetResponse)(nil), "hikari.coredaos.v1.MsgSpendFromBudgetResponse")
+}
+
+func init() { proto.RegisterFile("hikari/coredaos/v1/tx.proto", fileDescriptor_18283c9e9e835f4a) }
+
+var fileDescriptor_18283c9e9e835f4a = []byte{
+	// 1175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0xe3, 0xd8, 0x91, 0x9f, 0xdd, 0xc4, 0x61, 0xdc, 0x44, 0xa6, 0x1d, 0xd9, 0x61, 0xda,
+	0x44, 0x71, 0x6b, 0x32, 0x56, 0x61, 0xa7, 0x15, 0xd0, 0x21, 0x76, 0x1a, 0x24, 0x83, 0x90, 0x80,
+	0x46, 0x3d, 0x74, 0x71, 0x68, 0xf1, 0x40, 0x11, 0x91, 0xee, 0x88, 0x3b, 0x4a, 0x75, 0xb6, 0xa2,
+	0x63, 0xa6, 0x8c, 0xfd, 0x0b, 0x8a, 0xa2, 0x93, 0x87, 0xa2, 0x40, 0xe7, 0x2e, 0x59, 0x0a, 0xa4,
+	0x9d, 0x3a, 0xb5, 0x85, 0x3d, 0x78, 0xea, 0xff, 0x50, 0xdc, 0x0f, 0x9d, 0x68, 0x8a, 0xb4, 0x04,
+	0x74, 0xb1, 0x79, 0xef, 0xbe, 0x7b, 0xef, 0xfb, 0xee, 0xde, 0xbd, 0x77, 0x82, 0xa5, 0x56, 0xf4,
+	0xd2, 0xa7, 0x91, 0xdb, 0x24, 0x14, 0x05, 0x3e, 0x61, 0x6e, 0x6f, 0xc3, 0x4d, 0x0e, 0x9d, 0x98,
+	0x92, 0x84, 0x98, 0xa6, 0x9c, 0x74, 0xfa, 0x93, 0x4e, 0x6f, 0xc3, 0x5a, 0x08, 0x49, 0x48, 0xc4,
+	0xb4, 0xcb, 0xbf, 0x24, 0xd2, 0x5a, 0x6c, 0x12, 0xd6, 0x21, 0x6c, 0x5f, 0x4e, 0xc8, 0x81, 0x9a,
+	0xba, 0x21, 0x47, 0x6e, 0x87, 0x85, 0xdc, 0x79, 0x87, 0x85, 0x6a, 0xe2, 0xaa, 0xdf, 0x89, 0x30,
+	0x71, 0xc5, 0x5f, 0x65, 0xaa, 0x28, 0xec, 0x81, 0xcf, 0x90, 0xdb, 0xdb, 0x38, 0x40, 0x89, 0xbf,
+	0xe1, 0x36, 0x49, 0x84, 0xd5, 0xfc, 0xad, 0x1c, 0xb6, 0x9a, 0x9c, 0x80, 0xd8, 0xff, 0x1a, 0x70,
+	0xad, 0xc1, 0xc2, 0x87, 0x18, 0x93, 0xc4, 0x4f, 0xd0, 0x73, 0x4a, 0x62, 0xc2, 0xfc, 0xb6, 0xb9,
+	0x0c, 0x33, 0xbe, 0xb4, 0x11, 0x5a, 0x36, 0x56, 0x8d, 0xea, 0x8c, 0x37, 0x30, 0x98, 0x2b, 0x30,
+	0x1b, 0x2b, 0xe4, 0x7e, 0x14, 0x94, 0x2f, 0xac, 0x1a, 0xd5, 0x8b, 0x1e, 0xf4, 0x4d, 0x4f, 0x03,
+	0xb3, 0x02, 0xa0, 0xd0, 0x11, 0xc1, 0xe5, 0x49, 0xb1, 0x3e, 0x65, 0xe1, 0xee, 0x49, 0x0f, 0xd1,
+	0xaf, 0x69, 0x94, 0xa0, 0xf2, 0xc5, 0x55, 0xa3, 0x5a, 0xf2, 0x06, 0x06, 0xbe, 0x9a, 0x75, 0x63,
+	0x44, 0x19, 0x0a, 0x10, 0x2b, 0x4f, 0x49, 0xef, 0x03, 0x4b, 0xfd, 0xb3, 0x6f, 0x4f, 0x8f, 0xd6,
+	0x06, 0x74, 0x5e, 0x9f, 0x1e, 0xad, 0xdd, 0xc9, 0x91, 0x9a, 0xa3, 0xcb, 0xbe, 0x09, 0x4b, 0x39,
+	0x66, 0x0f, 0xb1, 0x98, 0x60, 0x86, 0xec, 0xd7, 0x06, 0x98, 0x0d, 0x16, 0x7e, 0x81, 0x03, 0x42,
+	0xd9, 0x60, 0x37, 0x2c, 0x28, 0x21, 0x69, 0xea, 0x6f, 0x86, 0x1e, 0x8f, 0xdc, 0x8b, 0xfa, 0x03,
+	0xce, 0x56, 0xe3, 0x39, 0xd9, 0x0f, 0xf3, 0xc9, 0x66, 0xa2, 0xda, 0xcb, 0x60, 0x0d, 0x5b, 0x35,
+	0xd5, 0x37, 0x06, 0xbc, 0xcf, 0xa7, 0x0f, 0x13, 0x84, 0x83, 0x3d, 0x92, 0x44, 0x38, 0x7c, 0x8e,
+	0x68, 0x44, 0x02, 0xc1, 0x56, 0x58, 0x53, 0x6c, 0xd5, 0x78, 0x34, 0xdb, 0xba, 0x64, 0xab, 0xf0,
+	0x9c, 0x6d, 0xb5, 0x80, 0xed, 0x50, 0x60, 0x7b, 0x05, 0x6e, 0xe6, 0x4e, 0x68, 0xce, 0xbf, 0x18,
+	0x70, 0xa5, 0xc1, 0xc2, 0x3d, 0x94, 0x10, 0xbd, 0xb7, 0xd7, 0x61, 0xba, 0x87, 0x12, 0xa2, 0xb9,
+	0xaa, 0xd1, 0xe8, 0x1c, 0xbb, 0x05, 0x73, 0x07, 0x5d, 0x8a, 0xf7, 0x03, 0x14, 0x13, 0x16, 0x25,
+	0x22, 0xcb, 0x4a, 0xde, 0x2c, 0xb7, 0x3d, 0x92, 0x26, 0xee, 0x9b, 0x22, 0x9f, 0x11, 0x2c, 0x72,
+	0x6c, 0xc6, 0x53, 0xa3, 0x7a, 0x8d, 0x8b, 0x54, 0x81, 0xb8, 0x44, 0x3b, 0x5f, 0x62, 0x9a, 0xa7,
+	0xbd, 0x08, 0x37, 0x32, 0x26, 0x2d, 0xeb, 0x7b, 0x03, 0xe6, 0x1b, 0x2c, 0xdc, 0x69, 0xf9, 0xed,
+	0x36, 0xc2, 0x21, 0xe2, 0x20, 0xf3, 0x53, 0x80, 0x66, 0xdf, 0xa0, 0xb4, 0x6d, 0x97, 0xff, 0xf8,
+	0x69, 0x7d, 0x41, 0x5d, 0xf7, 0x87, 0x41, 0x40, 0x11, 0x63, 0xbb, 0x09, 0x8d, 0x70, 0xe8, 0xa5,
+	0xb0, 0x63, 0x66, 0x54, 0x6a, 0x05, 0x97, 0x70, 0x3b, 0x5f, 0xc2, 0x19, 0x4e, 0xb6, 0x05, 0xe5,
+	0xac, 0x2d, 0x7d, 0x36, 0xbc, 0x12, 0xec, 0x91, 0x44, 0xd8, 0x9f, 0xf5, 0x10, 0xa5, 0x51, 0x80,
+	0x4c, 0x07, 0xa6, 0x7a, 0x24, 0x19, 0x43, 0x82, 0x84, 0x8d, 0x3e, 0x37, 0x0b, 0x4a, 0x44, 0x39,
+	0x57, 0x67, 0xa6, 0xc7, 0xf5, 0x4d, 0xae, 0x4c, 0x3a, 0x3a, 0xe7, 0x56, 0x67, 0x39, 0xaa, 0x5b,
+	0x9d, 0x35, 0x6b, 0x69, 0xbf, 0x19, 0x70, 0xbd, 0xc1, 0xc2, 0xdd, 0x66, 0x0b, 0x05, 0xdd, 0x36,
+	0x7a, 0xe4, 0x93, 0x27, 0x3e, 0x0e, 0x78, 0x50, 0xf3, 0x3e, 0x4c, 0xb3, 0x28, 0xc4, 0x63, 0xc8,
+	0x53, 0x38, 0xf3, 0x31, 0x94, 0x5a, 0x6a, 0xb5, 0x10, 0x37, 0x5b, 0x5b, 0x71, 0x86, 0x0b, 0xbf,
+	0x93, 0x0a, 0xb2, 0x3d, 0xf3, 0xf6, 0xaf, 0x95, 0x89, 0x1f, 0x4e, 0x8f, 0xd6, 0x0c, 0x4f, 0xaf,
+	0x95, 0x45, 0x4c, 0x39, 0xe5, 0x5a, 0xef, 0xe5, 0x6b, 0xcd, 0x21, 0x6d, 0xaf, 0x42, 0x25, 0x7f,
+	0x46, 0x2b, 0xfe, 0xdd, 0x80, 0x05, 0x7e, 0xd2, 0x3e, 0x6e, 0xa2, 0xf6, 0xff, 0xd3, 0xbb, 0x05,
+	0xa5, 0xc0, 0x27, 0xfb, 0x94, 0xb4, 0x91, 0xd0, 0x7b, 0xb9, 0xb6, 0x54, 0xa0, 0xd7, 0x23, 0x6d,
+	0xe4, 0x5d, 0x0a, 0xe4, 0x07, 0xbf, 0x7b, 0x2d, 0x14, 0x85, 0x2d, 0x79, 0x31, 0x27, 0x3d, 0x35,
+	0x92, 0xc9, 0x9b, 0xd2, 0x7d, 0xb7, 0x20, 0x71, 0xb3, 0xd4, 0xed, 0x0a, 0x2c, 0xe7, 0xd9, 0xb5,
+	0xe6, 0xef, 0x64, 0x71, 0xf9, 0x32, 0x0e, 0x78, 0x65, 0xf7, 0xa9, 0xdf, 0x61, 0xe6, 0x16, 0xcc,
+	0xf8, 0xdd, 0xa4, 0x45, 0x68, 0x94, 0xbc, 0x1a, 0xa9, 0x78, 0x00, 0x35, 0x3f, 0x87, 0xe9, 0x58,
+	0x78, 0x50, 0x47, 0x6c, 0xe5, 0x49, 0x96, 0x31, 0xd2, 0xa7, 0xab, 0x16, 0xd5, 0x2f, 0xcb, 0x06,
+	0xd5, 0x77, 0xa7, 0x6a, 0x47, 0x9a, 0x99, 0x66, 0xfd, 0xf3, 0x05, 0xd1, 0x71, 0x76, 0x63, 0x84,
+	0x83, 0xc7, 0x94, 0x74, 0xb6, 0xbb, 0x41, 0x88, 0x12, 0xb3, 0x06, 0x97, 0x58, 0x9c, 0x2a, 0xe1,
+	0xe7, 0xd0, 0xee, 0x03, 0xb9, 0x58, 0x8a, 0x9a, 0x51, 0x1c, 0x21, 0x9c, 0x08, 0xde, 0xe7, 0x8a,
+	0xd5, 0x50, 0xb3, 0x05, 0xd3, 0x7e, 0x87, 0x74, 0x31, 0x3f, 0xa9, 0xc9, 0xea, 0x6c, 0x6d, 0xd1,
+	0x51, 0x2b, 0xf8, 0xbb, 0xc2, 0x51, 0xef, 0x0a, 0x67, 0x87, 0x44, 0x78, 0x7b, 0x93, 0x6b, 0xfd,
+	0xf1, 0xef, 0x95, 0x6a, 0x18, 0x25, 0xad, 0xee, 0x81, 0xd3, 0x24, 0x1d, 0xf5, 0x7c, 0x51, 0xff,
+	0xd6, 0x59, 0xf0, 0xd2, 0x4d, 0x5e, 0xc5, 0x88, 0x89, 0x05, 0x4c, 0xed, 0x8b, 0xf4, 0x6f, 0x9a,
+	0x70, 0xb1, 0x83, 0x3a, 0x44, 0x55, 0x63, 0xf1, 0x5d, 0xdf, 0xe2, 0x7b, 0xd5, 0xd7, 0x70, 0x4e,
+	0x77, 0xcc, 0xec, 0x90, 0xfd, 0x40, 0x74, 0xc7, 0x8c, 0xb5, 0xbf, 0xad, 0xe6, 0x22, 0x94, 0x84,
+	0x4b, 0x5e, 0x82, 0x0c, 0x51, 0x82, 0x64, 0x88, 0xa7, 0x41, 0xed, 0xd7, 0x12, 0x4c, 0x36, 0x58,
+	0x68, 0xb6, 0x61, 0x7e, 0xe8, 0xd9, 0x73, 0x37, 0xef, 0x9c, 0x73, 0x1e, 0x0c, 0x96, 0x3b, 0x26,
+	0x50, 0x13, 0x8a, 0xe0, 0x4a, 0xf6, 0x55, 0x71, 0xa7, 0xc0, 0x47, 0x06, 0x67, 0x39, 0xe3, 0xe1,
+	0x74, 0x28, 0x0a, 0x66, 0xce, 0xab, 0xe0, 0x5e, 0x91, 0x97, 0x21, 0xa8, 0xb5, 0x31, 0x36, 0x54,
+	0xc7, 0x7c, 0x01, 0x73, 0x67, 0xba, 0xfa, 0xed, 0x02, 0x17, 0x69, 0x90, 0xf5, 0xd1, 0x18, 0x20,
+	0x1d, 0xa1, 0x09, 0xef, 0x9d, 0x6d, 0xb0, 0x1f, 0x14, 0xac, 0x3e, 0x83, 0xb2, 0x3e, 0x1e, 0x07,
+	0xa5, 0x83, 0xb4, 0x61, 0x7e, 0xa8, 0x01, 0x16, 0xe5, 0x44, 0x16, 0x58, 0x98, 0x13, 0x45, 0x7d,
+	0xc9, 0xec, 0xc2, 0xb5, 0xbc, 0x9e, 0xb4, 0x56, 0xe0, 0x27, 0x07, 0x6b, 0xd5, 0xc6, 0xc7, 0xea,
+	0xb0, 0x04, 0xae, 0x0e, 0x37, 0x86, 0x6a, 0xd1, 0x3e, 0x65, 0x91, 0xd6, 0xfd, 0x71, 0x91, 0xe9,
+	0xdc, 0xcf, 0xd6, 0xb7, 0xa2, 0xdc, 0xcf, 0xe0, 0x0a, 0x73, 0xbf, 0xe8, 0xde, 0xbf, 0x80, 0xb9,
+	0x33, 0x0d, 0xa0, 0x28, 0x0f, 0xd3, 0xa0, 0xc2, 0x3c, 0xcc, 0x2b, 0xd8, 0xd6, 0xd4, 0x37, 0xbc,
+	0xa4, 0x6d, 0x3f, 0x7b, 0x7b, 0x5c, 0x31, 0xde, 0x1d, 0x57, 0x8c, 0x7f, 0x8e, 0x2b, 0xc6, 0x9b,
+	0x93, 0xca, 0xc4, 0xbb, 0x93, 0xca, 0xc4, 0x9f, 0x27, 0x95, 0x89, 0xaf, 0x36, 0x53, 0xb5, 0xf1,
+	0x89, 0xf0, 0xbb, 0xbe, 0xd3, 0xf2, 0x23, 0xec, 0xca, 0x20, 0xeb, 0x4d, 0x31, 0x38, 0x1c, 0xd4,
+	0x37, 0x51, 0x2e, 0x0f, 0xa6, 0xc5, 0x0f, 0xb2, 0x4f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x59,
+	0x86, 0x7b, 0x79, 0x63, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
 // MsgClient is the client API for Msg service.
 //
@@ -614,6 +1190,22 @@ type MsgClient interface {
 	// VetoProposal defines a method to veto a proposal.
 	// It is only available to the Oversight DAO.
 	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
+	// ChallengeVeto defines a method for a staker to challenge a veto during
+	// its challenge window, which triggers a veto override vote.
+	ChallengeVeto(ctx context.Context, in *MsgChallengeVeto, opts ...grpc.CallOption) (*MsgChallengeVetoResponse, error)
+	// VoteVetoOverride defines a method for a staker to vote on the override
+	// of a challenged veto.
+	VoteVetoOverride(ctx context.Context, in *MsgVoteVetoOverride, opts ...grpc.CallOption) (*MsgVoteVetoOverrideResponse, error)
+	// ScheduleDaoHandover defines a method to schedule the handover of a core
+	// DAO at a given height. It is only available to the module authority.
+	ScheduleDaoHandover(ctx context.Context, in *MsgScheduleDaoHandover, opts ...grpc.CallOption) (*MsgScheduleDaoHandoverResponse, error)
+	// CancelDaoHandover defines a method to cancel a scheduled handover of a
+	// core DAO. It is only available to the module authority.
+	CancelDaoHandover(ctx context.Context, in *MsgCancelDaoHandover, opts ...grpc.CallOption) (*MsgCancelDaoHandoverResponse, error)
+	// SpendFromBudget defines a method to send funds from the community pool
+	// within the budget of a core DAO for the current budget epoch. It is
+	// available to the Steering DAO and the Oversight DAO.
+	SpendFromBudget(ctx context.Context, in *MsgSpendFromBudget, opts ...grpc.CallOption) (*MsgSpendFromBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/coredaos
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -663,6 +1255,51 @@ func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts
 	return out, nil
 }
 
+func (c *msgClient) ChallengeVeto(ctx context.Context, in *MsgChallengeVeto, opts ...grpc.CallOption) (*MsgChallengeVetoResponse, error) {
+	out := new(MsgChallengeVetoResponse)
+	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/ChallengeVeto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteVetoOverride(ctx context.Context, in *MsgVoteVetoOverride, opts ...grpc.CallOption) (*MsgVoteVetoOverrideResponse, error) {
+	out := new(MsgVoteVetoOverrideResponse)
+	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/VoteVetoOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleDaoHandover(ctx context.Context, in *MsgScheduleDaoHandover, opts ...grpc.CallOption) (*MsgScheduleDaoHandoverResponse, error) {
+	out := new(MsgScheduleDaoHandoverResponse)
+	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/ScheduleDaoHandover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDaoHandover(ctx context.Context, in *MsgCancelDaoHandover, opts ...grpc.CallOption) (*MsgCancelDaoHandoverResponse, error) {
+	out := new(MsgCancelDaoHandoverResponse)
+	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/CancelDaoHandover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SpendFromBudget(ctx context.Context, in *MsgSpendFromBudget, opts ...grpc.CallOption) (*MsgSpendFromBudgetResponse, error) {
+	out := new(MsgSpendFromBudgetResponse)
+	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/SpendFromBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/hikari.coredaos.v1.Msg/UpdateParams", in, out, opts...)
@@ -688,6 +1325,22 @@ type MsgServer interface {
 	// VetoProposal defines a method to veto a proposal.
 	// It is only available to the Oversight DAO.
 	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
+	// ChallengeVeto defines a method for a staker to challenge a veto during
+	// its challenge window, which triggers a veto override vote.
+	ChallengeVeto(context.Context, *MsgChallengeVeto) (*MsgChallengeVetoResponse, error)
+	// VoteVetoOverride defines a method for a staker to vote on the override
+	// of a challenged veto.
+	VoteVetoOverride(context.Context, *MsgVoteVetoOverride) (*MsgVoteVetoOverrideResponse, error)
+	// ScheduleDaoHandover defines a method to schedule the handover of a core
+	// DAO at a given height. It is only available to the module authority.
+	ScheduleDaoHandover(context.Context, *MsgScheduleDaoHandover) (*MsgScheduleDaoHandoverResponse, error)
+	// CancelDaoHandover defines a method to cancel a scheduled handover of a
+	// core DAO. It is only available to the module authority.
+	CancelDaoHandover(context.Context, *MsgCancelDaoHandover) (*MsgCancelDaoHandoverResponse, error)
+	// SpendFromBudget defines a method to send funds from the community pool
+	// within the budget of a core DAO for the current budget epoch. It is
+	// available to the Steering DAO and the Oversight DAO.
+	SpendFromBudget(context.Context, *MsgSpendFromBudget) (*MsgSpendFromBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/coredaos
 	// module parameters. The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -709,6 +1362,21 @@ func (*UnimplementedMsgServer) ExtendVotingPeriod(ctx context.Context, req *MsgE
 func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
 }
+func (*UnimplementedMsgServer) ChallengeVeto(ctx context.Context, req *MsgChallengeVeto) (*MsgChallengeVetoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeVeto not implemented")
+}
+func (*UnimplementedMsgServer) VoteVetoOverride(ctx context.Context, req *MsgVoteVetoOverride) (*MsgVoteVetoOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteVetoOverride not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleDaoHandover(ctx context.Context, req *MsgScheduleDaoHandover) (*MsgScheduleDaoHandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDaoHandover not implemented")
+}
+func (*UnimplementedMsgServer) CancelDaoHandover(ctx context.Context, req *MsgCancelDaoHandover) (*MsgCancelDaoHandoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDaoHandover not implemented")
+}
+func (*UnimplementedMsgServer) SpendFromBudget(ctx context.Context, req *MsgSpendFromBudget) (*MsgSpendFromBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendFromBudget not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -789,6 +1457,96 @@ func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChallengeVeto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeVeto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeVeto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.coredaos.v1.Msg/ChallengeVeto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeVeto(ctx, req.(*MsgChallengeVeto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteVetoOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteVetoOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteVetoOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.coredaos.v1.Msg/VoteVetoOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteVetoOverride(ctx, req.(*MsgVoteVetoOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleDaoHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleDaoHandover)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleDaoHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.coredaos.v1.Msg/ScheduleDaoHandover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleDaoHandover(ctx, req.(*MsgScheduleDaoHandover))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDaoHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDaoHandover)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDaoHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.coredaos.v1.Msg/CancelDaoHandover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDaoHandover(ctx, req.(*MsgCancelDaoHandover))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SpendFromBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSpendFromBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SpendFromBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.coredaos.v1.Msg/SpendFromBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SpendFromBudget(ctx, req.(*MsgSpendFromBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -828,6 +1586,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VetoProposal",
 			Handler:    _Msg_VetoProposal_Handler,
 		},
+		{
+			MethodName: "ChallengeVeto",
+			Handler:    _Msg_ChallengeVeto_Handler,
+		},
+		{
+			MethodName: "VoteVetoOverride",
+			Handler:    _Msg_VoteVetoOverride_Handler,
+		},
+		{
+			MethodName: "ScheduleDaoHandover",
+			Handler:    _Msg_ScheduleDaoHandover_Handler,
+		},
+		{
+			MethodName: "CancelDaoHandover",
+			Handler:    _Msg_CancelDaoHandover_Handler,
+		},
+		{
+			MethodName: "SpendFromBudget",
+			Handler:    _Msg_SpendFromBudget_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -857,6 +1635,11 @@ func (m *MsgAnnotateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Supersedes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Supersedes))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Overwrite {
 		i--
 		if m.Overwrite {
@@ -1048,6 +1831,13 @@ func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BurnDeposit {
 		i--
 		if m.BurnDeposit {
@@ -1096,7 +1886,7 @@ func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgChallengeVeto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1106,37 +1896,32 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChallengeVeto) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChallengeVeto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChallengeVetoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1146,12 +1931,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChallengeVetoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChallengeVetoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1159,158 +1944,1530 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgVoteVetoOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAnnotateProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgVoteVetoOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteVetoOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Annotator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	if m.Override {
+		i--
+		if m.Override {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Annotation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Overwrite {
-		n += 2
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAnnotateProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteVetoOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteVetoOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteVetoOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEndorseProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleDaoHandover) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDaoHandover) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDaoHandover) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Endorser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Handover.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEndorseProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleDaoHandoverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleDaoHandoverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDaoHandoverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExtendVotingPeriod) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelDaoHandover) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDaoHandover) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDaoHandover) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Extender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	if m.DaoRole != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DaoRole))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExtendVotingPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelDaoHandoverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDaoHandoverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDaoHandoverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVetoProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Vetoer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.BurnDeposit {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVetoProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSpendFromBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendFromBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendFromBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSpendFromBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendFromBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendFromBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpendId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAnnotateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Annotator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.Supersedes != 0 {
+		n += 1 + sovTx(uint64(m.Supersedes))
+	}
+	return n
+}
+
+func (m *MsgAnnotateProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEndorseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endorser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgEndorseProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendVotingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgExtendVotingPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChallengeVeto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgChallengeVetoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteVetoOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.Override {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVoteVetoOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleDaoHandover) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Handover.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleDaoHandoverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDaoHandover) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DaoRole != 0 {
+		n += 1 + sovTx(uint64(m.DaoRole))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelDaoHandoverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSpendFromBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSpendFromBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendId != 0 {
+		n += 1 + sovTx(uint64(m.SpendId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+			m.Supersedes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supersedes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnotateProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnotateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endorser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorseProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChallengeVeto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeVeto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeVeto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChallengeVetoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeVetoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeVetoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteVetoOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,15 +3490,15 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnotateProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteVetoOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnotateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteVetoOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1369,7 +3526,7 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotator = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1391,40 +3548,8 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Annotation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,7 +3566,7 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			m.Override = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1463,7 +3588,7 @@ func (m *MsgAnnotateProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteVetoOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,10 +3611,10 @@ func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnnotateProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteVetoOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnnotateProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteVetoOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1513,7 +3638,7 @@ func (m *MsgAnnotateProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleDaoHandover) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,15 +3661,15 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorseProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleDaoHandover: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleDaoHandover: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endorser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1572,13 +3697,13 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endorser = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handover", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1588,11 +3713,25 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Handover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1614,7 +3753,7 @@ func (m *MsgEndorseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleDaoHandoverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,10 +3776,10 @@ func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorseProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleDaoHandoverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorseProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleDaoHandoverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1664,7 +3803,7 @@ func (m *MsgEndorseProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDaoHandover) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,15 +3826,15 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriod: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDaoHandover: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDaoHandover: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,13 +3862,13 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRole", wireType)
 			}
-			m.ProposalId = 0
+			m.DaoRole = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1739,7 +3878,26 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.DaoRole |= DaoRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1765,7 +3923,7 @@ func (m *MsgExtendVotingPeriod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelDaoHandoverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1788,10 +3946,10 @@ func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelDaoHandoverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendVotingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelDaoHandoverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1815,7 +3973,7 @@ func (m *MsgExtendVotingPeriodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,15 +3996,15 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1874,13 +4032,13 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1890,31 +4048,25 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.BurnDeposit = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1936,7 +4088,7 @@ func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1959,10 +4111,10 @@ func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1986,7 +4138,7 @@ func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendFromBudget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2009,15 +4161,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendFromBudget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendFromBudget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2045,11 +4197,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Spender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2076,10 +4260,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2101,7 +4318,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSpendFromBudgetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2124,12 +4341,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSpendFromBudgetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSpendFromBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendId", wireType)
+			}
+			m.SpendId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypesv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// NewVeto creates a new Veto instance
+func NewVeto(
+	proposalID uint64, vetoer, reason string, burnDeposit bool, blockTime time.Time,
+	status VetoStatus, proposalStatus govtypesv1.ProposalStatus, remainingTime time.Duration,
+) Veto {
+	return Veto{
+		ProposalId:     proposalID,
+		Vetoer:         vetoer,
+		Reason:         reason,
+		BurnDeposit:    burnDeposit,
+		Time:           blockTime,
+		Status:         status,
+		ProposalStatus: proposalStatus,
+		RemainingTime:  remainingTime,
+	}
+}
+
+// ValidateBasic performs basic validation of the veto
+func (v Veto) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Vetoer); err != nil {
+		return fmt.Errorf("invalid vetoer address: %s: %w", v.Vetoer, err)
+	}
+	if len(v.Reason) == 0 {
+		return fmt.Errorf("veto reason cannot be empty")
+	}
+	if _, ok := VetoStatus_name[int32(v.Status)]; !ok || v.Status == VetoStatusUnspecified {
+		return fmt.Errorf("invalid veto status: %s", v.Status)
+	}
+	if v.ProposalStatus != govtypesv1.StatusVotingPeriod && v.ProposalStatus != govtypesv1.StatusPassedPendingExecution {
+		return fmt.Errorf("invalid vetoed proposal status: %s", v.ProposalStatus)
+	}
+	if v.RemainingTime < 0 {
+		return fmt.Errorf("remaining time must not be negative: %s", v.RemainingTime)
+	}
+	if v.Challenger != "" {
+		if _, err := sdk.AccAddressFromBech32(v.Challenger); err != nil {
+			return fmt.Errorf("invalid challenger address: %s: %w", v.Challenger, err)
+		}
+	}
+	if v.Status == VetoStatusChallenged && (v.Challenger == "" || v.OverrideVotingEndTime == nil) {
+		return fmt.Errorf("challenged veto must have a challenger and an override voting end time")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the veto override vote
+func (v VetoOverrideVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return fmt.Errorf("invalid voter address: %s: %w", v.Voter, err)
+	}
+	votingPower, ok := math.NewIntFromString(v.VotingPower)
+	if !ok || !votingPower.IsPositive() {
+		return fmt.Errorf("invalid voting power: %s", v.VotingPower)
+	}
+	return nil
+}
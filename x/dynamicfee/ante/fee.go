@@ -110,40 +110,44 @@ func (dfd dynamicfeeCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	if len(feeCoins) == 0 && !simulate {
 		return ctx, errorsmod.Wrapf(types.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
-	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(types.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
-	}
 
 	// if simulating - create a dummy zero value for the user
-	payCoin := sdk.NewCoin(params.FeeDenom, sdkmath.ZeroInt())
-	if !simulate {
-		payCoin = feeCoins[0]
+	if simulate {
+		feeCoins = sdk.Coins{sdk.NewCoin(params.FeeDenom, sdkmath.ZeroInt())}
 	}
 
 	feeGas := int64(feeTx.GetGas())
 
-	minGasPrice, err := dfd.dynamicfeeKeeper.GetMinGasPrice(ctx, payCoin.GetDenom())
-	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+	// apply the highest gas price multiplier among the tx msgs
+	multiplier := params.MsgsGasPriceMultiplier(tx.GetMsgs())
+
+	minGasPrices := make([]sdk.DecCoin, len(feeCoins))
+	for i, feeCoin := range feeCoins {
+		minGasPrice, err := dfd.dynamicfeeKeeper.GetMinGasPrice(ctx, feeCoin.GetDenom())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", feeCoin.GetDenom())
+		}
+		minGasPrices[i] = sdk.NewDecCoinFromDec(minGasPrice.Denom, minGasPrice.Amount.Mul(multiplier))
 	}
 
 	ctx.Logger().Info("fee deduct ante handle",
-		"min gas prices", minGasPrice,
+		"min gas prices", minGasPrices,
 		"fee", feeCoins,
 		"gas limit", gas,
+		"gas price multiplier", multiplier,
 	)
 
-	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(minGasPrice))
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(minGasPrices...))
 
 	if !simulate {
-		err := CheckTxFee(ctx, minGasPrice, payCoin, feeGas)
+		err := CheckTxFees(ctx, minGasPrices, feeCoins, feeGas)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "error checking fee")
 		}
 	}
 
-	// deduct the entire amount that the account provided as fee (payCoin)
-	err = dfd.DeductFees(ctx, tx, payCoin)
+	// deduct the entire amount that the account provided as fee (feeCoins)
+	err = dfd.DeductFees(ctx, tx, sdk.NewCoins(feeCoins...))
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "error deducting fee")
 	}
@@ -152,24 +156,20 @@ func (dfd dynamicfeeCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	}
 
 	// Compute tx priority
-	if payCoin.Denom == params.FeeDenom {
+	if len(feeCoins) == 1 && feeCoins[0].Denom == params.FeeDenom {
 		// Same denom no conversion needed
-		ctx = ctx.WithPriority(GetTxPriority(payCoin, int64(gas), minGasPrice))
+		ctx = ctx.WithPriority(GetTxPriority(feeCoins[0], int64(gas), minGasPrices[0]))
 	} else {
-		// Different denom, payCoin needs to be converted to params.FeeDenom
-		// 1. get gas price in params.FeeDenom
+		// Different denoms, feeCoins need to be converted to params.FeeDenom
+		// 1. get gas price in params.FeeDenom, with the same multiplier
 		baseGasPrice, err := dfd.dynamicfeeKeeper.GetMinGasPrice(ctx, params.FeeDenom)
 		if err != nil {
 			return ctx, err
 		}
-		// 2. compute conversion factor between the 2 denoms
-		factor := baseGasPrice.Amount.Quo(minGasPrice.Amount)
-		// 3. convert payCoin
-		feeCoin := sdk.NewCoin(
-			params.FeeDenom,
-			payCoin.Amount.ToLegacyDec().Mul(factor).TruncateInt(),
-		)
-		// 4. compute tx priority
+		baseGasPrice = sdk.NewDecCoinFromDec(baseGasPrice.Denom, baseGasPrice.Amount.Mul(multiplier))
+		// 2. convert and sum feeCoins
+		feeCoin := sdk.NewCoin(params.FeeDenom, ConvertFeeCoins(feeCoins, minGasPrices, baseGasPrice))
+		// 3. compute tx priority
 		ctx = ctx.WithPriority(GetTxPriority(feeCoin, int64(gas), baseGasPrice))
 	}
 
@@ -177,7 +177,7 @@ func (dfd dynamicfeeCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simul
 }
 
 // DeductFees deducts the provided fee from the payer account during tx execution.
-func (dfd dynamicfeeCheckDecorator) DeductFees(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coin) error {
+func (dfd dynamicfeeCheckDecorator) DeductFees(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -193,11 +193,9 @@ func (dfd dynamicfeeCheckDecorator) DeductFees(ctx sdk.Context, sdkTx sdk.Tx, pr
 		if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranter, feePayer) {
-			if !providedFee.IsNil() {
-				err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, sdk.NewCoins(providedFee), sdkTx.GetMsgs())
-				if err != nil {
-					return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sdk.AccAddress(feeGranter).String(), sdk.AccAddress(feePayer).String())
-				}
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, providedFee, sdkTx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sdk.AccAddress(feeGranter).String(), sdk.AccAddress(feePayer).String())
 			}
 		}
 
@@ -209,7 +207,7 @@ func (dfd dynamicfeeCheckDecorator) DeductFees(ctx sdk.Context, sdkTx sdk.Tx, pr
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", sdk.AccAddress(deductFeesFrom).String())
 	}
 
-	err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, deductFeesFromAcc.GetAddress(), authtypes.FeeCollectorName, sdk.NewCoins(providedFee))
+	err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, deductFeesFromAcc.GetAddress(), authtypes.FeeCollectorName, providedFee)
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
@@ -251,6 +249,66 @@ func CheckTxFee(ctx sdk.Context, gasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas
 	return nil
 }
 
+// CheckTxFees is the multi coin version of CheckTxFee, gasPrices being the
+// gas prices of the denoms of feeCoins. The fee coins jointly cover the
+// required fee if the gas they pay for at the gas price of their denom sums
+// up to at least feeGas.
+func CheckTxFees(ctx sdk.Context, gasPrices []sdk.DecCoin, feeCoins sdk.Coins, feeGas int64) error {
+	if len(feeCoins) == 1 {
+		return CheckTxFee(ctx, gasPrices[0], feeCoins[0], feeGas)
+	}
+
+	var (
+		paidGas     = sdkmath.LegacyZeroDec()
+		feeRequired bool
+	)
+	for i, feeCoin := range feeCoins {
+		if gasPrices[i].IsZero() {
+			// no fee is required in this denom, the coin pays for no gas
+			continue
+		}
+		feeRequired = true
+		// round up so that coins jointly paying exactly the required fee are
+		// not rejected because of the division precision.
+		paidGas = paidGas.Add(feeCoin.Amount.ToLegacyDec().QuoRoundUp(gasPrices[i].Amount))
+	}
+	if !feeRequired {
+		// no fee is required
+		return nil
+	}
+
+	if paidGas.LT(sdkmath.LegacyNewDec(feeGas)) {
+		return sdkerrors.ErrInsufficientFee.Wrapf(
+			"got: %s paying for %s gas required: %d gas, minGasPrices: %s",
+			feeCoins,
+			paidGas,
+			feeGas,
+			sdk.NewDecCoins(gasPrices...),
+		)
+	}
+
+	return nil
+}
+
+// ConvertFeeCoins returns the sum of feeCoins converted to the denom of
+// gasPrice, using the ratio of gasPrice to the gas price of their denom in
+// gasPrices.
+func ConvertFeeCoins(feeCoins sdk.Coins, gasPrices []sdk.DecCoin, gasPrice sdk.DecCoin) sdkmath.Int {
+	total := sdkmath.LegacyZeroDec()
+	for i, feeCoin := range feeCoins {
+		if feeCoin.Denom == gasPrice.Denom {
+			total = total.Add(feeCoin.Amount.ToLegacyDec())
+			continue
+		}
+		if !gasPrices[i].Amount.IsPositive() {
+			continue
+		}
+		factor := gasPrice.Amount.Quo(gasPrices[i].Amount)
+		total = total.Add(feeCoin.Amount.ToLegacyDec().Mul(factor))
+	}
+	return total.TruncateInt()
+}
+
 const (
 	// gasPricePrecision is the amount of digit precision to scale the gas prices to.
 	gasPricePrecision = 6
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	xtxsigning "cosmossdk.io/x/tx/signing"
@@ -19,6 +20,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -162,24 +164,6 @@ func TestAnteHandle(t *testing.T) {
 			},
 			expectedError: "got length 0: no fee coin provided. Must provide one.",
 		},
-		{
-			name: "fail: too many fee coins given",
-			tx: func() sdk.Tx {
-				txBuilder := txConfig.NewTxBuilder()
-				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
-				txBuilder.SetGasLimit(42)
-				txBuilder.SetFeeAmount(sdk.NewCoins(
-					sdk.NewInt64Coin(types.DefaultFeeDenom, 1),
-					sdk.NewInt64Coin("photon", 2),
-				))
-				return txBuilder.GetTx()
-			},
-			setup: func(m mocks) {
-				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
-					Return(types.DefaultParams(), nil).Times(2)
-			},
-			expectedError: "got length 2: too many fee coins provided. Only one fee coin may be provided",
-		},
 		{
 			name: "fail: getMinGasPrice returns an error",
 			tx: func() sdk.Tx {
@@ -291,6 +275,75 @@ func TestAnteHandle(t *testing.T) {
 			expectedMinGasPrices: "1.000000000000000000uphoton",
 			expectedTxPriority:   10000000,
 		},
+		{
+			name: "fail: not enough fee with msg gas price multiplier",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+				txBuilder.SetGasLimit(42)
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 42),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithMultiplier("2"), nil).Times(2)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+			},
+			expectedError: "error checking fee: got: 42uphoton required: 84uphoton, minGasPrice: 2.000000000000000000uphoton: insufficient fee",
+		},
+		{
+			name: "ok: enough fee with msg gas price multiplier",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+				txBuilder.SetGasLimit(42)
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 84),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithMultiplier("2"), nil).Times(2)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.AccountKeeper.EXPECT().GetAccount(gomock.Any(), addrs[0]).
+					Return(acc1)
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(),
+					addrs[0], authtypes.FeeCollectorName,
+					sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 84)))
+			},
+			expectedMinGasPrices: "2.000000000000000000uphoton",
+			expectedTxPriority:   1000000,
+		},
+		{
+			name: "ok: enough fee with discounted msg",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+				txBuilder.SetGasLimit(42)
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 21),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(paramsWithMultiplier("0.5"), nil).Times(2)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.AccountKeeper.EXPECT().GetAccount(gomock.Any(), addrs[0]).
+					Return(acc1)
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(),
+					addrs[0], authtypes.FeeCollectorName,
+					sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 21)))
+			},
+			expectedMinGasPrices: "0.500000000000000000uphoton",
+			expectedTxPriority:   1000000,
+		},
 		{
 			name: "ok: enough fee with different denom",
 			tx: func() sdk.Tx {
@@ -322,6 +375,66 @@ func TestAnteHandle(t *testing.T) {
 			expectedMinGasPrices: "10.000000000000000000ulight",
 			expectedTxPriority:   1000000,
 		},
+		{
+			name: "fail: not enough fee with multiple denoms",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+				txBuilder.SetGasLimit(42)
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 20),
+					sdk.NewInt64Coin("ulight", 210),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil).Times(2)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, "ulight").
+					Return(sdk.NewInt64DecCoin("ulight", 10), nil)
+			},
+			expectedError: "error checking fee: got: 210ulight,20uphoton paying for 41.000000000000000000 gas required: 42 gas, minGasPrices: 10.000000000000000000ulight,1.000000000000000000uphoton: insufficient fee",
+		},
+		{
+			name: "ok: enough fee with multiple denoms",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+				txBuilder.SetGasLimit(42)
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 21),
+					sdk.NewInt64Coin("ulight", 210),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil).Times(2)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, "ulight").
+					Return(sdk.NewInt64DecCoin("ulight", 10), nil)
+				m.AccountKeeper.EXPECT().GetAccount(gomock.Any(), addrs[0]).
+					Return(acc1)
+				m.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(),
+					addrs[0], authtypes.FeeCollectorName,
+					sdk.NewCoins(
+						sdk.NewInt64Coin(types.DefaultFeeDenom, 21),
+						sdk.NewInt64Coin("ulight", 210),
+					))
+				// second call to GetMinGasPrice for tx priority computation
+				ctx := m.ctx.WithMinGasPrices(sdk.NewDecCoins(
+					sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1),
+					sdk.NewInt64DecCoin("ulight", 10),
+				))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+			},
+			expectedMinGasPrices: "10.000000000000000000ulight,1.000000000000000000uphoton",
+			expectedTxPriority:   1000000,
+		},
 		{
 			name: "ok: enough fee with named payer",
 			tx: func() sdk.Tx {
@@ -488,3 +601,63 @@ func TestAnteHandle(t *testing.T) {
 		})
 	}
 }
+
+// paramsWithMultiplier returns the default params with a gas price multiplier
+// for the testdata.TestMsg type.
+func paramsWithMultiplier(multiplier string) types.Params {
+	params := types.DefaultParams()
+	params.MsgGasPriceMultipliers = []types.MsgGasPriceMultiplier{{
+		MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}),
+		Multiplier: sdkmath.LegacyMustNewDecFromStr(multiplier),
+	}}
+	return params
+}
+
+func TestCheckTxFees(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewTestLogger(t))
+	tests := []struct {
+		name          string
+		gasPrices     []sdk.DecCoin
+		feeCoins      sdk.Coins
+		expectedError bool
+	}{
+		{
+			name:      "fee coins jointly cover the required fee",
+			gasPrices: []sdk.DecCoin{sdk.NewInt64DecCoin("photon", 1), sdk.NewInt64DecCoin("uhikari", 2)},
+			feeCoins:  sdk.NewCoins(sdk.NewInt64Coin("photon", 50), sdk.NewInt64Coin("uhikari", 100)),
+		},
+		{
+			name:          "fee coins do not cover the required fee",
+			gasPrices:     []sdk.DecCoin{sdk.NewInt64DecCoin("photon", 1), sdk.NewInt64DecCoin("uhikari", 2)},
+			feeCoins:      sdk.NewCoins(sdk.NewInt64Coin("photon", 50), sdk.NewInt64Coin("uhikari", 98)),
+			expectedError: true,
+		},
+		{
+			name:      "no fee required in any denom",
+			gasPrices: []sdk.DecCoin{sdk.NewInt64DecCoin("photon", 0), sdk.NewInt64DecCoin("uhikari", 0)},
+			feeCoins:  sdk.NewCoins(sdk.NewInt64Coin("photon", 1), sdk.NewInt64Coin("uhikari", 1)),
+		},
+		{
+			name:          "fee coin of a denom without fee does not pay for the others",
+			gasPrices:     []sdk.DecCoin{sdk.NewInt64DecCoin("photon", 0), sdk.NewInt64DecCoin("uhikari", 2)},
+			feeCoins:      sdk.NewCoins(sdk.NewInt64Coin("photon", 1), sdk.NewInt64Coin("uhikari", 100)),
+			expectedError: true,
+		},
+		{
+			name:      "fee coins of the other denoms cover the required fee",
+			gasPrices: []sdk.DecCoin{sdk.NewInt64DecCoin("photon", 0), sdk.NewInt64DecCoin("uhikari", 2)},
+			feeCoins:  sdk.NewCoins(sdk.NewInt64Coin("photon", 1), sdk.NewInt64Coin("uhikari", 200)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ante.CheckTxFees(ctx, tt.gasPrices, tt.feeCoins, 100)
+
+			if tt.expectedError {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
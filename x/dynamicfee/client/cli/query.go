@@ -11,6 +11,8 @@ import (
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
+const flagBlocks = "blocks"
+
 // GetQueryCmd returns the parent command for all x/dynamicfee cli query commands.
 func GetQueryCmd() *cobra.Command {
 	// create base command
@@ -28,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetStateCmd(),
 		GetGasPriceCmd(),
 		GetGasPricesCmd(),
+		GetBurnedFeesCmd(),
+		GetFeeHistoryCmd(),
 	)
 
 	return cmd
@@ -142,3 +146,66 @@ func GetGasPricesCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetBurnedFeesCmd returns the cli-command that queries the cumulative burned fees.
+func GetBurnedFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Short: "Query for the cumulative amount of base fees burned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.BurnedFees(cmd.Context(), &types.BurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFeeHistoryCmd returns the cli-command that queries the fee history of the
+// last blocks.
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history",
+		Short: "Query for the base gas price, utilization and effective gas prices of the last blocks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := cmd.Flags().GetUint64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.FeeHistory(cmd.Context(), &types.FeeHistoryRequest{
+				Blocks: blocks,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Uint64(flagBlocks, 0, "(optional) number of most recent blocks to return, all the kept blocks if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,327 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+const (
+	flagCompare      = "compare"
+	flagTraceFormat  = "trace-format"
+	flagFormat       = "format"
+	flagMaxBlockGas  = "max-block-gas"
+	flagBaseGasPrice = "base-gas-price"
+	flagLearningRate = "learning-rate"
+
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+// TraceBlock is the gas used by a block of the trace replayed by the
+// dynamicfee-simulate command.
+type TraceBlock struct {
+	Height  int64  `json:"height"`
+	GasUsed uint64 `json:"gas_used"`
+}
+
+// SimulatedBlock is the output of the dynamicfee-simulate command for a
+// block of the trace. The compare fields are only set when the trace is also
+// replayed against the params of the --compare flag.
+type SimulatedBlock struct {
+	Height              int64           `json:"height"`
+	GasUsed             uint64          `json:"gas_used"`
+	BaseGasPrice        math.LegacyDec  `json:"base_gas_price"`
+	LearningRate        math.LegacyDec  `json:"learning_rate"`
+	CompareBaseGasPrice *math.LegacyDec `json:"compare_base_gas_price,omitempty"`
+	CompareLearningRate *math.LegacyDec `json:"compare_learning_rate,omitempty"`
+}
+
+// GetSimulateCmd returns the cli-command that replays a trace of block gas
+// usage against dynamicfee params, without connecting to a node.
+func GetSimulateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamicfee-simulate [trace-file] [params-file]",
+		Short: "Simulate the dynamicfee base gas price over a trace of block gas usage",
+		Long: `Simulate the dynamicfee base gas price over a trace of block gas usage.
+
+The trace is replayed block by block against the params, running the same
+state updates as the dynamicfee end blocker, and the base gas price and
+learning rate that applied to each block are written as CSV or JSON.
+
+The trace file is either a CSV file whose records are "height,gas_used" or
+"gas_used", with an optional header, or a JSON file containing an array of
+{"height": 1, "gas_used": 1000} objects. When the height is omitted, the blocks
+are numbered from 1.
+
+The params file contains the dynamicfee params in JSON, as output by
+"hikarid query dynamicfee params --output json". The simulation starts from
+the state the params would be set with by MsgUpdateParams, unless the
+--base-gas-price and --learning-rate flags are given.
+
+Example:
+	hikarid debug dynamicfee-simulate trace.csv params.json
+
+	hikarid debug dynamicfee-simulate trace.json params.json --compare candidate.json --format json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			traceFormat, err := cmd.Flags().GetString(flagTraceFormat)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != formatCSV && format != formatJSON {
+				return fmt.Errorf("invalid output format %q, must be %s or %s", format, formatCSV, formatJSON)
+			}
+
+			trace, err := ReadTrace(args[0], traceFormat)
+			if err != nil {
+				return fmt.Errorf("failed to read trace: %w", err)
+			}
+
+			params, err := readParams(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read params: %w", err)
+			}
+			blocks, err := replayTrace(cmd.Flags(), params, trace)
+			if err != nil {
+				return err
+			}
+
+			simulated := make([]SimulatedBlock, len(trace))
+			for i, block := range trace {
+				simulated[i] = SimulatedBlock{
+					Height:       block.Height,
+					GasUsed:      block.GasUsed,
+					BaseGasPrice: blocks[i].BaseGasPrice,
+					LearningRate: blocks[i].LearningRate,
+				}
+			}
+
+			compareFile, err := cmd.Flags().GetString(flagCompare)
+			if err != nil {
+				return err
+			}
+			if compareFile != "" {
+				compareParams, err := readParams(compareFile)
+				if err != nil {
+					return fmt.Errorf("failed to read compare params: %w", err)
+				}
+				compareBlocks, err := replayTrace(cmd.Flags(), compareParams, trace)
+				if err != nil {
+					return fmt.Errorf("compare params: %w", err)
+				}
+				for i := range simulated {
+					simulated[i].CompareBaseGasPrice = &compareBlocks[i].BaseGasPrice
+					simulated[i].CompareLearningRate = &compareBlocks[i].LearningRate
+				}
+			}
+
+			if format == formatJSON {
+				return writeSimulationJSON(cmd.OutOrStdout(), simulated)
+			}
+			return writeSimulationCSV(cmd.OutOrStdout(), simulated, compareFile != "")
+		},
+	}
+
+	cmd.Flags().String(flagCompare, "", "Params file to replay the trace against for comparison")
+	cmd.Flags().String(flagTraceFormat, "", "Format of the trace file (csv|json), guessed from its extension if empty")
+	cmd.Flags().String(flagFormat, formatCSV, "Output format (csv|json)")
+	cmd.Flags().Uint64(flagMaxBlockGas, 0, "Max block gas, defaults to the default_max_block_gas param")
+	cmd.Flags().String(flagBaseGasPrice, "", "Initial base gas price, defaults to the min_base_gas_price param")
+	cmd.Flags().String(flagLearningRate, "", "Initial learning rate, defaults to the min_learning_rate param")
+
+	return cmd
+}
+
+// ReadTrace reads the trace of block gas usage of the file at path, in the
+// given format. If format is empty, it is guessed from the file extension.
+func ReadTrace(path, format string) ([]TraceBlock, error) {
+	if format == "" {
+		format = formatCSV
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			format = formatJSON
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var trace []TraceBlock
+	switch format {
+	case formatCSV:
+		trace, err = readTraceCSV(f)
+	case formatJSON:
+		err = json.NewDecoder(f).Decode(&trace)
+	default:
+		return nil, fmt.Errorf("invalid trace format %q, must be %s or %s", format, formatCSV, formatJSON)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range trace {
+		if trace[i].Height == 0 {
+			trace[i].Height = int64(i + 1)
+		}
+	}
+	return trace, nil
+}
+
+// readTraceCSV reads the "height,gas_used" or "gas_used" records of r,
+// skipping the header if any.
+func readTraceCSV(r io.Reader) ([]TraceBlock, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var trace []TraceBlock
+	for i, record := range records {
+		gasField := record[len(record)-1]
+		gasUsed, err := strconv.ParseUint(gasField, 10, 64)
+		if err != nil {
+			if i == 0 {
+				// header
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid gas used %q: %w", i+1, gasField, err)
+		}
+
+		var height int64
+		if len(record) > 1 {
+			height, err = strconv.ParseInt(record[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid height %q: %w", i+1, record[0], err)
+			}
+		}
+
+		trace = append(trace, TraceBlock{Height: height, GasUsed: gasUsed})
+	}
+	return trace, nil
+}
+
+// readParams reads the JSON encoded params of the file at path.
+func readParams(path string) (types.Params, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var params types.Params
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+		return types.Params{}, err
+	}
+	return params, nil
+}
+
+// replayTrace replays trace against params, from the initial state set by
+// the flags.
+func replayTrace(fs *pflag.FlagSet, params types.Params, trace []TraceBlock) ([]types.ReplayedBlock, error) {
+	maxBlockGas, err := fs.GetUint64(flagMaxBlockGas)
+	if err != nil {
+		return nil, err
+	}
+	if maxBlockGas == 0 {
+		maxBlockGas = params.DefaultMaxBlockGas
+	}
+
+	baseGasPrice, err := decFlag(fs, flagBaseGasPrice, params.MinBaseGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	learningRate, err := decFlag(fs, flagLearningRate, params.MinLearningRate)
+	if err != nil {
+		return nil, err
+	}
+
+	blocksGas := make([]uint64, len(trace))
+	for i, block := range trace {
+		blocksGas[i] = block.GasUsed
+	}
+
+	state := types.NewState(params.Window, baseGasPrice, learningRate)
+	return types.Replay(log.NewNopLogger(), params, state, maxBlockGas, blocksGas)
+}
+
+// decFlag returns the value of the decimal flag name, or defaultValue if the
+// flag is not set.
+func decFlag(fs *pflag.FlagSet, name string, defaultValue math.LegacyDec) (math.LegacyDec, error) {
+	value, err := fs.GetString(name)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	dec, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return dec, nil
+}
+
+func writeSimulationJSON(w io.Writer, simulated []SimulatedBlock) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(simulated)
+}
+
+func writeSimulationCSV(w io.Writer, simulated []SimulatedBlock, compare bool) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"height", "gas_used", "base_gas_price", "learning_rate"}
+	if compare {
+		header = append(header, "compare_base_gas_price", "compare_learning_rate")
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, block := range simulated {
+		record := []string{
+			strconv.FormatInt(block.Height, 10),
+			strconv.FormatUint(block.GasUsed, 10),
+			block.BaseGasPrice.String(),
+			block.LearningRate.String(),
+		}
+		if compare {
+			record = append(record, block.CompareBaseGasPrice.String(), block.CompareLearningRate.String())
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
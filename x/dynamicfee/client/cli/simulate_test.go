@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestReadTrace(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		format        string
+		expectedTrace []TraceBlock
+		expectedErr   string
+	}{
+		{
+			name:    "csv with heights and header",
+			content: "height,gas_used\n10,1000\n11, 2000\n",
+			format:  formatCSV,
+			expectedTrace: []TraceBlock{
+				{Height: 10, GasUsed: 1000},
+				{Height: 11, GasUsed: 2000},
+			},
+		},
+		{
+			name:    "csv without heights",
+			content: "1000\n2000\n",
+			format:  formatCSV,
+			expectedTrace: []TraceBlock{
+				{Height: 1, GasUsed: 1000},
+				{Height: 2, GasUsed: 2000},
+			},
+		},
+		{
+			name:        "csv with invalid gas used",
+			content:     "1000\nabc\n",
+			format:      formatCSV,
+			expectedErr: `line 2: invalid gas used "abc"`,
+		},
+		{
+			name:        "csv with invalid height",
+			content:     "abc,1000\n",
+			format:      formatCSV,
+			expectedErr: `line 1: invalid height "abc"`,
+		},
+		{
+			name:    "json",
+			content: `[{"height": 5, "gas_used": 1000}, {"gas_used": 2000}]`,
+			format:  formatJSON,
+			expectedTrace: []TraceBlock{
+				{Height: 5, GasUsed: 1000},
+				{Height: 2, GasUsed: 2000},
+			},
+		},
+		{
+			name:        "invalid json",
+			content:     "1000",
+			format:      formatJSON,
+			expectedErr: "cannot unmarshal",
+		},
+		{
+			name:        "unknown format",
+			content:     "1000",
+			format:      "xml",
+			expectedErr: `invalid trace format "xml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := testutil.WriteToNewTempFile(t, tt.content)
+
+			trace, err := ReadTrace(f.Name(), tt.format)
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedTrace, trace)
+		})
+	}
+}
+
+func TestGetSimulateCmd(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	params := types.DefaultParams()
+	paramsFile := testutil.WriteToNewTempFile(t, string(cdc.MustMarshalJSON(&params)))
+	compareParams := types.DefaultAIMDParams()
+	compareParamsFile := testutil.WriteToNewTempFile(t, string(cdc.MustMarshalJSON(&compareParams)))
+	traceFile := testutil.WriteToNewTempFile(t, "gas_used\n0\n30000000\n30000000\n")
+
+	blocksGas := []uint64{0, 30000000, 30000000}
+	state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	expected, err := types.Replay(log.NewNopLogger(), params, state, params.DefaultMaxBlockGas, blocksGas)
+	require.NoError(t, err)
+	compareState := types.NewState(compareParams.Window, compareParams.MinBaseGasPrice, compareParams.MinLearningRate)
+	expectedCompare, err := types.Replay(log.NewNopLogger(), compareParams, compareState, compareParams.DefaultMaxBlockGas, blocksGas)
+	require.NoError(t, err)
+
+	t.Run("csv output", func(t *testing.T) {
+		cmd := GetSimulateCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{traceFile.Name(), paramsFile.Name()})
+
+		require.NoError(t, cmd.Execute())
+
+		records, err := csv.NewReader(out).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, []string{"height", "gas_used", "base_gas_price", "learning_rate"}, records[0])
+		require.Len(t, records, 4)
+		for i, record := range records[1:] {
+			require.Equal(t, expected[i].BaseGasPrice.String(), record[2])
+			require.Equal(t, expected[i].LearningRate.String(), record[3])
+		}
+	})
+
+	t.Run("json output with compare", func(t *testing.T) {
+		cmd := GetSimulateCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{
+			traceFile.Name(), paramsFile.Name(),
+			"--" + flagCompare, compareParamsFile.Name(),
+			"--" + flagFormat, formatJSON,
+		})
+
+		require.NoError(t, cmd.Execute())
+
+		var simulated []SimulatedBlock
+		require.NoError(t, json.Unmarshal(out.Bytes(), &simulated))
+		require.Len(t, simulated, 3)
+		for i, block := range simulated {
+			require.Equal(t, int64(i+1), block.Height)
+			require.Equal(t, blocksGas[i], block.GasUsed)
+			require.True(t, expected[i].BaseGasPrice.Equal(block.BaseGasPrice))
+			require.True(t, expected[i].LearningRate.Equal(block.LearningRate))
+			require.True(t, expectedCompare[i].BaseGasPrice.Equal(*block.CompareBaseGasPrice))
+			require.True(t, expectedCompare[i].LearningRate.Equal(*block.CompareLearningRate))
+		}
+	})
+
+	t.Run("initial state flags", func(t *testing.T) {
+		cmd := GetSimulateCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{
+			traceFile.Name(), paramsFile.Name(),
+			"--" + flagBaseGasPrice, "1",
+			"--" + flagFormat, formatJSON,
+		})
+
+		require.NoError(t, cmd.Execute())
+
+		var simulated []SimulatedBlock
+		require.NoError(t, json.Unmarshal(out.Bytes(), &simulated))
+		require.Equal(t, "1.000000000000000000", simulated[0].BaseGasPrice.String())
+	})
+
+	t.Run("invalid output format", func(t *testing.T) {
+		cmd := GetSimulateCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{traceFile.Name(), paramsFile.Name(), "--" + flagFormat, "xml"})
+
+		require.ErrorContains(t, cmd.Execute(), `invalid output format "xml"`)
+	})
+}
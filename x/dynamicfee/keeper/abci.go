@@ -8,7 +8,7 @@ import (
 
 // EndBlock returns an endblocker for the x/feemarket module. The endblocker
 // is responsible for updating the state of the fee market based on the
-// AIMD learning rate adjustment algorithm.
+// pricing model selected by the params.
 func (k *Keeper) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return k.UpdateDynamicfee(sdkCtx)
@@ -11,7 +11,7 @@ import (
 )
 
 // UpdateDynamicfee updates the base fee and learning rate based on the
-// AIMD learning rate adjustment algorithm. Note that if the dynamic fee
+// pricing model selected by the Model param. Note that if the dynamic fee
 // pricing is disabled, this function will return without updating the
 // dynamic fee pricing. This is executed in EndBlock which allows the next
 // block's base fee to be readily available for wallets to estimate gas prices.
@@ -40,16 +40,25 @@ func (k *Keeper) UpdateDynamicfee(ctx context.Context) error {
 		return err
 	}
 
-	// Update the learning rate based on the block gas seen in the
-	// current block. This is the AIMD learning rate adjustment algorithm.
-	newLR := state.UpdateLearningRate(params, maxBlockGas)
+	// Record the fee history of the current block before the state is
+	// updated for the next block.
+	if err := k.RecordFeeHistory(ctx, params, state, maxBlockGas); err != nil {
+		return err
+	}
+
+	model, err := types.GetPricingModel(params.Model)
+	if err != nil {
+		return err
+	}
 
-	// Update the base gas price based with the new learning rate.
-	newBaseGasPrice := state.UpdateBaseGasPrice(logger, params, maxBlockGas)
+	// Update the learning rate and the base gas price based on the block gas
+	// seen in the current block, according to the pricing model.
+	newBaseGasPrice, newLR := model.UpdateBaseGasPrice(logger, &state, params, maxBlockGas)
 
 	logger.Info(
 		"updated the dynamic fee pricing",
 		"height", sdkCtx.BlockHeight(),
+		"model", params.Model,
 		"new_base_gas_price", newBaseGasPrice,
 		"new_learning_rate", newLR,
 		"average_block_gas", state.GetAverageGas(maxBlockGas),
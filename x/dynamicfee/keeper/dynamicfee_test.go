@@ -447,6 +447,62 @@ func TestUpdateDynamicfee(t *testing.T) {
 		require.NoError(err)
 		require.Equal(state.BaseGasPrice, fee)
 	})
+
+	t.Run("empty blocks with eip1559 model uses a fixed learning rate", func(t *testing.T) {
+		require := require.New(t)
+		k, ctx := testutil.SetupKeeper(t, 0)
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = state.BaseGasPrice.Mul(math.LegacyNewDec(4))
+		params := types.DefaultAIMDParams()
+		params.Model = types.PricingModelEIP1559
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+
+		require.NoError(k.UpdateDynamicfee(ctx))
+
+		// We expect the learning rate to be the max learning rate, and the
+		// base fee to decrease by the max learning rate.
+		lr, err := k.GetLearningRate(ctx)
+		require.NoError(err)
+		require.Equal(params.MaxLearningRate, lr)
+
+		fee, err := k.GetBaseGasPrice(ctx)
+		require.NoError(err)
+		expectedFee := state.BaseGasPrice.Mul(math.LegacyOneDec().Sub(params.MaxLearningRate))
+		require.Equal(expectedFee, fee)
+	})
+
+	t.Run("full block with pid model", func(t *testing.T) {
+		require := require.New(t)
+		maxBlockGas := uint64(100)
+		k, ctx := testutil.SetupKeeper(t, maxBlockGas)
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.Model = types.PricingModelPID
+
+		err := state.Update(maxBlockGas, maxBlockGas)
+		require.NoError(err)
+
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+
+		require.NoError(k.UpdateDynamicfee(ctx))
+
+		// The error is (100 - 50) / 50 = 1, so is the integral and the
+		// derivative. We expect the base fee to increase by kp + ki + kd.
+		fee, err := k.GetBaseGasPrice(ctx)
+		require.NoError(err)
+		factor := math.LegacyOneDec().Add(params.PID.Kp).Add(params.PID.Ki).Add(params.PID.Kd)
+		require.Equal(state.BaseGasPrice.Mul(factor), fee)
+
+		// We expect the learning rate to remain the same.
+		lr, err := k.GetLearningRate(ctx)
+		require.NoError(err)
+		require.Equal(state.LearningRate, lr)
+
+		newState, err := k.GetState(ctx)
+		require.NoError(err)
+		require.Equal(math.LegacyOneDec(), newState.PID.Integral)
+		require.Equal(math.LegacyOneDec(), newState.PID.PrevError)
+	})
 }
 
 func TestGetBaseGasPrice(t *testing.T) {
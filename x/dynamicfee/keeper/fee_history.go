@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+// AddTxGasPrice records the effective gas price paid by the current tx, so
+// that it is accounted for in the fee history rewards of the current block.
+func (k *Keeper) AddTxGasPrice(ctx context.Context, gasPrice math.LegacyDec, gasUsed uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(&types.TxGasPrice{GasPrice: gasPrice, GasUsed: gasUsed})
+	if err != nil {
+		return err
+	}
+
+	txHash := sha256.Sum256(sdkCtx.TxBytes())
+	store.Set(types.TxGasPriceKey(txHash[:]), bz)
+
+	return nil
+}
+
+// GetTxGasPrices returns the effective gas prices paid by the txs of the
+// current block.
+func (k *Keeper) GetTxGasPrices(ctx context.Context) ([]types.TxGasPrice, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTxGasPrices)
+	defer iterator.Close()
+
+	var txGasPrices []types.TxGasPrice
+	for ; iterator.Valid(); iterator.Next() {
+		var txGasPrice types.TxGasPrice
+		if err := k.cdc.Unmarshal(iterator.Value(), &txGasPrice); err != nil {
+			return nil, err
+		}
+		txGasPrices = append(txGasPrices, txGasPrice)
+	}
+
+	return txGasPrices, nil
+}
+
+// clearTxGasPrices removes the effective gas prices of the current block.
+func (k *Keeper) clearTxGasPrices(ctx context.Context) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTxGasPrices)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetFeeHistoryEntry stores the given fee history entry.
+func (k *Keeper) SetFeeHistoryEntry(ctx context.Context, entry types.FeeHistoryEntry) error {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.FeeHistoryKey(entry.Height), bz)
+
+	return nil
+}
+
+// GetFeeHistory returns the fee history entries of the last blocks, ordered
+// by ascending height. If blocks is zero, all the entries are returned.
+func (k *Keeper) GetFeeHistory(ctx context.Context, blocks uint64) ([]types.FeeHistoryEntry, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyPrefixFeeHistory)
+	defer iterator.Close()
+
+	var entries []types.FeeHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		if blocks != 0 && uint64(len(entries)) == blocks {
+			break
+		}
+
+		var entry types.FeeHistoryEntry
+		if err := k.cdc.Unmarshal(iterator.Value(), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	// entries were collected from the most recent, reverse them.
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+
+	return entries, nil
+}
+
+// PruneFeeHistory removes the fee history entries that are older than size
+// blocks at the given height.
+func (k *Keeper) PruneFeeHistory(ctx context.Context, height int64, size uint64) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFeeHistory)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		entryHeight := int64(binary.BigEndian.Uint64(iterator.Key()[len(types.KeyPrefixFeeHistory):]))
+		if uint64(height-entryHeight) < size {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordFeeHistory records the fee history entry of the current block from
+// the given state, before it is updated for the next block, and prunes the
+// entries that fall out of the fee history.
+func (k *Keeper) RecordFeeHistory(ctx context.Context, params types.Params, state types.State, maxBlockGas uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	txGasPrices, err := k.GetTxGasPrices(ctx)
+	if err != nil {
+		return err
+	}
+	k.clearTxGasPrices(ctx)
+
+	if params.FeeHistorySize > 0 {
+		gasUsed := state.Window[state.Index]
+		entry := types.FeeHistoryEntry{
+			Height:       sdkCtx.BlockHeight(),
+			BaseGasPrice: state.BaseGasPrice,
+			LearningRate: state.LearningRate,
+			GasUsed:      gasUsed,
+			Utilization: math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).
+				QuoInt(math.NewIntFromUint64(maxBlockGas)),
+			Rewards: types.ComputeRewards(txGasPrices),
+		}
+		if err := k.SetFeeHistoryEntry(ctx, entry); err != nil {
+			return err
+		}
+	}
+
+	k.PruneFeeHistory(ctx, sdkCtx.BlockHeight(), params.FeeHistorySize)
+
+	return nil
+}
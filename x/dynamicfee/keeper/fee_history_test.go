@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestRecordFeeHistory(t *testing.T) {
+	t.Run("records the block fee data and clears the tx gas prices", func(t *testing.T) {
+		require := require.New(t)
+		k, ctx := testutil.SetupKeeper(t, 0)
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+		ctx = ctx.WithBlockHeight(10)
+
+		require.NoError(k.AddTxGasPrice(ctx.WithTxBytes([]byte("tx1")), math.LegacyMustNewDecFromStr("0.01"), 1000))
+		require.NoError(k.AddTxGasPrice(ctx.WithTxBytes([]byte("tx2")), math.LegacyMustNewDecFromStr("0.05"), 3000))
+		state.Window[state.Index] = 4000
+
+		require.NoError(k.RecordFeeHistory(ctx, params, state, testutil.MaxBlockGas))
+
+		entries, err := k.GetFeeHistory(ctx, 0)
+		require.NoError(err)
+		require.Len(entries, 1)
+		require.Equal(int64(10), entries[0].Height)
+		require.Equal(state.BaseGasPrice, entries[0].BaseGasPrice)
+		require.Equal(state.LearningRate, entries[0].LearningRate)
+		require.Equal(uint64(4000), entries[0].GasUsed)
+		require.Equal(math.LegacyNewDec(4000).QuoInt64(testutil.MaxBlockGas), entries[0].Utilization)
+		require.Equal([]math.LegacyDec{
+			math.LegacyMustNewDecFromStr("0.01"),
+			math.LegacyMustNewDecFromStr("0.01"),
+			math.LegacyMustNewDecFromStr("0.05"),
+			math.LegacyMustNewDecFromStr("0.05"),
+			math.LegacyMustNewDecFromStr("0.05"),
+		}, entries[0].Rewards)
+
+		txGasPrices, err := k.GetTxGasPrices(ctx)
+		require.NoError(err)
+		require.Empty(txGasPrices)
+	})
+
+	t.Run("prunes the entries out of the fee history size", func(t *testing.T) {
+		require := require.New(t)
+		k, ctx := testutil.SetupKeeper(t, 0)
+		params := types.DefaultParams()
+		params.FeeHistorySize = 3
+		state := types.DefaultState()
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+
+		for height := int64(1); height <= 5; height++ {
+			require.NoError(k.RecordFeeHistory(ctx.WithBlockHeight(height), params, state, testutil.MaxBlockGas))
+		}
+
+		entries, err := k.GetFeeHistory(ctx, 0)
+		require.NoError(err)
+		require.Len(entries, 3)
+		for i, entry := range entries {
+			require.Equal(int64(i+3), entry.Height)
+		}
+
+		entries, err = k.GetFeeHistory(ctx, 2)
+		require.NoError(err)
+		require.Len(entries, 2)
+		require.Equal(int64(4), entries[0].Height)
+		require.Equal(int64(5), entries[1].Height)
+	})
+
+	t.Run("disabled fee history prunes all the entries", func(t *testing.T) {
+		require := require.New(t)
+		k, ctx := testutil.SetupKeeper(t, 0)
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+		require.NoError(k.RecordFeeHistory(ctx.WithBlockHeight(1), params, state, testutil.MaxBlockGas))
+
+		params.FeeHistorySize = 0
+		require.NoError(k.AddTxGasPrice(ctx, math.LegacyMustNewDecFromStr("0.01"), 1000))
+		require.NoError(k.RecordFeeHistory(ctx.WithBlockHeight(2), params, state, testutil.MaxBlockGas))
+
+		entries, err := k.GetFeeHistory(ctx, 0)
+		require.NoError(err)
+		require.Empty(entries)
+
+		txGasPrices, err := k.GetTxGasPrices(ctx)
+		require.NoError(err)
+		require.Empty(txGasPrices)
+	})
+}
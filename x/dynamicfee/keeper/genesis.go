@@ -16,8 +16,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic("genesis state and parameters do not match for window")
 	}
 
-	// Initialize the dynamic fee pricing state and parameters.
-	if err := k.SetParams(ctx, gs.Params); err != nil {
+	// Initialize the dynamic fee pricing state and parameters. The fee denom
+	// rates without update time are considered updated at genesis.
+	params := gs.Params
+	params.StampFeeDenomRates(gs.Params, ctx.BlockTime())
+	if err := k.SetParams(ctx, params); err != nil {
 		panic(err)
 	}
 
@@ -25,6 +28,16 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.SetBurnedFees(ctx, gs.BurnedFees); err != nil {
+		panic(err)
+	}
+
+	for _, entry := range gs.FeeHistory {
+		if err := k.SetFeeHistoryEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
 	// always init enabled height to -1 until it is explicitly set later in the application
 	k.SetEnabledHeight(ctx, -1)
 }
@@ -43,5 +56,20 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, state)
+	// Get the cumulative burned fees.
+	burnedFees, err := k.GetBurnedFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Get the whole fee history.
+	feeHistory, err := k.GetFeeHistory(ctx, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := types.NewGenesisState(params, state)
+	gs.BurnedFees = burnedFees
+	gs.FeeHistory = feeHistory
+	return gs
 }
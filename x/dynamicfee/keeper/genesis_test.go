@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 	"github.com/stretchr/testify/require"
@@ -65,4 +68,34 @@ func TestExportGenesis(t *testing.T) {
 
 		require.Equal(t, gs, exportedGenesis)
 	})
+
+	t.Run("export genesis should include burned fees", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.BurnedFees = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000))
+		k.InitGenesis(ctx, *gs)
+
+		exportedGenesis := k.ExportGenesis(ctx)
+		require.Equal(t, gs, exportedGenesis)
+	})
+
+	t.Run("export genesis should include fee history", func(t *testing.T) {
+		k, ctx := testutil.SetupKeeper(t, 0)
+		gs := types.DefaultGenesisState()
+		for height := int64(1); height <= 2; height++ {
+			gs.FeeHistory = append(gs.FeeHistory, types.FeeHistoryEntry{
+				Height:       height,
+				BaseGasPrice: gs.State.BaseGasPrice,
+				LearningRate: gs.State.LearningRate,
+				GasUsed:      1000,
+				Utilization:  math.LegacyMustNewDecFromStr("0.5"),
+				Rewards: types.ComputeRewards([]types.TxGasPrice{
+					{GasPrice: gs.State.BaseGasPrice, GasUsed: 1000},
+				}),
+			})
+		}
+		k.InitGenesis(ctx, *gs)
+
+		exportedGenesis := k.ExportGenesis(ctx)
+		require.Equal(t, gs, exportedGenesis)
+	})
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,9 +17,10 @@ import (
 
 // Keeper is the x/dynamicfee keeper.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
-	resolver types.DenomResolver
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	resolver      types.DenomResolver
+	accountKeeper types.AccountKeeper
 
 	// The address that is capable of executing a MsgParams message.
 	// Typically, this will be the governance module's address.
@@ -30,6 +32,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	resolver types.DenomResolver,
+	ak types.AccountKeeper,
 	authority string,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -37,10 +40,11 @@ func NewKeeper(
 	}
 
 	k := &Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		resolver:  resolver,
-		authority: authority,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		resolver:      resolver,
+		accountKeeper: ak,
+		authority:     authority,
 	}
 
 	return k
@@ -153,3 +157,54 @@ func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
 
 	return nil
 }
+
+// GetBurnedFees returns the cumulative amount of base fees burned by the
+// dynamicfee module, per denom.
+func (k *Keeper) GetBurnedFees(ctx context.Context) (sdk.Coins, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBurnedFees)
+	defer iterator.Close()
+
+	var burned sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.KeyPrefixBurnedFees):])
+
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		burned = burned.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return burned, nil
+}
+
+// SetBurnedFees sets the cumulative amount of base fees burned by the
+// dynamicfee module, per denom.
+func (k *Keeper) SetBurnedFees(ctx context.Context, burned sdk.Coins) error {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+
+	for _, coin := range burned {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.BurnedFeesKey(coin.Denom), bz)
+	}
+
+	return nil
+}
+
+// AddBurnedFees adds the given coins to the cumulative amount of base fees
+// burned by the dynamicfee module.
+func (k *Keeper) AddBurnedFees(ctx context.Context, coins sdk.Coins) error {
+	burned, err := k.GetBurnedFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SetBurnedFees(ctx, burned.Add(coins...))
+}
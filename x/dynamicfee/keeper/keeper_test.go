@@ -7,6 +7,8 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -64,6 +66,8 @@ func TestParams(t *testing.T) {
 			MaxLearningRate:        math.LegacyMustNewDecFromStr("0.1"),
 			Window:                 1,
 			Enabled:                true,
+			PID:                    types.DefaultPIDParams(),
+			Lanes:                  types.DefaultLaneParams(),
 		}
 
 		err := k.SetParams(ctx, params)
@@ -87,6 +91,26 @@ func TestEnabledHeight(t *testing.T) {
 	})
 }
 
+func TestBurnedFees(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, 0)
+	t.Run("empty by default", func(t *testing.T) {
+		got, err := k.GetBurnedFees(ctx)
+		require.NoError(t, err)
+		require.True(t, got.IsZero())
+	})
+
+	t.Run("accumulates burned fees per denom", func(t *testing.T) {
+		err := k.AddBurnedFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("uphoton", 10)))
+		require.NoError(t, err)
+		err = k.AddBurnedFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("uphoton", 5), sdk.NewInt64Coin("uatone", 3)))
+		require.NoError(t, err)
+
+		got, err := k.GetBurnedFees(ctx)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uphoton", 15), sdk.NewInt64Coin("uatone", 3)), got)
+	})
+}
+
 func TestGetMaxBlockGas(t *testing.T) {
 	k, ctx := testutil.SetupKeeper(t, 0)
 	t.Run("get max block gas when 0", func(t *testing.T) {
@@ -30,6 +30,12 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, fmt.Errorf("invalid authority to execute message")
 	}
 
+	// the base fee would otherwise be silently left in the fee collector.
+	if msg.Params.BaseFeeDestination == types.BaseFeeDestinationModuleAccount &&
+		ms.k.accountKeeper.GetModuleAddress(msg.Params.BaseFeeRecipient) == nil {
+		return nil, types.ErrUnknownModule.Wrapf("base fee recipient %s", msg.Params.BaseFeeRecipient)
+	}
+
 	gotParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting params: %w", err)
@@ -41,11 +47,25 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	}
 
 	params := msg.Params
+	params.StampFeeDenomRates(gotParams, ctx.BlockTime())
+
+	// the state is reset, including the PID controller state, so a new
+	// pricing model starts from a fresh state which must be valid for it.
+	newState := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	if params.Model != gotParams.Model {
+		model, err := types.GetPricingModel(params.Model)
+		if err != nil {
+			return nil, err
+		}
+		if err := model.ValidateState(params, newState); err != nil {
+			return nil, fmt.Errorf("invalid state for pricing model %s: %w", params.Model, err)
+		}
+	}
+
 	if err := ms.k.SetParams(ctx, params); err != nil {
 		return nil, fmt.Errorf("error setting params: %w", err)
 	}
 
-	newState := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
 	if err := ms.k.SetState(ctx, newState); err != nil {
 		return nil, fmt.Errorf("error setting state: %w", err)
 	}
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
@@ -45,6 +48,63 @@ func TestMsgUpdateParams(t *testing.T) {
 		require.Equal(req.Params, params)
 	})
 
+	t.Run("sets the update time of the new and changed fee denom rates", func(t *testing.T) {
+		require := require.New(t)
+		msgServer, k, ctx := testutil.SetupMsgServer(t, 0)
+		params := types.DefaultParams()
+		params.FeeDenomRates = []types.FeeDenomRate{
+			types.NewFeeDenomRate("ibc/ATOM", math.LegacyNewDec(5)),
+			types.NewFeeDenomRate("ibc/OSMO", math.LegacyNewDec(2)),
+		}
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(err)
+		firstUpdate := ctx.BlockTime()
+
+		ctx = ctx.WithBlockTime(firstUpdate.Add(time.Hour))
+		params.FeeDenomRates = []types.FeeDenomRate{
+			types.NewFeeDenomRate("ibc/ATOM", math.LegacyNewDec(5)),
+			types.NewFeeDenomRate("ibc/OSMO", math.LegacyNewDec(3)),
+		}
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(err)
+
+		got, err := k.GetParams(ctx)
+		require.NoError(err)
+		require.Len(got.FeeDenomRates, 2)
+		require.True(firstUpdate.Equal(got.FeeDenomRates[0].UpdatedAt), "unchanged rate keeps its update time")
+		require.True(ctx.BlockTime().Equal(got.FeeDenomRates[1].UpdatedAt), "changed rate is updated")
+	})
+
+	t.Run("accepts a req with a known base fee recipient", func(t *testing.T) {
+		require := require.New(t)
+		msgServer, k, ctx := testutil.SetupMsgServer(t, 0)
+		params := types.DefaultParams()
+		params.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+		params.BaseFeeRecipient = "treasury"
+
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+
+		require.NoError(err)
+		got, err := k.GetParams(ctx)
+		require.NoError(err)
+		require.Equal("treasury", got.BaseFeeRecipient)
+	})
+
+	t.Run("rejects a req with an unknown base fee recipient", func(t *testing.T) {
+		require := require.New(t)
+		msgServer, k, ctx := testutil.SetupMsgServer(t, 0)
+		params := types.DefaultParams()
+		params.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+		params.BaseFeeRecipient = "unknown"
+
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+
+		require.ErrorIs(err, types.ErrUnknownModule)
+		got, err := k.GetParams(ctx)
+		require.NoError(err)
+		require.Empty(got.BaseFeeRecipient)
+	})
+
 	t.Run("rejects a req with invalid signer", func(t *testing.T) {
 		require := require.New(t)
 		msgServer, _, ctx := testutil.SetupMsgServer(t, 0)
@@ -97,6 +157,27 @@ func TestMsgUpdateParams(t *testing.T) {
 		require.Equal(ctx.BlockHeight(), newHeight)
 	})
 
+	t.Run("switching to the pid model starts from a neutral controller", func(t *testing.T) {
+		require := require.New(t)
+		msgServer, k, ctx := testutil.SetupMsgServer(t, 0)
+		params := types.DefaultParams()
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(err)
+		state, err := k.GetState(ctx)
+		require.NoError(err)
+		state.PID = types.PIDState{Integral: math.LegacyOneDec(), PrevError: math.LegacyOneDec()}
+		require.NoError(k.SetState(ctx, state))
+
+		params.Model = types.PricingModelPID
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+
+		require.NoError(err)
+		state, err = k.GetState(ctx)
+		require.NoError(err)
+		require.Equal(types.NewPIDState(), state.PID)
+		require.NoError(types.PIDPricingModel{}.ValidateState(params, state))
+	})
+
 	t.Run("resets state after new params request", func(t *testing.T) {
 		require := require.New(t)
 		msgServer, k, ctx := testutil.SetupMsgServer(t, 0)
@@ -51,3 +51,28 @@ func (q QueryServer) GasPrices(goCtx context.Context, _ *types.GasPricesRequest)
 	gasPrices, err := q.k.GetMinGasPrices(ctx)
 	return &types.GasPricesResponse{Prices: gasPrices}, err
 }
+
+// BurnedFees defines a method that returns the cumulative amount of burned fees.
+func (q QueryServer) BurnedFees(goCtx context.Context, _ *types.BurnedFeesRequest) (*types.BurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burned, err := q.k.GetBurnedFees(ctx)
+	return &types.BurnedFeesResponse{Burned: burned}, err
+}
+
+// FeeHistory defines a method that returns the fee history of the last blocks.
+func (q QueryServer) FeeHistory(goCtx context.Context, req *types.FeeHistoryRequest) (*types.FeeHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entries, err := q.k.GetFeeHistory(ctx, req.GetBlocks())
+	if err != nil {
+		return nil, err
+	}
+
+	baseGasPrice, err := q.k.GetBaseGasPrice(ctx)
+	return &types.FeeHistoryResponse{
+		Entries:           entries,
+		RewardPercentiles: types.FeeHistoryRewardPercentiles,
+		NextBaseGasPrice:  baseGasPrice,
+	}, err
+}
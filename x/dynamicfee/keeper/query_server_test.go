@@ -7,6 +7,8 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
@@ -43,6 +45,8 @@ func TestParamsRequest(t *testing.T) {
 			MaxLearningRate:        math.LegacyMustNewDecFromStr("0.1"),
 			Window:                 1,
 			Enabled:                true,
+			PID:                    types.DefaultPIDParams(),
+			Lanes:                  types.DefaultLaneParams(),
 		}
 		err := k.SetParams(ctx, params)
 		require.NoError(err)
@@ -88,6 +92,7 @@ func TestStateRequest(t *testing.T) {
 			LearningRate: math.LegacyOneDec(),
 			Window:       []uint64{1},
 			Index:        0,
+			PID:          types.NewPIDState(),
 		}
 		err := k.SetState(ctx, state)
 		require.NoError(err)
@@ -183,3 +188,52 @@ func TestGasPriceRequest(t *testing.T) {
 		require.Equal(resp.GetPrice(), fee)
 	})
 }
+
+func TestBurnedFeesRequest(t *testing.T) {
+	t.Run("can get burned fees", func(t *testing.T) {
+		require := require.New(t)
+		queryServer, k, ctx := testutil.SetupQueryServer(t, 0)
+
+		resp, err := queryServer.BurnedFees(ctx, &types.BurnedFeesRequest{})
+		require.NoError(err)
+		require.True(resp.Burned.IsZero())
+
+		burned := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 42))
+		err = k.AddBurnedFees(ctx, burned)
+		require.NoError(err)
+
+		resp, err = queryServer.BurnedFees(ctx, &types.BurnedFeesRequest{})
+		require.NoError(err)
+		require.Equal(burned, resp.Burned)
+	})
+}
+
+func TestFeeHistoryRequest(t *testing.T) {
+	t.Run("can get the fee history", func(t *testing.T) {
+		require := require.New(t)
+		queryServer, k, ctx := testutil.SetupQueryServer(t, 0)
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		k.InitGenesis(ctx, types.GenesisState{Params: params, State: state})
+
+		resp, err := queryServer.FeeHistory(ctx, &types.FeeHistoryRequest{})
+		require.NoError(err)
+		require.Empty(resp.Entries)
+		require.Equal(types.FeeHistoryRewardPercentiles, resp.RewardPercentiles)
+		require.Equal(state.BaseGasPrice, resp.NextBaseGasPrice)
+
+		for height := int64(1); height <= 3; height++ {
+			err := k.RecordFeeHistory(ctx.WithBlockHeight(height), params, state, testutil.MaxBlockGas)
+			require.NoError(err)
+		}
+
+		resp, err = queryServer.FeeHistory(ctx, &types.FeeHistoryRequest{})
+		require.NoError(err)
+		require.Len(resp.Entries, 3)
+
+		resp, err = queryServer.FeeHistory(ctx, &types.FeeHistoryRequest{Blocks: 1})
+		require.NoError(err)
+		require.Len(resp.Entries, 1)
+		require.Equal(int64(3), resp.Entries[0].Height)
+	})
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+var _ types.DenomResolver = FeeDenomResolver{}
+
+// FeeDenomResolver is the DenomResolver of the fee denoms whitelisted by the
+// FeeDenomRates param. It converts the fee denom using the rates set by
+// governance, so that no price oracle is needed.
+type FeeDenomResolver struct {
+	k *Keeper
+}
+
+// NewFeeDenomResolver returns the DenomResolver of the fee denoms whitelisted
+// in the params of k.
+func NewFeeDenomResolver(k *Keeper) FeeDenomResolver {
+	return FeeDenomResolver{k: k}
+}
+
+// ConvertToDenom converts coin, which must be denominated in the fee denom,
+// to denom using the rate of the latter. Returns an error if denom is not
+// whitelisted or if its rate is stale.
+func (r FeeDenomResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	params, err := r.k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	if coin.Denom != params.FeeDenom {
+		return sdk.DecCoin{}, types.ErrUnknownFeeDenom.Wrapf("cannot convert from denom %s", coin.Denom)
+	}
+
+	rate, ok := params.GetFeeDenomRate(denom)
+	if !ok {
+		return sdk.DecCoin{}, types.ErrUnknownFeeDenom.Wrapf("denom %s", denom)
+	}
+	if rate.IsStale(sdk.UnwrapSDKContext(ctx).BlockTime(), params.FeeDenomRateMaxAge) {
+		return sdk.DecCoin{}, types.ErrStaleFeeDenom.Wrapf("denom %s last updated at %s", denom, rate.UpdatedAt)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Quo(rate.Rate)), nil
+}
+
+// ExtraDenoms returns the whitelisted fee denoms whose rate is not stale.
+func (r FeeDenomResolver) ExtraDenoms(ctx context.Context) ([]string, error) {
+	params, err := r.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	denoms := []string{}
+	for _, rate := range params.FeeDenomRates {
+		if !rate.IsStale(now, params.FeeDenomRateMaxAge) {
+			denoms = append(denoms, rate.Denom)
+		}
+	}
+	return denoms, nil
+}
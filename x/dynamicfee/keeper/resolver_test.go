@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/keeper"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestFeeDenomResolver(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, 0)
+	params := types.DefaultParams()
+	params.FeeDenomRateMaxAge = time.Hour
+	params.FeeDenomRates = []types.FeeDenomRate{
+		{Denom: "ibc/ATOM", Rate: math.LegacyNewDec(5), UpdatedAt: ctx.BlockTime()},
+		{Denom: "ibc/OSMO", Rate: math.LegacyNewDec(2), UpdatedAt: ctx.BlockTime().Add(-2 * time.Hour)},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	r := keeper.NewFeeDenomResolver(k)
+	price := sdk.NewDecCoinFromDec(types.DefaultFeeDenom, math.LegacyMustNewDecFromStr("0.01"))
+
+	t.Run("converts with the rate", func(t *testing.T) {
+		coin, err := r.ConvertToDenom(ctx, price, "ibc/ATOM")
+
+		require.NoError(t, err)
+		require.Equal(t, "0.002000000000000000ibc/ATOM", coin.String())
+	})
+
+	t.Run("same denom", func(t *testing.T) {
+		coin, err := r.ConvertToDenom(ctx, price, types.DefaultFeeDenom)
+
+		require.NoError(t, err)
+		require.Equal(t, price, coin)
+	})
+
+	t.Run("denom not whitelisted", func(t *testing.T) {
+		_, err := r.ConvertToDenom(ctx, price, "ulight")
+
+		require.ErrorIs(t, err, types.ErrUnknownFeeDenom)
+	})
+
+	t.Run("coin not in the fee denom", func(t *testing.T) {
+		_, err := r.ConvertToDenom(ctx, sdk.NewInt64DecCoin("ulight", 1), "ibc/ATOM")
+
+		require.ErrorIs(t, err, types.ErrUnknownFeeDenom)
+	})
+
+	t.Run("stale rate", func(t *testing.T) {
+		_, err := r.ConvertToDenom(ctx, price, "ibc/OSMO")
+
+		require.ErrorIs(t, err, types.ErrStaleFeeDenom)
+	})
+
+	t.Run("extra denoms exclude the stale rates", func(t *testing.T) {
+		denoms, err := r.ExtraDenoms(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"ibc/ATOM"}, denoms)
+	})
+
+	t.Run("zero max age disables the staleness check", func(t *testing.T) {
+		params.FeeDenomRateMaxAge = 0
+		require.NoError(t, k.SetParams(ctx, params))
+
+		denoms, err := r.ExtraDenoms(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"ibc/ATOM", "ibc/OSMO"}, denoms)
+	})
+}
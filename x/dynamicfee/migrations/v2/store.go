@@ -0,0 +1,46 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+var (
+	ParamsKey = []byte{0x01}
+	StateKey  = []byte{0x02}
+)
+
+// Addition of the pricing models. The existing params keep the AIMD pricing
+// model, the PID params are set to their default values and the PID state is
+// initialized with no accumulated error.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(ParamsKey), &params)
+
+	params.Model = types.PricingModelAIMD
+	params.PID = types.DefaultPIDParams()
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(ParamsKey, bz)
+
+	var state types.State
+	cdc.MustUnmarshal(store.Get(StateKey), &state)
+
+	state.PID = types.NewPIDState()
+
+	bz, err = cdc.Marshal(&state)
+	if err != nil {
+		return err
+	}
+	store.Set(StateKey, bz)
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v2 "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/migrations/v2"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	// Store params and state without pricing model fields.
+	params := types.DefaultAIMDParams()
+	params.Model = types.PricingModelAIMD
+	params.PID = types.PIDParams{}
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&params))
+	state := types.DefaultAIMDState()
+	state.PID = types.PIDState{}
+	store.Set(v2.StateKey, cdc.MustMarshal(&state))
+
+	// Run migrations.
+	err := v2.MigrateStore(ctx, key, cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var migratedParams types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v2.ParamsKey), &migratedParams))
+	require.Equal(t, types.PricingModelAIMD, migratedParams.Model)
+	require.Equal(t, types.DefaultPIDParams(), migratedParams.PID)
+	require.NoError(t, migratedParams.ValidateBasic())
+
+	// Check state
+	var migratedState types.State
+	require.NoError(t, cdc.Unmarshal(store.Get(v2.StateKey), &migratedState))
+	require.Equal(t, state.BaseGasPrice, migratedState.BaseGasPrice)
+	require.Equal(t, state.LearningRate, migratedState.LearningRate)
+	require.Equal(t, state.Window, migratedState.Window)
+	require.Equal(t, types.NewPIDState(), migratedState.PID)
+	require.NoError(t, types.NewGenesisState(migratedParams, migratedState).ValidateBasic())
+}
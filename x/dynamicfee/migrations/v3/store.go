@@ -0,0 +1,30 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+var ParamsKey = []byte{0x01}
+
+// Addition of the mempool lanes. The lane params are set to their default
+// values.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(ParamsKey), &params)
+
+	params.Lanes = types.DefaultLaneParams()
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,38 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v3 "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/migrations/v3"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	// Store params without lane fields.
+	params := types.DefaultAIMDParams()
+	params.Lanes = types.LaneParams{}
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&params))
+
+	// Run migrations.
+	err := v3.MigrateStore(ctx, key, cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var migratedParams types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v3.ParamsKey), &migratedParams))
+	require.Equal(t, types.DefaultLaneParams(), migratedParams.Lanes)
+	require.Equal(t, params.Model, migratedParams.Model)
+	require.Equal(t, params.MinBaseGasPrice, migratedParams.MinBaseGasPrice)
+	require.NoError(t, migratedParams.ValidateBasic())
+}
@@ -3,6 +3,7 @@ package dynamicfee
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -26,7 +27,7 @@ import (
 )
 
 // ConsensusVersion is the x/dynamicfee module's consensus version identifier.
-const ConsensusVersion = 1
+const ConsensusVersion = 3
 
 var (
 	_ module.AppModuleBasic = AppModule{}
@@ -112,6 +113,14 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServer(&am.k))
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	m := keeper.NewMigrator(&am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dynamicfee from version 1 to version 2: %v", err))
+	}
+	if err := cfc.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dynamicfee from version 2 to version 3: %v", err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the dynamicfee
@@ -155,9 +164,10 @@ func init() {
 type Inputs struct {
 	depinject.In
 
-	Config *modulev1.Module
-	Cdc    codec.Codec
-	Key    *store.KVStoreKey
+	Config        *modulev1.Module
+	Cdc           codec.Codec
+	Key           *store.KVStoreKey
+	AccountKeeper types.AccountKeeper
 }
 
 type Outputs struct {
@@ -185,6 +195,7 @@ func ProvideModule(in Inputs) Outputs {
 		in.Cdc,
 		in.Key,
 		nil,
+		in.AccountKeeper,
 		authority.String(),
 	)
 
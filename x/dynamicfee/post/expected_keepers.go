@@ -3,6 +3,10 @@ package post
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
@@ -12,4 +16,25 @@ type DynamicfeeKeeper interface {
 	GetMaxBlockGas(ctx context.Context, params types.Params) uint64
 	SetState(ctx context.Context, state types.State) error
 	GetEnabledHeight(ctx context.Context) (int64, error)
+	GetMinGasPrice(ctx context.Context, denom string) (sdk.DecCoin, error)
+	GetBurnedFees(ctx context.Context) (sdk.Coins, error)
+	AddBurnedFees(ctx context.Context, coins sdk.Coins) error
+	AddTxGasPrice(ctx context.Context, gasPrice math.LegacyDec, gasUsed uint64) error
+}
+
+// BankKeeper defines the contract needed for supply related APIs.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// DistributionKeeper defines the contract needed for distribution related APIs.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
@@ -8,7 +8,9 @@ import (
 	context "context"
 	reflect "reflect"
 
+	math "cosmossdk.io/math"
 	types "github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+	types0 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +37,49 @@ func (m *MockDynamicfeeKeeper) EXPECT() *MockDynamicfeeKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddBurnedFees mocks base method.
+func (m *MockDynamicfeeKeeper) AddBurnedFees(ctx context.Context, coins types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBurnedFees", ctx, coins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBurnedFees indicates an expected call of AddBurnedFees.
+func (mr *MockDynamicfeeKeeperMockRecorder) AddBurnedFees(ctx, coins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBurnedFees", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).AddBurnedFees), ctx, coins)
+}
+
+// AddTxGasPrice mocks base method.
+func (m *MockDynamicfeeKeeper) AddTxGasPrice(ctx context.Context, gasPrice math.LegacyDec, gasUsed uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTxGasPrice", ctx, gasPrice, gasUsed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTxGasPrice indicates an expected call of AddTxGasPrice.
+func (mr *MockDynamicfeeKeeperMockRecorder) AddTxGasPrice(ctx, gasPrice, gasUsed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTxGasPrice", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).AddTxGasPrice), ctx, gasPrice, gasUsed)
+}
+
+// GetBurnedFees mocks base method.
+func (m *MockDynamicfeeKeeper) GetBurnedFees(ctx context.Context) (types0.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurnedFees", ctx)
+	ret0, _ := ret[0].(types0.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurnedFees indicates an expected call of GetBurnedFees.
+func (mr *MockDynamicfeeKeeperMockRecorder) GetBurnedFees(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurnedFees", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).GetBurnedFees), ctx)
+}
+
 // GetEnabledHeight mocks base method.
 func (m *MockDynamicfeeKeeper) GetEnabledHeight(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +109,21 @@ func (mr *MockDynamicfeeKeeperMockRecorder) GetMaxBlockGas(ctx, params interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxBlockGas", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).GetMaxBlockGas), ctx, params)
 }
 
+// GetMinGasPrice mocks base method.
+func (m *MockDynamicfeeKeeper) GetMinGasPrice(ctx context.Context, denom string) (types0.DecCoin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMinGasPrice", ctx, denom)
+	ret0, _ := ret[0].(types0.DecCoin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMinGasPrice indicates an expected call of GetMinGasPrice.
+func (mr *MockDynamicfeeKeeperMockRecorder) GetMinGasPrice(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMinGasPrice", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).GetMinGasPrice), ctx, denom)
+}
+
 // GetParams mocks base method.
 func (m *MockDynamicfeeKeeper) GetParams(ctx context.Context) (types.Params, error) {
 	m.ctrl.T.Helper()
@@ -107,3 +167,142 @@ func (mr *MockDynamicfeeKeeperMockRecorder) SetState(ctx, state interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockDynamicfeeKeeper)(nil).SetState), ctx, state)
 }
+
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
+// MockAccountKeeper is a mock of AccountKeeper interface.
+type MockAccountKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountKeeperMockRecorder
+}
+
+// MockAccountKeeperMockRecorder is the mock recorder for MockAccountKeeper.
+type MockAccountKeeperMockRecorder struct {
+	mock *MockAccountKeeper
+}
+
+// NewMockAccountKeeper creates a new mock instance.
+func NewMockAccountKeeper(ctrl *gomock.Controller) *MockAccountKeeper {
+	mock := &MockAccountKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetModuleAddress mocks base method.
+func (m *MockAccountKeeper) GetModuleAddress(moduleName string) types0.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleAddress", moduleName)
+	ret0, _ := ret[0].(types0.AccAddress)
+	return ret0
+}
+
+// GetModuleAddress indicates an expected call of GetModuleAddress.
+func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(moduleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), moduleName)
+}
+
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types0.Coins, sender types0.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
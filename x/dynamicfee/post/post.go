@@ -2,27 +2,43 @@ package post
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/ante"
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
 // DynamicfeeStateUpdateDecorator updates the state of the dynamic fee pricing
-// based on the gas consumed in the gasmeter. Call next PostHandler if fees
-// successfully deducted.
+// based on the gas consumed in the gasmeter and settles the fee deducted by
+// the ante handler. Call next PostHandler if fees successfully settled.
 // CONTRACT: Tx must implement FeeTx interface
 type DynamicfeeStateUpdateDecorator struct {
-	dynamicfeeKeeper DynamicfeeKeeper
+	dynamicfeeKeeper   DynamicfeeKeeper
+	bankKeeper         BankKeeper
+	accountKeeper      AccountKeeper
+	distributionKeeper DistributionKeeper
 }
 
-func NewDynamicfeeStateUpdateDecorator(fmk DynamicfeeKeeper) DynamicfeeStateUpdateDecorator {
+func NewDynamicfeeStateUpdateDecorator(fmk DynamicfeeKeeper, bk BankKeeper, ak AccountKeeper, dk DistributionKeeper) DynamicfeeStateUpdateDecorator {
 	return DynamicfeeStateUpdateDecorator{
-		dynamicfeeKeeper: fmk,
+		dynamicfeeKeeper:   fmk,
+		bankKeeper:         bk,
+		accountKeeper:      ak,
+		distributionKeeper: dk,
 	}
 }
 
-// PostHandle deducts the fee from the fee payer based on the min base fee and the gas consumed in the gasmeter.
-// If there is a difference between the provided fee and the min-base fee, the difference is paid as a tip.
-// Fees are sent to the x/dynamicfee fee-collector address.
+// PostHandle updates the dynamic fee pricing state with the gas consumed in
+// the gasmeter, then splits the fee deducted by the ante handler into a base
+// fee (gas consumed * min gas price) and a tip. The part of the provided fee
+// that exceeds the base fee and the tip is refunded to the account the fee
+// was deducted from. The base fee is routed according to the
+// BaseFeeDestination param, only the tip is left in the fee collector for
+// distribution.
 func (dfd DynamicfeeStateUpdateDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
 	// GenTx consume no fee
 	if ctx.BlockHeight() == 0 {
@@ -50,6 +66,11 @@ func (dfd DynamicfeeStateUpdateDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx,
 		return next(ctx, tx, simulate, success)
 	}
 
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
 	// update dynamic fee pricing state
 	state, err := dfd.dynamicfeeKeeper.GetState(ctx)
 	if err != nil {
@@ -74,5 +95,269 @@ func (dfd DynamicfeeStateUpdateDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx,
 		return ctx, errorsmod.Wrapf(err, "unable to set dynamicfee state")
 	}
 
+	if simulate {
+		if err := dfd.simulateSettleFee(ctx, params, state.BaseGasPrice, feeTx, gas); err != nil {
+			return ctx, errorsmod.Wrapf(err, "error simulating fee settlement")
+		}
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := dfd.settleFee(ctx, params, state.BaseGasPrice, feeTx, gas); err != nil {
+		return ctx, errorsmod.Wrapf(err, "error settling fee")
+	}
+
 	return next(ctx, tx, simulate, success)
 }
+
+// settleFee splits the fee provided by feeTx according to the gas used and
+// the gas price multiplier of the tx msgs, refunds the excess, routes the base
+// fee, records the effective gas price paid for the fee history and emits the
+// corresponding events.
+func (dfd DynamicfeeStateUpdateDecorator) settleFee(ctx sdk.Context, params types.Params, baseGasPrice sdkmath.LegacyDec, feeTx sdk.FeeTx, gasUsed uint64) error {
+	feeCoins := feeTx.GetFee()
+	if len(feeCoins) == 0 {
+		return nil
+	}
+
+	// the fee was checked against the min gas price multiplied by the highest
+	// gas price multiplier among the tx msgs.
+	multiplier := params.MsgsGasPriceMultiplier(feeTx.GetMsgs())
+
+	minGasPrices := make([]sdk.DecCoin, len(feeCoins))
+	paidGas := make([]sdkmath.LegacyDec, len(feeCoins))
+	totalPaidGas := sdkmath.LegacyZeroDec()
+	for i, feeCoin := range feeCoins {
+		minGasPrice, err := dfd.dynamicfeeKeeper.GetMinGasPrice(ctx, feeCoin.GetDenom())
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to get min gas price for denom %s", feeCoin.GetDenom())
+		}
+		minGasPrices[i] = minGasPrice
+
+		paidGas[i] = sdkmath.LegacyZeroDec()
+		if minGasPrice.Amount.IsPositive() {
+			paidGas[i] = feeCoin.Amount.ToLegacyDec().Quo(minGasPrice.Amount)
+		}
+		totalPaidGas = totalPaidGas.Add(paidGas[i])
+	}
+
+	// each fee coin is split at the tx gas price of its denom, weighted by
+	// the share of the fee it pays for, so that the fee coins jointly pay the
+	// base fee and the tip in proportion of their value.
+	var baseFees, tips, refunds sdk.Coins
+	for i, feeCoin := range feeCoins {
+		share := sdkmath.LegacyZeroDec()
+		if totalPaidGas.IsPositive() {
+			share = paidGas[i].Quo(totalPaidGas)
+		}
+		denomBaseGasPrice := sdk.NewDecCoinFromDec(minGasPrices[i].Denom, minGasPrices[i].Amount.Mul(share))
+		txGasPrice := sdk.NewDecCoinFromDec(minGasPrices[i].Denom, denomBaseGasPrice.Amount.Mul(multiplier))
+
+		baseFee, tip, refund := SplitFee(txGasPrice, feeCoin, gasUsed, feeTx.GetGas())
+		// only the gas used at the base gas price is the base fee, the premium
+		// required by the gas price multiplier goes to the tip.
+		if burnable, _, _ := SplitFee(denomBaseGasPrice, feeCoin, gasUsed, feeTx.GetGas()); burnable.IsLT(baseFee) {
+			tip = tip.Add(baseFee.Sub(burnable))
+			baseFee = burnable
+		}
+		baseFees = append(baseFees, baseFee)
+		tips = append(tips, tip)
+		refunds = append(refunds, refund)
+	}
+
+	// the fee was deducted from the fee granter if any, so the refund goes
+	// back there. The feegrant keeper cannot credit an allowance back, so the
+	// allowance of the fee payer remains consumed for the whole fee.
+	refundTo := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundTo = feeGranter
+	}
+
+	if refund := sdk.NewCoins(refunds...); refund.IsAllPositive() {
+		err := dfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundTo, refund)
+		if err != nil {
+			return err
+		}
+	}
+
+	if baseFee := sdk.NewCoins(baseFees...); baseFee.IsAllPositive() {
+		if err := dfd.routeBaseFee(ctx, params, baseFee); err != nil {
+			return errorsmod.Wrapf(err, "unable to route base fee")
+		}
+	}
+
+	if params.FeeHistorySize > 0 && !ctx.IsCheckTx() {
+		if err := dfd.recordTxGasPrice(ctx, baseGasPrice, minGasPrices, baseFees.Add(tips...), gasUsed, feeTx.GetGas()); err != nil {
+			return errorsmod.Wrapf(err, "unable to record tx gas price")
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeePay,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFees.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdkmath.NewIntFromUint64(gasUsed).String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFeeDest, params.BaseFeeDestination.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundTo.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeTipPay,
+			sdk.NewAttribute(types.AttributeKeyTip, tips.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundTo.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeFeeRefund,
+			sdk.NewAttribute(types.AttributeKeyRefund, refunds.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundRecipient, refundTo.String()),
+		),
+	})
+
+	return nil
+}
+
+// simulateSettleFee accounts for the gas consumed by settleFee in deliver
+// mode, so that gas estimations remain accurate. No fee is deducted in
+// simulation mode, so the fee transfers cannot be executed: the gas of each of
+// them is charged instead, assuming both the refund and the base fee are
+// positive, as is the update of the burned fees. The other store accesses of
+// the settlement are executed for real, their writes being discarded with the
+// rest of the simulation.
+func (dfd DynamicfeeStateUpdateDecorator) simulateSettleFee(ctx sdk.Context, params types.Params, baseGasPrice sdkmath.LegacyDec, feeTx sdk.FeeTx, gasUsed uint64) error {
+	denoms := []string{params.FeeDenom}
+	if feeCoins := feeTx.GetFee(); len(feeCoins) > 0 {
+		denoms = feeCoins.Denoms()
+	}
+	for _, denom := range denoms {
+		if _, err := dfd.dynamicfeeKeeper.GetMinGasPrice(ctx, denom); err != nil {
+			return errorsmod.Wrapf(err, "unable to get min gas price for denom %s", denom)
+		}
+	}
+
+	ctx.GasMeter().ConsumeGas(ante.BankSendGasConsumption, "simulation refund send gas consumption")
+
+	switch params.BaseFeeDestination {
+	case types.BaseFeeDestinationBurn:
+		ctx.GasMeter().ConsumeGas(ante.BankSendGasConsumption, "simulation base fee send gas consumption")
+		ctx.GasMeter().ConsumeGas(ante.BankSendGasConsumption, "simulation base fee burn gas consumption")
+		// the burned fees are read for real, but their update is charged
+		// instead of written, with the fee as an upper bound of the base fee.
+		burned, err := dfd.dynamicfeeKeeper.GetBurnedFees(ctx)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to route base fee")
+		}
+		baseFee := feeTx.GetFee()
+		if baseFee.Empty() {
+			baseFee = sdk.NewCoins(sdk.NewCoin(params.FeeDenom, sdkmath.OneInt()))
+		}
+		gasConfig := ctx.KVGasConfig()
+		for _, coin := range burned.Add(baseFee...) {
+			bz, err := coin.Amount.Marshal()
+			if err != nil {
+				return errorsmod.Wrapf(err, "unable to route base fee")
+			}
+			ctx.GasMeter().ConsumeGas(gasConfig.WriteCostFlat, "simulation burned fees write gas consumption")
+			ctx.GasMeter().ConsumeGas(gasConfig.WriteCostPerByte*uint64(len(types.BurnedFeesKey(coin.Denom))+len(bz)), "simulation burned fees write gas consumption")
+		}
+	case types.BaseFeeDestinationCommunityPool, types.BaseFeeDestinationModuleAccount:
+		ctx.GasMeter().ConsumeGas(ante.BankSendGasConsumption, "simulation base fee send gas consumption")
+	}
+
+	if params.FeeHistorySize > 0 && !ctx.IsCheckTx() && gasUsed > 0 && baseGasPrice.IsPositive() {
+		if err := dfd.dynamicfeeKeeper.AddTxGasPrice(ctx, baseGasPrice, gasUsed); err != nil {
+			return errorsmod.Wrapf(err, "unable to record tx gas price")
+		}
+	}
+	return nil
+}
+
+// recordTxGasPrice records the effective gas price paid by the tx for the fee
+// history. The paid coins are converted to the fee denom using the same rate
+// as the min gas price of their denom in minGasPrices.
+func (dfd DynamicfeeStateUpdateDecorator) recordTxGasPrice(ctx sdk.Context, baseGasPrice sdkmath.LegacyDec, minGasPrices []sdk.DecCoin, paid sdk.Coins, gasUsed, gasLimit uint64) error {
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+	if gasUsed == 0 || !baseGasPrice.IsPositive() {
+		return nil
+	}
+
+	paidAmount := sdkmath.LegacyZeroDec()
+	for _, minGasPrice := range minGasPrices {
+		if !minGasPrice.Amount.IsPositive() {
+			continue
+		}
+		paidAmount = paidAmount.Add(baseGasPrice.MulInt(paid.AmountOf(minGasPrice.Denom)).Quo(minGasPrice.Amount))
+	}
+	gasPrice := paidAmount.QuoInt(sdkmath.NewIntFromUint64(gasUsed))
+
+	return dfd.dynamicfeeKeeper.AddTxGasPrice(ctx, gasPrice, gasUsed)
+}
+
+// routeBaseFee moves the base fee out of the fee collector according to the
+// BaseFeeDestination param.
+func (dfd DynamicfeeStateUpdateDecorator) routeBaseFee(ctx sdk.Context, params types.Params, baseFee sdk.Coins) error {
+	switch params.BaseFeeDestination {
+	case types.BaseFeeDestinationBurn:
+		err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, baseFee)
+		if err != nil {
+			return err
+		}
+		if err := dfd.bankKeeper.BurnCoins(ctx, types.ModuleName, baseFee); err != nil {
+			return err
+		}
+		return dfd.dynamicfeeKeeper.AddBurnedFees(ctx, baseFee)
+
+	case types.BaseFeeDestinationCommunityPool:
+		feeCollector := dfd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		return dfd.distributionKeeper.FundCommunityPool(ctx, baseFee, feeCollector)
+
+	case types.BaseFeeDestinationModuleAccount:
+		// a misconfigured recipient must not make every tx fail, the base fee
+		// is then left in the fee collector.
+		if dfd.accountKeeper.GetModuleAddress(params.BaseFeeRecipient) == nil {
+			ctx.Logger().Error("unknown base fee recipient module account, base fee left in fee collector",
+				"recipient", params.BaseFeeRecipient,
+			)
+			return nil
+		}
+		return dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, params.BaseFeeRecipient, baseFee)
+
+	default:
+		// the base fee stays in the fee collector along with the tip.
+		return nil
+	}
+}
+
+// SplitFee splits the provided fee into the base fee, the tip and the refund,
+// given the gas price at which the fee was checked, the gas consumed by the
+// tx and the tx gas limit.
+//
+//	baseFee = ceil(gasPrice * gasUsed), capped to fee
+//	tipCap  = fee - ceil(gasPrice * gasLimit), the tip offered on top of the required fee
+//	tip     = tipCap * gasUsed / gasLimit
+//	refund  = fee - baseFee - tip
+//
+// The refund is never negative and baseFee + tip + refund always equals fee.
+func SplitFee(gasPrice sdk.DecCoin, fee sdk.Coin, gasUsed, gasLimit uint64) (baseFee, tip, refund sdk.Coin) {
+	zero := sdk.NewCoin(fee.Denom, sdkmath.ZeroInt())
+	if gasLimit == 0 {
+		return fee, zero, zero
+	}
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+
+	requiredFee := sdkmath.MinInt(
+		gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(gasLimit)).Ceil().TruncateInt(),
+		fee.Amount,
+	)
+	baseFee = sdk.NewCoin(fee.Denom, sdkmath.MinInt(
+		gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(gasUsed)).Ceil().TruncateInt(),
+		fee.Amount,
+	))
+
+	tipCap := fee.Amount.Sub(requiredFee)
+	tip = sdk.NewCoin(fee.Denom, tipCap.Mul(sdkmath.NewIntFromUint64(gasUsed)).Quo(sdkmath.NewIntFromUint64(gasLimit)))
+
+	refund = fee.Sub(baseFee).Sub(tip)
+	return baseFee, tip, refund
+}
@@ -1,6 +1,9 @@
 package post_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -8,37 +11,100 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	xtxsigning "cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/ante"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/post"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
 type mocks struct {
-	ctx              sdk.Context
-	DynamicfeeKeeper *MockDynamicfeeKeeper
+	ctx                sdk.Context
+	DynamicfeeKeeper   *MockDynamicfeeKeeper
+	BankKeeper         *MockBankKeeper
+	AccountKeeper      *MockAccountKeeper
+	DistributionKeeper *MockDistributionKeeper
 }
 
 func setupMocks(t *testing.T) mocks {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	return mocks{
-		ctx:              sdk.NewContext(nil, tmproto.Header{}, false, log.NewTestLogger(t)),
-		DynamicfeeKeeper: NewMockDynamicfeeKeeper(ctrl),
+		ctx:                sdk.NewContext(nil, tmproto.Header{}, false, log.NewTestLogger(t)),
+		DynamicfeeKeeper:   NewMockDynamicfeeKeeper(ctrl),
+		BankKeeper:         NewMockBankKeeper(ctrl),
+		AccountKeeper:      NewMockAccountKeeper(ctrl),
+		DistributionKeeper: NewMockDistributionKeeper(ctrl),
 	}
 }
 
+func (m mocks) expectBurn(amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, amount))
+	m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(m.ctx, authtypes.FeeCollectorName, types.ModuleName, coins)
+	m.BankKeeper.EXPECT().BurnCoins(m.ctx, types.ModuleName, coins)
+	m.DynamicfeeKeeper.EXPECT().AddBurnedFees(m.ctx, coins)
+}
+
+func (m mocks) expectTxGasPrice(gasPrice string, gasUsed uint64) {
+	m.DynamicfeeKeeper.EXPECT().AddTxGasPrice(m.ctx, sdkmath.LegacyMustNewDecFromStr(gasPrice), gasUsed)
+}
+
+func newTxConfig() client.TxConfig {
+	interfaceRegistry, _ := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: xtxsigning.Options{
+			AddressCodec: address.Bech32Codec{
+				Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			},
+			ValidatorAddressCodec: address.Bech32Codec{
+				Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			},
+		},
+	})
+	return authtx.NewTxConfig(
+		codec.NewProtoCodec(interfaceRegistry),
+		[]signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT},
+	)
+}
+
 func TestPostHandle(t *testing.T) {
+	txConfig := newTxConfig()
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	newTx := func(fee sdk.Coins, gasLimit uint64, granter sdk.AccAddress) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetFeeGranter(granter)
+		return txBuilder.GetTx()
+	}
+	defaultTx := newTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000)), 1000, nil)
+
 	tests := []struct {
 		name              string
+		tx                sdk.Tx
 		genTx             bool
 		simulate          bool
 		disableDynamicfee bool
 		setup             func(mocks)
+		expectedEvents    sdk.Events
+		expectedError     string
 	}{
 		{
 			name:  "ok: skip gentx",
@@ -63,6 +129,63 @@ func TestPostHandle(t *testing.T) {
 		},
 		{
 			name: "ok: state updated",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.expectBurn(1000)
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationBurn),
+		},
+		{
+			name: "ok: unused gas refunded",
+			tx:   newTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 4000)), 2000, nil),
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				// base fee = 1000, tip = (4000-2000)*1000/2000 = 1000
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(m.ctx, authtypes.FeeCollectorName,
+					addrs[0], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 2000)))
+				m.expectBurn(1000)
+				m.expectTxGasPrice("0.02", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "1000uphoton", "2000uphoton", 1000, types.BaseFeeDestinationBurn),
+		},
+		{
+			name: "ok: multiple fee coins split in proportion of their value",
+			tx: newTx(sdk.NewCoins(
+				sdk.NewInt64Coin(types.DefaultFeeDenom, 500),
+				sdk.NewInt64Coin("ibc/ATOM", 50),
+			), 1000, nil),
 			setup: func(m mocks) {
 				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
 					Return(types.DefaultParams(), nil)
@@ -78,10 +201,272 @@ func TestPostHandle(t *testing.T) {
 				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
 				maxBlockGas := testutil.MaxBlockGas
 				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, "ibc/ATOM").
+					Return(sdk.NewDecCoinFromDec("ibc/ATOM", sdkmath.LegacyMustNewDecFromStr("0.1")), nil)
+				// each coin pays for 500 gas, so half of the base fee
+				baseFee := sdk.NewCoins(
+					sdk.NewInt64Coin(types.DefaultFeeDenom, 500),
+					sdk.NewInt64Coin("ibc/ATOM", 50),
+				)
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(m.ctx, authtypes.FeeCollectorName, types.ModuleName, baseFee)
+				m.BankKeeper.EXPECT().BurnCoins(m.ctx, types.ModuleName, baseFee)
+				m.DynamicfeeKeeper.EXPECT().AddBurnedFees(m.ctx, baseFee)
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "50ibc/ATOM,500uphoton", "0ibc/ATOM,0uphoton", "0ibc/ATOM,0uphoton", 1000, types.BaseFeeDestinationBurn),
+		},
+		{
+			name: "ok: unused gas refunded to granter",
+			tx:   newTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 2000)), 2000, addrs[2]),
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(m.ctx, authtypes.FeeCollectorName,
+					addrs[2], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000)))
+				m.expectBurn(1000)
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[2], "1000uphoton", "0uphoton", "1000uphoton", 1000, types.BaseFeeDestinationBurn),
+		},
+		{
+			name: "fail: refund error",
+			tx:   newTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 2000)), 2000, nil),
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(m.ctx, authtypes.FeeCollectorName,
+					addrs[0], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000))).
+					Return(errors.New("NOPE"))
+			},
+			expectedError: "error settling fee: NOPE",
+		},
+		{
+			name: "fail: burn error",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams(), nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000))
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(m.ctx, authtypes.FeeCollectorName, types.ModuleName, coins)
+				m.BankKeeper.EXPECT().BurnCoins(m.ctx, types.ModuleName, coins).Return(errors.New("NOPE"))
+			},
+			expectedError: "error settling fee: unable to route base fee: NOPE",
+		},
+		{
+			name: "ok: base fee sent to community pool",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.BaseFeeDestination = types.BaseFeeDestinationCommunityPool
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+				m.AccountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollector)
+				m.DistributionKeeper.EXPECT().FundCommunityPool(m.ctx,
+					sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000)), feeCollector)
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationCommunityPool),
+		},
+		{
+			name: "ok: base fee sent to module account",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+				params.BaseFeeRecipient = "treasury"
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.AccountKeeper.EXPECT().GetModuleAddress("treasury").Return(authtypes.NewModuleAddress("treasury"))
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(m.ctx, authtypes.FeeCollectorName, "treasury",
+					sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1000)))
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationModuleAccount),
+		},
+		{
+			name: "ok: base fee left in fee collector for unknown module account",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+				params.BaseFeeRecipient = "unknown"
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.AccountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+				m.expectTxGasPrice("0.01", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationModuleAccount),
+		},
+		{
+			name: "ok: base fee left in fee collector when destination unspecified",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.BaseFeeDestination = types.BaseFeeDestinationUnspecified
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.expectTxGasPrice("0.01", 1000)
 			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationUnspecified),
+		},
+		{
+			name: "ok: msg gas price multiplier applied to base fee",
+			tx:   newTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 4000)), 2000, nil),
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.MsgGasPriceMultipliers = []types.MsgGasPriceMultiplier{{
+					MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}),
+					Multiplier: sdkmath.LegacyNewDec(2),
+				}}
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				// base fee = 1*1000, the premium of the multiplier 1*1000 is
+				// the tip, no other tip since fee = 2*2000
+				m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(m.ctx, authtypes.FeeCollectorName,
+					addrs[0], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 2000)))
+				m.expectBurn(1000)
+				m.expectTxGasPrice("0.02", 1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "1000uphoton", "2000uphoton", 1000, types.BaseFeeDestinationBurn),
+		},
+		{
+			name: "ok: fee history disabled",
+			tx:   defaultTx,
+			setup: func(m mocks) {
+				params := types.DefaultParams()
+				params.FeeHistorySize = 0
+				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).Return(params, nil)
+				m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(m.ctx).Return(int64(0), nil)
+				m.DynamicfeeKeeper.EXPECT().GetState(m.ctx).
+					Return(types.DefaultState(), nil)
+
+				gasConsumed := storetypes.Gas(1000)
+				m.ctx.GasMeter().ConsumeGas(gasConsumed, "")
+
+				expectedState := types.DefaultState()
+				expectedState.Window[0] = gasConsumed
+				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
+				maxBlockGas := testutil.MaxBlockGas
+				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, params).Return(uint64(maxBlockGas))
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.expectBurn(1000)
+			},
+			expectedEvents: feeEvents(addrs[0], "1000uphoton", "0uphoton", "0uphoton", 1000, types.BaseFeeDestinationBurn),
 		},
 		{
 			name:     "ok: simulate && state updated",
+			tx:       defaultTx,
 			simulate: true,
 			setup: func(m mocks) {
 				m.DynamicfeeKeeper.EXPECT().GetParams(m.ctx).
@@ -98,6 +483,11 @@ func TestPostHandle(t *testing.T) {
 				m.DynamicfeeKeeper.EXPECT().SetState(m.ctx, expectedState)
 				maxBlockGas := testutil.MaxBlockGas
 				m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(m.ctx, types.DefaultParams()).Return(uint64(maxBlockGas))
+				// no fee transfer in simulation mode, only the store accesses
+				m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(m.ctx, types.DefaultFeeDenom).
+					Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+				m.DynamicfeeKeeper.EXPECT().GetBurnedFees(m.ctx).Return(sdk.NewCoins(), nil)
+				m.expectTxGasPrice("0.01", 1000)
 			},
 		},
 	}
@@ -105,7 +495,7 @@ func TestPostHandle(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var (
 				m           = setupMocks(t)
-				dfd         = post.NewDynamicfeeStateUpdateDecorator(m.DynamicfeeKeeper)
+				dfd         = post.NewDynamicfeeStateUpdateDecorator(m.DynamicfeeKeeper, m.BankKeeper, m.AccountKeeper, m.DistributionKeeper)
 				nextInvoked bool
 				next        = func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
 					nextInvoked = true
@@ -121,10 +511,229 @@ func TestPostHandle(t *testing.T) {
 				tt.setup(m)
 			}
 
-			_, err := dfd.PostHandle(m.ctx, nil, tt.simulate, true, next)
+			m.ctx = m.ctx.WithEventManager(sdk.NewEventManager())
 
+			newCtx, err := dfd.PostHandle(m.ctx, tt.tx, tt.simulate, true, next)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
 			require.NoError(t, err)
 			assert.True(t, nextInvoked, "next is not invoked")
+			if tt.expectedEvents != nil {
+				assert.Equal(t, tt.expectedEvents, newCtx.EventManager().Events())
+			}
+		})
+	}
+}
+
+func TestPostHandleSimulateGas(t *testing.T) {
+	txConfig := newTxConfig()
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetMsgs(testdata.NewTestMsg(addrs[0], addrs[1]))
+	txBuilder.SetGasLimit(2000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 4000)))
+	tx := txBuilder.GetTx()
+
+	// the mocked bank operations consume the gas of a bank send, as the real
+	// keepers do in deliver mode.
+	consumeGas := func(ctx context.Context) {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(ante.BankSendGasConsumption, "")
+	}
+	postHandle := func(t *testing.T, params types.Params, simulate bool) storetypes.Gas {
+		t.Helper()
+		m := setupMocks(t)
+		m.ctx = m.ctx.WithBlockHeight(1)
+		m.DynamicfeeKeeper.EXPECT().GetParams(gomock.Any()).Return(params, nil)
+		m.DynamicfeeKeeper.EXPECT().GetEnabledHeight(gomock.Any()).Return(int64(0), nil)
+		m.DynamicfeeKeeper.EXPECT().GetState(gomock.Any()).Return(types.DefaultState(), nil)
+		m.DynamicfeeKeeper.EXPECT().SetState(gomock.Any(), gomock.Any())
+		m.DynamicfeeKeeper.EXPECT().GetMaxBlockGas(gomock.Any(), params).Return(uint64(testutil.MaxBlockGas))
+		m.DynamicfeeKeeper.EXPECT().GetMinGasPrice(gomock.Any(), types.DefaultFeeDenom).
+			Return(sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), nil)
+		// the mocked burned fees update consumes the gas of the store write,
+		// as the real keeper does in deliver mode.
+		m.DynamicfeeKeeper.EXPECT().GetBurnedFees(gomock.Any()).Return(sdk.NewCoins(), nil).AnyTimes()
+		m.DynamicfeeKeeper.EXPECT().AddBurnedFees(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, coins sdk.Coins) error {
+				sdkCtx := sdk.UnwrapSDKContext(ctx)
+				for _, coin := range coins {
+					bz, err := coin.Amount.Marshal()
+					require.NoError(t, err)
+					sdkCtx.GasMeter().ConsumeGas(sdkCtx.KVGasConfig().WriteCostFlat, "")
+					sdkCtx.GasMeter().ConsumeGas(sdkCtx.KVGasConfig().WriteCostPerByte*uint64(len(types.BurnedFeesKey(coin.Denom))+len(bz)), "")
+				}
+				return nil
+			}).AnyTimes()
+		m.DynamicfeeKeeper.EXPECT().AddTxGasPrice(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		m.AccountKeeper.EXPECT().GetModuleAddress(gomock.Any()).
+			DoAndReturn(authtypes.NewModuleAddress).AnyTimes()
+		m.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ sdk.AccAddress, _ sdk.Coins) error {
+				consumeGas(ctx)
+				return nil
+			}).AnyTimes()
+		m.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _, _ string, _ sdk.Coins) error {
+				consumeGas(ctx)
+				return nil
+			}).AnyTimes()
+		m.BankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ sdk.Coins) error {
+				consumeGas(ctx)
+				return nil
+			}).AnyTimes()
+		m.DistributionKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ sdk.Coins, _ sdk.AccAddress) error {
+				consumeGas(ctx)
+				return nil
+			}).AnyTimes()
+		dfd := post.NewDynamicfeeStateUpdateDecorator(m.DynamicfeeKeeper, m.BankKeeper, m.AccountKeeper, m.DistributionKeeper)
+		next := func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+			return ctx, nil
+		}
+		m.ctx.GasMeter().ConsumeGas(1000, "")
+
+		newCtx, err := dfd.PostHandle(m.ctx, tx, simulate, true, next)
+
+		require.NoError(t, err)
+		return newCtx.GasMeter().GasConsumed()
+	}
+
+	for _, dest := range []types.BaseFeeDestination{
+		types.BaseFeeDestinationBurn,
+		types.BaseFeeDestinationCommunityPool,
+		types.BaseFeeDestinationModuleAccount,
+		types.BaseFeeDestinationUnspecified,
+	} {
+		t.Run(dest.String(), func(t *testing.T) {
+			params := types.DefaultParams()
+			params.BaseFeeDestination = dest
+			params.BaseFeeRecipient = "treasury"
+
+			deliverGas := postHandle(t, params, false)
+			simulateGas := postHandle(t, params, true)
+
+			assert.Greater(t, deliverGas, storetypes.Gas(1000))
+			assert.Equal(t, deliverGas, simulateGas)
+		})
+	}
+}
+
+func feeEvents(payer sdk.AccAddress, baseFee, tip, refund string, gasUsed uint64, dest types.BaseFeeDestination) sdk.Events {
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeePay,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprint(gasUsed)),
+			sdk.NewAttribute(types.AttributeKeyBaseFeeDest, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, payer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeTipPay,
+			sdk.NewAttribute(types.AttributeKeyTip, tip),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, payer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeFeeRefund,
+			sdk.NewAttribute(types.AttributeKeyRefund, refund),
+			sdk.NewAttribute(types.AttributeKeyRefundRecipient, payer.String()),
+		),
+	}
+}
+
+func TestSplitFee(t *testing.T) {
+	tests := []struct {
+		name            string
+		gasPrice        sdk.DecCoin
+		fee             sdk.Coin
+		gasUsed         uint64
+		gasLimit        uint64
+		expectedBaseFee string
+		expectedTip     string
+		expectedRefund  string
+	}{
+		{
+			name:            "all gas used, no tip",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 1),
+			fee:             sdk.NewInt64Coin("uphoton", 100),
+			gasUsed:         100,
+			gasLimit:        100,
+			expectedBaseFee: "100uphoton",
+			expectedTip:     "0uphoton",
+			expectedRefund:  "0uphoton",
+		},
+		{
+			name:            "all gas used with tip",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 1),
+			fee:             sdk.NewInt64Coin("uphoton", 150),
+			gasUsed:         100,
+			gasLimit:        100,
+			expectedBaseFee: "100uphoton",
+			expectedTip:     "50uphoton",
+			expectedRefund:  "0uphoton",
+		},
+		{
+			name:            "half gas used with tip",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 1),
+			fee:             sdk.NewInt64Coin("uphoton", 150),
+			gasUsed:         50,
+			gasLimit:        100,
+			expectedBaseFee: "50uphoton",
+			expectedTip:     "25uphoton",
+			expectedRefund:  "75uphoton",
+		},
+		{
+			name:            "decimal gas price rounds base fee up",
+			gasPrice:        sdk.NewDecCoinFromDec("uphoton", sdkmath.LegacyMustNewDecFromStr("0.25")),
+			fee:             sdk.NewInt64Coin("uphoton", 25),
+			gasUsed:         33,
+			gasLimit:        100,
+			expectedBaseFee: "9uphoton",
+			expectedTip:     "0uphoton",
+			expectedRefund:  "16uphoton",
+		},
+		{
+			name:            "gas used above gas limit is capped",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 1),
+			fee:             sdk.NewInt64Coin("uphoton", 100),
+			gasUsed:         200,
+			gasLimit:        100,
+			expectedBaseFee: "100uphoton",
+			expectedTip:     "0uphoton",
+			expectedRefund:  "0uphoton",
+		},
+		{
+			name:            "fee lower than required is fully consumed",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 2),
+			fee:             sdk.NewInt64Coin("uphoton", 100),
+			gasUsed:         100,
+			gasLimit:        100,
+			expectedBaseFee: "100uphoton",
+			expectedTip:     "0uphoton",
+			expectedRefund:  "0uphoton",
+		},
+		{
+			name:            "zero gas limit",
+			gasPrice:        sdk.NewInt64DecCoin("uphoton", 1),
+			fee:             sdk.NewInt64Coin("uphoton", 100),
+			gasUsed:         0,
+			gasLimit:        0,
+			expectedBaseFee: "100uphoton",
+			expectedTip:     "0uphoton",
+			expectedRefund:  "0uphoton",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFee, tip, refund := post.SplitFee(tt.gasPrice, tt.fee, tt.gasUsed, tt.gasLimit)
+
+			assert.Equal(t, tt.expectedBaseFee, baseFee.String())
+			assert.Equal(t, tt.expectedTip, tip.String())
+			assert.Equal(t, tt.expectedRefund, refund.String())
+			assert.Equal(t, tt.fee, baseFee.Add(tip).Add(refund))
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"slices"
 	"testing"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -48,5 +49,18 @@ func SetupKeeper(t *testing.T, maxBlockGas uint64) (*keeper.Keeper, sdk.Context)
 	// banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
-	return keeper.NewKeeper(encCfg.Codec, key, &types.ErrorDenomResolver{}, authority), ctx
+	return keeper.NewKeeper(encCfg.Codec, key, &types.ErrorDenomResolver{}, accountKeeper{}, authority), ctx
+}
+
+// ModuleAccounts are the names of the module accounts known by the account
+// keeper of the test keepers.
+var ModuleAccounts = []string{authtypes.FeeCollectorName, types.ModuleName, "treasury"}
+
+type accountKeeper struct{}
+
+func (accountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	if !slices.Contains(ModuleAccounts, moduleName) {
+		return nil
+	}
+	return authtypes.NewModuleAddress(moduleName)
 }
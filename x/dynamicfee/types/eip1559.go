@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 
 	photontypes "github.com/Hikari-Chain/hikari-chain/x/photon/types"
@@ -43,6 +45,45 @@ var (
 
 	// DefaultFeeDenom is the Cosmos SDK default bond denom.
 	DefaultFeeDenom = photontypes.Denom
+
+	// DefaultBaseFeeDestination burns the base fee, only the tip is
+	// distributed to validators.
+	DefaultBaseFeeDestination = BaseFeeDestinationBurn
+
+	// DefaultFeeHistorySize is the default number of blocks kept in the fee
+	// history.
+	DefaultFeeHistorySize uint64 = 100
+
+	// DefaultModel is the classic EIP-1559 pricing model, with a learning rate
+	// fixed to DefaultMaxLearningRate.
+	DefaultModel = PricingModelEIP1559
+
+	// DefaultPIDKp is the default proportional gain of the PID pricing model.
+	// A full block moves the base gas price as much as in the base EIP-1559
+	// implementation.
+	DefaultPIDKp = math.LegacyMustNewDecFromStr("0.125")
+
+	// DefaultPIDKi is the default integral gain of the PID pricing model.
+	DefaultPIDKi = math.LegacyMustNewDecFromStr("0.01")
+
+	// DefaultPIDKd is the default derivative gain of the PID pricing model.
+	DefaultPIDKd = math.LegacyMustNewDecFromStr("0.0")
+
+	// DefaultPIDIntegralLimit is the default bound of the integral of the
+	// error of the PID pricing model.
+	DefaultPIDIntegralLimit = math.LegacyMustNewDecFromStr("5.0")
+
+	// DefaultFeeDenomRateMaxAge is the default duration after which a fee
+	// denom rate that was not updated can no longer be used to pay fees.
+	DefaultFeeDenomRateMaxAge = 30 * 24 * time.Hour
+
+	// DefaultGovernanceLane is the default share of the block space reserved
+	// to the governance votes.
+	DefaultGovernanceLane = math.LegacyMustNewDecFromStr("0.1")
+
+	// DefaultIBCLane is the default share of the block space reserved to the
+	// IBC relayer messages.
+	DefaultIBCLane = math.LegacyMustNewDecFromStr("0.2")
 )
 
 // DefaultParams returns a default set of parameters that implements
@@ -61,6 +102,34 @@ func DefaultParams() Params {
 		DefaultMaxLearningRate,
 		DefaultFeeDenom,
 		true,
+		DefaultBaseFeeDestination,
+		"",
+		DefaultFeeHistorySize,
+		nil,
+		DefaultModel,
+		DefaultPIDParams(),
+		nil,
+		DefaultFeeDenomRateMaxAge,
+		DefaultLaneParams(),
+	)
+}
+
+// DefaultPIDParams returns the default parameters of the PID pricing model.
+func DefaultPIDParams() PIDParams {
+	return NewPIDParams(
+		DefaultPIDKp,
+		DefaultPIDKi,
+		DefaultPIDKd,
+		DefaultPIDIntegralLimit,
+	)
+}
+
+// DefaultLaneParams returns the default shares of the block space reserved to
+// the mempool lanes.
+func DefaultLaneParams() LaneParams {
+	return NewLaneParams(
+		DefaultGovernanceLane,
+		DefaultIBCLane,
 	)
 }
 
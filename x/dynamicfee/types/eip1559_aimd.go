@@ -46,6 +46,29 @@ var (
 
 	// DefaultAIMDFeeDenom is the Cosmos SDK default bond denom.
 	DefaultAIMDFeeDenom = DefaultFeeDenom
+
+	// DefaultAIMDBaseFeeDestination burns the base fee, only the tip is
+	// distributed to validators.
+	DefaultAIMDBaseFeeDestination = DefaultBaseFeeDestination
+
+	// DefaultAIMDFeeHistorySize is the default number of blocks kept in the
+	// fee history.
+	DefaultAIMDFeeHistorySize = DefaultFeeHistorySize
+
+	// DefaultAIMDModel is the AIMD EIP-1559 pricing model.
+	DefaultAIMDModel = PricingModelAIMD
+
+	// DefaultAIMDFeeDenomRateMaxAge is the default duration after which a fee
+	// denom rate that was not updated can no longer be used to pay fees.
+	DefaultAIMDFeeDenomRateMaxAge = DefaultFeeDenomRateMaxAge
+
+	// DefaultAIMDGovernanceLane is the default share of the block space
+	// reserved to the governance votes.
+	DefaultAIMDGovernanceLane = DefaultGovernanceLane
+
+	// DefaultAIMDIBCLane is the default share of the block space reserved to
+	// the IBC relayer messages.
+	DefaultAIMDIBCLane = DefaultIBCLane
 )
 
 // DefaultAIMDParams returns a default set of parameters that implements
@@ -65,6 +88,15 @@ func DefaultAIMDParams() Params {
 		DefaultAIMDMaxLearningRate,
 		DefaultAIMDFeeDenom,
 		true,
+		DefaultAIMDBaseFeeDestination,
+		"",
+		DefaultAIMDFeeHistorySize,
+		nil,
+		DefaultAIMDModel,
+		DefaultPIDParams(),
+		nil,
+		DefaultAIMDFeeDenomRateMaxAge,
+		NewLaneParams(DefaultAIMDGovernanceLane, DefaultAIMDIBCLane),
 	)
 }
 
@@ -9,4 +9,8 @@ var (
 	ErrTooManyFeeCoins = sdkerrors.New(ModuleName, 2, "too many fee coins provided. Only one fee coin may be provided")
 	ErrResolverNotSet  = sdkerrors.New(ModuleName, 3, "denom resolver interface not set. Only the dynamicfee base fee denomination can be used")
 	ErrMaxGasExceeded  = sdkerrors.New(ModuleName, 4, "block gas cannot exceed max block gas")
+	ErrUnknownModel    = sdkerrors.New(ModuleName, 5, "unknown pricing model")
+	ErrUnknownFeeDenom = sdkerrors.New(ModuleName, 6, "fee denom not whitelisted")
+	ErrStaleFeeDenom   = sdkerrors.New(ModuleName, 7, "fee denom rate is stale")
+	ErrUnknownModule   = sdkerrors.New(ModuleName, 8, "unknown module account")
 )
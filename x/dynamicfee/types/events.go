@@ -0,0 +1,15 @@
+package types
+
+// Dynamicfee module event types
+const (
+	EventTypeFeePay    = "fee_pay"
+	EventTypeTipPay    = "tip_pay"
+	EventTypeFeeRefund = "fee_refund"
+
+	AttributeKeyBaseFee         = "base_fee"
+	AttributeKeyTip             = "tip"
+	AttributeKeyRefund          = "refund"
+	AttributeKeyRefundRecipient = "refund_recipient"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyBaseFeeDest     = "base_fee_destination"
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	fmt "fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// FeeHistoryRewardPercentiles are the percentiles at which the rewards of a
+// FeeHistoryEntry are computed.
+var FeeHistoryRewardPercentiles = []uint32{10, 25, 50, 75, 90}
+
+// ComputeRewards returns the effective gas prices paid at each of the
+// FeeHistoryRewardPercentiles, weighting each tx by the gas it consumed.
+// If there is no tx, or no gas was consumed, all the rewards are zero.
+func ComputeRewards(txGasPrices []TxGasPrice) []math.LegacyDec {
+	rewards := make([]math.LegacyDec, len(FeeHistoryRewardPercentiles))
+	for i := range rewards {
+		rewards[i] = math.LegacyZeroDec()
+	}
+
+	var totalGas uint64
+	for _, tgp := range txGasPrices {
+		totalGas += tgp.GasUsed
+	}
+	if totalGas == 0 {
+		return rewards
+	}
+
+	sorted := make([]TxGasPrice, len(txGasPrices))
+	copy(sorted, txGasPrices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GasPrice.LT(sorted[j].GasPrice)
+	})
+
+	var (
+		idx        int
+		cumulative = math.NewIntFromUint64(sorted[0].GasUsed)
+		total      = math.NewIntFromUint64(totalGas)
+	)
+	for i, percentile := range FeeHistoryRewardPercentiles {
+		// threshold = ceil(totalGas * percentile / 100)
+		threshold := total.MulRaw(int64(percentile)).AddRaw(99).QuoRaw(100)
+		for cumulative.LT(threshold) && idx < len(sorted)-1 {
+			idx++
+			cumulative = cumulative.Add(math.NewIntFromUint64(sorted[idx].GasUsed))
+		}
+		rewards[i] = sorted[idx].GasPrice
+	}
+
+	return rewards
+}
+
+// ValidateBasic performs basic validation on the fee history entry.
+func (e FeeHistoryEntry) ValidateBasic() error {
+	if e.Height <= 0 {
+		return fmt.Errorf("fee history height must be positive")
+	}
+
+	if e.BaseGasPrice.IsNil() || e.BaseGasPrice.IsNegative() {
+		return fmt.Errorf("fee history base gas price cannot be nil or negative")
+	}
+
+	if e.LearningRate.IsNil() || e.LearningRate.IsNegative() {
+		return fmt.Errorf("fee history learning rate cannot be nil or negative")
+	}
+
+	if e.Utilization.IsNil() || e.Utilization.IsNegative() {
+		return fmt.Errorf("fee history utilization cannot be nil or negative")
+	}
+
+	if len(e.Rewards) != len(FeeHistoryRewardPercentiles) {
+		return fmt.Errorf("fee history must have %d rewards, got %d", len(FeeHistoryRewardPercentiles), len(e.Rewards))
+	}
+
+	for _, reward := range e.Rewards {
+		if reward.IsNil() || reward.IsNegative() {
+			return fmt.Errorf("fee history reward cannot be nil or negative")
+		}
+	}
+
+	return nil
+}
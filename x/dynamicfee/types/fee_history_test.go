@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestComputeRewards(t *testing.T) {
+	var (
+		dec  = math.LegacyMustNewDecFromStr
+		zero = math.LegacyZeroDec()
+	)
+
+	tests := []struct {
+		name            string
+		txGasPrices     []types.TxGasPrice
+		expectedRewards []math.LegacyDec
+	}{
+		{
+			name:            "no tx",
+			expectedRewards: []math.LegacyDec{zero, zero, zero, zero, zero},
+		},
+		{
+			name:            "no gas used",
+			txGasPrices:     []types.TxGasPrice{{GasPrice: dec("1"), GasUsed: 0}},
+			expectedRewards: []math.LegacyDec{zero, zero, zero, zero, zero},
+		},
+		{
+			name:            "single tx",
+			txGasPrices:     []types.TxGasPrice{{GasPrice: dec("0.2"), GasUsed: 100}},
+			expectedRewards: []math.LegacyDec{dec("0.2"), dec("0.2"), dec("0.2"), dec("0.2"), dec("0.2")},
+		},
+		{
+			name: "weighted by gas used",
+			txGasPrices: []types.TxGasPrice{
+				{GasPrice: dec("0.5"), GasUsed: 100},
+				{GasPrice: dec("0.1"), GasUsed: 800},
+				{GasPrice: dec("0.3"), GasUsed: 100},
+			},
+			expectedRewards: []math.LegacyDec{dec("0.1"), dec("0.1"), dec("0.1"), dec("0.1"), dec("0.3")},
+		},
+		{
+			name: "evenly distributed",
+			txGasPrices: []types.TxGasPrice{
+				{GasPrice: dec("4"), GasUsed: 10},
+				{GasPrice: dec("3"), GasUsed: 10},
+				{GasPrice: dec("2"), GasUsed: 10},
+				{GasPrice: dec("1"), GasUsed: 10},
+			},
+			expectedRewards: []math.LegacyDec{dec("1"), dec("1"), dec("2"), dec("3"), dec("4")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rewards := types.ComputeRewards(tt.txGasPrices)
+
+			require.Equal(t, tt.expectedRewards, rewards)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -23,7 +24,30 @@ func (gs *GenesisState) ValidateBasic() error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.State.ValidateBasic()
+	if err := gs.BurnedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid burned fees: %w", err)
+	}
+	if uint64(len(gs.FeeHistory)) > gs.Params.FeeHistorySize {
+		return fmt.Errorf("fee history has %d entries, more than fee history size %d",
+			len(gs.FeeHistory), gs.Params.FeeHistorySize)
+	}
+	for i, entry := range gs.FeeHistory {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+		if i > 0 && entry.Height <= gs.FeeHistory[i-1].Height {
+			return fmt.Errorf("fee history must be ordered by strictly ascending height")
+		}
+	}
+	if err := gs.State.ValidateBasic(); err != nil {
+		return err
+	}
+
+	model, err := GetPricingModel(gs.Params.Model)
+	if err != nil {
+		return err
+	}
+	return model.ValidateState(gs.Params, gs.State)
 }
 
 // GetGenesisStateFromAppState returns x/dynamicfee GenesisState given raw application
@@ -7,6 +7,9 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +36,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// State contains the current state of the AIMD dynamic fee pricer.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// BurnedFees is the cumulative amount of base fees burned, per denom.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+	// FeeHistory contains the fee history of the last blocks, ordered by
+	// ascending height.
+	FeeHistory []FeeHistoryEntry `protobuf:"bytes,4,rep,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +90,20 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeHistory() []FeeHistoryEntry {
+	if m != nil {
+		return m.FeeHistory
+	}
+	return nil
+}
+
 // State is utilized to track the current state of the dynamic fee pricer.
 // This includes the current base fee, learning rate, and block gas within the
 // specified AIMD window.
@@ -97,6 +119,8 @@ type State struct {
 	Window []uint64 `protobuf:"varint,3,rep,packed,name=window,proto3" json:"window,omitempty"`
 	// Index is the index of the current block in the block gas window.
 	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// PID contains the state of the PRICING_MODEL_TYPE_PID pricing model.
+	PID PIDState `protobuf:"bytes,5,opt,name=pid,proto3" json:"pid"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -146,9 +170,175 @@ func (m *State) GetIndex() uint64 {
 	return 0
 }
 
+func (m *State) GetPID() PIDState {
+	if m != nil {
+		return m.PID
+	}
+	return PIDState{}
+}
+
+// PIDState contains the state of the PID controller pricing model.
+type PIDState struct {
+	// Integral is the accumulated error, bounded by the integral_limit param.
+	Integral cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=integral,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral"`
+	// PrevError is the error of the previous block, used to compute the
+	// derivative term.
+	PrevError cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=prev_error,json=prevError,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"prev_error"`
+}
+
+func (m *PIDState) Reset()         { *m = PIDState{} }
+func (m *PIDState) String() string { return proto.CompactTextString(m) }
+func (*PIDState) ProtoMessage()    {}
+func (*PIDState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27eabe108b6b94e3, []int{2}
+}
+func (m *PIDState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PIDState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PIDState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PIDState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PIDState.Merge(m, src)
+}
+func (m *PIDState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PIDState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PIDState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PIDState proto.InternalMessageInfo
+
+// FeeHistoryEntry contains the fee data of a past block.
+type FeeHistoryEntry struct {
+	// Height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BaseGasPrice is the base gas price that applied to the block.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// LearningRate is the learning rate that applied to the block.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// GasUsed is the gas consumed by the txs of the block.
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Utilization is the ratio of gas_used to the max block gas.
+	Utilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=utilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization"`
+	// Rewards contains the effective gas prices paid by the txs of the block,
+	// weighted by gas used, at each of the fee history reward percentiles.
+	// Prices are denominated in the fee denom.
+	Rewards []cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,rep,name=rewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rewards"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27eabe108b6b94e3, []int{3}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// TxGasPrice is the effective gas price paid by a tx of the current block,
+// used to compute the FeeHistoryEntry rewards.
+type TxGasPrice struct {
+	// GasPrice is the effective gas price paid, in the fee denom.
+	GasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_price"`
+	// GasUsed is the gas consumed by the tx.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TxGasPrice) Reset()         { *m = TxGasPrice{} }
+func (m *TxGasPrice) String() string { return proto.CompactTextString(m) }
+func (*TxGasPrice) ProtoMessage()    {}
+func (*TxGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27eabe108b6b94e3, []int{4}
+}
+func (m *TxGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxGasPrice.Merge(m, src)
+}
+func (m *TxGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxGasPrice proto.InternalMessageInfo
+
+func (m *TxGasPrice) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hikari.dynamicfee.v1.GenesisState")
 	proto.RegisterType((*State)(nil), "hikari.dynamicfee.v1.State")
+	proto.RegisterType((*PIDState)(nil), "hikari.dynamicfee.v1.PIDState")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "hikari.dynamicfee.v1.FeeHistoryEntry")
+	proto.RegisterType((*TxGasPrice)(nil), "hikari.dynamicfee.v1.TxGasPrice")
 }
 
 func init() {
@@ -156,31 +346,50 @@ func init() {
 }
 
 var fileDescriptor_27eabe108b6b94e3 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x3d, 0x4f, 0x3a, 0x31,
-	0x1c, 0xc7, 0xaf, 0x7f, 0x0e, 0x92, 0x7f, 0x45, 0x87, 0x0b, 0x31, 0x27, 0x98, 0x03, 0x99, 0x58,
-	0xe8, 0x05, 0x1d, 0x48, 0x1c, 0x91, 0x04, 0x07, 0x07, 0x3c, 0x13, 0x4d, 0x5c, 0x48, 0xe9, 0xd5,
-	0xbb, 0x06, 0xef, 0x4a, 0xae, 0x27, 0x0f, 0xb3, 0x6f, 0xc0, 0x17, 0xe3, 0x8b, 0x60, 0x24, 0x4e,
-	0xc6, 0x81, 0x18, 0x48, 0x7c, 0x1d, 0xa6, 0xed, 0x11, 0x1d, 0x98, 0xdc, 0xfa, 0x4b, 0x3f, 0xdf,
-	0x87, 0x5f, 0x0b, 0xeb, 0x21, 0x1b, 0xe1, 0x84, 0xb9, 0xfe, 0x3c, 0xc6, 0x11, 0x23, 0x0f, 0x94,
-	0xba, 0x93, 0x96, 0x1b, 0xd0, 0x98, 0x0a, 0x26, 0xd0, 0x38, 0xe1, 0x29, 0xb7, 0x4a, 0x9a, 0x41,
-	0x3f, 0x0c, 0x9a, 0xb4, 0xca, 0xa5, 0x80, 0x07, 0x5c, 0x01, 0xae, 0x3c, 0x69, 0xb6, 0x7c, 0x44,
-	0xb8, 0x88, 0xb8, 0x18, 0xe8, 0x0b, 0x3d, 0x64, 0x57, 0x27, 0x3b, 0xa3, 0xc6, 0x38, 0xc1, 0x51,
-	0x86, 0xd4, 0x9f, 0x01, 0x2c, 0xf6, 0x74, 0xf6, 0x4d, 0x8a, 0x53, 0x6a, 0x9d, 0xc3, 0x82, 0x06,
-	0x6c, 0x50, 0x03, 0x8d, 0xbd, 0xd3, 0x63, 0xb4, 0xab, 0x0b, 0xea, 0x2b, 0xa6, 0x63, 0x2e, 0x56,
-	0x55, 0xc3, 0xcb, 0x14, 0x56, 0x1b, 0xe6, 0x85, 0x34, 0xb1, 0xff, 0x29, 0x69, 0x65, 0xb7, 0x54,
-	0xe5, 0x64, 0x4a, 0xcd, 0xd7, 0xbf, 0x00, 0xcc, 0xeb, 0xf8, 0x3b, 0x78, 0x30, 0xc4, 0x82, 0x0e,
-	0x02, 0x2c, 0x37, 0x62, 0x84, 0xaa, 0x1a, 0xff, 0x3b, 0x2d, 0x89, 0x7f, 0xac, 0xaa, 0x15, 0xbd,
-	0xa0, 0xf0, 0x47, 0x88, 0x71, 0x37, 0xc2, 0x69, 0x88, 0xae, 0x68, 0x80, 0xc9, 0xbc, 0x4b, 0xc9,
-	0xdb, 0x6b, 0x13, 0x66, 0xfb, 0x77, 0x29, 0xf1, 0x8a, 0xd2, 0xa8, 0x87, 0x45, 0x5f, 0xda, 0x58,
-	0xb7, 0x70, 0xff, 0x91, 0xe2, 0x24, 0x66, 0x71, 0x30, 0x48, 0xb6, 0x1d, 0xff, 0xe6, 0xbb, 0xf5,
-	0xf1, 0x64, 0xe1, 0x43, 0x58, 0x98, 0xb2, 0xd8, 0xe7, 0x53, 0x3b, 0x57, 0xcb, 0x35, 0x4c, 0x2f,
-	0x9b, 0xac, 0x12, 0xcc, 0xb3, 0xd8, 0xa7, 0x33, 0xdb, 0xac, 0x81, 0x86, 0xe9, 0xe9, 0xa1, 0x73,
-	0xbd, 0x58, 0x3b, 0x60, 0xb9, 0x76, 0xc0, 0xe7, 0xda, 0x01, 0x2f, 0x1b, 0xc7, 0x58, 0x6e, 0x1c,
-	0xe3, 0x7d, 0xe3, 0x18, 0xf7, 0xed, 0x80, 0xa5, 0xe1, 0xd3, 0x10, 0x11, 0x1e, 0xb9, 0x97, 0xea,
-	0xd9, 0x9a, 0x17, 0x21, 0x66, 0xb1, 0xab, 0xdf, 0xb0, 0x49, 0xd4, 0x30, 0xfb, 0xfd, 0x97, 0xe9,
-	0x7c, 0x4c, 0xc5, 0xb0, 0xa0, 0x3e, 0xf2, 0xec, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xde, 0xe8, 0xb4,
-	0x74, 0x58, 0x02, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0x12, 0x5b,
+	0x18, 0x66, 0x18, 0xa0, 0xf4, 0xd0, 0x7b, 0x6f, 0xee, 0xa4, 0xb9, 0x99, 0xb6, 0x37, 0x03, 0x92,
+	0x98, 0x10, 0x13, 0x66, 0x42, 0x8d, 0x69, 0x62, 0xe2, 0x86, 0xd2, 0xaf, 0x58, 0x0d, 0x4e, 0xfd,
+	0x48, 0xdc, 0x90, 0xc3, 0xcc, 0xcb, 0x70, 0xd2, 0x32, 0x07, 0xcf, 0x19, 0x4a, 0xf1, 0x57, 0xb8,
+	0xf3, 0x17, 0x98, 0x18, 0xdd, 0xb8, 0xf0, 0x27, 0xb8, 0xe8, 0xb2, 0x71, 0x65, 0x5c, 0x54, 0x43,
+	0x17, 0xfe, 0x0d, 0x73, 0x3e, 0xa8, 0x68, 0x70, 0x43, 0xea, 0x06, 0x78, 0xcf, 0x79, 0x9f, 0xe7,
+	0x7d, 0xce, 0xf3, 0x70, 0x0e, 0x2a, 0x77, 0xc9, 0x21, 0x66, 0xc4, 0x0b, 0x47, 0x31, 0xee, 0x91,
+	0xa0, 0x03, 0xe0, 0x1d, 0xd7, 0xbc, 0x08, 0x62, 0xe0, 0x84, 0xbb, 0x7d, 0x46, 0x13, 0x6a, 0x2d,
+	0xab, 0x1e, 0xf7, 0x47, 0x8f, 0x7b, 0x5c, 0x5b, 0x5d, 0x8e, 0x68, 0x44, 0x65, 0x83, 0x27, 0x7e,
+	0xa9, 0xde, 0xd5, 0x95, 0x80, 0xf2, 0x1e, 0xe5, 0x2d, 0xb5, 0xa1, 0x0a, 0xbd, 0xe5, 0xa8, 0xca,
+	0x6b, 0x63, 0x2e, 0x86, 0xb4, 0x21, 0xc1, 0x35, 0x2f, 0xa0, 0x24, 0xd6, 0xfb, 0xff, 0xe2, 0x1e,
+	0x89, 0xa9, 0x27, 0x3f, 0xf5, 0xd2, 0xb5, 0x99, 0xea, 0xfa, 0x98, 0xe1, 0x9e, 0x66, 0x2d, 0x7f,
+	0x48, 0xa3, 0xa5, 0x1d, 0x25, 0xf7, 0x20, 0xc1, 0x09, 0x58, 0xb7, 0x51, 0x4e, 0x35, 0xd8, 0x46,
+	0xc9, 0xa8, 0x14, 0xd6, 0xff, 0x77, 0x67, 0xc9, 0x77, 0x9b, 0xb2, 0xa7, 0x9e, 0x39, 0x3d, 0x2f,
+	0xa6, 0x7c, 0x8d, 0xb0, 0x36, 0x50, 0x96, 0x0b, 0x12, 0x3b, 0x2d, 0xa1, 0x6b, 0xb3, 0xa1, 0x72,
+	0x8e, 0x46, 0xaa, 0x7e, 0xeb, 0x19, 0x2a, 0xb4, 0x07, 0x2c, 0x86, 0xb0, 0xd5, 0x01, 0xe0, 0xb6,
+	0x59, 0x32, 0x2b, 0x85, 0xf5, 0x15, 0x57, 0x9f, 0x5f, 0x9c, 0xd8, 0xd5, 0x27, 0x76, 0x37, 0x29,
+	0x89, 0xeb, 0xb7, 0x04, 0xf8, 0xcd, 0x97, 0x62, 0x25, 0x22, 0x49, 0x77, 0xd0, 0x76, 0x03, 0xda,
+	0xd3, 0x66, 0xe9, 0xaf, 0x2a, 0x0f, 0x0f, 0xbd, 0x64, 0xd4, 0x07, 0x2e, 0x01, 0xfc, 0xf5, 0xb7,
+	0x77, 0x37, 0x0c, 0x1f, 0xa9, 0x21, 0xdb, 0x00, 0xdc, 0xda, 0x47, 0x85, 0x0e, 0x40, 0xab, 0x4b,
+	0x78, 0x42, 0xd9, 0xc8, 0xce, 0xc8, 0x91, 0xd7, 0x67, 0x2b, 0xde, 0x06, 0xd8, 0x55, 0x7d, 0x5b,
+	0x71, 0xc2, 0x46, 0x5a, 0x3b, 0xea, 0x5c, 0x2e, 0x97, 0x5f, 0xa5, 0x51, 0x56, 0xf9, 0xf7, 0x04,
+	0xfd, 0x2d, 0xf4, 0xb6, 0x22, 0x2c, 0x52, 0x24, 0x01, 0x48, 0x1f, 0x17, 0xeb, 0x35, 0x81, 0xf9,
+	0x7c, 0x5e, 0x5c, 0x53, 0x02, 0x79, 0x78, 0xe8, 0x12, 0xea, 0xf5, 0x70, 0xd2, 0x75, 0xf7, 0x21,
+	0xc2, 0xc1, 0xa8, 0x01, 0xc1, 0xc7, 0xf7, 0x55, 0xa4, 0xcf, 0xdc, 0x80, 0xc0, 0x5f, 0x12, 0x44,
+	0x3b, 0x98, 0x37, 0x05, 0x8d, 0xf5, 0x18, 0xfd, 0x75, 0x04, 0x98, 0xc5, 0x24, 0x8e, 0x5a, 0x6c,
+	0x62, 0xf2, 0x7c, 0xbc, 0x13, 0x1e, 0x5f, 0x08, 0xfe, 0x0f, 0xe5, 0x86, 0x24, 0x0e, 0xe9, 0x50,
+	0xda, 0x9e, 0xf1, 0x75, 0x65, 0x2d, 0xa3, 0x2c, 0x89, 0x43, 0x38, 0xb1, 0x33, 0x25, 0xa3, 0x92,
+	0xf1, 0x55, 0x61, 0xdd, 0x41, 0x66, 0x9f, 0x84, 0x76, 0x56, 0x06, 0xec, 0xfc, 0xe6, 0xbf, 0xb1,
+	0xd7, 0x50, 0x19, 0x17, 0x84, 0xb6, 0xf1, 0x79, 0xd1, 0x6c, 0xee, 0x35, 0x7c, 0x81, 0x2b, 0xbf,
+	0x35, 0x50, 0x7e, 0xb2, 0x6d, 0xdd, 0x43, 0x79, 0x12, 0x27, 0x10, 0x31, 0x7c, 0x34, 0xbf, 0x49,
+	0x97, 0x14, 0x56, 0x13, 0xa1, 0x3e, 0x83, 0xe3, 0x16, 0x30, 0x46, 0xd9, 0xfc, 0xee, 0x2c, 0x0a,
+	0x92, 0x2d, 0xc1, 0x51, 0x7e, 0x69, 0xa2, 0x7f, 0x7e, 0xc9, 0x5e, 0xd8, 0xd5, 0x05, 0x12, 0x75,
+	0x13, 0x29, 0xd9, 0xf4, 0x75, 0x35, 0x23, 0xf7, 0xf4, 0x1f, 0xca, 0xdd, 0xbc, 0x9a, 0xdc, 0x57,
+	0x50, 0x5e, 0x68, 0x1d, 0x70, 0x08, 0x75, 0xc4, 0x0b, 0x11, 0xe6, 0x8f, 0x38, 0x84, 0xd6, 0x01,
+	0x2a, 0x0c, 0x12, 0x72, 0x44, 0x9e, 0xe3, 0x84, 0xd0, 0xd8, 0xce, 0xce, 0x3b, 0x70, 0x9a, 0xc5,
+	0xba, 0x8b, 0x16, 0x18, 0x0c, 0x31, 0x0b, 0xb9, 0x9d, 0x2b, 0x99, 0xf3, 0x11, 0x4e, 0x18, 0xca,
+	0x43, 0x84, 0x1e, 0x9e, 0x5c, 0x5a, 0x74, 0x1f, 0x2d, 0x5e, 0xc1, 0x75, 0xcb, 0x47, 0x13, 0xbe,
+	0x69, 0x6b, 0xd2, 0x3f, 0x59, 0x53, 0x7f, 0x70, 0x3a, 0x76, 0x8c, 0xb3, 0xb1, 0x63, 0x7c, 0x1d,
+	0x3b, 0xc6, 0x8b, 0x0b, 0x27, 0x75, 0x76, 0xe1, 0xa4, 0x3e, 0x5d, 0x38, 0xa9, 0xa7, 0x1b, 0x53,
+	0x6f, 0xd1, 0xae, 0xbc, 0x16, 0xd5, 0xcd, 0x2e, 0x26, 0xb1, 0xa7, 0xee, 0x48, 0x35, 0x90, 0xc5,
+	0xc9, 0xf4, 0x63, 0x2c, 0x1f, 0xa8, 0x76, 0x4e, 0xbe, 0xc4, 0x37, 0xbf, 0x07, 0x00, 0x00, 0xff,
+	0xff, 0x92, 0x3e, 0x94, 0x22, 0x4c, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -203,6 +412,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeHistory) > 0 {
+		for iNdEx := len(m.FeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -246,26 +483,36 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Index != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
 		i--
 		dAtA[i] = 0x20
 	}
 	if len(m.Window) > 0 {
-		dAtA4 := make([]byte, len(m.Window)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Window)*10)
+		var j4 int
 		for _, num := range m.Window {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -292,6 +539,164 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PIDState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PIDState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PIDState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PrevError.Size()
+		i -= size
+		if _, err := m.PrevError.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -313,6 +718,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeHistory) > 0 {
+		for _, e := range m.FeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,24 +753,80 @@ func (m *State) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovGenesis(uint64(m.Index))
 	}
+	l = m.PID.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PIDState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Integral.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PrevError.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.GasUsed))
+	}
+	l = m.Utilization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -440,6 +913,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeHistory = append(m.FeeHistory, FeeHistoryEntry{})
+			if err := m.FeeHistory[len(m.FeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -653,6 +1194,486 @@ func (m *State) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PIDState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PIDState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PIDState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
@@ -18,4 +22,75 @@ func TestGenesis(t *testing.T) {
 		gs := types.DefaultAIMDGenesisState()
 		require.NoError(t, gs.ValidateBasic())
 	})
+
+	t.Run("can accept a genesis state with burned fees", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.BurnedFees = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100))
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with invalid burned fees", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.BurnedFees = sdk.Coins{sdk.Coin{Denom: types.DefaultFeeDenom, Amount: math.NewInt(-1)}}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("can accept a genesis state with fee history", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.FeeHistory = []types.FeeHistoryEntry{newFeeHistoryEntry(1), newFeeHistoryEntry(2)}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with more fee history than its size", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Params.FeeHistorySize = 1
+		gs.FeeHistory = []types.FeeHistoryEntry{newFeeHistoryEntry(1), newFeeHistoryEntry(2)}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with unordered fee history", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.FeeHistory = []types.FeeHistoryEntry{newFeeHistoryEntry(2), newFeeHistoryEntry(1)}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with invalid fee history entry", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		entry := newFeeHistoryEntry(1)
+		entry.Rewards = entry.Rewards[1:]
+		gs.FeeHistory = []types.FeeHistoryEntry{entry}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("can accept a genesis state with the pid pricing model", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Params.Model = types.PricingModelPID
+		gs.State.PID.Integral = gs.Params.PID.IntegralLimit.Neg()
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with a nil pid state", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Params.Model = types.PricingModelPID
+		gs.State.PID = types.PIDState{}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("can reject a genesis state with a pid integral above the limit", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Params.Model = types.PricingModelPID
+		gs.State.PID.Integral = gs.Params.PID.IntegralLimit.Add(math.LegacyOneDec())
+		require.Error(t, gs.ValidateBasic())
+	})
+}
+
+func newFeeHistoryEntry(height int64) types.FeeHistoryEntry {
+	return types.FeeHistoryEntry{
+		Height:       height,
+		BaseGasPrice: types.DefaultMinBaseGasPrice,
+		LearningRate: types.DefaultMinLearningRate,
+		GasUsed:      1000,
+		Utilization:  math.LegacyMustNewDecFromStr("0.5"),
+		Rewards:      types.ComputeRewards(nil),
+	}
 }
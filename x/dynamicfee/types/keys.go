@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName is the name of the dynamicfee module.
 	ModuleName = "dynamicfee"
@@ -11,6 +13,9 @@ const (
 	prefixParams = iota + 1
 	prefixState
 	prefixEnableHeight = 3
+	prefixBurnedFees   = 4
+	prefixFeeHistory   = 5
+	prefixTxGasPrices  = 6
 )
 
 var (
@@ -22,4 +27,34 @@ var (
 
 	// KeyEnabledHeight is the store key for the dynamicfee module's enabled height.
 	KeyEnabledHeight = []byte{prefixEnableHeight}
+
+	// KeyPrefixBurnedFees is the store key prefix for the cumulative burned
+	// fees, indexed by denom.
+	KeyPrefixBurnedFees = []byte{prefixBurnedFees}
+
+	// KeyPrefixFeeHistory is the store key prefix for the fee history,
+	// indexed by height.
+	KeyPrefixFeeHistory = []byte{prefixFeeHistory}
+
+	// KeyPrefixTxGasPrices is the store key prefix for the effective gas
+	// prices paid by the txs of the current block.
+	KeyPrefixTxGasPrices = []byte{prefixTxGasPrices}
 )
+
+// BurnedFeesKey returns the store key of the cumulative burned fees for the
+// given denom.
+func BurnedFeesKey(denom string) []byte {
+	return append(KeyPrefixBurnedFees, []byte(denom)...)
+}
+
+// FeeHistoryKey returns the store key of the fee history entry for the given
+// height.
+func FeeHistoryKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, KeyPrefixFeeHistory...), uint64(height))
+}
+
+// TxGasPriceKey returns the store key of the effective gas price paid by the
+// tx identified by txHash.
+func TxGasPriceKey(txHash []byte) []byte {
+	return append(append([]byte{}, KeyPrefixTxGasPrices...), txHash...)
+}
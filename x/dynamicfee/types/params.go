@@ -2,8 +2,13 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
+	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // NewParams instantiates a new EIP-1559 Params object. This params object is
@@ -21,6 +26,15 @@ func NewParams(
 	maxLearningRate math.LegacyDec,
 	feeDenom string,
 	enabled bool,
+	baseFeeDestination BaseFeeDestination,
+	baseFeeRecipient string,
+	feeHistorySize uint64,
+	msgGasPriceMultipliers []MsgGasPriceMultiplier,
+	model PricingModelType,
+	pid PIDParams,
+	feeDenomRates []FeeDenomRate,
+	feeDenomRateMaxAge time.Duration,
+	lanes LaneParams,
 ) Params {
 	return Params{
 		Alpha:                  alpha,
@@ -34,6 +48,15 @@ func NewParams(
 		Window:                 window,
 		FeeDenom:               feeDenom,
 		Enabled:                enabled,
+		BaseFeeDestination:     baseFeeDestination,
+		BaseFeeRecipient:       baseFeeRecipient,
+		FeeHistorySize:         feeHistorySize,
+		MsgGasPriceMultipliers: msgGasPriceMultipliers,
+		Model:                  model,
+		PID:                    pid,
+		FeeDenomRates:          feeDenomRates,
+		FeeDenomRateMaxAge:     feeDenomRateMaxAge,
+		Lanes:                  lanes,
 	}
 }
 
@@ -83,5 +106,211 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("fee denom must be set")
 	}
 
+	if _, ok := BaseFeeDestination_name[int32(p.BaseFeeDestination)]; !ok {
+		return fmt.Errorf("invalid base fee destination %d", p.BaseFeeDestination)
+	}
+
+	if p.BaseFeeDestination == BaseFeeDestinationModuleAccount {
+		if p.BaseFeeRecipient == "" {
+			return fmt.Errorf("base fee recipient must be set when base fee destination is %s", p.BaseFeeDestination)
+		}
+	} else if p.BaseFeeRecipient != "" {
+		return fmt.Errorf("base fee recipient can only be set when base fee destination is %s", BaseFeeDestinationModuleAccount)
+	}
+
+	msgTypeURLs := make(map[string]bool, len(p.MsgGasPriceMultipliers))
+	for _, m := range p.MsgGasPriceMultipliers {
+		if !strings.HasPrefix(m.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid gas price multiplier msg type url %q", m.MsgTypeUrl)
+		}
+		if msgTypeURLs[m.MsgTypeUrl] {
+			return fmt.Errorf("duplicate gas price multiplier for msg type url %s", m.MsgTypeUrl)
+		}
+		msgTypeURLs[m.MsgTypeUrl] = true
+
+		if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() {
+			return fmt.Errorf("gas price multiplier for msg type url %s cannot be nil and must be greater than zero", m.MsgTypeUrl)
+		}
+	}
+
+	feeDenoms := make(map[string]bool, len(p.FeeDenomRates))
+	for _, r := range p.FeeDenomRates {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom rate denom: %w", err)
+		}
+		if r.Denom == p.FeeDenom {
+			return fmt.Errorf("fee denom rate denom cannot be the fee denom %s", p.FeeDenom)
+		}
+		if feeDenoms[r.Denom] {
+			return fmt.Errorf("duplicate fee denom rate for denom %s", r.Denom)
+		}
+		feeDenoms[r.Denom] = true
+
+		if r.Rate.IsNil() || !r.Rate.IsPositive() {
+			return fmt.Errorf("fee denom rate for denom %s cannot be nil and must be greater than zero", r.Denom)
+		}
+	}
+
+	if p.FeeDenomRateMaxAge < 0 {
+		return fmt.Errorf("fee denom rate max age cannot be negative")
+	}
+
+	if err := p.Lanes.ValidateBasic(); err != nil {
+		return err
+	}
+
+	model, err := GetPricingModel(p.Model)
+	if err != nil {
+		return err
+	}
+
+	return model.ValidateParams(*p)
+}
+
+// NewPIDParams instantiates a new PIDParams object.
+func NewPIDParams(kp, ki, kd, integralLimit math.LegacyDec) PIDParams {
+	return PIDParams{
+		Kp:            kp,
+		Ki:            ki,
+		Kd:            kd,
+		IntegralLimit: integralLimit,
+	}
+}
+
+// ValidateBasic performs basic validation on the PID parameters.
+func (p *PIDParams) ValidateBasic() error {
+	if p.Kp.IsNil() || p.Kp.IsNegative() {
+		return fmt.Errorf("pid kp cannot be nil and must be between [0, inf)")
+	}
+
+	if p.Ki.IsNil() || p.Ki.IsNegative() {
+		return fmt.Errorf("pid ki cannot be nil and must be between [0, inf)")
+	}
+
+	if p.Kd.IsNil() || p.Kd.IsNegative() {
+		return fmt.Errorf("pid kd cannot be nil and must be between [0, inf)")
+	}
+
+	if p.IntegralLimit.IsNil() || p.IntegralLimit.IsNegative() {
+		return fmt.Errorf("pid integral limit cannot be nil and must be between [0, inf)")
+	}
+
 	return nil
 }
+
+// NewLaneParams instantiates a new LaneParams object.
+func NewLaneParams(governance, ibc math.LegacyDec) LaneParams {
+	return LaneParams{
+		Governance: governance,
+		IBC:        ibc,
+	}
+}
+
+// ValidateBasic performs basic validation on the lane parameters.
+func (p *LaneParams) ValidateBasic() error {
+	if p.Governance.IsNil() || p.Governance.IsNegative() || p.Governance.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("governance lane cannot be nil and must be between [0, 1]")
+	}
+
+	if p.IBC.IsNil() || p.IBC.IsNegative() || p.IBC.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("ibc lane cannot be nil and must be between [0, 1]")
+	}
+
+	if p.Governance.Add(p.IBC).GT(math.LegacyOneDec()) {
+		return fmt.Errorf("governance and ibc lanes cannot exceed 1 in total")
+	}
+
+	return nil
+}
+
+// Default returns the share of the block space left to the default lane
+// once the governance and IBC lanes are reserved.
+func (p *LaneParams) Default() math.LegacyDec {
+	return math.LegacyOneDec().Sub(p.Governance).Sub(p.IBC)
+}
+
+// MsgsGasPriceMultiplier returns the highest gas price multiplier among msgs,
+// the multiplier of a msg without MsgGasPriceMultiplier being 1. This ensures
+// that a discounted msg cannot be used to lower the price of other msgs
+// bundled in the same tx. An authz MsgExec is priced as the msgs it executes,
+// so that wrapping a msg neither avoids nor changes its multiplier.
+func (p *Params) MsgsGasPriceMultiplier(msgs []sdk.Msg) math.LegacyDec {
+	if len(p.MsgGasPriceMultipliers) == 0 || len(msgs) == 0 {
+		return math.LegacyOneDec()
+	}
+
+	var multiplier math.LegacyDec
+	for _, msg := range msgs {
+		m := p.msgGasPriceMultiplier(sdk.MsgTypeURL(msg))
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			// the msgs of a decoded tx are always unpacked, so they can only
+			// be missing if the MsgExec is invalid anyway.
+			if execMsgs, err := execMsg.GetMessages(); err == nil && len(execMsgs) > 0 {
+				m = p.MsgsGasPriceMultiplier(execMsgs)
+			}
+		}
+		if multiplier.IsNil() || m.GT(multiplier) {
+			multiplier = m
+		}
+	}
+	return multiplier
+}
+
+// msgGasPriceMultiplier returns the gas price multiplier of msgTypeURL, or 1 if
+// there's none.
+func (p *Params) msgGasPriceMultiplier(msgTypeURL string) math.LegacyDec {
+	for _, m := range p.MsgGasPriceMultipliers {
+		if m.MsgTypeUrl == msgTypeURL {
+			return m.Multiplier
+		}
+	}
+	return math.LegacyOneDec()
+}
+
+// NewFeeDenomRate instantiates a new FeeDenomRate object.
+func NewFeeDenomRate(denom string, rate math.LegacyDec) FeeDenomRate {
+	return FeeDenomRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// IsStale returns true if the rate was last updated more than maxAge before
+// now. A zero maxAge disables the staleness check.
+func (r FeeDenomRate) IsStale(now time.Time, maxAge time.Duration) bool {
+	return maxAge > 0 && now.Sub(r.UpdatedAt) > maxAge
+}
+
+// GetFeeDenomRate returns the fee denom rate of denom, and false if denom is
+// not whitelisted.
+func (p *Params) GetFeeDenomRate(denom string) (FeeDenomRate, bool) {
+	for _, r := range p.FeeDenomRates {
+		if r.Denom == denom {
+			return r, true
+		}
+	}
+	return FeeDenomRate{}, false
+}
+
+// StampFeeDenomRates sets the update time of the fee denom rates that are new
+// or changed compared to prev, or that have no update time, to now. The other
+// rates keep their update time from prev.
+func (p *Params) StampFeeDenomRates(prev Params, now time.Time) {
+	if len(p.FeeDenomRates) == 0 {
+		return
+	}
+
+	// copy the rates so that the slice p shares with its caller is not
+	// modified.
+	rates := make([]FeeDenomRate, len(p.FeeDenomRates))
+	for i, r := range p.FeeDenomRates {
+		rates[i] = r
+		prevRate, ok := prev.GetFeeDenomRate(r.Denom)
+		if ok && !prevRate.UpdatedAt.IsZero() && prevRate.Rate.Equal(r.Rate) {
+			rates[i].UpdatedAt = prevRate.UpdatedAt
+			continue
+		}
+		rates[i].UpdatedAt = now
+	}
+	p.FeeDenomRates = rates
+}
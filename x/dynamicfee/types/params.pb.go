@@ -9,15 +9,20 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,83 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeDestination enumerates the possible destinations of the base fee.
+type BaseFeeDestination int32
+
+const (
+	// BASE_FEE_DESTINATION_UNSPECIFIED leaves the base fee in the fee
+	// collector, along with the tip.
+	BaseFeeDestinationUnspecified BaseFeeDestination = 0
+	// BASE_FEE_DESTINATION_BURN burns the base fee.
+	BaseFeeDestinationBurn BaseFeeDestination = 1
+	// BASE_FEE_DESTINATION_COMMUNITY_POOL sends the base fee to the community
+	// pool.
+	BaseFeeDestinationCommunityPool BaseFeeDestination = 2
+	// BASE_FEE_DESTINATION_MODULE_ACCOUNT sends the base fee to the module
+	// account named by base_fee_recipient.
+	BaseFeeDestinationModuleAccount BaseFeeDestination = 3
+)
+
+var BaseFeeDestination_name = map[int32]string{
+	0: "BASE_FEE_DESTINATION_UNSPECIFIED",
+	1: "BASE_FEE_DESTINATION_BURN",
+	2: "BASE_FEE_DESTINATION_COMMUNITY_POOL",
+	3: "BASE_FEE_DESTINATION_MODULE_ACCOUNT",
+}
+
+var BaseFeeDestination_value = map[string]int32{
+	"BASE_FEE_DESTINATION_UNSPECIFIED":    0,
+	"BASE_FEE_DESTINATION_BURN":           1,
+	"BASE_FEE_DESTINATION_COMMUNITY_POOL": 2,
+	"BASE_FEE_DESTINATION_MODULE_ACCOUNT": 3,
+}
+
+func (x BaseFeeDestination) String() string {
+	return proto.EnumName(BaseFeeDestination_name, int32(x))
+}
+
+func (BaseFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{0}
+}
+
+// PricingModelType enumerates the available pricing models.
+type PricingModelType int32
+
+const (
+	// PRICING_MODEL_TYPE_AIMD is the AIMD EIP-1559 pricing model, where the
+	// learning rate is adjusted according to the block gas of the window. This
+	// is the zero value so that the params stored before the introduction of
+	// the pricing models keep their behavior.
+	PricingModelAIMD PricingModelType = 0
+	// PRICING_MODEL_TYPE_EIP1559 is the classic EIP-1559 pricing model, where
+	// the learning rate is fixed to max_learning_rate.
+	PricingModelEIP1559 PricingModelType = 1
+	// PRICING_MODEL_TYPE_PID is a PID controller pricing model, which adjusts
+	// the base gas price to drive the block utilization towards
+	// target_block_utilization.
+	PricingModelPID PricingModelType = 2
+)
+
+var PricingModelType_name = map[int32]string{
+	0: "PRICING_MODEL_TYPE_AIMD",
+	1: "PRICING_MODEL_TYPE_EIP1559",
+	2: "PRICING_MODEL_TYPE_PID",
+}
+
+var PricingModelType_value = map[string]int32{
+	"PRICING_MODEL_TYPE_AIMD":    0,
+	"PRICING_MODEL_TYPE_EIP1559": 1,
+	"PRICING_MODEL_TYPE_PID":     2,
+}
+
+func (x PricingModelType) String() string {
+	return proto.EnumName(PricingModelType_name, int32(x))
+}
+
+func (PricingModelType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{1}
+}
+
 // Params contains the required set of parameters for the EIP1559 dynamic fee
 // pricing implementation.
 type Params struct {
@@ -68,6 +150,37 @@ type Params struct {
 	// Enabled is a boolean that determines whether the EIP1559 dynamic fee
 	// pricing is enabled.
 	Enabled bool `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// BaseFeeDestination determines where the base fee portion of the fees
+	// (gas used * base gas price) is sent once the tx has been executed. Only
+	// the tip remains in the fee collector for distribution.
+	BaseFeeDestination BaseFeeDestination `protobuf:"varint,13,opt,name=base_fee_destination,json=baseFeeDestination,proto3,enum=hikari.dynamicfee.v1.BaseFeeDestination" json:"base_fee_destination,omitempty"`
+	// BaseFeeRecipient is the name of the module account receiving the base
+	// fee when base_fee_destination is BASE_FEE_DESTINATION_MODULE_ACCOUNT.
+	BaseFeeRecipient string `protobuf:"bytes,14,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+	// FeeHistorySize is the number of past blocks for which the fee history is
+	// kept. Older entries are pruned, 0 disables the fee history.
+	FeeHistorySize uint64 `protobuf:"varint,15,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
+	// MsgGasPriceMultipliers are the multipliers applied to the min gas price
+	// of the txs containing the given Msg types. A tx must pay the min gas
+	// price multiplied by the highest multiplier among its msgs, msgs without
+	// multiplier count as 1.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,16,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers"`
+	// Model is the pricing model used to update the base gas price at the end
+	// of each block.
+	Model PricingModelType `protobuf:"varint,17,opt,name=model,proto3,enum=hikari.dynamicfee.v1.PricingModelType" json:"model,omitempty"`
+	// PID contains the parameters of the PRICING_MODEL_TYPE_PID pricing model.
+	PID PIDParams `protobuf:"bytes,18,opt,name=pid,proto3" json:"pid"`
+	// FeeDenomRates is the whitelist of the denoms, in addition to fee_denom
+	// and the denoms of the chain's denom resolver, that can be used to pay
+	// fees, along with their conversion rate to fee_denom.
+	FeeDenomRates []FeeDenomRate `protobuf:"bytes,19,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates"`
+	// FeeDenomRateMaxAge is the duration after which a fee denom rate that
+	// was not updated is considered stale, and its denom can no longer be used
+	// to pay fees. 0 disables the staleness check.
+	FeeDenomRateMaxAge time.Duration `protobuf:"bytes,20,opt,name=fee_denom_rate_max_age,json=feeDenomRateMaxAge,proto3,stdduration" json:"fee_denom_rate_max_age"`
+	// Lanes are the shares of the block space reserved to the mempool lanes
+	// when building block proposals.
+	Lanes LaneParams `protobuf:"bytes,21,opt,name=lanes,proto3" json:"lanes"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -131,43 +244,381 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetBaseFeeDestination() BaseFeeDestination {
+	if m != nil {
+		return m.BaseFeeDestination
+	}
+	return BaseFeeDestinationUnspecified
+}
+
+func (m *Params) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetFeeHistorySize() uint64 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetModel() PricingModelType {
+	if m != nil {
+		return m.Model
+	}
+	return PricingModelAIMD
+}
+
+func (m *Params) GetPID() PIDParams {
+	if m != nil {
+		return m.PID
+	}
+	return PIDParams{}
+}
+
+func (m *Params) GetFeeDenomRates() []FeeDenomRate {
+	if m != nil {
+		return m.FeeDenomRates
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenomRateMaxAge() time.Duration {
+	if m != nil {
+		return m.FeeDenomRateMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetLanes() LaneParams {
+	if m != nil {
+		return m.Lanes
+	}
+	return LaneParams{}
+}
+
+// LaneParams contains the shares of the block space, in bytes and in gas,
+// reserved to the mempool lanes. The governance and IBC lanes are filled
+// first, up to their share, and the default lane gets the rest of the block
+// space, which is at least 1 - governance - ibc.
+type LaneParams struct {
+	// Governance is the share of the block space reserved to the txs that only
+	// contain governance votes.
+	//
+	// Must be between [0, 1].
+	Governance cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=governance,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"governance"`
+	// IBC is the share of the block space reserved to the txs that only
+	// contain IBC relayer messages.
+	//
+	// Must be between [0, 1], and governance + ibc must be <= 1.
+	IBC cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ibc,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ibc"`
+}
+
+func (m *LaneParams) Reset()         { *m = LaneParams{} }
+func (m *LaneParams) String() string { return proto.CompactTextString(m) }
+func (*LaneParams) ProtoMessage()    {}
+func (*LaneParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{1}
+}
+func (m *LaneParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaneParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaneParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaneParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaneParams.Merge(m, src)
+}
+func (m *LaneParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaneParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaneParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaneParams proto.InternalMessageInfo
+
+// FeeDenomRate is the conversion rate of a whitelisted fee denom to the fee
+// denom.
+type FeeDenomRate struct {
+	// Denom is the whitelisted fee denom, e.g. an IBC denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the amount of fee_denom worth one unit of denom. The gas price in
+	// denom is the gas price in fee_denom divided by the rate.
+	//
+	// Must be > 0.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// UpdatedAt is the block time at which the rate was last changed. It is
+	// set by the module when the params are updated, the value provided in
+	// MsgUpdateParams is ignored.
+	UpdatedAt time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+}
+
+func (m *FeeDenomRate) Reset()         { *m = FeeDenomRate{} }
+func (m *FeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRate) ProtoMessage()    {}
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{2}
+}
+func (m *FeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRate.Merge(m, src)
+}
+func (m *FeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRate proto.InternalMessageInfo
+
+func (m *FeeDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomRate) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// MsgGasPriceMultiplier is the multiplier applied to the min gas price of the
+// txs containing a Msg type.
+type MsgGasPriceMultiplier struct {
+	// MsgTypeUrl is the type URL of the Msg, e.g.
+	// /cosmos.bank.v1beta1.MsgSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is applied to the min gas price. Must be > 0, values lower
+	// than 1 are discounts.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *MsgGasPriceMultiplier) Reset()         { *m = MsgGasPriceMultiplier{} }
+func (m *MsgGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceMultiplier) ProtoMessage()    {}
+func (*MsgGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{3}
+}
+func (m *MsgGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceMultiplier.Merge(m, src)
+}
+func (m *MsgGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *MsgGasPriceMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// PIDParams contains the parameters of the PID controller pricing model. The
+// error is the deviation of the block gas from the target block gas,
+// relative to the latter. The base gas price is multiplied by
+// 1 + kp * error + ki * integral + kd * derivative.
+type PIDParams struct {
+	// Kp is the proportional gain.
+	//
+	// Must be >= 0.
+	Kp cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=kp,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"kp"`
+	// Ki is the integral gain.
+	//
+	// Must be >= 0.
+	Ki cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ki,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ki"`
+	// Kd is the derivative gain.
+	//
+	// Must be >= 0.
+	Kd cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=kd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"kd"`
+	// IntegralLimit bounds the absolute value of the integral of the error, to
+	// prevent the integral term from winding up during long periods of
+	// congestion or inactivity.
+	//
+	// Must be >= 0.
+	IntegralLimit cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=integral_limit,json=integralLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral_limit"`
+}
+
+func (m *PIDParams) Reset()         { *m = PIDParams{} }
+func (m *PIDParams) String() string { return proto.CompactTextString(m) }
+func (*PIDParams) ProtoMessage()    {}
+func (*PIDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e19436d96abcb2de, []int{4}
+}
+func (m *PIDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PIDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PIDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PIDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PIDParams.Merge(m, src)
+}
+func (m *PIDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PIDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PIDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PIDParams proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("hikari.dynamicfee.v1.BaseFeeDestination", BaseFeeDestination_name, BaseFeeDestination_value)
+	proto.RegisterEnum("hikari.dynamicfee.v1.PricingModelType", PricingModelType_name, PricingModelType_value)
 	proto.RegisterType((*Params)(nil), "hikari.dynamicfee.v1.Params")
+	proto.RegisterType((*LaneParams)(nil), "hikari.dynamicfee.v1.LaneParams")
+	proto.RegisterType((*FeeDenomRate)(nil), "hikari.dynamicfee.v1.FeeDenomRate")
+	proto.RegisterType((*MsgGasPriceMultiplier)(nil), "hikari.dynamicfee.v1.MsgGasPriceMultiplier")
+	proto.RegisterType((*PIDParams)(nil), "hikari.dynamicfee.v1.PIDParams")
 }
 
 func init() { proto.RegisterFile("hikari/dynamicfee/v1/params.proto", fileDescriptor_e19436d96abcb2de) }
 
 var fileDescriptor_e19436d96abcb2de = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x1b, 0x58, 0xbb, 0xd6, 0x20, 0x21, 0xac, 0x31, 0x99, 0x4d, 0xca, 0x0a, 0xa7, 0x5e,
-	0x9a, 0xa8, 0xe2, 0xc0, 0xbd, 0x14, 0x95, 0xc3, 0x90, 0x46, 0x24, 0x2e, 0x48, 0x10, 0x7d, 0x49,
-	0xbe, 0x26, 0x56, 0x63, 0xbb, 0x8a, 0xdd, 0x2d, 0xe5, 0x57, 0xf0, 0x63, 0xf8, 0x11, 0x3b, 0x4e,
-	0x9c, 0x10, 0x87, 0x09, 0xb5, 0x77, 0x7e, 0x03, 0x8a, 0x13, 0x54, 0xe0, 0x98, 0xdd, 0xf2, 0x3a,
-	0xdf, 0xfb, 0xbc, 0xfe, 0x2c, 0xbd, 0xe4, 0x59, 0xc6, 0x97, 0x50, 0x70, 0x3f, 0xd9, 0x48, 0x10,
-	0x3c, 0x5e, 0x20, 0xfa, 0x97, 0x13, 0x7f, 0x05, 0x05, 0x08, 0xed, 0xad, 0x0a, 0x65, 0x14, 0x3d,
-	0xaa, 0x47, 0xbc, 0xfd, 0x88, 0x77, 0x39, 0x39, 0x79, 0x1a, 0x2b, 0x2d, 0x94, 0x0e, 0xed, 0x8c,
-	0x5f, 0x8b, 0xda, 0x70, 0x72, 0x94, 0xaa, 0x54, 0xd5, 0xe7, 0xd5, 0x57, 0x7d, 0xfa, 0xfc, 0x57,
-	0x97, 0xf4, 0x2e, 0x2c, 0x97, 0xce, 0x49, 0x17, 0xf2, 0x55, 0x06, 0xcc, 0x19, 0x3a, 0xa3, 0xc1,
-	0x74, 0x72, 0x7d, 0x7b, 0xd6, 0xf9, 0x71, 0x7b, 0x76, 0x5a, 0x53, 0x74, 0xb2, 0xf4, 0xb8, 0xf2,
-	0x05, 0x98, 0xcc, 0x3b, 0xc7, 0x14, 0xe2, 0xcd, 0x0c, 0xe3, 0x6f, 0x5f, 0xc7, 0xa4, 0x09, 0x99,
-	0x61, 0x1c, 0xd4, 0x7e, 0xfa, 0x9a, 0x1c, 0x44, 0x68, 0x80, 0xdd, 0x6b, 0xcb, 0xb1, 0xf6, 0xea,
-	0x3e, 0x29, 0x08, 0x01, 0xec, 0x7e, 0xeb, 0xfb, 0x58, 0x3f, 0xfd, 0x44, 0xa8, 0xe0, 0x32, 0x8c,
-	0x40, 0x63, 0x98, 0x42, 0xf5, 0x38, 0x3c, 0x46, 0xd6, 0x6d, 0x4b, 0x7d, 0x24, 0xb8, 0x9c, 0x82,
-	0xc6, 0x39, 0xe8, 0x8b, 0x8a, 0x44, 0x97, 0x84, 0x19, 0x28, 0x52, 0x34, 0x61, 0x94, 0xab, 0x78,
-	0x19, 0xae, 0x0d, 0xcf, 0xf9, 0x67, 0x30, 0x5c, 0x49, 0xd6, 0x6b, 0x9b, 0x72, 0x5c, 0x23, 0xa7,
-	0x15, 0xf1, 0xfd, 0x1e, 0x48, 0x27, 0xe4, 0x49, 0x82, 0x0b, 0x58, 0xe7, 0x26, 0x14, 0x50, 0x36,
-	0x89, 0x29, 0x68, 0x76, 0x38, 0x74, 0x46, 0x07, 0x01, 0x6d, 0x7e, 0xbe, 0x85, 0xd2, 0x5a, 0xe7,
-	0xa0, 0xe9, 0x47, 0xf2, 0xb8, 0xda, 0x3f, 0x47, 0x28, 0x24, 0x97, 0x69, 0x58, 0x80, 0x41, 0xd6,
-	0xbf, 0xcb, 0xfa, 0xe7, 0x0d, 0x2a, 0x00, 0x83, 0x16, 0x0f, 0xe5, 0x7f, 0xf8, 0x41, 0x7b, 0x3c,
-	0x94, 0xff, 0xe0, 0x8f, 0x49, 0xef, 0x8a, 0xcb, 0x44, 0x5d, 0x31, 0x62, 0x37, 0x6c, 0x14, 0x3d,
-	0x25, 0x83, 0x05, 0x62, 0x98, 0xa0, 0x54, 0x82, 0x3d, 0xa8, 0xe2, 0x82, 0xfe, 0x02, 0x71, 0x56,
-	0x69, 0xca, 0xc8, 0x21, 0x4a, 0x88, 0x72, 0x4c, 0xd8, 0xc3, 0xa1, 0x33, 0xea, 0x07, 0x7f, 0xe4,
-	0xf4, 0xdd, 0xf5, 0xd6, 0x75, 0x6e, 0xb6, 0xae, 0xf3, 0x73, 0xeb, 0x3a, 0x5f, 0x76, 0x6e, 0xe7,
-	0x66, 0xe7, 0x76, 0xbe, 0xef, 0xdc, 0xce, 0x87, 0x97, 0x29, 0x37, 0xd9, 0x3a, 0xf2, 0x62, 0x25,
-	0xfc, 0x37, 0xb6, 0x5c, 0xe3, 0x57, 0x19, 0x70, 0xe9, 0xd7, 0x4d, 0x1b, 0xc7, 0x56, 0x94, 0x7f,
-	0x97, 0xd2, 0x6c, 0x56, 0xa8, 0xa3, 0x9e, 0xad, 0xd2, 0x8b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe0, 0xb6, 0x3d, 0xb6, 0x03, 0x00, 0x00,
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x86, 0x4d, 0xf9, 0x12, 0x7b, 0x9c, 0xd8, 0xca, 0xc4, 0x71, 0x68, 0x05, 0x95, 0x18, 0x07,
+	0x28, 0x84, 0xb4, 0x91, 0x60, 0x17, 0x41, 0x1a, 0xa0, 0x28, 0xa0, 0x9b, 0x1d, 0x16, 0xba, 0x30,
+	0xb4, 0x84, 0x36, 0x01, 0x5a, 0x62, 0x44, 0x8e, 0xa8, 0x81, 0x78, 0x03, 0x39, 0x4c, 0xa4, 0x3c,
+	0x41, 0x21, 0x74, 0x91, 0x65, 0x37, 0x5a, 0x75, 0x59, 0xa0, 0xe8, 0xa2, 0xdd, 0xf4, 0x09, 0xb2,
+	0x0c, 0xba, 0x2a, 0xba, 0x48, 0x0b, 0xe7, 0x21, 0xba, 0x2d, 0x66, 0x48, 0xd9, 0x8a, 0x25, 0x03,
+	0x05, 0xbb, 0xf3, 0xcc, 0x9c, 0xff, 0xe3, 0x39, 0x73, 0xce, 0xfc, 0x32, 0xb8, 0xd3, 0x27, 0x03,
+	0xe4, 0x93, 0xa2, 0x31, 0x72, 0x90, 0x4d, 0xf4, 0x1e, 0xc6, 0xc5, 0xe7, 0x07, 0x45, 0x0f, 0xf9,
+	0xc8, 0x0e, 0x0a, 0x9e, 0xef, 0x52, 0x17, 0xee, 0x44, 0x21, 0x85, 0xf3, 0x90, 0xc2, 0xf3, 0x83,
+	0xcc, 0x9e, 0xee, 0x06, 0xb6, 0x1b, 0x68, 0x3c, 0xa6, 0x18, 0x2d, 0x22, 0x41, 0x66, 0xc7, 0x74,
+	0x4d, 0x37, 0xda, 0x67, 0x7f, 0xc5, 0xbb, 0x59, 0xd3, 0x75, 0x4d, 0x0b, 0x17, 0xf9, 0xaa, 0x1b,
+	0xf6, 0x8a, 0x46, 0xe8, 0x23, 0x4a, 0x5c, 0x27, 0x3e, 0xcf, 0x5d, 0x3c, 0xa7, 0xc4, 0xc6, 0x01,
+	0x45, 0xb6, 0x17, 0x05, 0xec, 0xff, 0x03, 0xc0, 0x9a, 0xc2, 0x13, 0x83, 0xc7, 0x60, 0x15, 0x59,
+	0x5e, 0x1f, 0x89, 0x82, 0x24, 0xe4, 0x37, 0xca, 0x07, 0xaf, 0xdf, 0xe6, 0x96, 0xfe, 0x7c, 0x9b,
+	0xbb, 0x1d, 0xa5, 0x11, 0x18, 0x83, 0x02, 0x71, 0x8b, 0x36, 0xa2, 0xfd, 0x42, 0x1d, 0x9b, 0x48,
+	0x1f, 0x55, 0xb1, 0xfe, 0xfb, 0x2f, 0xf7, 0x41, 0x9c, 0x65, 0x15, 0xeb, 0x6a, 0xa4, 0x87, 0x35,
+	0xb0, 0xd2, 0xc5, 0x14, 0x89, 0xa9, 0xa4, 0x1c, 0x2e, 0x67, 0xf9, 0x98, 0xc8, 0xb6, 0x91, 0xb8,
+	0x9c, 0x38, 0x1f, 0xae, 0x87, 0xdf, 0x00, 0x68, 0x13, 0x47, 0xeb, 0xa2, 0x00, 0x6b, 0x26, 0x62,
+	0xb7, 0x4b, 0x74, 0x2c, 0xae, 0x26, 0xa5, 0x6e, 0xdb, 0xc4, 0x29, 0xa3, 0x00, 0x1f, 0xa3, 0x40,
+	0x61, 0x24, 0x38, 0x00, 0x22, 0x45, 0xbe, 0x89, 0xa9, 0xd6, 0xb5, 0x5c, 0x7d, 0xa0, 0x85, 0x94,
+	0x58, 0xe4, 0x25, 0x6f, 0x83, 0xb8, 0x96, 0xf4, 0x2b, 0xbb, 0x11, 0xb2, 0xcc, 0x88, 0x9d, 0x73,
+	0x20, 0x3c, 0x00, 0x37, 0x0d, 0xdc, 0x43, 0xa1, 0x45, 0x35, 0x1b, 0x0d, 0xe3, 0x2f, 0x9a, 0x28,
+	0x10, 0xaf, 0x48, 0x42, 0x7e, 0x45, 0x85, 0xf1, 0x61, 0x03, 0x0d, 0xb9, 0xf4, 0x18, 0x05, 0xf0,
+	0x6b, 0x70, 0x9d, 0xd5, 0x6f, 0x61, 0xe4, 0x3b, 0xc4, 0x31, 0x35, 0x1f, 0x51, 0x2c, 0xae, 0xff,
+	0x9f, 0xf2, 0xeb, 0x31, 0x4a, 0x45, 0x14, 0x73, 0x3c, 0x1a, 0x5e, 0xc0, 0x6f, 0x24, 0xc7, 0xa3,
+	0xe1, 0x7b, 0xf8, 0x5d, 0xb0, 0xf6, 0x82, 0x38, 0x86, 0xfb, 0x42, 0x04, 0xbc, 0xc2, 0x78, 0x05,
+	0x6f, 0x83, 0x8d, 0x1e, 0xc6, 0x9a, 0x81, 0x1d, 0xd7, 0x16, 0x37, 0xd9, 0xe7, 0xd4, 0xf5, 0x1e,
+	0xc6, 0x55, 0xb6, 0x86, 0x22, 0xb8, 0x82, 0x1d, 0xd4, 0xb5, 0xb0, 0x21, 0x5e, 0x95, 0x84, 0xfc,
+	0xba, 0x3a, 0x5d, 0xc2, 0x67, 0x60, 0x87, 0x0f, 0x42, 0xa4, 0x0d, 0x28, 0x71, 0xa2, 0x46, 0x5d,
+	0x93, 0x84, 0xfc, 0xd6, 0x61, 0xbe, 0xb0, 0xe8, 0x5d, 0x16, 0x58, 0xbb, 0x8f, 0x18, 0xfb, 0x2c,
+	0x5e, 0x85, 0xdd, 0xb9, 0x3d, 0xf8, 0x31, 0x80, 0x67, 0x6c, 0x1f, 0xeb, 0xc4, 0x23, 0xd8, 0xa1,
+	0xe2, 0x16, 0xcf, 0x2d, 0x1d, 0xc7, 0xab, 0xd3, 0x7d, 0x98, 0x07, 0x69, 0x16, 0xd8, 0x27, 0x01,
+	0x75, 0xfd, 0x91, 0x16, 0x90, 0x97, 0x58, 0xdc, 0xe6, 0x25, 0x6e, 0xf5, 0x30, 0x7e, 0x1c, 0x6d,
+	0x9f, 0x90, 0x97, 0x18, 0x5a, 0x60, 0xcf, 0x0e, 0xcc, 0xf3, 0xd9, 0xd5, 0xec, 0xd0, 0xa2, 0xc4,
+	0xb3, 0x08, 0xf6, 0x03, 0x31, 0x2d, 0x2d, 0xe7, 0x37, 0x0f, 0x3f, 0x5a, 0x9c, 0x78, 0x23, 0x30,
+	0xa7, 0x63, 0xda, 0x38, 0xd3, 0x94, 0x57, 0x58, 0x5b, 0xd4, 0x5d, 0x7b, 0xd1, 0x61, 0x00, 0x3f,
+	0x03, 0xab, 0xb6, 0x6b, 0x60, 0x4b, 0xbc, 0xce, 0xaf, 0xe4, 0xc3, 0xc5, 0x64, 0x26, 0x23, 0x8e,
+	0xd9, 0x60, 0x91, 0xed, 0x91, 0x87, 0xd5, 0x48, 0x04, 0x3f, 0x07, 0xcb, 0x1e, 0x31, 0x44, 0x28,
+	0x09, 0xf9, 0xcd, 0xc3, 0xdc, 0x25, 0x5a, 0xb9, 0x1a, 0x79, 0x4e, 0x79, 0x93, 0x65, 0x72, 0xfa,
+	0x36, 0xb7, 0xac, 0xc8, 0x55, 0x95, 0x09, 0xa1, 0x02, 0xb6, 0xcf, 0xda, 0xca, 0x47, 0x29, 0x10,
+	0x6f, 0xf0, 0x0a, 0xf7, 0x17, 0xb3, 0x8e, 0xe2, 0x96, 0xb3, 0x59, 0x89, 0x0b, 0xbb, 0xd6, 0x9b,
+	0xd9, 0x0b, 0xe0, 0x97, 0x60, 0xf7, 0x7d, 0x22, 0x7f, 0x38, 0xc8, 0xc4, 0xe2, 0x0e, 0x4f, 0x72,
+	0xaf, 0x10, 0x99, 0x64, 0x61, 0x6a, 0x92, 0x85, 0x6a, 0x6c, 0xa2, 0xe5, 0x75, 0xc6, 0xfb, 0xfe,
+	0xaf, 0x9c, 0xa0, 0xc2, 0x59, 0x66, 0x03, 0x0d, 0x4b, 0x26, 0x66, 0x17, 0x65, 0x21, 0x07, 0x07,
+	0xe2, 0x4d, 0xce, 0x91, 0x16, 0x27, 0x58, 0x47, 0x0e, 0x8e, 0xab, 0x8d, 0xd2, 0x8b, 0x44, 0xfb,
+	0x3f, 0x0a, 0x00, 0x9c, 0x9f, 0xc1, 0x27, 0x00, 0x98, 0xee, 0x73, 0xec, 0x3b, 0xc8, 0xd1, 0x71,
+	0x72, 0x0b, 0x9e, 0x81, 0xc0, 0x2f, 0xc0, 0x32, 0xe9, 0xea, 0xb1, 0x0d, 0x7f, 0xfa, 0x1f, 0x58,
+	0xac, 0x11, 0x72, 0xb9, 0x72, 0x01, 0xc9, 0x20, 0xfb, 0x3f, 0x0b, 0xe0, 0xea, 0xec, 0x55, 0xc3,
+	0x1d, 0xb0, 0x1a, 0x3d, 0x3d, 0x9e, 0xaa, 0x1a, 0x2d, 0x98, 0xf5, 0xf3, 0xe7, 0x9f, 0xdc, 0xfa,
+	0x99, 0x1c, 0x56, 0x00, 0x08, 0x3d, 0x03, 0x51, 0x6c, 0x68, 0x88, 0x72, 0xff, 0xdf, 0x3c, 0xcc,
+	0xcc, 0xb5, 0xa9, 0x3d, 0xfd, 0x2d, 0x8b, 0xfa, 0xf4, 0x8a, 0xf5, 0x69, 0x23, 0xd6, 0x95, 0xe8,
+	0xfe, 0x77, 0x02, 0xb8, 0xb9, 0x70, 0xfe, 0xa1, 0x04, 0xae, 0xb2, 0xf7, 0x44, 0x47, 0x1e, 0xd6,
+	0x42, 0xdf, 0x8a, 0x4b, 0x00, 0x76, 0x60, 0xb2, 0x49, 0xee, 0xf8, 0x16, 0xeb, 0xc6, 0xf9, 0x1b,
+	0x4b, 0x5e, 0xcd, 0x0c, 0x64, 0xff, 0xa7, 0x14, 0xd8, 0x38, 0x1b, 0x7c, 0x58, 0x02, 0xa9, 0x81,
+	0x97, 0xbc, 0xcd, 0xa9, 0x81, 0xc7, 0x11, 0x24, 0x79, 0x6e, 0xa9, 0x01, 0xe1, 0x08, 0x23, 0xf9,
+	0xef, 0x6b, 0x6a, 0x60, 0xc0, 0xaf, 0xc0, 0x16, 0x71, 0x28, 0x36, 0x7d, 0x64, 0x69, 0x16, 0xb1,
+	0x09, 0x15, 0x57, 0x92, 0xe2, 0xae, 0x4d, 0x41, 0x75, 0xc6, 0xb9, 0xf7, 0x5b, 0x0a, 0xc0, 0x79,
+	0xe3, 0x85, 0xc7, 0x40, 0x2a, 0x97, 0x4e, 0x6a, 0xda, 0x51, 0xad, 0xa6, 0x55, 0x6b, 0x27, 0x6d,
+	0xb9, 0x59, 0x6a, 0xcb, 0xad, 0xa6, 0xd6, 0x69, 0x9e, 0x28, 0xb5, 0x8a, 0x7c, 0x24, 0xd7, 0xaa,
+	0xe9, 0xa5, 0xcc, 0x9d, 0xf1, 0x44, 0xfa, 0x60, 0x5e, 0xdd, 0x71, 0x02, 0x0f, 0xeb, 0xa4, 0x47,
+	0xb0, 0x01, 0x1f, 0x81, 0xbd, 0x85, 0xa0, 0x72, 0x47, 0x6d, 0xa6, 0x85, 0x4c, 0x66, 0x3c, 0x91,
+	0x76, 0xe7, 0x09, 0xe5, 0xd0, 0x77, 0x60, 0x1d, 0xdc, 0x5d, 0x28, 0xad, 0xb4, 0x1a, 0x8d, 0x4e,
+	0x53, 0x6e, 0x3f, 0xd5, 0x94, 0x56, 0xab, 0x9e, 0x4e, 0x65, 0xee, 0x8e, 0x27, 0x52, 0x6e, 0x1e,
+	0x52, 0x71, 0x6d, 0x3b, 0x74, 0x08, 0x1d, 0x29, 0xae, 0x6b, 0x5d, 0x4a, 0x6b, 0xb4, 0xaa, 0x9d,
+	0x7a, 0x4d, 0x2b, 0x55, 0x2a, 0xad, 0x4e, 0xb3, 0x9d, 0x5e, 0xbe, 0x8c, 0xd6, 0x70, 0x8d, 0xd0,
+	0xc2, 0x25, 0x5d, 0x77, 0x43, 0x87, 0x66, 0x56, 0xbe, 0xfd, 0x21, 0xbb, 0x74, 0xef, 0x57, 0x01,
+	0xa4, 0x2f, 0x5a, 0x34, 0x3c, 0x00, 0xb7, 0x14, 0x55, 0xae, 0xc8, 0xcd, 0x63, 0xc6, 0xae, 0xd5,
+	0xb5, 0xf6, 0x53, 0xa5, 0xa6, 0x95, 0xe4, 0x06, 0xbb, 0xb1, 0x9d, 0xf1, 0x44, 0x7a, 0x4f, 0xc2,
+	0xf6, 0xe1, 0x43, 0x90, 0x59, 0x20, 0xa9, 0xc9, 0xca, 0xc1, 0x83, 0x07, 0x8f, 0xd2, 0x42, 0xe6,
+	0xd6, 0x78, 0x22, 0xdd, 0x98, 0x55, 0xc5, 0x47, 0xb0, 0x08, 0x76, 0x17, 0x08, 0x15, 0xb9, 0x9a,
+	0x4e, 0x65, 0x6e, 0x8c, 0x27, 0xd2, 0xf6, 0xac, 0x48, 0x91, 0xab, 0x51, 0xde, 0xe5, 0x27, 0xaf,
+	0x4f, 0xb3, 0xc2, 0x9b, 0xd3, 0xac, 0xf0, 0xf7, 0x69, 0x56, 0x78, 0xf5, 0x2e, 0xbb, 0xf4, 0xe6,
+	0x5d, 0x76, 0xe9, 0x8f, 0x77, 0xd9, 0xa5, 0x67, 0x0f, 0x4d, 0x42, 0xfb, 0x61, 0xb7, 0xa0, 0xbb,
+	0x76, 0xf1, 0x31, 0x37, 0xda, 0xfb, 0x95, 0x3e, 0x22, 0x4e, 0x31, 0x72, 0xdd, 0xfb, 0x3a, 0x5f,
+	0x0c, 0x67, 0xff, 0xe9, 0x66, 0x0f, 0x3c, 0xe8, 0xae, 0x71, 0xc3, 0xf8, 0xe4, 0xdf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x53, 0x43, 0xd5, 0x39, 0x96, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -190,6 +641,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Lanes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeeDenomRateMaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeDenomRateMaxAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.FeeDenomRates) > 0 {
+		for iNdEx := len(m.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size, err := m.PID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.Model != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Model))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.BaseFeeDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeDestination))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -290,41 +831,235 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LaneParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LaneParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaneParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Alpha.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.Beta.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.Gamma.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MinBaseGasPrice.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.TargetBlockUtilization.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.DefaultMaxBlockGas != 0 {
-		n += 1 + sovParams(uint64(m.DefaultMaxBlockGas))
+	{
+		size := m.IBC.Size()
+		i -= size
+		if _, err := m.IBC.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	l = m.MinLearningRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MaxLearningRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.Window != 0 {
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Governance.Size()
+		i -= size
+		if _, err := m.Governance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PIDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PIDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PIDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IntegralLimit.Size()
+		i -= size
+		if _, err := m.IntegralLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kd.Size()
+		i -= size
+		if _, err := m.Kd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ki.Size()
+		i -= size
+		if _, err := m.Ki.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Kp.Size()
+		i -= size
+		if _, err := m.Kp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Alpha.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetBlockUtilization.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DefaultMaxBlockGas != 0 {
+		n += 1 + sovParams(uint64(m.DefaultMaxBlockGas))
+	}
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Window != 0 {
 		n += 1 + sovParams(uint64(m.Window))
 	}
 	l = len(m.FeeDenom)
@@ -334,6 +1069,99 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if m.BaseFeeDestination != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeDestination))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.FeeHistorySize))
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Model != 0 {
+		n += 2 + sovParams(uint64(m.Model))
+	}
+	l = m.PID.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.FeeDenomRates) > 0 {
+		for _, e := range m.FeeDenomRates {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeeDenomRateMaxAge)
+	n += 2 + l + sovParams(uint64(l))
+	l = m.Lanes.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *LaneParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Governance.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.IBC.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PIDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Kp.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Ki.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Kd.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.IntegralLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -343,7 +1171,641 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBlockGas", wireType)
+			}
+			m.DefaultMaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultMaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDestination", wireType)
+			}
+			m.BaseFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeDestination |= BaseFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			m.Model = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Model |= PricingModelType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRates = append(m.FeeDenomRates, FeeDenomRate{})
+			if err := m.FeeDenomRates[len(m.FeeDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRateMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeeDenomRateMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lanes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LaneParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -366,15 +1828,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LaneParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LaneParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,13 +1864,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Governance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBC", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -436,13 +1898,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IBC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -470,13 +1982,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -504,15 +2014,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -522,31 +2032,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMaxBlockGas", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DefaultMaxBlockGas = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasPriceMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -556,14 +2115,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultMaxBlockGas |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -591,13 +2163,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PIDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PIDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PIDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -625,15 +2247,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
 			}
-			m.Window = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -643,14 +2265,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Window |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -678,13 +2315,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Kd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -694,12 +2333,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntegralLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
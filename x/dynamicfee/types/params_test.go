@@ -2,11 +2,16 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
 )
 
@@ -284,6 +289,241 @@ func TestParams(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "base fee destination is invalid",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BaseFeeDestination = 42
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "base fee destination is community pool",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BaseFeeDestination = types.BaseFeeDestinationCommunityPool
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "base fee destination is module account without recipient",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "base fee destination is module account with recipient",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BaseFeeDestination = types.BaseFeeDestinationModuleAccount
+				p.BaseFeeRecipient = "treasury"
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "base fee recipient set without module account destination",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.BaseFeeRecipient = "treasury"
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid msg gas price multipliers",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/ibc.core.client.v1.MsgUpdateClient", Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+			),
+			expectedErr: false,
+		},
+		{
+			name: "msg gas price multiplier with empty type url",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{Multiplier: math.LegacyNewDec(2)},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "msg gas price multiplier with invalid type url",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "duplicate msg gas price multiplier",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(3)},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "msg gas price multiplier is nil",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "msg gas price multiplier is zero",
+			p: paramsWithMultipliers(
+				types.MsgGasPriceMultiplier{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyZeroDec()},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "valid pid pricing model",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Model = types.PricingModelPID
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "unknown pricing model",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Model = 42
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid pricing model with nil params",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Model = types.PricingModelPID
+				p.PID = types.PIDParams{}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid pricing model with negative gain",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Model = types.PricingModelPID
+				p.PID.Kd = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "valid fee denom rates",
+			p: paramsWithFeeDenomRates(
+				types.NewFeeDenomRate("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", math.LegacyNewDec(5)),
+				types.NewFeeDenomRate("ulight", math.LegacyMustNewDecFromStr("0.5")),
+			),
+			expectedErr: false,
+		},
+		{
+			name: "fee denom rate with invalid denom",
+			p: paramsWithFeeDenomRates(
+				types.NewFeeDenomRate("1x", math.LegacyNewDec(5)),
+			),
+			expectedErr: true,
+		},
+		{
+			name: "fee denom rate for the fee denom",
+			p: paramsWithFeeDenomRates(
+				types.NewFeeDenomRate(types.DefaultFeeDenom, math.LegacyNewDec(5)),
+			),
+			expectedErr: true,
+		},
+		{
+			name: "duplicate fee denom rate",
+			p: paramsWithFeeDenomRates(
+				types.NewFeeDenomRate("ulight", math.LegacyNewDec(5)),
+				types.NewFeeDenomRate("ulight", math.LegacyNewDec(6)),
+			),
+			expectedErr: true,
+		},
+		{
+			name: "fee denom rate is nil",
+			p: paramsWithFeeDenomRates(
+				types.FeeDenomRate{Denom: "ulight"},
+			),
+			expectedErr: true,
+		},
+		{
+			name: "fee denom rate is zero",
+			p: paramsWithFeeDenomRates(
+				types.NewFeeDenomRate("ulight", math.LegacyZeroDec()),
+			),
+			expectedErr: true,
+		},
+		{
+			name: "negative fee denom rate max age",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.FeeDenomRateMaxAge = -time.Second
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "lanes take the whole block space",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Lanes = types.NewLaneParams(math.LegacyMustNewDecFromStr("0.4"), math.LegacyMustNewDecFromStr("0.6"))
+				return p
+			}(),
+			expectedErr: false,
+		},
+		{
+			name: "lanes are nil",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Lanes = types.LaneParams{}
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "governance lane is negative",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Lanes.Governance = math.LegacyMustNewDecFromStr("-0.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "ibc lane is greater than 1",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Lanes.IBC = math.LegacyMustNewDecFromStr("1.1")
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "lanes exceed the block space",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.Lanes = types.NewLaneParams(math.LegacyMustNewDecFromStr("0.5"), math.LegacyMustNewDecFromStr("0.6"))
+				return p
+			}(),
+			expectedErr: true,
+		},
+		{
+			name: "pid params are not validated by other pricing models",
+			p: func() types.Params {
+				p := types.DefaultParams()
+				p.PID = types.PIDParams{}
+				return p
+			}(),
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -297,3 +537,128 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgsGasPriceMultiplier(t *testing.T) {
+	params := paramsWithMultipliers(
+		types.MsgGasPriceMultiplier{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), Multiplier: math.LegacyNewDec(3)},
+		types.MsgGasPriceMultiplier{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Multiplier: math.LegacyNewDec(2)},
+		types.MsgGasPriceMultiplier{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}), Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+	)
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected math.LegacyDec
+	}{
+		{
+			name:     "no msgs",
+			expected: math.LegacyOneDec(),
+		},
+		{
+			name:     "msg without multiplier",
+			msgs:     []sdk.Msg{&banktypes.MsgSetSendEnabled{}},
+			expected: math.LegacyOneDec(),
+		},
+		{
+			name:     "msg with multiplier",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}},
+			expected: math.LegacyNewDec(2),
+		},
+		{
+			name:     "discounted msg",
+			msgs:     []sdk.Msg{&banktypes.MsgUpdateParams{}},
+			expected: math.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			name:     "highest multiplier among msgs",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}, &banktypes.MsgUpdateParams{}},
+			expected: math.LegacyNewDec(3),
+		},
+		{
+			name:     "discounted msg with msg without multiplier",
+			msgs:     []sdk.Msg{&banktypes.MsgUpdateParams{}, &banktypes.MsgSetSendEnabled{}},
+			expected: math.LegacyOneDec(),
+		},
+		{
+			name:     "msg with multiplier executed by authz",
+			msgs:     []sdk.Msg{execMsg(&banktypes.MsgSend{})},
+			expected: math.LegacyNewDec(2),
+		},
+		{
+			name:     "discounted msg executed by authz",
+			msgs:     []sdk.Msg{execMsg(&banktypes.MsgUpdateParams{})},
+			expected: math.LegacyMustNewDecFromStr("0.5"),
+		},
+		{
+			name:     "highest multiplier among msgs executed by nested authz",
+			msgs:     []sdk.Msg{&banktypes.MsgUpdateParams{}, execMsg(&banktypes.MsgSend{}, execMsg(&banktypes.MsgMultiSend{}))},
+			expected: math.LegacyNewDec(3),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.MsgsGasPriceMultiplier(tc.msgs))
+		})
+	}
+}
+
+func paramsWithMultipliers(multipliers ...types.MsgGasPriceMultiplier) types.Params {
+	p := types.DefaultParams()
+	p.MsgGasPriceMultipliers = multipliers
+	return p
+}
+
+func paramsWithFeeDenomRates(rates ...types.FeeDenomRate) types.Params {
+	p := types.DefaultParams()
+	p.FeeDenomRates = rates
+	return p
+}
+
+func TestStampFeeDenomRates(t *testing.T) {
+	var (
+		then = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		now  = then.Add(time.Hour)
+	)
+	prev := paramsWithFeeDenomRates(
+		types.FeeDenomRate{Denom: "ibc/ATOM", Rate: math.LegacyNewDec(5), UpdatedAt: then},
+		types.FeeDenomRate{Denom: "ibc/OSMO", Rate: math.LegacyNewDec(2), UpdatedAt: then},
+		types.FeeDenomRate{Denom: "ibc/USDC", Rate: math.LegacyNewDec(3)},
+	)
+	rates := []types.FeeDenomRate{
+		// unchanged
+		types.NewFeeDenomRate("ibc/ATOM", math.LegacyNewDec(5)),
+		// changed
+		types.NewFeeDenomRate("ibc/OSMO", math.LegacyNewDec(4)),
+		// unchanged but without update time
+		types.NewFeeDenomRate("ibc/USDC", math.LegacyNewDec(3)),
+		// new
+		types.NewFeeDenomRate("ulight", math.LegacyNewDec(1)),
+	}
+	p := paramsWithFeeDenomRates(rates...)
+
+	p.StampFeeDenomRates(prev, now)
+
+	require.Equal(t, then, p.FeeDenomRates[0].UpdatedAt)
+	require.Equal(t, now, p.FeeDenomRates[1].UpdatedAt)
+	require.Equal(t, now, p.FeeDenomRates[2].UpdatedAt)
+	require.Equal(t, now, p.FeeDenomRates[3].UpdatedAt)
+	// the slice of the caller is not modified
+	for _, r := range rates {
+		require.True(t, r.UpdatedAt.IsZero())
+	}
+}
+
+func TestFeeDenomRateIsStale(t *testing.T) {
+	var (
+		updatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		rate      = types.FeeDenomRate{Denom: "ibc/ATOM", Rate: math.LegacyNewDec(5), UpdatedAt: updatedAt}
+	)
+
+	require.False(t, rate.IsStale(updatedAt.Add(time.Hour), time.Hour))
+	require.True(t, rate.IsStale(updatedAt.Add(time.Hour+time.Second), time.Hour))
+	require.False(t, rate.IsStale(updatedAt.Add(1000*time.Hour), 0), "zero max age disables the staleness check")
+}
+
+func execMsg(msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(sdk.AccAddress("grantee"), msgs)
+	return &msg
+}
@@ -0,0 +1,187 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+)
+
+// PricingModel implements the algorithm that updates the base gas price of
+// the dynamic fee pricing at the end of each block. The implementation is
+// selected by the Model param, which allows governance to switch models
+// without a binary upgrade.
+type PricingModel interface {
+	// ValidateParams performs the validation of the params specific to the
+	// pricing model.
+	ValidateParams(params Params) error
+
+	// ValidateState performs the validation of the state specific to the
+	// pricing model.
+	ValidateState(params Params, state State) error
+
+	// UpdateBaseGasPrice updates the learning rate and the base gas price of
+	// the state based on the block gas of the current block, and returns
+	// them.
+	UpdateBaseGasPrice(logger log.Logger, state *State, params Params, maxBlockGas uint64) (baseGasPrice, learningRate math.LegacyDec)
+}
+
+// pricingModels is the registry of the pricing model implementations.
+var pricingModels = map[PricingModelType]PricingModel{}
+
+func init() {
+	RegisterPricingModel(PricingModelAIMD, AIMDPricingModel{})
+	RegisterPricingModel(PricingModelEIP1559, EIP1559PricingModel{})
+	RegisterPricingModel(PricingModelPID, PIDPricingModel{})
+}
+
+// RegisterPricingModel registers the implementation of the given pricing
+// model type. It panics if the type already has an implementation.
+func RegisterPricingModel(modelType PricingModelType, model PricingModel) {
+	if _, ok := pricingModels[modelType]; ok {
+		panic(fmt.Sprintf("pricing model %s already registered", modelType))
+	}
+	pricingModels[modelType] = model
+}
+
+// GetPricingModel returns the implementation of the given pricing model type.
+func GetPricingModel(modelType PricingModelType) (PricingModel, error) {
+	model, ok := pricingModels[modelType]
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrUnknownModel, "%s", modelType)
+	}
+	return model, nil
+}
+
+// AIMDPricingModel is the AIMD EIP-1559 pricing model. The learning rate is
+// adjusted according to the average block gas of the window, then used to
+// update the base gas price.
+type AIMDPricingModel struct{}
+
+var _ PricingModel = AIMDPricingModel{}
+
+// ValidateParams implements PricingModel.
+func (AIMDPricingModel) ValidateParams(Params) error { return nil }
+
+// ValidateState implements PricingModel.
+func (AIMDPricingModel) ValidateState(Params, State) error { return nil }
+
+// UpdateBaseGasPrice implements PricingModel.
+func (AIMDPricingModel) UpdateBaseGasPrice(logger log.Logger, state *State, params Params, maxBlockGas uint64) (math.LegacyDec, math.LegacyDec) {
+	// Update the learning rate based on the block gas seen in the
+	// current block. This is the AIMD learning rate adjustment algorithm.
+	learningRate := state.UpdateLearningRate(params, maxBlockGas)
+
+	// Update the base gas price based with the new learning rate.
+	return state.UpdateBaseGasPrice(logger, params, maxBlockGas), learningRate
+}
+
+// EIP1559PricingModel is the classic EIP-1559 pricing model. The learning
+// rate is fixed to the MaxLearningRate param.
+type EIP1559PricingModel struct{}
+
+var _ PricingModel = EIP1559PricingModel{}
+
+// ValidateParams implements PricingModel.
+func (EIP1559PricingModel) ValidateParams(Params) error { return nil }
+
+// ValidateState implements PricingModel.
+func (EIP1559PricingModel) ValidateState(Params, State) error { return nil }
+
+// UpdateBaseGasPrice implements PricingModel.
+func (EIP1559PricingModel) UpdateBaseGasPrice(logger log.Logger, state *State, params Params, maxBlockGas uint64) (math.LegacyDec, math.LegacyDec) {
+	state.LearningRate = params.MaxLearningRate
+	return state.UpdateBaseGasPrice(logger, params, maxBlockGas), state.LearningRate
+}
+
+// PIDPricingModel is a PID controller pricing model, which adjusts the base
+// gas price to drive the block gas towards the target block gas. The error
+// is the deviation of the block gas from the target block gas, relative to
+// the latter, and the base gas price is updated as follows:
+//
+//	integral = clamp(integral + error, -integralLimit, integralLimit)
+//	derivative = error - prevError
+//	baseGasPrice = baseGasPrice * (1 + kp * error + ki * integral + kd * derivative)
+//
+// The base gas price is bounded by the MinBaseGasPrice param. The learning
+// rate is not used and left unchanged.
+type PIDPricingModel struct{}
+
+var _ PricingModel = PIDPricingModel{}
+
+// ValidateParams implements PricingModel.
+func (PIDPricingModel) ValidateParams(params Params) error {
+	return params.PID.ValidateBasic()
+}
+
+// ValidateState implements PricingModel.
+func (PIDPricingModel) ValidateState(params Params, state State) error {
+	if err := state.PID.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if state.PID.Integral.Abs().GT(params.PID.IntegralLimit) {
+		return fmt.Errorf("pid integral %s exceeds the integral limit %s", state.PID.Integral, params.PID.IntegralLimit)
+	}
+
+	return nil
+}
+
+// UpdateBaseGasPrice implements PricingModel.
+func (PIDPricingModel) UpdateBaseGasPrice(logger log.Logger, state *State, params Params, maxBlockGas uint64) (gasPrice, learningRate math.LegacyDec) {
+	// Panic catch in case there is an overflow
+	defer func() {
+		if rec := recover(); rec != nil {
+			logger.Error("Panic recovered in PIDPricingModel.UpdateBaseGasPrice", "err", rec)
+			state.BaseGasPrice = params.MinBaseGasPrice
+			state.PID = NewPIDState()
+			gasPrice = state.BaseGasPrice
+			learningRate = state.LearningRate
+		}
+	}()
+
+	// A state without PID fields, e.g. set by another pricing model, starts
+	// from a neutral controller.
+	if state.PID.ValidateBasic() != nil {
+		state.PID = NewPIDState()
+	}
+
+	// Without target block gas, the error is undefined, so the base gas
+	// price is left unchanged.
+	targetGas := GetTargetBlockGas(maxBlockGas, params)
+	if targetGas == 0 {
+		return state.BaseGasPrice, state.LearningRate
+	}
+
+	currentBlockGas := math.LegacyNewDecFromInt(math.NewIntFromUint64(state.Window[state.Index]))
+	targetBlockGas := math.LegacyNewDecFromInt(math.NewIntFromUint64(targetGas))
+	e := currentBlockGas.Sub(targetBlockGas).Quo(targetBlockGas)
+
+	limit := params.PID.IntegralLimit
+	integral := state.PID.Integral.Add(e)
+	if integral.GT(limit) {
+		integral = limit
+	} else if integral.LT(limit.Neg()) {
+		integral = limit.Neg()
+	}
+	derivative := e.Sub(state.PID.PrevError)
+
+	adjustment := params.PID.Kp.Mul(e).
+		Add(params.PID.Ki.Mul(integral)).
+		Add(params.PID.Kd.Mul(derivative))
+
+	gasPrice = state.BaseGasPrice.Mul(math.LegacyOneDec().Add(adjustment))
+
+	// Ensure the base gasPrice is greater than the minimum base gasPrice.
+	if gasPrice.LT(params.MinBaseGasPrice) {
+		gasPrice = params.MinBaseGasPrice
+	}
+
+	state.BaseGasPrice = gasPrice
+	state.PID = PIDState{
+		Integral:  integral,
+		PrevError: e,
+	}
+	return state.BaseGasPrice, state.LearningRate
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestGetPricingModel(t *testing.T) {
+	for _, modelType := range []types.PricingModelType{
+		types.PricingModelAIMD,
+		types.PricingModelEIP1559,
+		types.PricingModelPID,
+	} {
+		model, err := types.GetPricingModel(modelType)
+		require.NoError(t, err, modelType)
+		require.NotNil(t, model, modelType)
+	}
+
+	_, err := types.GetPricingModel(42)
+	require.ErrorIs(t, err, types.ErrUnknownModel)
+
+	require.Panics(t, func() {
+		types.RegisterPricingModel(types.PricingModelAIMD, types.AIMDPricingModel{})
+	})
+}
+
+func TestPIDPricingModel_UpdateBaseGasPrice(t *testing.T) {
+	const maxBlockGas = 100
+
+	newParams := func() types.Params {
+		params := types.DefaultParams()
+		params.Model = types.PricingModelPID
+		params.PID = types.NewPIDParams(
+			math.LegacyMustNewDecFromStr("0.1"),
+			math.LegacyMustNewDecFromStr("0.01"),
+			math.LegacyMustNewDecFromStr("0.05"),
+			math.LegacyMustNewDecFromStr("2"),
+		)
+		return params
+	}
+
+	t.Run("target block keeps the base gas price", func(t *testing.T) {
+		params := newParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+		state.Window[0] = 50
+
+		gasPrice, lr := types.PIDPricingModel{}.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.02"), gasPrice)
+		require.Equal(t, types.DefaultMinLearningRate, lr)
+		require.True(t, state.PID.Integral.IsZero())
+		require.True(t, state.PID.PrevError.IsZero())
+	})
+
+	t.Run("full blocks accumulate the error", func(t *testing.T) {
+		params := newParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+		model := types.PIDPricingModel{}
+
+		// error = 1, integral = 1, derivative = 1
+		state.Window[0] = maxBlockGas
+		gasPrice, _ := model.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.0232"), gasPrice)
+		require.Equal(t, math.LegacyOneDec(), state.PID.Integral)
+		require.Equal(t, math.LegacyOneDec(), state.PID.PrevError)
+
+		// error = 1, integral = 2, derivative = 0
+		gasPrice, _ = model.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.0232").Mul(math.LegacyMustNewDecFromStr("1.12")), gasPrice)
+		require.Equal(t, math.LegacyNewDec(2), state.PID.Integral)
+
+		// error = 1, integral is bounded by the integral limit
+		model.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+		require.Equal(t, params.PID.IntegralLimit, state.PID.Integral)
+	})
+
+	t.Run("empty blocks cannot go below the min base gas price", func(t *testing.T) {
+		params := newParams()
+		params.PID.Kp = math.LegacyNewDec(2)
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+
+		gasPrice, _ := types.PIDPricingModel{}.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+
+		require.Equal(t, params.MinBaseGasPrice, gasPrice)
+		require.Equal(t, math.LegacyOneDec().Neg(), state.PID.Integral)
+		require.Equal(t, math.LegacyOneDec().Neg(), state.PID.PrevError)
+	})
+
+	t.Run("nil pid state starts from a neutral controller", func(t *testing.T) {
+		params := newParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+		state.PID = types.PIDState{}
+
+		// error = -1, integral = -1, derivative = -1
+		gasPrice, _ := types.PIDPricingModel{}.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, maxBlockGas)
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.0168"), gasPrice)
+		require.Equal(t, math.LegacyOneDec().Neg(), state.PID.Integral)
+		require.Equal(t, math.LegacyOneDec().Neg(), state.PID.PrevError)
+	})
+
+	t.Run("zero target block gas keeps the base gas price", func(t *testing.T) {
+		params := newParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+		state.Window[0] = 1
+
+		gasPrice, _ := types.PIDPricingModel{}.UpdateBaseGasPrice(log.NewNopLogger(), &state, params, 1)
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.02"), gasPrice)
+		require.Equal(t, types.NewPIDState(), state.PID)
+	})
+}
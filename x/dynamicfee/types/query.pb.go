@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -371,6 +373,197 @@ func (m *GasPricesResponse) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
+// BurnedFeesRequest is the request type for the Query/BurnedFees RPC method.
+type BurnedFeesRequest struct {
+}
+
+func (m *BurnedFeesRequest) Reset()         { *m = BurnedFeesRequest{} }
+func (m *BurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*BurnedFeesRequest) ProtoMessage()    {}
+func (*BurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0efd7157c9ee870d, []int{8}
+}
+func (m *BurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedFeesRequest.Merge(m, src)
+}
+func (m *BurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedFeesRequest proto.InternalMessageInfo
+
+// BurnedFeesResponse is the response type for the Query/BurnedFees RPC
+// method. Returns the cumulative burned amount for each denom.
+type BurnedFeesResponse struct {
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *BurnedFeesResponse) Reset()         { *m = BurnedFeesResponse{} }
+func (m *BurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*BurnedFeesResponse) ProtoMessage()    {}
+func (*BurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0efd7157c9ee870d, []int{9}
+}
+func (m *BurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedFeesResponse.Merge(m, src)
+}
+func (m *BurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedFeesResponse proto.InternalMessageInfo
+
+func (m *BurnedFeesResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// FeeHistoryRequest is the request type for the Query/FeeHistory RPC method.
+type FeeHistoryRequest struct {
+	// blocks is the number of most recent blocks to return. If zero, all the
+	// kept blocks are returned.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *FeeHistoryRequest) Reset()         { *m = FeeHistoryRequest{} }
+func (m *FeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryRequest) ProtoMessage()    {}
+func (*FeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0efd7157c9ee870d, []int{10}
+}
+func (m *FeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryRequest.Merge(m, src)
+}
+func (m *FeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryRequest proto.InternalMessageInfo
+
+func (m *FeeHistoryRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// FeeHistoryResponse is the response type for the Query/FeeHistory RPC
+// method.
+type FeeHistoryResponse struct {
+	// entries contains the fee history of the last blocks, ordered by
+	// ascending height.
+	Entries []FeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// reward_percentiles are the percentiles at which the entries rewards are
+	// computed.
+	RewardPercentiles []uint32 `protobuf:"varint,2,rep,packed,name=reward_percentiles,json=rewardPercentiles,proto3" json:"reward_percentiles,omitempty"`
+	// next_base_gas_price is the base gas price that will apply to the next
+	// block.
+	NextBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=next_base_gas_price,json=nextBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_base_gas_price"`
+}
+
+func (m *FeeHistoryResponse) Reset()         { *m = FeeHistoryResponse{} }
+func (m *FeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryResponse) ProtoMessage()    {}
+func (*FeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0efd7157c9ee870d, []int{11}
+}
+func (m *FeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryResponse.Merge(m, src)
+}
+func (m *FeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryResponse proto.InternalMessageInfo
+
+func (m *FeeHistoryResponse) GetEntries() []FeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *FeeHistoryResponse) GetRewardPercentiles() []uint32 {
+	if m != nil {
+		return m.RewardPercentiles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "hikari.dynamicfee.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "hikari.dynamicfee.v1.ParamsResponse")
@@ -380,48 +573,68 @@ func init() {
 	proto.RegisterType((*GasPriceResponse)(nil), "hikari.dynamicfee.v1.GasPriceResponse")
 	proto.RegisterType((*GasPricesRequest)(nil), "hikari.dynamicfee.v1.GasPricesRequest")
 	proto.RegisterType((*GasPricesResponse)(nil), "hikari.dynamicfee.v1.GasPricesResponse")
+	proto.RegisterType((*BurnedFeesRequest)(nil), "hikari.dynamicfee.v1.BurnedFeesRequest")
+	proto.RegisterType((*BurnedFeesResponse)(nil), "hikari.dynamicfee.v1.BurnedFeesResponse")
+	proto.RegisterType((*FeeHistoryRequest)(nil), "hikari.dynamicfee.v1.FeeHistoryRequest")
+	proto.RegisterType((*FeeHistoryResponse)(nil), "hikari.dynamicfee.v1.FeeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("hikari/dynamicfee/v1/query.proto", fileDescriptor_0efd7157c9ee870d) }
 
 var fileDescriptor_0efd7157c9ee870d = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x31, 0x6f, 0x13, 0x31,
-	0x18, 0xcd, 0x41, 0x2f, 0x22, 0x86, 0xb6, 0xd4, 0xca, 0x80, 0xd2, 0x70, 0x0d, 0x4e, 0x21, 0x05,
-	0x94, 0xb3, 0x52, 0x86, 0x22, 0x24, 0x96, 0x14, 0x89, 0x0e, 0x0c, 0x4d, 0xd8, 0x58, 0x90, 0x73,
-	0x31, 0x17, 0xab, 0x9c, 0x7d, 0x3d, 0x5f, 0x2a, 0x22, 0xc4, 0x02, 0x12, 0x03, 0x0b, 0x48, 0xfc,
-	0x09, 0xc4, 0xc4, 0xcf, 0xe8, 0x18, 0x89, 0x85, 0x09, 0x50, 0x82, 0xc4, 0xdf, 0x40, 0x67, 0xfb,
-	0x92, 0x08, 0x9d, 0xee, 0x96, 0x24, 0xfe, 0xfc, 0xbe, 0xf7, 0x9e, 0xbf, 0xef, 0x29, 0xa0, 0x31,
-	0x62, 0x27, 0x24, 0x62, 0x78, 0x38, 0xe1, 0x24, 0x60, 0xde, 0x0b, 0x4a, 0xf1, 0x59, 0x07, 0x9f,
-	0x8e, 0x69, 0x34, 0x71, 0xc3, 0x48, 0xc4, 0x02, 0x56, 0x35, 0xc2, 0x5d, 0x22, 0xdc, 0xb3, 0x4e,
-	0xad, 0xea, 0x0b, 0x5f, 0x28, 0x00, 0x4e, 0x7e, 0x69, 0x6c, 0xad, 0xee, 0x0b, 0xe1, 0xbf, 0xa4,
-	0x98, 0x84, 0x0c, 0x13, 0xce, 0x45, 0x4c, 0x62, 0x26, 0xb8, 0x34, 0xb7, 0x8e, 0x27, 0x64, 0x20,
-	0x24, 0x1e, 0x10, 0x99, 0xa8, 0x0c, 0x68, 0x4c, 0x3a, 0xd8, 0x13, 0x8c, 0x9b, 0xfb, 0x2d, 0x12,
-	0x30, 0x2e, 0xb0, 0xfa, 0x34, 0xa5, 0x1b, 0x99, 0xf6, 0x42, 0x12, 0x91, 0x20, 0x65, 0x45, 0x99,
-	0x10, 0x9f, 0x72, 0x2a, 0x99, 0xc1, 0xa0, 0x4d, 0xb0, 0x7e, 0xac, 0x7a, 0xfa, 0xf4, 0x74, 0x4c,
-	0x65, 0x8c, 0x9e, 0x80, 0x8d, 0xb4, 0x20, 0x43, 0xc1, 0x25, 0x85, 0x0f, 0x40, 0x59, 0xd3, 0x5e,
-	0xb3, 0x1a, 0xd6, 0xde, 0xe5, 0xfd, 0xba, 0x9b, 0xf5, 0x6e, 0x57, 0x77, 0x75, 0xd7, 0xce, 0x7f,
-	0xee, 0x94, 0xfa, 0xa6, 0x03, 0x6d, 0x80, 0x2b, 0x4f, 0x63, 0x12, 0xd3, 0x94, 0xfd, 0x08, 0xac,
-	0x9b, 0xb3, 0x21, 0x3f, 0x00, 0xb6, 0x4c, 0x0a, 0x86, 0x7b, 0x3b, 0x9b, 0x5b, 0xf5, 0x18, 0x6a,
-	0x8d, 0x47, 0x2d, 0xb0, 0xf9, 0x98, 0xc8, 0xe3, 0x88, 0x79, 0x29, 0x39, 0xac, 0x02, 0x7b, 0x48,
-	0xb9, 0x08, 0x14, 0x57, 0xa5, 0xaf, 0x0f, 0xa8, 0x07, 0xae, 0x2e, 0x81, 0x46, 0xf5, 0x21, 0xb0,
-	0xc3, 0xa4, 0xb0, 0x78, 0x91, 0x9e, 0xbf, 0x9b, 0xcc, 0xdf, 0x35, 0xf3, 0x77, 0x1f, 0x51, 0xef,
-	0x50, 0x30, 0xde, 0xad, 0x24, 0xb2, 0x5f, 0xfe, 0x7e, 0xbb, 0x63, 0xf5, 0x75, 0x17, 0x82, 0x4b,
-	0xca, 0xc5, 0xdc, 0xde, 0x59, 0x60, 0x6b, 0xa5, 0x68, 0x84, 0x38, 0x28, 0xab, 0x96, 0x64, 0x76,
-	0x17, 0x0b, 0x95, 0xee, 0x27, 0x4a, 0x5f, 0x7f, 0xed, 0xdc, 0xf5, 0x59, 0x3c, 0x1a, 0x0f, 0x5c,
-	0x4f, 0x04, 0xd8, 0x24, 0x43, 0x7f, 0xb5, 0xe5, 0xf0, 0x04, 0xc7, 0x93, 0x90, 0xca, 0xb4, 0x47,
-	0x6a, 0x63, 0x46, 0x65, 0xff, 0xe3, 0x1a, 0xb0, 0x7b, 0x49, 0x44, 0xe1, 0x04, 0x94, 0xf5, 0x46,
-	0x60, 0x33, 0x6f, 0x5f, 0xc6, 0x7e, 0x6d, 0x37, 0x1f, 0xa4, 0x9f, 0x83, 0x76, 0xdf, 0x7e, 0xff,
-	0xf3, 0xf9, 0x82, 0x03, 0xeb, 0x38, 0x27, 0x7d, 0x70, 0x0c, 0x6c, 0xb5, 0x30, 0x88, 0x72, 0xb6,
-	0x99, 0x0a, 0x37, 0x73, 0x31, 0x46, 0xb7, 0xa9, 0x74, 0xaf, 0xc3, 0xed, 0x6c, 0x5d, 0x95, 0x08,
-	0xf8, 0xc1, 0x02, 0x97, 0xd2, 0x0d, 0xc0, 0x9b, 0xd9, 0xb4, 0xff, 0x45, 0xa6, 0x76, 0xab, 0x08,
-	0x66, 0x0c, 0x60, 0x65, 0xe0, 0x36, 0x6c, 0x65, 0x1b, 0xf0, 0x89, 0x7c, 0xae, 0x36, 0x80, 0x5f,
-	0xab, 0xd0, 0xbd, 0x81, 0xef, 0x2d, 0x50, 0x59, 0xc4, 0x01, 0x16, 0xc8, 0x2c, 0xb6, 0xd0, 0x2a,
-	0xc4, 0x19, 0x3f, 0x7b, 0xca, 0x0f, 0x82, 0x8d, 0x02, 0x3f, 0xb2, 0xdb, 0x3b, 0x9f, 0x39, 0xd6,
-	0x74, 0xe6, 0x58, 0xbf, 0x67, 0x8e, 0xf5, 0x69, 0xee, 0x94, 0xa6, 0x73, 0xa7, 0xf4, 0x63, 0xee,
-	0x94, 0x9e, 0x1d, 0xac, 0xa4, 0xec, 0x48, 0xb1, 0xb4, 0x0f, 0x47, 0x84, 0x71, 0x43, 0xd9, 0xf6,
-	0xd4, 0xe1, 0xd5, 0x2a, 0xb5, 0x8a, 0xde, 0xa0, 0xac, 0xfe, 0x3a, 0xee, 0xfd, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xfe, 0x27, 0xe4, 0x3d, 0x22, 0x05, 0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x4f, 0x1b, 0x47,
+	0x18, 0xf5, 0x02, 0x76, 0xcb, 0xb4, 0xfc, 0xf0, 0x80, 0x2a, 0x30, 0x74, 0x31, 0x6b, 0x5a, 0x4c,
+	0x91, 0x77, 0x65, 0xaa, 0x8a, 0xaa, 0x52, 0x2f, 0x86, 0x52, 0x54, 0x71, 0x00, 0xf7, 0xd6, 0x8b,
+	0x3b, 0x5e, 0x7f, 0xac, 0x47, 0xc6, 0x33, 0x66, 0x67, 0x4d, 0xb1, 0xaa, 0xf6, 0xd0, 0x4a, 0x3d,
+	0xf4, 0x14, 0x29, 0xff, 0x44, 0x92, 0x53, 0x0e, 0xf9, 0x23, 0x38, 0xa2, 0xe4, 0x12, 0xe5, 0x40,
+	0x22, 0x88, 0x94, 0x73, 0xfe, 0x83, 0x68, 0x67, 0x66, 0xbd, 0x56, 0x58, 0x6c, 0x2e, 0xe0, 0xf9,
+	0xe6, 0x7d, 0xef, 0x7b, 0x7e, 0x33, 0x6f, 0x8c, 0xf2, 0x4d, 0xda, 0x22, 0x3e, 0x75, 0x1a, 0x3d,
+	0x46, 0xda, 0xd4, 0x3d, 0x06, 0x70, 0xce, 0xca, 0xce, 0x69, 0x17, 0xfc, 0x9e, 0xdd, 0xf1, 0x79,
+	0xc0, 0xf1, 0xbc, 0x42, 0xd8, 0x31, 0xc2, 0x3e, 0x2b, 0xe7, 0xe6, 0x3d, 0xee, 0x71, 0x09, 0x70,
+	0xc2, 0x4f, 0x0a, 0x9b, 0x5b, 0x74, 0xb9, 0x68, 0x73, 0x51, 0x53, 0x1b, 0x6a, 0xa1, 0xb7, 0x96,
+	0x3d, 0xce, 0xbd, 0x13, 0x70, 0x48, 0x87, 0x3a, 0x84, 0x31, 0x1e, 0x90, 0x80, 0x72, 0x16, 0xed,
+	0x9a, 0x0a, 0xeb, 0xd4, 0x89, 0x08, 0x05, 0xd4, 0x21, 0x20, 0x65, 0xc7, 0xe5, 0x94, 0xe9, 0xfd,
+	0x2c, 0x69, 0x53, 0xc6, 0x1d, 0xf9, 0x57, 0x97, 0x56, 0x13, 0x95, 0x77, 0x88, 0x4f, 0xda, 0x11,
+	0xab, 0x95, 0x08, 0xf1, 0x80, 0x81, 0xa0, 0x1a, 0x63, 0xcd, 0xa0, 0xa9, 0x43, 0xd9, 0x53, 0x85,
+	0xd3, 0x2e, 0x88, 0xc0, 0x3a, 0x40, 0xd3, 0x51, 0x41, 0x74, 0x38, 0x13, 0x80, 0x7f, 0x40, 0x19,
+	0x45, 0xbb, 0x60, 0xe4, 0x8d, 0xe2, 0x67, 0x5b, 0xcb, 0x76, 0x92, 0x25, 0xb6, 0xea, 0xaa, 0x4c,
+	0x5c, 0x5c, 0xad, 0xa4, 0xaa, 0xba, 0xc3, 0x9a, 0x46, 0x9f, 0xff, 0x1a, 0x90, 0x00, 0x22, 0xf6,
+	0x7d, 0x34, 0xa5, 0xd7, 0x9a, 0x7c, 0x1b, 0xa5, 0x45, 0x58, 0xd0, 0xdc, 0x4b, 0xc9, 0xdc, 0xb2,
+	0x47, 0x53, 0x2b, 0xbc, 0xb5, 0x8e, 0x66, 0x7e, 0x26, 0xe2, 0xd0, 0xa7, 0x6e, 0x44, 0x8e, 0xe7,
+	0x51, 0xba, 0x01, 0x8c, 0xb7, 0x25, 0xd7, 0x64, 0x55, 0x2d, 0xac, 0x23, 0x34, 0x1b, 0x03, 0xf5,
+	0xd4, 0x1f, 0x51, 0xba, 0x13, 0x16, 0xfa, 0xdf, 0x48, 0x9f, 0x55, 0xe8, 0xbf, 0xad, 0xfd, 0xb7,
+	0x77, 0xc1, 0xdd, 0xe1, 0x94, 0x55, 0x26, 0xc3, 0xb1, 0x8f, 0xde, 0x3d, 0xfd, 0xc6, 0xa8, 0xaa,
+	0x2e, 0x0b, 0xc7, 0x94, 0x7d, 0xdf, 0xfe, 0x35, 0x50, 0x76, 0xa0, 0xa8, 0x07, 0x31, 0x94, 0x91,
+	0x2d, 0xa1, 0x77, 0xe3, 0x23, 0x27, 0x7d, 0x1f, 0x4e, 0x7a, 0xf2, 0x7a, 0x65, 0xd3, 0xa3, 0x41,
+	0xb3, 0x5b, 0xb7, 0x5d, 0xde, 0xd6, 0xb7, 0x48, 0xff, 0x2b, 0x89, 0x46, 0xcb, 0x09, 0x7a, 0x1d,
+	0x10, 0x51, 0x8f, 0x50, 0xc2, 0xf4, 0x14, 0x6b, 0x0e, 0x65, 0x2b, 0x5d, 0x9f, 0x41, 0x63, 0x0f,
+	0x62, 0x69, 0x7f, 0x23, 0x3c, 0x58, 0xd4, 0xd2, 0x9a, 0x28, 0x53, 0x97, 0x55, 0x2d, 0x6d, 0x31,
+	0x51, 0x9a, 0xd4, 0xf5, 0x9d, 0xd6, 0x55, 0xbc, 0x87, 0xae, 0x41, 0x51, 0x8a, 0xdf, 0xda, 0x44,
+	0xd9, 0x3d, 0x80, 0x7d, 0x2a, 0x02, 0xee, 0xf7, 0xa2, 0xc3, 0xfa, 0x02, 0x65, 0xea, 0x27, 0xdc,
+	0x6d, 0xa9, 0x5b, 0x35, 0x51, 0xd5, 0x2b, 0xeb, 0xbd, 0x81, 0xf0, 0x20, 0x5a, 0xab, 0xfd, 0x05,
+	0x7d, 0x02, 0x2c, 0xf0, 0x69, 0xdf, 0xc9, 0xaf, 0x92, 0x6f, 0x4a, 0xdc, 0xfa, 0x13, 0x0b, 0xfc,
+	0xde, 0xe0, 0xe1, 0x45, 0x04, 0xb8, 0x84, 0xb0, 0x0f, 0x7f, 0x10, 0xbf, 0x51, 0xeb, 0x80, 0xef,
+	0x02, 0x0b, 0xe8, 0x09, 0x88, 0x85, 0xb1, 0xfc, 0x78, 0x71, 0xaa, 0x9a, 0x55, 0x3b, 0x87, 0xf1,
+	0x06, 0xfe, 0x1d, 0xcd, 0x31, 0x38, 0x0f, 0x6a, 0xa1, 0x2f, 0x35, 0x8f, 0x88, 0x9a, 0xba, 0x3a,
+	0xe3, 0xe1, 0x25, 0xab, 0x94, 0x43, 0xfe, 0x57, 0x57, 0x2b, 0x4b, 0xca, 0x08, 0xd1, 0x68, 0xd9,
+	0x94, 0x3b, 0x6d, 0x12, 0x34, 0xed, 0x03, 0xf0, 0x88, 0xdb, 0xdb, 0x05, 0xf7, 0xf9, 0xb3, 0x12,
+	0xd2, 0xde, 0xee, 0x82, 0x5b, 0x9d, 0x0d, 0xd9, 0x2a, 0x44, 0x40, 0x74, 0x5d, 0xb6, 0x1e, 0x67,
+	0x50, 0xfa, 0x28, 0x7c, 0x73, 0x70, 0x0f, 0x65, 0x54, 0x8e, 0x70, 0x61, 0x58, 0xca, 0xb4, 0x89,
+	0xb9, 0xb5, 0xe1, 0x20, 0xe5, 0x9d, 0xb5, 0xf6, 0xcf, 0x8b, 0xb7, 0x0f, 0xc7, 0x4c, 0xbc, 0xec,
+	0x0c, 0x79, 0x33, 0x70, 0x17, 0xa5, 0x65, 0xcc, 0xb0, 0x35, 0x24, 0x83, 0xd1, 0xe0, 0xc2, 0x50,
+	0x8c, 0x9e, 0x5b, 0x90, 0x73, 0xbf, 0xc4, 0x4b, 0xc9, 0x73, 0x65, 0x8e, 0xf1, 0xff, 0x06, 0xfa,
+	0x34, 0x32, 0x02, 0xdf, 0x71, 0xa8, 0x1f, 0x05, 0x3d, 0xf7, 0xf5, 0x28, 0x98, 0x16, 0xe0, 0x48,
+	0x01, 0x1b, 0x78, 0x3d, 0x59, 0x40, 0xff, 0x2c, 0x9d, 0x3f, 0xe5, 0x53, 0xf1, 0x17, 0xfe, 0xcf,
+	0x40, 0x93, 0xfd, 0x10, 0xe3, 0x11, 0x63, 0xfa, 0xa7, 0xb0, 0x3e, 0x12, 0xa7, 0xf5, 0x14, 0xa5,
+	0x1e, 0x0b, 0xe7, 0x47, 0xe8, 0x11, 0xa1, 0x2b, 0x28, 0xce, 0x2c, 0xbe, 0x63, 0xc2, 0xad, 0xa8,
+	0xe7, 0x8a, 0xa3, 0x81, 0x5a, 0xcb, 0x86, 0xd4, 0x52, 0xc0, 0xab, 0xc9, 0x5a, 0x54, 0x74, 0x6b,
+	0xc7, 0xa0, 0xc5, 0xc4, 0xb9, 0xba, 0x4b, 0xcc, 0xad, 0x88, 0xe7, 0x8a, 0xa3, 0x81, 0xf7, 0x13,
+	0x73, 0x0c, 0x50, 0x6b, 0xaa, 0x96, 0xca, 0xd1, 0xc5, 0xb5, 0x69, 0x5c, 0x5e, 0x9b, 0xc6, 0x9b,
+	0x6b, 0xd3, 0x78, 0x70, 0x63, 0xa6, 0x2e, 0x6f, 0xcc, 0xd4, 0xcb, 0x1b, 0x33, 0xf5, 0xdb, 0xf6,
+	0xc0, 0xeb, 0xb4, 0x2f, 0x69, 0x4a, 0x3b, 0x4d, 0x42, 0x99, 0xe6, 0x2c, 0xb9, 0x72, 0x71, 0x3e,
+	0xc8, 0x2d, 0x9f, 0xac, 0x7a, 0x46, 0xfe, 0x14, 0x7e, 0xfb, 0x21, 0x00, 0x00, 0xff, 0xff, 0x68,
+	0xd5, 0xb8, 0xe7, 0x0d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +659,12 @@ type QueryClient interface {
 	// GasPrices returns the current dynamicfee module list of gas prices
 	// in all available denoms.
 	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// BurnedFees returns the cumulative amount of base fees burned by the
+	// dynamicfee module, per denom.
+	BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error)
+	// FeeHistory returns the fee history of the last blocks, along with the
+	// base gas price that will apply to the next block.
+	FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -492,6 +711,24 @@ func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *BurnedFeesRequest, opts ...grpc.CallOption) (*BurnedFeesResponse, error) {
+	out := new(BurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/hikari.dynamicfee.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error) {
+	out := new(FeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/hikari.dynamicfee.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the current dynamicfee module parameters.
@@ -504,6 +741,12 @@ type QueryServer interface {
 	// GasPrices returns the current dynamicfee module list of gas prices
 	// in all available denoms.
 	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// BurnedFees returns the cumulative amount of base fees burned by the
+	// dynamicfee module, per denom.
+	BurnedFees(context.Context, *BurnedFeesRequest) (*BurnedFeesResponse, error)
+	// FeeHistory returns the fee history of the last blocks, along with the
+	// base gas price that will apply to the next block.
+	FeeHistory(context.Context, *FeeHistoryRequest) (*FeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -522,6 +765,12 @@ func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequ
 func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *BurnedFeesRequest) (*BurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *FeeHistoryRequest) (*FeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -599,6 +848,42 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.dynamicfee.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*BurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hikari.dynamicfee.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*FeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hikari.dynamicfee.v1.Query",
@@ -620,6 +905,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hikari/dynamicfee/v1/query.proto",
@@ -860,6 +1153,159 @@ func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextBaseGasPrice.Size()
+		i -= size
+		if _, err := m.NextBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardPercentiles) > 0 {
+		dAtA5 := make([]byte, len(m.RewardPercentiles)*10)
+		var j4 int
+		for _, num := range m.RewardPercentiles {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -959,13 +1405,206 @@ func (m *GasPricesResponse) Size() (n int) {
 	return n
 }
 
+func (m *BurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *FeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardPercentiles) > 0 {
+		l = 0
+		for _, e := range m.RewardPercentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = m.NextBaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -988,10 +1627,10 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1015,7 +1654,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *StateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1038,15 +1677,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1073,7 +1712,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1098,7 +1737,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateRequest) Unmarshal(dAtA []byte) error {
+func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1121,12 +1760,44 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1148,7 +1819,7 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1171,15 +1842,15 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1206,7 +1877,7 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1231,7 +1902,7 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,17 +1925,67 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1274,23 +1995,25 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Prices = append(m.Prices, types.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1313,7 +2036,7 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *BurnedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1336,15 +2059,65 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1371,7 +2144,8 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1396,7 +2170,7 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1419,12 +2193,31 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1446,7 +2239,7 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *FeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,15 +2262,15 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1504,8 +2297,118 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types.DecCoin{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, FeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardPercentiles = append(m.RewardPercentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardPercentiles) == 0 {
+					m.RewardPercentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardPercentiles = append(m.RewardPercentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPercentiles", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+)
+
+// ReplayedBlock contains the base gas price and the learning rate that
+// applied to a block replayed by Replay.
+type ReplayedBlock struct {
+	BaseGasPrice math.LegacyDec
+	LearningRate math.LegacyDec
+}
+
+// Replay replays the gas used by a sequence of blocks against params,
+// starting from state, and returns the base gas price and learning rate that
+// applied to each block. Each block goes through the same state updates as
+// on-chain: State.Update for the gas consumed, then the pricing model update
+// and State.IncrementHeight in place of the end blocker.
+func Replay(logger log.Logger, params Params, state State, maxBlockGas uint64, blocksGas []uint64) ([]ReplayedBlock, error) {
+	if err := params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if params.Window != uint64(len(state.Window)) {
+		return nil, fmt.Errorf("state window size %d does not match params window %d", len(state.Window), params.Window)
+	}
+
+	model, err := GetPricingModel(params.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy the window so the caller's state is left untouched.
+	state.Window = append([]uint64(nil), state.Window...)
+
+	blocks := make([]ReplayedBlock, len(blocksGas))
+	for i, gas := range blocksGas {
+		blocks[i] = ReplayedBlock{
+			BaseGasPrice: state.BaseGasPrice,
+			LearningRate: state.LearningRate,
+		}
+
+		if err := state.Update(gas, maxBlockGas); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		model.UpdateBaseGasPrice(logger, &state, params, maxBlockGas)
+		state.IncrementHeight()
+	}
+
+	return blocks, nil
+}
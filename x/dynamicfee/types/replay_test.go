@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+func TestReplay(t *testing.T) {
+	const maxBlockGas = 100
+
+	t.Run("replays the blocks gas with the default eip-1559", func(t *testing.T) {
+		params := types.DefaultParams()
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.02")
+
+		blocks, err := types.Replay(log.NewNopLogger(), params, state, maxBlockGas, []uint64{100, 50, 0})
+		require.NoError(t, err)
+		require.Len(t, blocks, 3)
+
+		// The base gas price increases by 1/8th after a full block, remains the
+		// same after a target block.
+		expected := math.LegacyMustNewDecFromStr("0.02")
+		require.Equal(t, expected, blocks[0].BaseGasPrice)
+		expected = expected.Mul(math.LegacyMustNewDecFromStr("1.125"))
+		require.Equal(t, expected, blocks[1].BaseGasPrice)
+		require.Equal(t, expected, blocks[2].BaseGasPrice)
+		for _, block := range blocks {
+			require.Equal(t, params.MaxLearningRate, block.LearningRate)
+		}
+	})
+
+	t.Run("replays the blocks gas with the aimd eip-1559", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+
+		blocks, err := types.Replay(log.NewNopLogger(), params, state, maxBlockGas, []uint64{100, 100})
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+
+		require.Equal(t, state.LearningRate, blocks[0].LearningRate)
+		require.Equal(t, state.LearningRate.Add(params.Alpha), blocks[1].LearningRate)
+		require.True(t, blocks[1].BaseGasPrice.GT(blocks[0].BaseGasPrice))
+	})
+
+	t.Run("does not modify the given state", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+
+		_, err := types.Replay(log.NewNopLogger(), params, state, maxBlockGas, []uint64{100, 100})
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultAIMDState(), state)
+	})
+
+	t.Run("fails with invalid params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.Window = 0
+
+		_, err := types.Replay(log.NewNopLogger(), params, types.DefaultState(), maxBlockGas, []uint64{100})
+		require.Error(t, err)
+	})
+
+	t.Run("fails when the window does not match the params", func(t *testing.T) {
+		_, err := types.Replay(log.NewNopLogger(), types.DefaultAIMDParams(), types.DefaultState(), maxBlockGas, []uint64{100})
+		require.Error(t, err)
+	})
+
+	t.Run("fails when a block exceeds the max block gas", func(t *testing.T) {
+		_, err := types.Replay(log.NewNopLogger(), types.DefaultParams(), types.DefaultState(), maxBlockGas, []uint64{50, maxBlockGas + 1})
+		require.ErrorIs(t, err, types.ErrMaxGasExceeded)
+	})
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,6 +16,58 @@ type DenomResolver interface {
 	ExtraDenoms(ctx context.Context) ([]string, error)
 }
 
+// CompositeDenomResolver chains several DenomResolvers. A denom is converted
+// by the first resolver that lists it in its extra denoms.
+type CompositeDenomResolver struct {
+	resolvers []DenomResolver
+}
+
+var _ DenomResolver = (*CompositeDenomResolver)(nil)
+
+// NewCompositeDenomResolver returns a DenomResolver chaining resolvers, in
+// order of precedence.
+func NewCompositeDenomResolver(resolvers ...DenomResolver) *CompositeDenomResolver {
+	return &CompositeDenomResolver{resolvers: resolvers}
+}
+
+// ConvertToDenom converts coin using the first resolver that lists denom in
+// its extra denoms.
+func (r *CompositeDenomResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	for _, resolver := range r.resolvers {
+		denoms, err := resolver.ExtraDenoms(ctx)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+		if slices.Contains(denoms, denom) {
+			return resolver.ConvertToDenom(ctx, coin, denom)
+		}
+	}
+
+	return sdk.DecCoin{}, ErrUnknownFeeDenom.Wrapf("denom %s", denom)
+}
+
+// ExtraDenoms returns the extra denoms of all the resolvers, without
+// duplicates.
+func (r *CompositeDenomResolver) ExtraDenoms(ctx context.Context) ([]string, error) {
+	extraDenoms := []string{}
+	for _, resolver := range r.resolvers {
+		denoms, err := resolver.ExtraDenoms(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, denom := range denoms {
+			if !slices.Contains(extraDenoms, denom) {
+				extraDenoms = append(extraDenoms, denom)
+			}
+		}
+	}
+	return extraDenoms, nil
+}
+
 // TestDenomResolver is a test implementation of the DenomResolver interface.  It returns "feeCoin.Amount baseDenom" for all coins that are not the baseDenom.
 // NOTE: DO NOT USE THIS IN PRODUCTION
 type TestDenomResolver struct{}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/dynamicfee/types"
+)
+
+// fixedRateResolver converts to its extra denoms at a fixed rate.
+type fixedRateResolver struct {
+	denoms []string
+	rate   math.LegacyDec
+}
+
+func (r fixedRateResolver) ConvertToDenom(_ context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(r.rate)), nil
+}
+
+func (r fixedRateResolver) ExtraDenoms(_ context.Context) ([]string, error) {
+	return r.denoms, nil
+}
+
+// failingResolver returns an error for its extra denoms.
+type failingResolver struct{}
+
+func (failingResolver) ConvertToDenom(context.Context, sdk.DecCoin, string) (sdk.DecCoin, error) {
+	return sdk.DecCoin{}, fmt.Errorf("OUPS")
+}
+
+func (failingResolver) ExtraDenoms(context.Context) ([]string, error) {
+	return nil, fmt.Errorf("OUPS")
+}
+
+func TestCompositeDenomResolver(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		price  = sdk.NewDecCoinFromDec(types.DefaultFeeDenom, math.LegacyMustNewDecFromStr("0.01"))
+		first  = fixedRateResolver{denoms: []string{"ulight"}, rate: math.LegacyNewDec(2)}
+		second = fixedRateResolver{denoms: []string{"ulight", "ibc/ATOM"}, rate: math.LegacyNewDec(3)}
+		r      = types.NewCompositeDenomResolver(first, second)
+	)
+
+	t.Run("same denom", func(t *testing.T) {
+		coin, err := r.ConvertToDenom(ctx, price, types.DefaultFeeDenom)
+
+		require.NoError(t, err)
+		require.Equal(t, price, coin)
+	})
+
+	t.Run("first resolver listing the denom converts", func(t *testing.T) {
+		coin, err := r.ConvertToDenom(ctx, price, "ulight")
+		require.NoError(t, err)
+		require.Equal(t, "0.020000000000000000ulight", coin.String())
+
+		coin, err = r.ConvertToDenom(ctx, price, "ibc/ATOM")
+		require.NoError(t, err)
+		require.Equal(t, "0.030000000000000000ibc/ATOM", coin.String())
+	})
+
+	t.Run("unknown denom", func(t *testing.T) {
+		_, err := r.ConvertToDenom(ctx, price, "xxx")
+
+		require.ErrorIs(t, err, types.ErrUnknownFeeDenom)
+	})
+
+	t.Run("extra denoms without duplicates", func(t *testing.T) {
+		denoms, err := r.ExtraDenoms(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"ulight", "ibc/ATOM"}, denoms)
+	})
+
+	t.Run("resolver error", func(t *testing.T) {
+		r := types.NewCompositeDenomResolver(failingResolver{}, second)
+
+		_, err := r.ConvertToDenom(ctx, price, "ulight")
+		require.EqualError(t, err, "OUPS")
+
+		_, err = r.ExtraDenoms(ctx)
+		require.EqualError(t, err, "OUPS")
+	})
+}
@@ -22,6 +22,16 @@ func NewState(
 		BaseGasPrice: baseGasPrice,
 		Index:        0,
 		LearningRate: learningRate,
+		PID:          NewPIDState(),
+	}
+}
+
+// NewPIDState instantiates a new PID pricing model state, with no
+// accumulated error.
+func NewPIDState() PIDState {
+	return PIDState{
+		Integral:  math.LegacyZeroDec(),
+		PrevError: math.LegacyZeroDec(),
 	}
 }
 
@@ -170,6 +180,19 @@ func (s *State) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic performs basic validation on the PID state.
+func (s *PIDState) ValidateBasic() error {
+	if s.Integral.IsNil() {
+		return fmt.Errorf("pid integral cannot be nil")
+	}
+
+	if s.PrevError.IsNil() {
+		return fmt.Errorf("pid previous error cannot be nil")
+	}
+
+	return nil
+}
+
 func GetTargetBlockGas(maxBlockGas uint64, params Params) uint64 {
 	targetBlockUtilization := params.TargetBlockUtilization
 	return uint64(math.LegacyNewDecFromInt(math.NewIntFromUint64(maxBlockGas)).Mul(targetBlockUtilization).TruncateInt().Int64())
@@ -27,7 +27,7 @@ func EndBlocker(goCtx context.Context, keeper *keeper.Keeper) {
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		// before deleting, check one last time if the proposal has enough deposits to get into voting phase,
 		// maybe because min deposit decreased in the meantime.
-		minDeposit := keeper.GetMinDeposit(ctx)
+		minDeposit := keeper.GetProposalMinDeposit(ctx, proposal)
 		if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDeposit) {
 			keeper.ActivateVotingPeriod(ctx, proposal)
 			return false
@@ -71,6 +71,10 @@ func EndBlocker(goCtx context.Context, keeper *keeper.Keeper) {
 			params := keeper.GetParams(ctx)
 			// remove from queue
 			keeper.RemoveFromQuorumCheckQueue(ctx, proposal.Id, endTime)
+			// skip proposals no longer in voting period, e.g. vetoed proposals
+			if proposal.Status != v1.StatusVotingPeriod {
+				return false
+			}
 			// check if proposal passed quorum
 			quorum, err := keeper.HasReachedQuorum(ctx, proposal)
 			if err != nil {
@@ -148,7 +152,15 @@ func EndBlocker(goCtx context.Context, keeper *keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, participation, tallyResults, err := keeper.Tally(ctx, proposal)
+		// The final tally deletes the votes, which an expedited proposal
+		// must keep if it is converted to a regular proposal, so it is done
+		// in a cached context only written if the proposal is not converted.
+		tallyCtx, writeTally := ctx, func() {}
+		if proposal.Expedited {
+			tallyCtx, writeTally = ctx.CacheContext()
+		}
+
+		passes, burnDeposits, participation, tallyResults, err := keeper.Tally(tallyCtx, proposal)
 		if err != nil {
 			logger.Error(
 				"proposal tally",
@@ -157,63 +169,59 @@ func EndBlocker(goCtx context.Context, keeper *keeper.Keeper) {
 			)
 			// NOTE: do not return here as we want the proposal to be rejected
 		}
+
+		// An expedited proposal that did not pass is converted to a regular
+		// proposal, and stays in voting period with its votes and deposits
+		// until the end of the regular voting period.
+		if proposal.Expedited && !passes {
+			proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+		writeTally()
+
+		// The messages of a proposal that passed are executed once the
+		// execution delay of the proposal has elapsed. Its deposits are kept
+		// until then, so that they can still be burned if it is vetoed.
+		executionDelay := keeper.GetExecutionDelay(ctx, proposal)
+		pendingExecution := passes && executionDelay > 0
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-		} else {
+		} else if !pendingExecution {
 			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
 		}
 
-		if passes {
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err == nil {
-				for idx, msg = range messages {
-					handler := keeper.Router().Handler(msg)
-					var res *sdk.Result
-					res, err = safeExecuteHandler(cacheCtx, msg, handler)
-					if err != nil {
-						break
-					}
-
-					events = append(events, res.GetEvents()...)
-				}
-			}
+		proposal.FinalTallyResult = &tallyResults
 
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
-
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
-			} else {
-				proposal.Status = v1.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-			}
-		} else {
+		switch {
+		case pendingExecution:
+			executionTime := ctx.BlockTime().Add(executionDelay)
+			proposal.Status = v1.StatusPassedPendingExecution
+			proposal.ExecutionTime = &executionTime
+			keeper.InsertExecutionQueue(ctx, proposal.Id, executionTime)
+			tagValue = types.AttributeValueProposalPendingExecution
+			logMsg = fmt.Sprintf("passed, execution scheduled at %s", executionTime)
+		case passes:
+			proposal.Status, tagValue, logMsg = executeProposal(ctx, keeper, proposal)
+		default:
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = &tallyResults
-
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 		keeper.DecrementActiveProposalsNumber(ctx)
@@ -238,10 +246,90 @@ func EndBlocker(goCtx context.Context, keeper *keeper.Keeper) {
 		return false
 	})
 
+	// execute the proposals whose execution delay has elapsed
+	keeper.IterateExecutionQueue(ctx, ctx.BlockTime(), func(proposal v1.Proposal) bool {
+		var tagValue, logMsg string
+
+		proposal.Status, tagValue, logMsg = executeProposal(ctx, keeper, proposal)
+		keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.RemoveFromExecutionQueue(ctx, proposal.Id, *proposal.ExecutionTime)
+
+		logger.Info(
+			"proposal executed",
+			"proposal", proposal.Id,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			),
+		)
+		return false
+	})
+
+	// prune the archived votes whose retention period has elapsed
+	keeper.PruneArchivedVotes(ctx)
+
 	keeper.UpdateMinInitialDeposit(ctx, true)
 	keeper.UpdateMinDeposit(ctx, true)
 }
 
+// executeProposal attempts to execute all the messages of a proposal that
+// passed, and returns the resulting status of the proposal along with the
+// event tag value and the log message.
+func executeProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal v1.Proposal) (status v1.ProposalStatus, tagValue, logMsg string) {
+	var (
+		idx    int
+		events sdk.Events
+		msg    sdk.Msg
+	)
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged. The cached context carries the proposal id, so
+	// that the enacted laws record the proposal they originate from.
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = types.WithProposalID(cacheCtx, proposal.Id)
+	messages, err := proposal.GetMsgs()
+	if proposal.IsMultipleChoice() {
+		// only the messages of the winning option are executed
+		messages, err = proposal.GetOptionMsgs(proposal.FinalTallyResult.WinningOption)
+	}
+	if err == nil {
+		for idx, msg = range messages {
+			handler := keeper.Router().Handler(msg)
+			var res *sdk.Result
+			res, err = safeExecuteHandler(cacheCtx, msg, handler)
+			if err != nil {
+				break
+			}
+
+			events = append(events, res.GetEvents()...)
+		}
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err != nil {
+		return v1.StatusFailed, types.AttributeValueProposalFailed,
+			fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	// propagate the msg events to the current context
+	ctx.EventManager().EmitEvents(events)
+
+	return v1.StatusPassed, types.AttributeValueProposalPassed, "passed"
+}
+
 // executes handle(msg) and recovers from panic.
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
 ) (res *sdk.Result, err error) {
@@ -19,6 +19,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/gov"
+	"github.com/Hikari-Chain/hikari-chain/x/gov/client/testutil"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/keeper"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
@@ -331,7 +332,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], "", false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -362,6 +363,191 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
 }
 
+func TestEndBlockerExecutionDelay(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	executionDelay := time.Hour
+	params := suite.GovKeeper.GetParams(ctx)
+	params.ExecutionDelay = &executionDelay
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	macc := suite.GovKeeper.GetGovernanceAccount(ctx)
+	require.NotNil(t, macc)
+	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], "", false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	newDepositMsg := v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins)
+
+	res, err := govMsgSvr.Deposit(ctx, newDepositMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal passed, but its execution is delayed and its deposits kept
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassedPendingExecution, proposal.Status)
+	require.Equal(t, ctx.BlockTime().Add(executionDelay), *proposal.ExecutionTime)
+	require.NotNil(t, proposal.FinalTallyResult)
+	require.False(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
+
+	// nothing happens before the end of the execution delay
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(executionDelay - time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassedPendingExecution, proposal.Status)
+
+	// the proposal is executed and its deposits refunded
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
+
+	iterator := suite.GovKeeper.ExecutionQueueIterator(ctx, ctx.BlockTime())
+	require.False(t, iterator.Valid())
+	iterator.Close()
+}
+
+func TestEndBlockerExpeditedProposal(t *testing.T) {
+	testcases := []struct {
+		name       string
+		voteOption v1.VoteOption
+		// whether the proposal passes its expedited threshold
+		passes bool
+	}{
+		{
+			name:       "expedited proposal passes",
+			voteOption: v1.OptionYes,
+			passes:     true,
+		},
+		{
+			name:       "expedited proposal fails and is converted to regular",
+			voteOption: v1.OptionNo,
+			passes:     false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.QuorumCheckCount = 10 // enable quorum check
+			params.MinDepositThrottler.ExpeditedMultiplier = "2"
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			valAddr := sdk.ValAddress(addrs[0])
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			_, err := suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], "", true)
+			require.NoError(t, err)
+
+			// the min deposit of regular proposals doesn't activate an expedited proposal
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			minDeposit := suite.GovKeeper.GetMinDeposit(ctx)
+			_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[1], proposal.Id, minDeposit))
+			require.NoError(t, err)
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[1], proposal.Id, minDeposit))
+			require.NoError(t, err)
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, ctx.BlockTime().Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			// expedited proposals are not checked for quorum
+			iterator := suite.GovKeeper.QuorumCheckQueueIterator(ctx, *proposal.VotingEndTime)
+			require.False(t, iterator.Valid())
+			iterator.Close()
+
+			err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(tc.voteOption), "")
+			require.NoError(t, err)
+
+			ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			if tc.passes {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, proposal.Expedited)
+				return
+			}
+
+			// the proposal keeps its votes and deposits and goes on with the
+			// regular voting period, with a quorum check
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, v1.EmptyTallyResult(), *proposal.FinalTallyResult)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			_, ok = suite.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+			require.True(t, ok)
+			require.Len(t, suite.GovKeeper.GetDeposits(ctx, proposal.Id), 1)
+			require.EqualValues(t, 1, suite.GovKeeper.GetActiveProposalsNumber(ctx))
+
+			quorumTimeoutTime := proposal.VotingStartTime.Add(*params.QuorumTimeout)
+			quorumCheckEntry, ok := testutil.GetQuorumCheckQueueEntry(ctx, suite.GovKeeper, proposal.Id, quorumTimeoutTime)
+			require.True(t, ok)
+			require.EqualValues(t, params.QuorumCheckCount, quorumCheckEntry.QuorumCheckCount)
+
+			// the proposal is rejected at the end of the regular voting period
+			ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusRejected, proposal.Status)
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite := createTestSuite(t)
 	app := suite.App
@@ -381,7 +567,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000))))
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0], "", false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -546,6 +732,74 @@ func TestEndBlockerQuorumCheck(t *testing.T) {
 	}
 }
 
+func TestEndBlockerQuorumCheckAfterVetoOverride(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false)
+	params := v1.DefaultParams()
+	params.QuorumCheckCount = 10 // enable quorum check
+	quorumTimeout := *params.VotingPeriod - time.Hour*8
+	params.QuorumTimeout = &quorumTimeout
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+	valAddr := sdk.ValAddress(addrs[0])
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err := suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], "", false)
+	require.NoError(t, err)
+	suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+
+	// veto the proposal the way the core DAOs do
+	remainingTime := proposal.VotingEndTime.Sub(ctx.BlockTime())
+	proposal.Status = v1.StatusVetoed
+	suite.GovKeeper.SetProposal(ctx, proposal)
+	suite.GovKeeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	suite.GovKeeper.DecrementActiveProposalsNumber(ctx)
+
+	// the quorum check of the vetoed proposal is dropped
+	ctx = ctx.WithBlockTime(proposal.VotingStartTime.Add(quorumTimeout))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.False(t, suite.GovKeeper.QuorumCheckQueueIterator(ctx, *proposal.VotingEndTime).Valid())
+
+	// override the veto the way the core DAOs do
+	endTime := ctx.BlockTime().Add(remainingTime)
+	proposal.Status = v1.StatusVotingPeriod
+	proposal.VotingEndTime = &endTime
+	suite.GovKeeper.SetProposal(ctx, proposal)
+	suite.GovKeeper.InsertActiveProposalQueue(ctx, proposal.Id, endTime)
+	suite.GovKeeper.ResetQuorumCheck(ctx, proposal)
+	suite.GovKeeper.IncrementActiveProposalsNumber(ctx)
+
+	// the quorum timeout has already elapsed, so the first check is due now
+	quorumCheckEntry, ok := testutil.GetQuorumCheckQueueEntry(ctx, suite.GovKeeper, proposal.Id, ctx.BlockTime())
+	require.True(t, ok)
+	require.EqualValues(t, params.QuorumCheckCount, quorumCheckEntry.QuorumCheckCount)
+	require.Zero(t, quorumCheckEntry.QuorumChecksDone)
+
+	// the quorum is not reached, so the next check is scheduled
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.False(t, testutil.HasQuorumCheck(ctx, suite.GovKeeper, proposal.Id, ctx.BlockTime()))
+	nextCheckTime := ctx.BlockTime().Add(remainingTime / time.Duration(params.QuorumCheckCount))
+	quorumCheckEntry, ok = testutil.GetQuorumCheckQueueEntry(ctx, suite.GovKeeper, proposal.Id, nextCheckTime)
+	require.True(t, ok)
+	require.EqualValues(t, 1, quorumCheckEntry.QuorumChecksDone)
+
+	// the quorum is reached, so the quorum checks end before the voting period
+	ctx = ctx.WithBlockTime(nextCheckTime)
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, endTime, *proposal.VotingEndTime)
+	require.False(t, suite.GovKeeper.QuorumCheckQueueIterator(ctx, endTime).Valid())
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
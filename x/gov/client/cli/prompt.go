@@ -303,17 +303,24 @@ func NewCmdDraftProposal() *cobra.Command {
 				return err
 			}
 
-			if err := writeFile(draftProposalFileName, result); err != nil {
-				return err
-			}
-
 			if !skipMetadataPrompt {
-				if err := writeFile(draftMetadataFileName, metadata); err != nil {
+				raw, err := writeFile(draftMetadataFileName, metadata)
+				if err != nil {
 					return err
 				}
+
+				// commit the proposal to the exact metadata file to upload
+				result.MetadataHash = types.ComputeMetadataHash(raw)
+			}
+
+			if _, err := writeFile(draftProposalFileName, result); err != nil {
+				return err
 			}
 
 			cmd.Println("The draft proposal has successfully been generated.\nProposals should contain off-chain metadata, please upload the metadata JSON to IPFS.\nThen, replace the generated metadata field with the IPFS CID.")
+			if !skipMetadataPrompt {
+				cmd.Println("The metadata hash of the draft proposal commits to the generated metadata JSON, which must not be modified before uploading it.")
+			}
 
 			return nil
 		},
@@ -324,16 +331,16 @@ func NewCmdDraftProposal() *cobra.Command {
 	return cmd
 }
 
-// writeFile writes the input to the file
-func writeFile(fileName string, input any) error {
+// writeFile writes the input to the file and returns the written bytes
+func writeFile(fileName string, input any) ([]byte, error) {
 	raw, err := json.MarshalIndent(input, "", " ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal proposal: %w", err)
+		return nil, fmt.Errorf("failed to marshal proposal: %w", err)
 	}
 
 	if err := os.WriteFile(fileName, raw, 0o600); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return raw, nil
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -30,9 +31,13 @@ func GetQueryCmd() *cobra.Command {
 
 	govQueryCmd.AddCommand(
 		GetCmdQueryProposal(),
+		GetCmdQueryVerifyMetadata(),
 		GetCmdQueryProposals(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
+		GetCmdQueryArchivedVotes(),
+		GetCmdQueryVoterHistory(),
+		GetCmdQueryVotingPower(),
 		GetCmdQueryParams(),
 		GetCmdQueryQuorums(),
 		GetCmdQueryParticipationEMAs(),
@@ -42,8 +47,19 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
 		GetCmdConstitution(),
+		GetCmdQueryConstitutionHistory(),
+		GetCmdQueryConstitutionDiff(),
+		GetCmdQueryConstitutionBlame(),
+		GetCmdQueryConstitutionAmendmentStatuses(),
 		GetCmdQueryMinDeposit(),
 		GetCmdQueryMinInitialDeposit(),
+		GetCmdQueryGovernor(),
+		GetCmdQueryGovernors(),
+		GetCmdQueryGovernanceDelegation(),
+		GetCmdQueryGovernanceDelegations(),
+		GetCmdQueryGovernorValShares(),
+		GetCmdQueryLaw(),
+		GetCmdQueryLaws(),
 	)
 
 	return govQueryCmd
@@ -96,6 +112,65 @@ $ %s query gov proposal 1
 	return cmd
 }
 
+// GetCmdQueryVerifyMetadata implements the query verify metadata command.
+func GetCmdQueryVerifyMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-metadata [proposal-id] [path/to/metadata.json]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Verify a local metadata file against the metadata hash of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify that a local metadata file, e.g. downloaded from IPFS, is the
+off-chain metadata the proposal committed to with its metadata hash.
+
+Example:
+$ %s query gov verify-metadata 1 path/to/metadata.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			metadata, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Query the proposal
+			res, err := queryClient.Proposal(
+				cmd.Context(),
+				&v1.QueryProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			if res.Proposal.MetadataHash == "" {
+				return fmt.Errorf("proposal %d has no metadata hash", proposalID)
+			}
+			if hash := types.ComputeMetadataHash(metadata); hash != res.Proposal.MetadataHash {
+				return fmt.Errorf("metadata hash mismatch: proposal %d has %s, file has %s", proposalID, res.Proposal.MetadataHash, hash)
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("metadata matches the metadata hash %s of proposal %d\n", res.Proposal.MetadataHash, proposalID))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get
 // Proposals Information.
 func GetCmdQueryProposals() *cobra.Command {
@@ -108,7 +183,7 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|PassedPendingExecution|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -177,7 +252,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed_pending_execution/passed/rejected")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -297,8 +372,23 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			propStatus := proposalRes.GetProposal().Status
 			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
+				// votes are deleted once the proposal is tallied, prefer the
+				// archived votes if any over searching the vote txs
+				archivedRes, err := queryClient.ArchivedVotes(
+					ctx,
+					&v1.QueryArchivedVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+				)
+				if err == nil && len(archivedRes.Votes) > 0 {
+					return clientCtx.PrintProto(archivedRes)
+				}
+
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -316,14 +406,167 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 
 			}
 
+			res, err := queryClient.Votes(
+				ctx,
+				&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryArchivedVotes implements the command to query the archived votes
+// of a proposal.
+func GetCmdQueryArchivedVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the archived votes of a finished proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the votes of a finished proposal, archived along with their voting
+power when the proposal was tallied.
+
+Example:
+$ %[1]s query gov archived-votes 1
+$ %[1]s query gov archived-votes 1 --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.Votes(
-				ctx,
-				&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+			res, err := queryClient.ArchivedVotes(
+				cmd.Context(),
+				&v1.QueryArchivedVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "archived votes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoterHistory implements the command to query the archived votes
+// of a voter.
+func GetCmdQueryVoterHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-history [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the archived votes of a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the archived votes of a voter on the finished proposals, along with
+their voting power.
+
+Example:
+$ %s query gov voter-history cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoterHistory(
+				cmd.Context(),
+				&v1.QueryVoterHistoryRequest{Voter: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "voter history")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotingPower implements the command to query the voting power
+// counted for a voter on a proposal.
+func GetCmdQueryVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the voting power counted for a voter on a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power counted for a voter on a proposal in voting period,
+along with its snapshotted and current voting power. If the voting power of
+the proposal is snapshotted, the counted voting power is the minimum of both.
+
+Example:
+$ %s query gov voting-power 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPower(
+				cmd.Context(),
+				&v1.QueryVotingPowerRequest{ProposalId: proposalID, Voter: args[1]},
 			)
 			if err != nil {
 				return err
@@ -333,7 +576,6 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 		},
 	}
 
-	flags.AddPaginationFlagsToCmd(cmd, "votes")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -745,10 +987,21 @@ $ %s query gov proposer 1
 }
 
 func GetCmdConstitution() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "constitution",
 		Short: "Get the constitution",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the current constitution, or a given version of the constitution
+along with the proposal and the amendment that produced it.
+
+Example:
+$ %s query gov constitution
+$ %s query gov constitution --version 2
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -756,6 +1009,19 @@ func GetCmdConstitution() *cobra.Command {
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
+			constitutionVersion, _ := cmd.Flags().GetUint64(flagVersion)
+			if constitutionVersion != 0 {
+				res, err := queryClient.ConstitutionVersion(
+					cmd.Context(),
+					&v1.QueryConstitutionVersionRequest{Version: constitutionVersion},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res.ConstitutionVersion)
+			}
+
 			resp, err := queryClient.Constitution(cmd.Context(), &v1.QueryConstitutionRequest{})
 			if err != nil {
 				return err
@@ -764,68 +1030,593 @@ func GetCmdConstitution() *cobra.Command {
 			return clientCtx.PrintProto(resp)
 		},
 	}
+
+	cmd.Flags().Uint64(flagVersion, 0, "(optional) version of the constitution to query")
+
+	return cmd
 }
 
-// GetCmdQueryMinDeposit implements the query min deposit command.
-func GetCmdQueryMinDeposit() *cobra.Command {
-	return &cobra.Command{
-		Use:   "min-deposit",
-		Args:  cobra.ExactArgs(0),
-		Short: "Query the minimum deposit currently needed for a proposal to enter voting period",
+// GetCmdQueryConstitutionHistory implements the query constitution history command.
+func GetCmdQueryConstitutionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-history",
+		Args:  cobra.NoArgs,
+		Short: "Query all the versions of the constitution",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the minimum deposit needed for a proposal to enter voting period.
+			fmt.Sprintf(`Query for all paginated versions of the constitution.
 
 Example:
-$ %s query gov min-deposit
+$ %s query gov constitution-history
+$ %s query gov constitution-history --page=2 --limit=100
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			resp, err := queryClient.MinDeposit(cmd.Context(), &v1.QueryMinDepositRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(resp)
+			res, err := queryClient.ConstitutionHistory(
+				cmd.Context(),
+				&v1.QueryConstitutionHistoryRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "constitution versions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
 }
 
-// GetCmdQueryMinInitialDeposit implements the query min initial deposit command.
-func GetCmdQueryMinInitialDeposit() *cobra.Command {
-	return &cobra.Command{
-		Use:   "min-initial-deposit",
-		Args:  cobra.ExactArgs(0),
-		Short: "Query the minimum initial deposit needed for a proposal to enter deposit period",
+// GetCmdQueryConstitutionDiff implements the query constitution diff command.
+func GetCmdQueryConstitutionDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-diff [from-version] [to-version]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the unified diff between two versions of the constitution",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the minimum initial deposit needed for a proposal to enter deposit period.
+			fmt.Sprintf(`Query the unified diff that turns a version of the constitution into another.
 
 Example:
-$ %s query gov min-initial-deposit
+$ %s query gov constitution-diff 1 3
 `,
 				version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			resp, err := queryClient.MinInitialDeposit(cmd.Context(), &v1.QueryMinInitialDepositRequest{})
+			fromVersion, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from-version %s not a valid uint, please input a valid version", args[0])
+			}
+			toVersion, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to-version %s not a valid uint, please input a valid version", args[1])
+			}
+
+			res, err := queryClient.ConstitutionDiff(
+				cmd.Context(),
+				&v1.QueryConstitutionDiffRequest{FromVersion: fromVersion, ToVersion: toVersion},
+			)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(resp)
+			return clientCtx.PrintProto(res)
 		},
 	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConstitutionBlame implements the query constitution blame command.
+func GetCmdQueryConstitutionBlame() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-blame [version]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the version and proposal that introduced each line of the constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lines of a version of the constitution, the latest if no version
+is given, along with the version and proposal that introduced each of them.
+
+Example:
+$ %s query gov constitution-blame
+$ %s query gov constitution-blame 2
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			var constitutionVersion uint64
+			if len(args) == 1 {
+				constitutionVersion, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("version %s not a valid uint, please input a valid version", args[0])
+				}
+			}
+
+			res, err := queryClient.ConstitutionBlame(
+				cmd.Context(),
+				&v1.QueryConstitutionBlameRequest{Version: constitutionVersion},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConstitutionAmendmentStatuses implements the query constitution
+// amendment statuses command.
+func GetCmdQueryConstitutionAmendmentStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution-amendments",
+		Args:  cobra.NoArgs,
+		Short: "Query whether the pending constitution amendments apply to the current constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the constitution amendments of the proposals in deposit or
+voting period apply cleanly to the current constitution.
+
+Example:
+$ %s query gov constitution-amendments
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConstitutionAmendmentStatuses(
+				cmd.Context(),
+				&v1.QueryConstitutionAmendmentStatusesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMinDeposit implements the query min deposit command.
+func GetCmdQueryMinDeposit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "min-deposit",
+		Args:  cobra.ExactArgs(0),
+		Short: "Query the minimum deposit currently needed for a proposal to enter voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum deposit needed for a proposal to enter voting period.
+
+Example:
+$ %s query gov min-deposit
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.MinDeposit(cmd.Context(), &v1.QueryMinDepositRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+}
+
+// GetCmdQueryMinInitialDeposit implements the query min initial deposit command.
+func GetCmdQueryMinInitialDeposit() *cobra.Command {
+	return &cobra.Command{
+		Use:   "min-initial-deposit",
+		Args:  cobra.ExactArgs(0),
+		Short: "Query the minimum initial deposit needed for a proposal to enter deposit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum initial deposit needed for a proposal to enter deposit period.
+
+Example:
+$ %s query gov min-initial-deposit
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.MinInitialDeposit(cmd.Context(), &v1.QueryMinInitialDepositRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+}
+
+// GetCmdQueryGovernor implements the query governor command.
+func GetCmdQueryGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a governor.
+
+Example:
+$ %s query gov governor cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governor(
+				cmd.Context(),
+				&v1.QueryGovernorRequest{GovernorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernors implements the query governors command.
+func GetCmdQueryGovernors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governors",
+		Args:  cobra.NoArgs,
+		Short: "Query all the governors",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for all the governors.
+
+Example:
+$ %s query gov governors
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governors(
+				cmd.Context(),
+				&v1.QueryGovernorsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governors")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegation implements the query governance delegation
+// command.
+func GetCmdQueryGovernanceDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegation [delegator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governor a delegator delegates its governance voting power to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governor a delegator delegates its governance voting power to.
+
+Example:
+$ %s query gov governance-delegation cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegation(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegations implements the query governance
+// delegations of a governor command.
+func GetCmdQueryGovernanceDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegations [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance delegations of a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the governance delegations of a governor.
+
+Example:
+$ %s query gov governance-delegations cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegations(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationsRequest{GovernorAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governance delegations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernorValShares implements the query validator shares of a
+// governor command.
+func GetCmdQueryGovernorValShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor-valshares [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validator shares delegated to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator shares delegated by the governance delegators of a
+governor, which the governor votes with.
+
+Example:
+$ %s query gov governor-valshares cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernorValShares(
+				cmd.Context(),
+				&v1.QueryGovernorValSharesRequest{GovernorAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governor validator shares")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLaw implements the query law command.
+func GetCmdQueryLaw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "law [law-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single law",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a law. You can find the law-id by running
+"%s query gov laws".
+
+Example:
+$ %s query gov law 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the law id is a uint
+			lawID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("law-id %s not a valid uint, please input a valid law-id", args[0])
+			}
+
+			res, err := queryClient.Law(
+				cmd.Context(),
+				&v1.QueryLawRequest{LawId: lawID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Law)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLaws implements the query laws command.
+func GetCmdQueryLaws() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "laws",
+		Short: "Query laws with an optional status filter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all paginated laws, optionally filtered by status.
+
+Example:
+$ %s query gov laws
+$ %s query gov laws --status (in_force|superseded|repealed)
+$ %s query gov laws --page=2 --limit=100
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strLawStatus, _ := cmd.Flags().GetString(flagStatus)
+
+			var lawStatus v1.LawStatus
+			if len(strLawStatus) != 0 {
+				var err error
+				lawStatus, err = v1.LawStatusFromString(strLawStatus)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Laws(
+				cmd.Context(),
+				&v1.QueryLawsRequest{Status: lawStatus, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "(optional) filter laws by law status, status: in_force/superseded/repealed")
+	flags.AddPaginationFlagsToCmd(cmd, "laws")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
 }
@@ -187,6 +187,34 @@ func (s *CLITestSuite) TestCmdGetProposal() {
 	}
 }
 
+func (s *CLITestSuite) TestCmdVerifyMetadata() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"verify metadata",
+			[]string{
+				"1",
+				"metadata.json",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 metadata.json --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryVerifyMetadata()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
 func (s *CLITestSuite) TestCmdGetProposals() {
 	testCases := []struct {
 		name         string
@@ -336,6 +364,71 @@ func (s *CLITestSuite) TestCmdQueryVotes() {
 	}
 }
 
+func (s *CLITestSuite) TestCmdQueryArchivedVotes() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get archived votes of a proposal",
+			[]string{
+				"10",
+			},
+			"10",
+		},
+		{
+			"get archived votes of a proposal (json output)",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryArchivedVotes()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryLaws() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get laws in force",
+			[]string{
+				"--status=in_force",
+			},
+			"--status=in_force",
+		},
+		{
+			"get laws (json output)",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLaws()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
 func (s *CLITestSuite) TestCmdQueryVote() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
@@ -384,6 +477,42 @@ func (s *CLITestSuite) TestCmdQueryVote() {
 	}
 }
 
+func (s *CLITestSuite) TestCmdQueryVotingPower() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"get voting power of a voter on a proposal",
+			[]string{
+				"10",
+				val[0].Address.String(),
+			},
+			fmt.Sprintf("10 %s", val[0].Address.String()),
+		},
+		{
+			"get voting power of a voter on a proposal (json output)",
+			[]string{
+				"1",
+				val[0].Address.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			fmt.Sprintf("1 %s --output=json", val[0].Address.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryVotingPower()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
 func (s *CLITestSuite) TestCmdGetConstitution() {
 	testCases := []struct {
 		name      string
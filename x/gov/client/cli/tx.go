@@ -37,6 +37,19 @@ const (
 	FlagProposal = "proposal"
 )
 
+// Governor flags
+const (
+	FlagIdentity        = "identity"
+	FlagWebsite         = "website"
+	FlagSecurityContact = "security-contact"
+	FlagDetails         = "details"
+)
+
+// Constitution flags
+const (
+	flagVersion = "version"
+)
+
 // ProposalFlags defines the core required fields of a legacy proposal. It is used to
 // verify that these values are not provided in conjunction with a JSON proposal
 // file.
@@ -69,11 +82,18 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
+		NewCmdCancelProposal(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdMultipleChoiceVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdGenerateConstitutionAmendment(),
+		NewCmdCreateGovernor(),
+		NewCmdEditGovernor(),
+		NewCmdUpdateGovernorStatus(),
+		NewCmdDelegateGovernor(),
+		NewCmdUndelegateGovernor(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -112,6 +132,27 @@ Where proposal.json contains:
   "metadata": "4pIMOgIGx1vZGU=",
   "deposit": "10stake",
   "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  // optional hex-encoded SHA-256 hash of the off-chain metadata file
+  "metadata_hash": "",
+  // optional, an expedited proposal has a shorter voting period, a higher
+  // threshold and a higher minimum deposit
+  "expedited": false
+}
+
+A multiple-choice proposal has no messages of its own, but 2 or more named
+options, each with the messages executed if the option wins:
+
+{
+  "options": [
+    {"name": "Alice", "messages": [...]},
+    {"name": "Bob", "messages": [...]}
+  ],
+  // TALLY_MODE_PLURALITY (default) or TALLY_MODE_INSTANT_RUNOFF
+  "tally_mode": "TALLY_MODE_INSTANT_RUNOFF",
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
   "summary": "A short summary of my proposal"
 }
 
@@ -134,15 +175,22 @@ metadata example:
 				return err
 			}
 
-			msgs, metadata, title, summary, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.MetadataHash = proposal.MetadataHash
+			msg.Expedited = proposal.Expedited
+
+			msg.Options, msg.TallyMode, err = parseMultipleChoice(clientCtx.Codec, proposal)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -270,6 +318,47 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposal implements canceling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in its deposit or voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in its deposit or voting period. Only the proposer
+can cancel a proposal. A part of the deposits, as defined by the proposal_cancel_ratio
+parameter, is burned or sent to the proposal_cancel_dest address, the rest is refunded
+to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -378,6 +467,64 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
 	return cmd
 }
 
+// NewCmdMultipleChoiceVote implements creating a new vote on a multiple-choice
+// proposal command.
+func NewCmdMultipleChoiceVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multiple-choice-vote [proposal-id] [weighted-choices]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for the options of an active multiple-choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for the options of an active multiple-choice proposal.
+Options are designated by their index in the options of the proposal, and
+their weights must sum up to 1. With instant-runoff tally, the weights also
+rank the options. You can find the proposal-id and its options by running
+"%s query gov proposal [proposal-id]".
+
+Example:
+$ %s tx gov multiple-choice-vote 1 0=0.7,2=0.3 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which options user chose
+			choices, err := v1.WeightedChoicesFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := v1.NewMsgVoteMultipleChoice(from, proposalID, choices, metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdConstitutionAmendmentMsg returns the command to generate the sdk.Msg
 // required for a constitution amendment proposal generating the unified diff
 // between the current constitution (queried) and the updated constitution
@@ -470,3 +617,218 @@ $ %s tx gov generate-constitution-amendment path/to/updated/constitution.md
 
 	return cmd
 }
+
+// NewCmdCreateGovernor implements creating a new governor command.
+func NewCmdCreateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-governor [moniker]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a new governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new governor, which other accounts can delegate their governance
+voting power to. The account must have at least min_governor_self_delegation
+bonded tokens, and its governance voting power is delegated to itself.
+
+Example:
+$ %s tx gov create-governor mygovernor --website https://example.com --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description, err := governorDescriptionFromFlags(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCreateGovernor(clientCtx.GetFromAddress(), description)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGovernorDescriptionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdEditGovernor implements editing the description of a governor command.
+func NewCmdEditGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-governor [moniker]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Edit the description of a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the description of a governor. The whole description is replaced, the
+fields whose flag is not given are cleared.
+
+Example:
+$ %s tx gov edit-governor mygovernor --details "new details" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description, err := governorDescriptionFromFlags(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgEditGovernor(clientCtx.GetFromAddress(), description)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addGovernorDescriptionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGovernorStatus implements updating the status of a governor
+// command.
+func NewCmdUpdateGovernorStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-governor-status [status]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the status of a governor, status: active/inactive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the status of a governor. The votes of an inactive governor only
+count for its own stake. The status can only be changed once per
+governor_status_change_period.
+
+Example:
+$ %s tx gov update-governor-status inactive --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := v1.GovernorStatusFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUpdateGovernorStatus(clientCtx.GetFromAddress(), status)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateGovernor implements delegating the governance voting power to
+// a governor command.
+func NewCmdDelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-governor [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate governance voting power to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate governance voting power to an active governor. The vote of the
+governor counts for the stake of the delegator, unless the delegator votes
+itself. The stake remains delegated to the same validators.
+
+Example:
+$ %s tx gov delegate-governor cosmos1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovernor(clientCtx.GetFromAddress(), governor)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateGovernor implements undelegating the governance voting power
+// from a governor command.
+func NewCmdUndelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-governor",
+		Args:  cobra.NoArgs,
+		Short: "Undelegate governance voting power from the current governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Undelegate governance voting power from the current governor.
+
+Example:
+$ %s tx gov undelegate-governor --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernor(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addGovernorDescriptionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
+	cmd.Flags().String(FlagWebsite, "", "The governor's (optional) website")
+	cmd.Flags().String(FlagSecurityContact, "", "The governor's (optional) security contact email")
+	cmd.Flags().String(FlagDetails, "", "The governor's (optional) details")
+}
+
+func governorDescriptionFromFlags(cmd *cobra.Command, moniker string) (v1.GovernorDescription, error) {
+	identity, err := cmd.Flags().GetString(FlagIdentity)
+	if err != nil {
+		return v1.GovernorDescription{}, err
+	}
+	website, err := cmd.Flags().GetString(FlagWebsite)
+	if err != nil {
+		return v1.GovernorDescription{}, err
+	}
+	securityContact, err := cmd.Flags().GetString(FlagSecurityContact)
+	if err != nil {
+		return v1.GovernorDescription{}, err
+	}
+	details, err := cmd.Flags().GetString(FlagDetails)
+	if err != nil {
+		return v1.GovernorDescription{}, err
+	}
+
+	return v1.NewGovernorDescription(moniker, identity, website, securityContact, details), nil
+}
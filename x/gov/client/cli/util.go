@@ -88,20 +88,35 @@ type proposal struct {
 	Deposit  string            `json:"deposit"`
 	Title    string            `json:"title"`
 	Summary  string            `json:"summary"`
+	// MetadataHash is the hex-encoded SHA-256 hash of the off-chain metadata.
+	MetadataHash string `json:"metadata_hash,omitempty"`
+	// Options defines the options of a multiple-choice proposal.
+	Options []multipleChoiceOption `json:"options,omitempty"`
+	// TallyMode defines the tally mode of a multiple-choice proposal.
+	TallyMode string `json:"tally_mode,omitempty"`
+	// Expedited defines if the proposal is expedited.
+	Expedited bool `json:"expedited,omitempty"`
+}
+
+// multipleChoiceOption defines an option of a multiple-choice proposal.
+type multipleChoiceOption struct {
+	Name string `json:"name"`
+	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
+	Messages []json.RawMessage `json:"messages,omitempty"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, string, string, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk.Coins, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -109,7 +124,7 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, "", "", "", nil, err
+			return proposal, nil, nil, err
 		}
 
 		msgs[i] = msg
@@ -117,10 +132,45 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
+	}
+
+	return proposal, msgs, deposit, nil
+}
+
+// parseMultipleChoice parses the options and the tally mode of a
+// multiple-choice proposal.
+func parseMultipleChoice(cdc codec.Codec, proposal proposal) ([]*govv1.MultipleChoiceOption, govv1.TallyMode, error) {
+	tallyMode := govv1.TallyModePlurality
+	if proposal.TallyMode != "" {
+		mode, ok := govv1.TallyMode_value[proposal.TallyMode]
+		if !ok {
+			return nil, tallyMode, fmt.Errorf("'%s' is not a valid tally mode", proposal.TallyMode)
+		}
+		tallyMode = govv1.TallyMode(mode)
+	}
+
+	options := make([]*govv1.MultipleChoiceOption, len(proposal.Options))
+	for i, opt := range proposal.Options {
+		msgs := make([]sdk.Msg, len(opt.Messages))
+		for j, anyJSON := range opt.Messages {
+			var msg sdk.Msg
+			err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
+			if err != nil {
+				return nil, tallyMode, err
+			}
+
+			msgs[j] = msg
+		}
+
+		var err error
+		options[i], err = govv1.NewMultipleChoiceOption(opt.Name, msgs)
+		if err != nil {
+			return nil, tallyMode, err
+		}
 	}
 
-	return msgs, proposal.Metadata, proposal.Title, proposal.Summary, deposit, nil
+	return options, tallyMode, nil
 }
 
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
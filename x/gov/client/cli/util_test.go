@@ -23,6 +23,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
 )
@@ -165,25 +166,29 @@ func TestParseSubmitProposal(t *testing.T) {
 	"metadata": "%s",
 	"title": "My awesome title",
 	"summary": "My awesome summary",
-	"deposit": "1000test"
+	"deposit": "1000test",
+	"metadata_hash": "%s",
+	"expedited": true
 }
-`, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
+`, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata), types.ComputeMetadataHash(expectedMetadata)))
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
 
 	// nonexistent json
-	_, _, _, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
+	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
 	require.Error(t, err)
 
 	// invalid json
-	_, _, _, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
+	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, title, summary, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(1000))), deposit)
-	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
+	require.Equal(t, types.ComputeMetadataHash(expectedMetadata), proposal.MetadataHash)
+	require.True(t, proposal.Expedited)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
@@ -202,8 +207,8 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "My awesome title", textProp.Title)
 	require.Equal(t, "My awesome description", textProp.Description)
-	require.Equal(t, "My awesome title", title)
-	require.Equal(t, "My awesome summary", summary)
+	require.Equal(t, "My awesome title", proposal.Title)
+	require.Equal(t, "My awesome summary", proposal.Summary)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
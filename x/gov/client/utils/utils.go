@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
 )
 
@@ -61,6 +62,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1beta1.StatusRejected.String()
 	case "Vetoed", "vetoed":
 		return v1beta1.StatusVetoed.String()
+	case "PassedPendingExecution", "passed_pending_execution":
+		return v1.StatusPassedPendingExecution.String()
 	default:
 		return status
 	}
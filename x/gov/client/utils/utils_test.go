@@ -77,6 +77,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"Vetoed", args{"Vetoed"}, "PROPOSAL_STATUS_VETOED"},
 		{"vetoed", args{"vetoed"}, "PROPOSAL_STATUS_VETOED"},
+		{"PassedPendingExecution", args{"PassedPendingExecution"}, "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION"},
+		{"passed_pending_execution", args{"passed_pending_execution"}, "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	_ "github.com/cosmos/cosmos-sdk/x/params"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -122,6 +123,7 @@ func createTestSuite(t *testing.T) suite {
 				configurator.AuthModule(),
 				configurator.StakingModule(),
 				configurator.BankModule(),
+				configurator.DistributionModule(),
 				govtestutil.GovModule(),
 				configurator.ConsensusModule(),
 			),
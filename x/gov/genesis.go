@@ -18,7 +18,20 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	if err := k.SetParams(ctx, *data.Params); err != nil {
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
-	k.SetConstitution(ctx, data.Constitution)
+	if len(data.ConstitutionHistory) == 0 {
+		// the genesis constitution is the first version of the history
+		k.SetConstitution(ctx, data.Constitution)
+		k.SetConstitutionVersion(ctx, v1.ConstitutionVersion{
+			Version:      1,
+			Height:       ctx.BlockHeight(),
+			Constitution: data.Constitution,
+		})
+	} else {
+		k.SetConstitution(ctx, data.Constitution)
+		for _, constitutionVersion := range data.ConstitutionHistory {
+			k.SetConstitutionVersion(ctx, *constitutionVersion)
+		}
+	}
 
 	participationEma, err := math.LegacyNewDecFromStr(data.ParticipationEma)
 	if err != nil {
@@ -38,6 +51,30 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	}
 	k.SetLawParticipationEMA(ctx, lawParticipationEma)
 
+	for _, governor := range data.Governors {
+		k.SetGovernor(ctx, *governor)
+	}
+	for _, delegation := range data.GovernanceDelegations {
+		k.SetGovernanceDelegation(ctx, *delegation)
+	}
+	for _, valShares := range data.GovernorValShares {
+		k.SetGovernorValShares(ctx, *valShares)
+	}
+	for _, vote := range data.ArchivedVotes {
+		k.SetArchivedVote(ctx, *vote)
+	}
+
+	nextLawID := data.NextLawId
+	for _, law := range data.Laws {
+		k.SetLaw(ctx, *law)
+		if data.NextLawId == 0 && law.Id >= nextLawID {
+			nextLawID = law.Id + 1
+		}
+	}
+	if nextLawID != 0 {
+		k.SetLawID(ctx, nextLawID)
+	}
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
@@ -53,6 +90,12 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	for _, vote := range data.Votes {
 		k.SetVote(ctx, *vote)
 	}
+	for _, snapshot := range data.VotingPowerSnapshots {
+		k.SetVotingPowerSnapshot(ctx, *snapshot)
+	}
+	for _, snapshot := range data.GovernorPowerSnapshots {
+		k.SetGovernorPowerSnapshot(ctx, *snapshot)
+	}
 
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
@@ -60,6 +103,8 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
 		case v1.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		case v1.StatusPassedPendingExecution:
+			k.InsertExecutionQueue(ctx, proposal.Id, *proposal.ExecutionTime)
 		}
 		k.SetProposal(ctx, *proposal)
 
@@ -141,6 +186,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		Time:  &blockTime,
 	}
 
+	governors := k.GetAllGovernors(ctx)
+	governanceDelegations := k.GetAllGovernanceDelegations(ctx)
+	governorValShares := k.GetAllGovernorValShares(ctx)
+	archivedVotes := k.GetAllArchivedVotes(ctx)
+	laws := k.GetAllLaws(ctx)
+
 	return &v1.GenesisState{
 		StartingProposalId:                    startingProposalID,
 		Deposits:                              proposalsDeposits,
@@ -153,5 +204,14 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		ParticipationEma:                      participationEma,
 		ConstitutionAmendmentParticipationEma: constitutionAmendmentParticipationEma,
 		LawParticipationEma:                   lawParticipationEma,
+		Governors:                             governors,
+		GovernanceDelegations:                 governanceDelegations,
+		GovernorValShares:                     governorValShares,
+		ArchivedVotes:                         archivedVotes,
+		Laws:                                  laws,
+		NextLawId:                             k.GetLawID(ctx),
+		ConstitutionHistory:                   k.GetConstitutionHistory(ctx),
+		VotingPowerSnapshots:                  k.GetAllVotingPowerSnapshots(ctx),
+		GovernorPowerSnapshots:                k.GetAllGovernorPowerSnapshots(ctx),
 	}
 }
@@ -34,6 +34,8 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 		Value: expectedGenState.Params.MinInitialDepositThrottler.FloorValue,
 		Time:  &time.Time{},
 	}
+	expectedGenState.NextLawId = 1
+	expectedGenState.ConstitutionHistory = []*v1.ConstitutionVersion{{Version: 1, Height: ctx.BlockHeight()}}
 	require.Panics(t, func() {
 		gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, &v1.GenesisState{
 			Deposits: v1.Deposits{
@@ -193,6 +195,29 @@ func TestInitGenesis(t *testing.T) {
 				assert.Equal(t, v1.DefaultParticipationEma, s.GovKeeper.GetParticipationEMA(ctx).String())
 				assert.Equal(t, v1.DefaultParticipationEma, s.GovKeeper.GetConstitutionAmendmentParticipationEMA(ctx).String())
 				assert.Equal(t, v1.DefaultParticipationEma, s.GovKeeper.GetLawParticipationEMA(ctx).String())
+				assert.Equal(t, []*v1.ConstitutionVersion{{Version: 1}}, s.GovKeeper.GetConstitutionHistory(ctx))
+			},
+		},
+		{
+			name: "ok: genesis with constitution history",
+			genesis: v1.GenesisState{
+				Params:                                params,
+				ParticipationEma:                      v1.DefaultParticipationEma,
+				ConstitutionAmendmentParticipationEma: v1.DefaultParticipationEma,
+				LawParticipationEma:                   v1.DefaultParticipationEma,
+				Constitution:                          "one\ntwo",
+				ConstitutionHistory: []*v1.ConstitutionVersion{
+					{Version: 1, Constitution: "one"},
+					{Version: 2, ProposalId: 1, Height: 10, Amendment: "@@ -1 +1,2 @@\n one\n+two", Constitution: "one\ntwo"},
+				},
+			},
+			assert: func(t *testing.T, ctx sdk.Context, s suite) {
+				t.Helper()
+				assert.Equal(t, "one\ntwo", s.GovKeeper.GetConstitution(ctx))
+				latest, found := s.GovKeeper.GetLatestConstitutionVersion(ctx)
+				assert.True(t, found)
+				assert.Equal(t, uint64(2), latest.Version)
+				assert.Len(t, s.GovKeeper.GetConstitutionHistory(ctx), 2)
 			},
 		},
 		{
@@ -20,6 +20,7 @@ import (
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/gov/keeper"
@@ -32,6 +33,7 @@ import (
 var (
 	_, _, addr            = testdata.KeyTestPubAddr()
 	govAcct               = authtypes.NewModuleAddress(types.ModuleName)
+	distrAcct             = authtypes.NewModuleAddress(distrtypes.ModuleName)
 	TestProposal          = getTestProposal()
 	TestAmendmentProposal = getTestConstitutionAmendmentProposal()
 	TestLawProposal       = getTestLawProposal()
@@ -72,13 +74,11 @@ func getTestConstitutionAmendmentProposal() []sdk.Msg {
 
 // getTestLawProposal creates and returns a test law proposal message.
 func getTestLawProposal() []sdk.Msg {
-	proposalMsg := v1.MsgProposeLaw{
-		Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
-	}
+	proposalMsg := v1.NewMsgProposeLaw(authtypes.NewModuleAddress(types.ModuleName), "Test law", "Test law text", "", nil)
 
 	return []sdk.Msg{
 		banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000)))),
-		&proposalMsg,
+		proposalMsg,
 	}
 }
 
@@ -86,10 +86,12 @@ type mocks struct {
 	acctKeeper    *govtestutil.MockAccountKeeper
 	bankKeeper    *govtestutil.MockBankKeeper
 	stakingKeeper *govtestutil.MockStakingKeeper
+	distrKeeper   *govtestutil.MockDistributionKeeper
 }
 
 func mockAccountKeeperExpectations(ctx sdk.Context, m mocks) {
 	m.acctKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(govAcct).AnyTimes()
+	m.acctKeeper.EXPECT().GetModuleAddress(distrtypes.ModuleName).Return(distrAcct).AnyTimes()
 	m.acctKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName)).AnyTimes()
 }
 
@@ -112,6 +114,17 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	mocks,
 	moduletestutil.TestEncodingConfig,
 	sdk.Context,
+) {
+	return setupGovKeeperWithConfig(t, types.DefaultConfig(), expectations...)
+}
+
+// setupGovKeeperWithConfig creates a govKeeper with the given config as well
+// as all its dependencies.
+func setupGovKeeperWithConfig(t *testing.T, config types.Config, expectations ...func(sdk.Context, mocks)) (
+	*keeper.Keeper,
+	mocks,
+	moduletestutil.TestEncodingConfig,
+	sdk.Context,
 ) {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
@@ -131,6 +144,7 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 		acctKeeper:    govtestutil.NewMockAccountKeeper(ctrl),
 		bankKeeper:    govtestutil.NewMockBankKeeper(ctrl),
 		stakingKeeper: govtestutil.NewMockStakingKeeper(ctrl),
+		distrKeeper:   govtestutil.NewMockDistributionKeeper(ctrl),
 	}
 	if len(expectations) == 0 {
 		mockDefaultExpectations(ctx, m)
@@ -141,7 +155,7 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	}
 
 	// Gov keeper initializations
-	govKeeper := keeper.NewKeeper(encCfg.Codec, key, m.acctKeeper, m.bankKeeper, m.stakingKeeper, msr, types.DefaultConfig(), govAcct.String())
+	govKeeper := keeper.NewKeeper(encCfg.Codec, key, m.acctKeeper, m.bankKeeper, m.stakingKeeper, m.distrKeeper, msr, config, govAcct.String())
 	govKeeper.SetProposalID(ctx, 1)
 
 	govRouter := v1beta1.NewRouter() // Also register legacy gov handlers to test them too.
@@ -1,9 +1,17 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 )
 
 func (keeper Keeper) GetConstitution(ctx sdk.Context) (constitution string) {
@@ -26,10 +34,353 @@ func (k Keeper) ApplyConstitutionAmendment(ctx sdk.Context, amendment string) (u
 	}
 
 	currentConstitution := k.GetConstitution(ctx)
-	updatedConstitution, err = types.ApplyUnifiedDiff(currentConstitution, amendment)
+	updatedConstitution, err = types.ApplyUnifiedDiffWithConfig(currentConstitution, amendment, k.constitutionPatchConfig(ctx))
 	if err != nil {
 		return "", types.ErrInvalidConstitutionAmendment.Wrapf("failed to apply amendment: %v", err)
 	}
 
 	return updatedConstitution, nil
 }
+
+// constitutionPatchConfig returns the configuration used to apply the
+// constitution amendments, charging the lines processed to the gas meter of
+// ctx.
+func (keeper Keeper) constitutionPatchConfig(ctx sdk.Context) types.PatchConfig {
+	return types.PatchConfig{
+		MaxOffset:  keeper.config.ConstitutionAmendmentMaxOffset,
+		Fuzz:       keeper.config.ConstitutionAmendmentFuzz,
+		GasMeter:   ctx.GasMeter(),
+		GasPerLine: keeper.config.ConstitutionAmendmentGasPerLine,
+	}
+}
+
+// AddConstitutionVersion sets the constitution and stores it as a new version
+// of the constitution, along with the amendment that produced it and the id of
+// the proposal that amended it, if any. It returns the new version.
+func (keeper Keeper) AddConstitutionVersion(ctx sdk.Context, constitution, amendment string, proposalID uint64) v1.ConstitutionVersion {
+	version := uint64(1)
+	if latest, found := keeper.GetLatestConstitutionVersion(ctx); found {
+		version = latest.Version + 1
+	}
+
+	constitutionVersion := v1.ConstitutionVersion{
+		Version:      version,
+		ProposalId:   proposalID,
+		Height:       ctx.BlockHeight(),
+		Amendment:    amendment,
+		Constitution: constitution,
+	}
+	keeper.SetConstitution(ctx, constitution)
+	keeper.SetConstitutionVersion(ctx, constitutionVersion)
+
+	return constitutionVersion
+}
+
+// GetConstitutionVersion gets a version of the constitution from the store
+func (keeper Keeper) GetConstitutionVersion(ctx sdk.Context, version uint64) (constitutionVersion v1.ConstitutionVersion, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ConstitutionVersionKey(version))
+	if bz == nil {
+		return constitutionVersion, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &constitutionVersion)
+
+	return constitutionVersion, true
+}
+
+// SetConstitutionVersion sets a version of the constitution to the gov store
+func (keeper Keeper) SetConstitutionVersion(ctx sdk.Context, constitutionVersion v1.ConstitutionVersion) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&constitutionVersion)
+	store.Set(types.ConstitutionVersionKey(constitutionVersion.Version), bz)
+}
+
+// GetLatestConstitutionVersion gets the latest version of the constitution
+// from the store
+func (keeper Keeper) GetLatestConstitutionVersion(ctx sdk.Context) (constitutionVersion v1.ConstitutionVersion, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.ConstitutionVersionsKeyPrefix)
+
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return constitutionVersion, false
+	}
+
+	keeper.cdc.MustUnmarshal(iterator.Value(), &constitutionVersion)
+
+	return constitutionVersion, true
+}
+
+// GetConstitutionHistory returns all the versions of the constitution from
+// the store, in ascending order
+func (keeper Keeper) GetConstitutionHistory(ctx sdk.Context) (history []*v1.ConstitutionVersion) {
+	keeper.IterateConstitutionVersions(ctx, func(constitutionVersion v1.ConstitutionVersion) bool {
+		history = append(history, &constitutionVersion)
+		return false
+	})
+	return
+}
+
+// IterateConstitutionVersions iterates over all the versions of the
+// constitution in ascending order and performs a callback function
+func (keeper Keeper) IterateConstitutionVersions(ctx sdk.Context, cb func(constitutionVersion v1.ConstitutionVersion) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConstitutionVersionsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var constitutionVersion v1.ConstitutionVersion
+		keeper.cdc.MustUnmarshal(iterator.Value(), &constitutionVersion)
+
+		if cb(constitutionVersion) {
+			break
+		}
+	}
+}
+
+// GetConstitutionBlame returns the lines of the given version of the
+// constitution, each along with the version that introduced it. The origins
+// of the lines are tracked by diffing the successive versions.
+func (keeper Keeper) GetConstitutionBlame(ctx sdk.Context, version uint64) ([]*v1.ConstitutionBlameLine, error) {
+	target, found := keeper.GetConstitutionVersion(ctx, version)
+	if !found {
+		return nil, types.ErrUnknownConstitutionVersion.Wrapf("%d", version)
+	}
+
+	var (
+		prev        string
+		origins     []uint64
+		proposalIDs = make(map[uint64]uint64)
+	)
+	keeper.IterateConstitutionVersions(ctx, func(cv v1.ConstitutionVersion) bool {
+		if cv.Version > version {
+			return true
+		}
+		origins = types.PropagateLineOrigins(prev, cv.Constitution, origins, cv.Version)
+		proposalIDs[cv.Version] = cv.ProposalId
+		prev = cv.Constitution
+		return false
+	})
+
+	lines := strings.Split(target.Constitution, "\n")
+	blame := make([]*v1.ConstitutionBlameLine, len(lines))
+	for i, line := range lines {
+		blame[i] = &v1.ConstitutionBlameLine{
+			Line:       line,
+			Version:    origins[i],
+			ProposalId: proposalIDs[origins[i]],
+		}
+	}
+	return blame, nil
+}
+
+// RebaseConstitutionAmendments rebases the constitution amendments of the
+// proposals in deposit period, except the excluded one, from the previous
+// constitution onto the current one. The proposals whose amendments cannot be
+// rebased cleanly are flagged as conflicting with the constitution. The
+// amendments of the proposals in voting period or pending execution are not
+// rewritten, as they are or were voted as submitted: these proposals are only
+// flagged as conflicting if their amendments no longer apply to the current
+// constitution. At most ConstitutionAmendmentMaxRebasesPerBlock proposals are
+// rebased or checked in a block, the next ones being flagged as conflicting.
+func (keeper Keeper) RebaseConstitutionAmendments(ctx sdk.Context, previousConstitution string, excludedProposalID uint64) {
+	currentConstitution := keeper.GetConstitution(ctx)
+	patchConfig := keeper.constitutionPatchConfig(ctx)
+	rebases := keeper.getConstitutionAmendmentRebases(ctx)
+
+	keeper.iteratePendingProposals(ctx, func(proposal v1.Proposal) bool {
+		if proposal.Id == excludedProposalID || proposal.ConstitutionAmendmentConflict {
+			return false
+		}
+		messages, err := proposal.GetMsgs()
+		if err != nil {
+			return false
+		}
+		hasAmendment := slices.ContainsFunc(messages, func(msg sdk.Msg) bool {
+			_, ok := msg.(*v1.MsgProposeConstitutionAmendment)
+			return ok
+		})
+		if !hasAmendment {
+			return false
+		}
+
+		if rebases >= keeper.config.ConstitutionAmendmentMaxRebasesPerBlock {
+			keeper.setConstitutionAmendmentConflict(ctx, proposal, "too many constitution amendments rebased in the block")
+			return false
+		}
+		rebases++
+
+		if proposal.Status != v1.StatusDepositPeriod {
+			// dry-run the amendments as submitted against the current
+			// constitution, the way they would be executed
+			updated := currentConstitution
+			for _, msg := range messages {
+				amendmentMsg, ok := msg.(*v1.MsgProposeConstitutionAmendment)
+				if !ok {
+					continue
+				}
+				if updated, err = types.ApplyUnifiedDiffWithConfig(updated, amendmentMsg.Amendment, patchConfig); err != nil {
+					keeper.setConstitutionAmendmentConflict(ctx, proposal, err.Error())
+					return false
+				}
+			}
+			return false
+		}
+
+		var (
+			rebased bool
+			base    = previousConstitution
+			updated = currentConstitution
+		)
+		for i, msg := range messages {
+			amendmentMsg, ok := msg.(*v1.MsgProposeConstitutionAmendment)
+			if !ok {
+				continue
+			}
+			// the next amendments of the proposal apply to the constitution
+			// amended by the previous ones
+			rebasedAmendment, err := types.RebaseUnifiedDiff(base, updated, amendmentMsg.Amendment, patchConfig)
+			if err == nil {
+				base, err = types.ApplyUnifiedDiffWithConfig(base, amendmentMsg.Amendment, patchConfig)
+			}
+			if err == nil {
+				updated, err = types.ApplyUnifiedDiff(updated, rebasedAmendment)
+			}
+			if err != nil {
+				keeper.setConstitutionAmendmentConflict(ctx, proposal, err.Error())
+				return false
+			}
+			if rebasedAmendment != amendmentMsg.Amendment {
+				messages[i] = v1.NewMsgProposeConstitutionAmendment(sdk.MustAccAddressFromBech32(amendmentMsg.Authority), rebasedAmendment)
+				rebased = true
+			}
+		}
+
+		if rebased {
+			proposal.Messages, err = sdktx.SetMsgs(messages)
+			if err != nil {
+				panic(err)
+			}
+			keeper.SetProposal(ctx, proposal)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRebaseConstitutionAmendment,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				),
+			)
+		}
+		return false
+	})
+
+	keeper.setConstitutionAmendmentRebases(ctx, rebases)
+}
+
+// setConstitutionAmendmentConflict flags the proposal as conflicting with the
+// constitution, for the given reason.
+func (keeper Keeper) setConstitutionAmendmentConflict(ctx sdk.Context, proposal v1.Proposal, reason string) {
+	proposal.ConstitutionAmendmentConflict = true
+	keeper.SetProposal(ctx, proposal)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConstitutionAmendmentConflict,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyConflictReason, reason),
+		),
+	)
+}
+
+// getConstitutionAmendmentRebases returns the number of proposals whose
+// constitution amendments were rebased in the current block.
+func (keeper Keeper) getConstitutionAmendmentRebases(ctx sdk.Context) int {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ConstitutionAmendmentRebasesKey)
+	if bz == nil || int64(sdk.BigEndianToUint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+	return int(sdk.BigEndianToUint64(bz[8:]))
+}
+
+// setConstitutionAmendmentRebases sets the number of proposals whose
+// constitution amendments were rebased in the current block.
+func (keeper Keeper) setConstitutionAmendmentRebases(ctx sdk.Context, rebases int) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), sdk.Uint64ToBigEndian(uint64(rebases))...)
+	store.Set(types.ConstitutionAmendmentRebasesKey, bz)
+}
+
+// GetConstitutionAmendmentStatuses returns whether the constitution amendments
+// of each proposal in deposit or voting period or pending execution apply
+// cleanly to the current constitution.
+func (keeper Keeper) GetConstitutionAmendmentStatuses(ctx sdk.Context) (statuses []*v1.ConstitutionAmendmentStatus) {
+	currentConstitution := keeper.GetConstitution(ctx)
+	patchConfig := keeper.constitutionPatchConfig(ctx)
+
+	keeper.iteratePendingProposals(ctx, func(proposal v1.Proposal) bool {
+		messages, err := proposal.GetMsgs()
+		if err != nil {
+			return false
+		}
+
+		var (
+			hasAmendment bool
+			constitution = currentConstitution
+		)
+		for _, msg := range messages {
+			amendmentMsg, ok := msg.(*v1.MsgProposeConstitutionAmendment)
+			if !ok {
+				continue
+			}
+			hasAmendment = true
+			if err == nil {
+				constitution, err = types.ApplyUnifiedDiffWithConfig(constitution, amendmentMsg.Amendment, patchConfig)
+			}
+		}
+		if !hasAmendment {
+			return false
+		}
+
+		status := &v1.ConstitutionAmendmentStatus{
+			ProposalId:     proposal.Id,
+			ProposalStatus: proposal.Status,
+			Applies:        err == nil,
+			Conflict:       proposal.ConstitutionAmendmentConflict,
+		}
+		if err != nil {
+			status.Error = err.Error()
+		}
+		statuses = append(statuses, status)
+		return false
+	})
+	return statuses
+}
+
+// iteratePendingProposals iterates over the proposals in deposit period, then
+// over the proposals in voting period, then over the proposals pending
+// execution, and performs a callback function.
+func (keeper Keeper) iteratePendingProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterateQueue := func(queuePrefix []byte) (stop bool) {
+		iterator := storetypes.KVStorePrefixIterator(store, queuePrefix)
+
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			// the keys of the inactive, active and execution queues share the
+			// same layout
+			proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+			proposal, found := keeper.GetProposal(ctx, proposalID)
+			if !found {
+				panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+			}
+
+			if cb(proposal) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !iterateQueue(types.InactiveProposalQueuePrefix) && !iterateQueue(types.ActiveProposalQueuePrefix) {
+		iterateQueue(types.ExecutionQueuePrefix)
+	}
+}
@@ -4,6 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/keeper"
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 )
 
 func TestApplyConstitutionAmendment(t *testing.T) {
@@ -29,6 +35,13 @@ func TestApplyConstitutionAmendment(t *testing.T) {
 			expectError:         false,
 			expectedResult:      "Hi\nWorld",
 		},
+		{
+			name:                "successful patch application with offset and fuzz",
+			initialConstitution: "Preamble\nHello\nWorld\n!",
+			amendment:           "@@ -1,3 +1,3 @@\n-Hello\n+Hi\n World\n ?",
+			expectError:         false,
+			expectedResult:      "Preamble\nHi\nWorld\n!",
+		},
 		{
 			name:                "successful patch application with multiple hunks",
 			initialConstitution: "Line one\nLine two\nLine three\nLine four\nLine five\nLine six\nLine seven\nLine eight\nLine nine",
@@ -51,3 +64,176 @@ func TestApplyConstitutionAmendment(t *testing.T) {
 		})
 	}
 }
+
+func TestAddConstitutionVersion(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	_, found := govKeeper.GetLatestConstitutionVersion(ctx)
+	require.False(t, found)
+
+	first := govKeeper.AddConstitutionVersion(ctx, "Hello\nWorld", "", 0)
+	require.Equal(t, uint64(1), first.Version)
+
+	ctx = ctx.WithBlockHeight(10)
+	amendment := "@@ -1,2 +1,2 @@\n-Hello\n+Hi\n World"
+	second := govKeeper.AddConstitutionVersion(ctx, "Hi\nWorld", amendment, 3)
+	require.Equal(t, v1.ConstitutionVersion{
+		Version:      2,
+		ProposalId:   3,
+		Height:       10,
+		Amendment:    amendment,
+		Constitution: "Hi\nWorld",
+	}, second)
+	require.Equal(t, "Hi\nWorld", govKeeper.GetConstitution(ctx))
+
+	latest, found := govKeeper.GetLatestConstitutionVersion(ctx)
+	require.True(t, found)
+	require.Equal(t, second, latest)
+	got, found := govKeeper.GetConstitutionVersion(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, first, got)
+	require.Equal(t, []*v1.ConstitutionVersion{&first, &second}, govKeeper.GetConstitutionHistory(ctx))
+}
+
+func TestGetConstitutionBlame(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+
+	govKeeper.AddConstitutionVersion(ctx, "one\ntwo\nthree", "", 0)
+	govKeeper.AddConstitutionVersion(ctx, "one\n2\nthree", "@@ -2 +2 @@\n-two\n+2", 1)
+	govKeeper.AddConstitutionVersion(ctx, "zero\none\n2\nthree", "@@ -1 +1,2 @@\n+zero\n one", 2)
+
+	blame, err := govKeeper.GetConstitutionBlame(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ConstitutionBlameLine{
+		{Line: "zero", Version: 3, ProposalId: 2},
+		{Line: "one", Version: 1},
+		{Line: "2", Version: 2, ProposalId: 1},
+		{Line: "three", Version: 1},
+	}, blame)
+
+	blame, err = govKeeper.GetConstitutionBlame(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ConstitutionBlameLine{
+		{Line: "one", Version: 1},
+		{Line: "2", Version: 2, ProposalId: 1},
+		{Line: "three", Version: 1},
+	}, blame)
+
+	_, err = govKeeper.GetConstitutionBlame(ctx, 4)
+	require.ErrorIs(t, err, types.ErrUnknownConstitutionVersion)
+}
+
+func TestRebaseConstitutionAmendments(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	govAcct := govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	msgSrvr := keeper.NewMsgServerImpl(govKeeper)
+	govKeeper.AddConstitutionVersion(ctx, "one\ntwo\nthree\nfour\nfive", "", 0)
+
+	submit := func(amendment string) v1.Proposal {
+		proposal, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{
+			v1.NewMsgProposeConstitutionAmendment(govAcct, amendment),
+		}, "", "title", "summary", addr, "", false)
+		require.NoError(t, err)
+		return proposal
+	}
+	amendment := "@@ -1,2 +1,3 @@\n-one\n+zero\n+one!\n two"
+	enacting := submit(amendment)
+	rebased := submit("@@ -4,2 +4,2 @@\n four\n-five\n+5")
+	conflicting := submit("@@ -1,2 +1,2 @@\n-one\n+1\n two")
+	voting := submit("@@ -4,2 +4,2 @@\n four\n-five\n+FIVE")
+	govKeeper.ActivateVotingPeriod(ctx, voting)
+	votingConflicting := submit("@@ -1,2 +1,2 @@\n-one\n+ONE\n two")
+	govKeeper.ActivateVotingPeriod(ctx, votingConflicting)
+
+	_, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{
+		v1.NewMsgProposeConstitutionAmendment(govAcct, "@@ -1 +1 @@\n-zero\n+0"),
+	}, "", "title", "summary", addr, "", false)
+	require.ErrorIs(t, err, types.ErrInvalidConstitutionAmendment)
+
+	_, err = msgSrvr.ProposeConstitutionAmendment(
+		types.WithProposalID(ctx, enacting.Id),
+		v1.NewMsgProposeConstitutionAmendment(govAcct, amendment),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "zero\none!\ntwo\nthree\nfour\nfive", govKeeper.GetConstitution(ctx))
+
+	proposal, _ := govKeeper.GetProposal(ctx, rebased.Id)
+	require.False(t, proposal.ConstitutionAmendmentConflict)
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, "--- src\n+++ dst\n@@ -3,4 +3,4 @@\n two\n three\n four\n-five\n+5\n",
+		msgs[0].(*v1.MsgProposeConstitutionAmendment).Amendment)
+
+	proposal, _ = govKeeper.GetProposal(ctx, conflicting.Id)
+	require.True(t, proposal.ConstitutionAmendmentConflict)
+
+	// the amendments of a proposal in voting period are not rewritten, and
+	// the proposal is only flagged if they no longer apply
+	proposal, _ = govKeeper.GetProposal(ctx, voting.Id)
+	require.False(t, proposal.ConstitutionAmendmentConflict)
+	msgs, err = proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, "@@ -4,2 +4,2 @@\n four\n-five\n+FIVE", msgs[0].(*v1.MsgProposeConstitutionAmendment).Amendment)
+
+	proposal, _ = govKeeper.GetProposal(ctx, votingConflicting.Id)
+	require.True(t, proposal.ConstitutionAmendmentConflict)
+	msgs, err = proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, "@@ -1,2 +1,2 @@\n-one\n+ONE\n two", msgs[0].(*v1.MsgProposeConstitutionAmendment).Amendment)
+
+	statuses := govKeeper.GetConstitutionAmendmentStatuses(ctx)
+	require.Len(t, statuses, 5)
+	require.Equal(t, &v1.ConstitutionAmendmentStatus{
+		ProposalId:     rebased.Id,
+		ProposalStatus: v1.StatusDepositPeriod,
+		Applies:        true,
+	}, statuses[1])
+	require.Equal(t, conflicting.Id, statuses[2].ProposalId)
+	require.False(t, statuses[2].Applies)
+	require.True(t, statuses[2].Conflict)
+	require.NotEmpty(t, statuses[2].Error)
+}
+
+func TestRebaseConstitutionAmendmentsPerBlockLimit(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	govAcct := govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	msgSrvr := keeper.NewMsgServerImpl(govKeeper)
+	govKeeper.AddConstitutionVersion(ctx, "one\ntwo\nthree\nfour\nfive", "", 0)
+
+	submit := func(amendment string) v1.Proposal {
+		proposal, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{
+			v1.NewMsgProposeConstitutionAmendment(govAcct, amendment),
+		}, "", "title", "summary", addr, "", false)
+		require.NoError(t, err)
+		return proposal
+	}
+	enact := func(ctx sdk.Context, proposalID uint64) {
+		proposal, _ := govKeeper.GetProposal(ctx, proposalID)
+		msgs, err := proposal.GetMsgs()
+		require.NoError(t, err)
+		_, err = msgSrvr.ProposeConstitutionAmendment(types.WithProposalID(ctx, proposalID), msgs[0].(*v1.MsgProposeConstitutionAmendment))
+		require.NoError(t, err)
+	}
+	first := submit("@@ -1 +1 @@\n-one\n+1")
+	second := submit("@@ -2 +2 @@\n-two\n+2")
+	limit := types.DefaultConfig().ConstitutionAmendmentMaxRebasesPerBlock
+	var pending []v1.Proposal
+	for i := 0; i < limit; i++ {
+		pending = append(pending, submit("@@ -5 +5 @@\n-five\n+5"))
+	}
+
+	// the second proposal and all the pending ones but the last are rebased
+	enact(ctx, first.Id)
+	for i, p := range pending {
+		proposal, _ := govKeeper.GetProposal(ctx, p.Id)
+		require.Equal(t, i == limit-1, proposal.ConstitutionAmendmentConflict, "proposal %d", i)
+	}
+
+	// the limit applies per block
+	enact(ctx.WithBlockHeight(ctx.BlockHeight()+1), second.Id)
+	require.Equal(t, "1\n2\nthree\nfour\nfive", govKeeper.GetConstitution(ctx))
+	for i, p := range pending {
+		proposal, _ := govKeeper.GetProposal(ctx, p.Id)
+		require.Equal(t, i == limit-1, proposal.ConstitutionAmendmentConflict, "proposal %d", i)
+	}
+}
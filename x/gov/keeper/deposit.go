@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors1 "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
@@ -124,7 +125,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	minDeposit := keeper.GetMinDeposit(ctx)
+	minDeposit := keeper.GetProposalMinDeposit(ctx, proposal)
 	// Check if deposit has already sufficient total funds to transition the proposal into the voting period
 	// perhaps because the min deposit was lowered in the meantime. If so, the minDepositRatio check is skipped,
 	// the user is using this message to trigger activation for a proposal already meeting the minimum deposit.
@@ -136,7 +137,6 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	params := keeper.GetParams(ctx)
 
 	// NOTE: backported from v50
-	// v47 does not have expedited proposals so we always use params.MinDeposit
 	minDepositRatio, err := math.LegacyNewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
@@ -234,6 +234,62 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeAndDeleteDeposits charges the cancelRatio of all the deposits on a
+// specific proposal, then refunds the rest and deletes the deposits. The
+// charged coins are burned if destAddress is empty, sent to the community pool
+// if destAddress is the distribution module address, and sent to destAddress
+// otherwise. It returns the charged coins.
+func (keeper Keeper) ChargeAndDeleteDeposits(ctx sdk.Context, proposalID uint64, destAddress string, cancelRatio math.LegacyDec) (sdk.Coins, error) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	charges := sdk.NewCoins()
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		charge := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			charge = charge.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToLegacyDec().Mul(cancelRatio).TruncateInt()))
+		}
+		charges = charges.Add(charge...)
+
+		// keep the remaining deposit so that it is refunded below
+		remaining := sdk.NewCoins(deposit.Amount...).Sub(charge...)
+		if remaining.Empty() {
+			store.Delete(types.DepositKey(proposalID, sdk.MustAccAddressFromBech32(deposit.Depositor)))
+		} else {
+			deposit.Amount = remaining
+			keeper.SetDeposit(ctx, *deposit)
+		}
+	}
+
+	if !charges.IsZero() {
+		if err := keeper.sendCancellationCharges(ctx, destAddress, charges); err != nil {
+			return nil, err
+		}
+	}
+
+	keeper.RefundAndDeleteDeposits(ctx, proposalID)
+
+	return charges, nil
+}
+
+// sendCancellationCharges burns the cancellation charges of a proposal or
+// sends them to destAddress.
+func (keeper Keeper) sendCancellationCharges(ctx sdk.Context, destAddress string, charges sdk.Coins) error {
+	if destAddress == "" {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, charges)
+	}
+
+	dest, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return err
+	}
+
+	if dest.Equals(keeper.authKeeper.GetModuleAddress(disttypes.ModuleName)) {
+		return keeper.distrKeeper.FundCommunityPool(ctx, charges, keeper.authKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, charges)
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
+
 	"cosmossdk.io/math"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
@@ -23,7 +25,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, math.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], "", false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -107,7 +109,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], "", false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake, false)
@@ -283,7 +285,7 @@ func TestDepositAmount(t *testing.T) {
 			require.NoError(t, err)
 
 			tp := TestProposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", testAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", testAddrs[0], "", false)
 			require.NoError(t, err)
 			proposalID := proposal.Id
 
@@ -297,3 +299,73 @@ func TestDepositAmount(t *testing.T) {
 		})
 	}
 }
+
+func TestChargeAndDeleteDeposits(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, math.NewInt(10000000))
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 2)))
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+
+	tests := []struct {
+		name            string
+		destAddress     string
+		cancelRatio     math.LegacyDec
+		setup           func()
+		expectedCharges sdk.Coins
+	}{
+		{
+			name:            "burn half of the deposits",
+			cancelRatio:     math.LegacyNewDecWithPrec(5, 1),
+			expectedCharges: twoStake.Add(twoStake...),
+		},
+		{
+			name:        "refund all the deposits",
+			cancelRatio: math.LegacyZeroDec(),
+		},
+		{
+			name:            "send a quarter of the deposits to an account",
+			destAddress:     TestAddrs[2].String(),
+			cancelRatio:     math.LegacyNewDecWithPrec(25, 2),
+			expectedCharges: oneStake.Add(oneStake...),
+		},
+		{
+			name:        "send all the deposits to the community pool",
+			destAddress: distrAcct.String(),
+			cancelRatio: math.LegacyOneDec(),
+			setup: func() {
+				mocks.distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), fourStake.Add(fourStake...), govAcct).Return(nil)
+			},
+			expectedCharges: fourStake.Add(fourStake...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", TestAddrs[0], "", false)
+			require.NoError(t, err)
+			for _, addr := range TestAddrs[:2] {
+				_, err = govKeeper.AddDeposit(ctx, proposal.Id, addr, fourStake, false)
+				require.NoError(t, err)
+			}
+			addr0Balance := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			addr2Balance := bankKeeper.GetAllBalances(ctx, TestAddrs[2])
+
+			charges, err := govKeeper.ChargeAndDeleteDeposits(ctx, proposal.Id, tt.destAddress, tt.cancelRatio)
+
+			require.NoError(t, err)
+			require.True(t, tt.expectedCharges.Equal(charges), charges.String())
+			require.Empty(t, govKeeper.GetDeposits(ctx, proposal.Id))
+			refund := fourStake.Sub(tt.expectedCharges.QuoInt(math.NewInt(2))...)
+			require.Equal(t, addr0Balance.Add(refund...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+			if tt.destAddress == TestAddrs[2].String() {
+				require.Equal(t, addr2Balance.Add(charges...), bankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+			}
+		})
+	}
+}
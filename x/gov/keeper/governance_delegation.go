@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// GetGovernanceDelegation gets the governance delegation of a delegator from
+// the store
+func (keeper Keeper) GetGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (delegation v1.GovernanceDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernanceDelegationKey(delegatorAddr))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &delegation)
+
+	return delegation, true
+}
+
+// SetGovernanceDelegation sets a governance delegation to the gov store,
+// along with its index by governor.
+func (keeper Keeper) SetGovernanceDelegation(ctx sdk.Context, delegation v1.GovernanceDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&delegation)
+	delegatorAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+
+	store.Set(types.GovernanceDelegationKey(delegatorAddr), bz)
+	store.Set(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr), []byte{0x01})
+}
+
+// removeGovernanceDelegation removes the governance delegation of a
+// delegator from the store, along with its index by governor.
+func (keeper Keeper) removeGovernanceDelegation(ctx sdk.Context, delegation v1.GovernanceDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	delegatorAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+
+	store.Delete(types.GovernanceDelegationKey(delegatorAddr))
+	store.Delete(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr))
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations from the
+// store
+func (keeper Keeper) GetAllGovernanceDelegations(ctx sdk.Context) (delegations []*v1.GovernanceDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GovernanceDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation v1.GovernanceDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, &delegation)
+	}
+	return
+}
+
+// IterateGovernorDelegations iterates over the governance delegations of a
+// governor and performs a callback function
+func (keeper Keeper) IterateGovernorDelegations(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(delegation v1.GovernanceDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GovernanceDelegationsByGovernorKey(governorAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, delegatorAddr := types.SplitGovernanceDelegationByGovernorKey(iterator.Key())
+		delegation := v1.NewGovernanceDelegation(delegatorAddr.String(), governorAddr.String())
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// DelegateToGovernor delegates the governance voting power of a delegator to
+// a governor. If the delegator already delegates to another governor, the
+// previous delegation is removed first.
+func (keeper Keeper) DelegateToGovernor(ctx sdk.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if _, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr); found {
+		if err := keeper.UndelegateFromGovernor(ctx, delegatorAddr); err != nil {
+			return err
+		}
+	}
+
+	keeper.SetGovernanceDelegation(ctx, v1.NewGovernanceDelegation(delegatorAddr.String(), governorAddr.String()))
+
+	return keeper.iterateDelegationShares(ctx, delegatorAddr, func(validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+		keeper.IncreaseGovernorShares(ctx, governorAddr, validatorAddr, shares)
+	})
+}
+
+// UndelegateFromGovernor removes the governance delegation of a delegator.
+func (keeper Keeper) UndelegateFromGovernor(ctx sdk.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return types.ErrUnknownGovernanceDelegation.Wrap(delegatorAddr.String())
+	}
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+
+	keeper.removeGovernanceDelegation(ctx, delegation)
+
+	return keeper.iterateDelegationShares(ctx, delegatorAddr, func(validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+		keeper.DecreaseGovernorShares(ctx, governorAddr, validatorAddr, shares)
+	})
+}
+
+// iterateDelegationShares calls cb with the validator address and the shares
+// of each staking delegation of a delegator.
+func (keeper Keeper) iterateDelegationShares(ctx sdk.Context, delegatorAddr sdk.AccAddress, cb func(validatorAddr sdk.ValAddress, shares math.LegacyDec)) error {
+	var err error
+	iterErr := keeper.sk.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		var validatorAddr sdk.ValAddress
+		validatorAddr, err = sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
+		if err != nil {
+			return true
+		}
+		cb(validatorAddr, delegation.GetShares())
+		return false
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	return err
+}
+
+// GetGovernorValShares gets the validator shares delegated by the governance
+// delegators of a governor from the store
+func (keeper Keeper) GetGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (valShares v1.GovernorValShares, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernorValSharesKey(governorAddr, validatorAddr))
+	if bz == nil {
+		return valShares, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &valShares)
+
+	return valShares, true
+}
+
+// SetGovernorValShares sets the validator shares delegated by the governance
+// delegators of a governor to the gov store
+func (keeper Keeper) SetGovernorValShares(ctx sdk.Context, valShares v1.GovernorValShares) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&valShares)
+	governorAddr := sdk.MustAccAddressFromBech32(valShares.GovernorAddress)
+	validatorAddr, err := sdk.ValAddressFromBech32(valShares.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.GovernorValSharesKey(governorAddr, validatorAddr), bz)
+}
+
+// IncreaseGovernorShares increases the validator shares delegated by the
+// governance delegators of a governor
+func (keeper Keeper) IncreaseGovernorShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+	valShares, found := keeper.GetGovernorValShares(ctx, governorAddr, validatorAddr)
+	if !found {
+		valShares = v1.NewGovernorValShares(governorAddr.String(), validatorAddr.String(), math.LegacyZeroDec())
+	}
+	valShares.Shares = valShares.Shares.Add(shares)
+
+	keeper.SetGovernorValShares(ctx, valShares)
+}
+
+// DecreaseGovernorShares decreases the validator shares delegated by the
+// governance delegators of a governor. The entry is removed from the store
+// when no shares are left.
+func (keeper Keeper) DecreaseGovernorShares(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec) {
+	valShares, found := keeper.GetGovernorValShares(ctx, governorAddr, validatorAddr)
+	if !found {
+		return
+	}
+	valShares.Shares = valShares.Shares.Sub(shares)
+
+	if !valShares.Shares.IsPositive() {
+		store := ctx.KVStore(keeper.storeKey)
+		store.Delete(types.GovernorValSharesKey(governorAddr, validatorAddr))
+		return
+	}
+	keeper.SetGovernorValShares(ctx, valShares)
+}
+
+// GetAllGovernorValShares returns the validator shares of all the governors
+// from the store
+func (keeper Keeper) GetAllGovernorValShares(ctx sdk.Context) (valShares []*v1.GovernorValShares) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GovernorValSharesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var shares v1.GovernorValShares
+		keeper.cdc.MustUnmarshal(iterator.Value(), &shares)
+		valShares = append(valShares, &shares)
+	}
+	return
+}
+
+// IterateGovernorValShares iterates over the validator shares delegated by
+// the governance delegators of a governor and performs a callback function
+func (keeper Keeper) IterateGovernorValShares(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(valShares v1.GovernorValShares) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GovernorValSharesByGovernorKey(governorAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var valShares v1.GovernorValShares
+		keeper.cdc.MustUnmarshal(iterator.Value(), &valShares)
+
+		if cb(valShares) {
+			break
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// GetGovernor gets the governor with the given address from the store
+func (keeper Keeper) GetGovernor(ctx sdk.Context, governorAddr sdk.AccAddress) (governor v1.Governor, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernorKey(governorAddr))
+	if bz == nil {
+		return governor, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &governor)
+
+	return governor, true
+}
+
+// SetGovernor sets a governor to the gov store
+func (keeper Keeper) SetGovernor(ctx sdk.Context, governor v1.Governor) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&governor)
+	store.Set(types.GovernorKey(governor.GetAddress()), bz)
+}
+
+// GetAllGovernors returns all the governors from the store
+func (keeper Keeper) GetAllGovernors(ctx sdk.Context) (governors []*v1.Governor) {
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+		governors = append(governors, &governor)
+		return false
+	})
+	return
+}
+
+// IterateGovernors iterates over all the governors and performs a callback
+// function
+func (keeper Keeper) IterateGovernors(ctx sdk.Context, cb func(governor v1.Governor) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GovernorsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var governor v1.Governor
+		keeper.cdc.MustUnmarshal(iterator.Value(), &governor)
+
+		if cb(governor) {
+			break
+		}
+	}
+}
+
+// HasMinGovernorSelfDelegation returns true if the bonded tokens of the
+// governor are at least the min_governor_self_delegation param.
+func (keeper Keeper) HasMinGovernorSelfDelegation(ctx sdk.Context, governorAddr sdk.AccAddress) (bool, error) {
+	minSelfDelegation, ok := math.NewIntFromString(keeper.GetParams(ctx).MinGovernorSelfDelegation)
+	if !ok {
+		minSelfDelegation = v1.DefaultMinGovernorSelfDelegation
+	}
+
+	bonded, err := keeper.sk.GetDelegatorBonded(ctx, governorAddr)
+	if err != nil {
+		return false, err
+	}
+
+	return bonded.GTE(minSelfDelegation), nil
+}
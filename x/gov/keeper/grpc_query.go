@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -161,6 +162,125 @@ func (q Keeper) Votes(c context.Context, req *v1.QueryVotesRequest) (*v1.QueryVo
 	return &v1.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
 }
 
+// ArchivedVotes returns the archived votes of a proposal
+func (q Keeper) ArchivedVotes(c context.Context, req *v1.QueryArchivedVotesRequest) (*v1.QueryArchivedVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var votes []*v1.ArchivedVote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	votesStore := prefix.NewStore(store, types.ArchivedVotesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote v1.ArchivedVote
+		if err := q.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryArchivedVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VoterHistory returns the archived votes of a voter
+func (q Keeper) VoterHistory(c context.Context, req *v1.QueryVoterHistoryRequest) (*v1.QueryVoterHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var votes []*v1.ArchivedVote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	historyStore := prefix.NewStore(store, types.ArchivedVotesByVoterKey(voter))
+
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key []byte, _ []byte) error {
+		// key is <proposalID (8 bytes)>
+		proposalID := types.GetProposalIDFromBytes(key)
+		vote, found := q.GetArchivedVote(ctx, proposalID, voter)
+		if !found {
+			return fmt.Errorf("archived vote of %s on proposal %d not found", req.Voter, proposalID)
+		}
+
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoterHistoryResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VotingPower returns the voting power counted for a voter on a proposal in
+// voting period, along with its snapshotted and current voting power
+func (q Keeper) VotingPower(c context.Context, req *v1.QueryVotingPowerRequest) (*v1.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	currValidators, err := q.getBondedValidatorsByAddress(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	currentVotingPower, err := q.getVoterPower(ctx, voter, currValidators)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &v1.QueryVotingPowerResponse{
+		CurrentVotingPower: currentVotingPower.String(),
+		CountedVotingPower: q.capVoterPower(ctx, proposal, voter, currentVotingPower).String(),
+	}
+	if snapshot, found := q.GetVotingPowerSnapshot(ctx, proposal.Id, voter); found {
+		res.SnapshotVotingPower = snapshot.VotingPower
+	}
+	return res, nil
+}
+
 // Params queries all params
 func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
 	if req == nil {
@@ -290,7 +410,8 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	case proposal.Status == v1.StatusDepositPeriod:
 		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed || proposal.Status == v1.StatusVetoed:
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed || proposal.Status == v1.StatusVetoed ||
+		proposal.Status == v1.StatusPassedPendingExecution:
 		tallyResult = *proposal.FinalTallyResult
 
 	default:
@@ -309,8 +430,9 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 func (q Keeper) MinDeposit(c context.Context, req *v1.QueryMinDepositRequest) (*v1.QueryMinDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	minDeposit := q.GetMinDeposit(ctx)
+	expeditedMinDeposit := q.GetExpeditedMinDeposit(ctx)
 
-	return &v1.QueryMinDepositResponse{MinDeposit: minDeposit}, nil
+	return &v1.QueryMinDepositResponse{MinDeposit: minDeposit, ExpeditedMinDeposit: expeditedMinDeposit}, nil
 }
 
 // MinInitialDeposit returns the minimum deposit required for a proposal to be submitted
@@ -341,6 +463,318 @@ func (q Keeper) ParticipationEMAs(c context.Context, _ *v1.QueryParticipationEMA
 	}, nil
 }
 
+// Governor returns the governor with the given address
+func (q Keeper) Governor(c context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	governorAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	governor, found := q.GetGovernor(ctx, governorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors returns all the governors
+func (q Keeper) Governors(c context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var governors []*v1.Governor
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	governorStore := prefix.NewStore(store, types.GovernorsKeyPrefix)
+
+	pageRes, err := query.Paginate(governorStore, req.Pagination, func(key []byte, value []byte) error {
+		var governor v1.Governor
+		if err := q.cdc.Unmarshal(value, &governor); err != nil {
+			return err
+		}
+
+		governors = append(governors, &governor)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernanceDelegation returns the governor a delegator delegates its
+// governance voting power to
+func (q Keeper) GovernanceDelegation(c context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	delegation, found := q.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governance delegation for %s doesn't exist", req.DelegatorAddress)
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernorAddress: delegation.GovernorAddress}, nil
+}
+
+// GovernanceDelegations returns the governance delegations of a governor
+func (q Keeper) GovernanceDelegations(c context.Context, req *v1.QueryGovernanceDelegationsRequest) (*v1.QueryGovernanceDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var delegations []*v1.GovernanceDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	delegationStore := prefix.NewStore(store, types.GovernanceDelegationsByGovernorKey(governorAddr))
+
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(key []byte, _ []byte) error {
+		// key is <delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>
+		delegatorAddr := sdk.AccAddress(key[1:])
+		delegation := v1.NewGovernanceDelegation(delegatorAddr.String(), req.GovernorAddress)
+		delegations = append(delegations, &delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// GovernorValShares returns the validator shares delegated by the governance
+// delegators of a governor
+func (q Keeper) GovernorValShares(c context.Context, req *v1.QueryGovernorValSharesRequest) (*v1.QueryGovernorValSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var valShares []*v1.GovernorValShares
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	valSharesStore := prefix.NewStore(store, types.GovernorValSharesByGovernorKey(governorAddr))
+
+	pageRes, err := query.Paginate(valSharesStore, req.Pagination, func(key []byte, value []byte) error {
+		var shares v1.GovernorValShares
+		if err := q.cdc.Unmarshal(value, &shares); err != nil {
+			return err
+		}
+
+		valShares = append(valShares, &shares)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorValSharesResponse{ValShares: valShares, Pagination: pageRes}, nil
+}
+
+// Law returns a law by its id
+func (q Keeper) Law(c context.Context, req *v1.QueryLawRequest) (*v1.QueryLawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.LawId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "law id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	law, found := q.GetLaw(ctx, req.LawId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "law %d doesn't exist", req.LawId)
+	}
+
+	return &v1.QueryLawResponse{Law: &law}, nil
+}
+
+// Laws returns all the laws, optionally filtered by status
+func (q Keeper) Laws(c context.Context, req *v1.QueryLawsRequest) (*v1.QueryLawsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	lawStore := prefix.NewStore(store, types.LawsKeyPrefix)
+
+	filteredLaws, pageRes, err := query.GenericFilteredPaginate(
+		q.cdc,
+		lawStore,
+		req.Pagination,
+		func(key []byte, l *v1.Law) (*v1.Law, error) {
+			// match status (if supplied/valid)
+			if req.Status.IsValid() && l.Status != req.Status {
+				return nil, nil
+			}
+
+			return l, nil
+		}, func() *v1.Law {
+			return &v1.Law{}
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryLawsResponse{Laws: filteredLaws, Pagination: pageRes}, nil
+}
+
+// ConstitutionVersion returns a version of the constitution
+func (q Keeper) ConstitutionVersion(c context.Context, req *v1.QueryConstitutionVersionRequest) (*v1.QueryConstitutionVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Version == 0 {
+		return nil, status.Error(codes.InvalidArgument, "constitution version can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	constitutionVersion, found := q.GetConstitutionVersion(ctx, req.Version)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "constitution version %d doesn't exist", req.Version)
+	}
+
+	return &v1.QueryConstitutionVersionResponse{ConstitutionVersion: &constitutionVersion}, nil
+}
+
+// ConstitutionHistory returns all the versions of the constitution
+func (q Keeper) ConstitutionHistory(c context.Context, req *v1.QueryConstitutionHistoryRequest) (*v1.QueryConstitutionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var constitutionVersions []*v1.ConstitutionVersion
+	store := ctx.KVStore(q.storeKey)
+	versionStore := prefix.NewStore(store, types.ConstitutionVersionsKeyPrefix)
+
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(key []byte, value []byte) error {
+		var constitutionVersion v1.ConstitutionVersion
+		if err := q.cdc.Unmarshal(value, &constitutionVersion); err != nil {
+			return err
+		}
+
+		constitutionVersions = append(constitutionVersions, &constitutionVersion)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConstitutionHistoryResponse{ConstitutionVersions: constitutionVersions, Pagination: pageRes}, nil
+}
+
+// ConstitutionDiff returns the unified diff between two versions of the constitution
+func (q Keeper) ConstitutionDiff(c context.Context, req *v1.QueryConstitutionDiffRequest) (*v1.QueryConstitutionDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.FromVersion == 0 || req.ToVersion == 0 {
+		return nil, status.Error(codes.InvalidArgument, "constitution version can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	from, found := q.GetConstitutionVersion(ctx, req.FromVersion)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "constitution version %d doesn't exist", req.FromVersion)
+	}
+	to, found := q.GetConstitutionVersion(ctx, req.ToVersion)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "constitution version %d doesn't exist", req.ToVersion)
+	}
+
+	return &v1.QueryConstitutionDiffResponse{Diff: types.ComputeUnifiedDiff(from.Constitution, to.Constitution)}, nil
+}
+
+// ConstitutionBlame returns the lines of a version of the constitution along
+// with the version and proposal that introduced each of them
+func (q Keeper) ConstitutionBlame(c context.Context, req *v1.QueryConstitutionBlameRequest) (*v1.QueryConstitutionBlameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	version := req.Version
+	if version == 0 {
+		latest, found := q.GetLatestConstitutionVersion(ctx)
+		if !found {
+			return nil, status.Error(codes.NotFound, "no constitution version found")
+		}
+		version = latest.Version
+	}
+
+	lines, err := q.GetConstitutionBlame(ctx, version)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "constitution version %d doesn't exist", version)
+	}
+
+	return &v1.QueryConstitutionBlameResponse{Lines: lines}, nil
+}
+
+// ConstitutionAmendmentStatuses returns whether the constitution amendments
+// of the pending proposals apply cleanly to the current constitution
+func (q Keeper) ConstitutionAmendmentStatuses(c context.Context, req *v1.QueryConstitutionAmendmentStatusesRequest) (*v1.QueryConstitutionAmendmentStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &v1.QueryConstitutionAmendmentStatusesResponse{Statuses: q.GetConstitutionAmendmentStatuses(ctx)}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
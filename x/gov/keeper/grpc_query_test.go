@@ -54,7 +54,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -125,7 +125,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -139,7 +139,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 			func(suite *KeeperTestSuite) {
 				req = &v1beta1.QueryProposalRequest{ProposalId: 1}
 
-				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, nil, "metadata", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, nil, "metadata", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -200,7 +200,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -226,7 +226,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -252,7 +252,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -278,7 +278,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -304,7 +304,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -334,7 +334,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -400,7 +400,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -478,7 +478,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -589,7 +589,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -690,7 +690,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -787,7 +787,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -1087,7 +1087,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1186,7 +1186,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1274,7 +1274,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1366,7 +1366,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func(suite *KeeperTestSuite) {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], "", false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1829,3 +1829,182 @@ func (suite *KeeperTestSuite) TestGRPCQueryQuorums() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryLaw() {
+	testCases := []struct {
+		msg    string
+		lawID  uint64
+		expErr bool
+	}{
+		{"zero law id", 0, true},
+		{"non existing law", 2, true},
+		{"valid request", 1, false},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			lawID, err := suite.govKeeper.EnactLaw(suite.ctx, "title", "text", "", nil, 1)
+			suite.Require().NoError(err)
+			expLaw, _ := suite.govKeeper.GetLaw(suite.ctx, lawID)
+
+			res, err := suite.queryClient.Law(gocontext.Background(), &v1.QueryLawRequest{LawId: testCase.lawID})
+
+			if testCase.expErr {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expLaw, res.Law)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLaws() {
+	testCases := []struct {
+		msg       string
+		status    v1.LawStatus
+		expLawIDs []uint64
+	}{
+		{"all laws", v1.LawStatusUnspecified, []uint64{1, 2, 3, 4}},
+		{"laws in force", v1.LawStatusInForce, []uint64{3, 4}},
+		{"superseded laws", v1.LawStatusSuperseded, []uint64{1}},
+		{"repealed laws", v1.LawStatusRepealed, []uint64{2}},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			for i := 1; i <= 3; i++ {
+				_, err := suite.govKeeper.EnactLaw(suite.ctx, "title", fmt.Sprintf("law %d", i), "", nil, uint64(i))
+				suite.Require().NoError(err)
+			}
+			_, err := suite.govKeeper.EnactLaw(suite.ctx, "title", "law 4", "", []uint64{1}, 4)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.govKeeper.RepealLaw(suite.ctx, 2))
+
+			res, err := suite.queryClient.Laws(gocontext.Background(), &v1.QueryLawsRequest{Status: testCase.status})
+
+			suite.Require().NoError(err)
+			var lawIDs []uint64
+			for _, law := range res.Laws {
+				lawIDs = append(lawIDs, law.Id)
+			}
+			suite.Require().Equal(testCase.expLawIDs, lawIDs)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionVersion() {
+	testCases := []struct {
+		msg     string
+		version uint64
+		expErr  bool
+	}{
+		{"zero version", 0, true},
+		{"non existing version", 3, true},
+		{"valid request", 2, false},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "constitution", "", 0)
+			expVersion := suite.govKeeper.AddConstitutionVersion(suite.ctx, "constitution\namended", "@@ -1 +1,2 @@\n constitution\n+amended", 1)
+
+			res, err := suite.queryClient.ConstitutionVersion(gocontext.Background(), &v1.QueryConstitutionVersionRequest{Version: testCase.version})
+
+			if testCase.expErr {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expVersion, res.ConstitutionVersion)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionHistory() {
+	suite.Run("Case paginated history", func() {
+		suite.govKeeper.AddConstitutionVersion(suite.ctx, "one", "", 0)
+		suite.govKeeper.AddConstitutionVersion(suite.ctx, "one\ntwo", "@@ -1 +1,2 @@\n one\n+two", 1)
+		suite.govKeeper.AddConstitutionVersion(suite.ctx, "one\ntwo\nthree", "@@ -1,2 +1,3 @@\n one\n two\n+three", 2)
+
+		res, err := suite.queryClient.ConstitutionHistory(gocontext.Background(), &v1.QueryConstitutionHistoryRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(suite.govKeeper.GetConstitutionHistory(suite.ctx), res.ConstitutionVersions)
+
+		res, err = suite.queryClient.ConstitutionHistory(gocontext.Background(), &v1.QueryConstitutionHistoryRequest{
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.ConstitutionVersions, 1)
+		suite.Require().Equal(uint64(2), res.ConstitutionVersions[0].Version)
+		suite.Require().Equal(uint64(3), res.Pagination.Total)
+	})
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionDiff() {
+	testCases := []struct {
+		msg         string
+		fromVersion uint64
+		toVersion   uint64
+		expDiff     string
+		expErr      bool
+	}{
+		{"zero version", 0, 2, "", true},
+		{"non existing version", 1, 3, "", true},
+		{"forward diff", 1, 2, "--- src\n+++ dst\n@@ -1,2 +1,2 @@\n-one\n+1\n two\n", false},
+		{"backward diff", 2, 1, "--- src\n+++ dst\n@@ -1,2 +1,2 @@\n-1\n+one\n two\n", false},
+		{"identical versions", 2, 2, "", false},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "one\ntwo", "", 0)
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "1\ntwo", "@@ -1,2 +1,2 @@\n-one\n+1\n two", 1)
+
+			res, err := suite.queryClient.ConstitutionDiff(gocontext.Background(), &v1.QueryConstitutionDiffRequest{
+				FromVersion: testCase.fromVersion,
+				ToVersion:   testCase.toVersion,
+			})
+
+			if testCase.expErr {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testCase.expDiff, res.Diff)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitutionBlame() {
+	testCases := []struct {
+		msg      string
+		version  uint64
+		expLines []*v1.ConstitutionBlameLine
+		expErr   bool
+	}{
+		{"non existing version", 3, nil, true},
+		{"first version", 1, []*v1.ConstitutionBlameLine{{Line: "one", Version: 1}, {Line: "two", Version: 1}}, false},
+		{"latest version", 0, []*v1.ConstitutionBlameLine{{Line: "1", Version: 2, ProposalId: 1}, {Line: "two", Version: 1}}, false},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "one\ntwo", "", 0)
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "1\ntwo", "@@ -1,2 +1,2 @@\n-one\n+1\n two", 1)
+
+			res, err := suite.queryClient.ConstitutionBlame(gocontext.Background(), &v1.QueryConstitutionBlameRequest{Version: testCase.version})
+
+			if testCase.expErr {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(testCase.expLines, res.Lines)
+			}
+		})
+	}
+}
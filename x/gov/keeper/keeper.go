@@ -24,6 +24,10 @@ type Keeper struct {
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The reference to the distribution keeper, used to fund the community
+	// pool with the deposits of canceled proposals
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -60,7 +64,7 @@ func (k Keeper) GetAuthority() string {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, authKeeper types.AccountKeeper,
-	bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	router *baseapp.MsgServiceRouter, config types.Config, authority string,
 ) *Keeper {
 	// ensure governance module account is set
@@ -77,15 +81,22 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If ConstitutionAmendmentMaxRebasesPerBlock not set by app developer, set
+	// to default value.
+	if config.ConstitutionAmendmentMaxRebasesPerBlock == 0 {
+		config.ConstitutionAmendmentMaxRebasesPerBlock = types.DefaultConfig().ConstitutionAmendmentMaxRebasesPerBlock
+	}
+
 	return &Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     router,
-		config:     config,
-		authority:  authority,
+		storeKey:    key,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		distrKeeper: distrKeeper,
+		cdc:         cdc,
+		router:      router,
+		config:      config,
+		authority:   authority,
 	}
 }
 
@@ -180,6 +191,20 @@ func (keeper Keeper) RemoveFromQuorumCheckQueue(ctx sdk.Context, proposalID uint
 	store.Delete(types.QuorumCheckQueueKey(proposalID, endTime))
 }
 
+// InsertExecutionQueue inserts a proposalID into the execution queue at
+// executionTime
+func (keeper Keeper) InsertExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.ExecutionQueueKey(proposalID, executionTime), bz)
+}
+
+// RemoveFromExecutionQueue removes a proposalID from the execution queue
+func (keeper Keeper) RemoveFromExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ExecutionQueueKey(proposalID, executionTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -240,6 +265,25 @@ func (keeper Keeper) IterateQuorumCheckQueue(ctx sdk.Context, endTime time.Time,
 	}
 }
 
+// IterateExecutionQueue iterates over the proposals in the execution queue
+// and performs a callback function
+func (keeper Keeper) IterateExecutionQueue(ctx sdk.Context, executionTime time.Time, cb func(proposal v1.Proposal) (stop bool)) {
+	iterator := keeper.ExecutionQueueIterator(ctx, executionTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitExecutionQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -258,6 +302,12 @@ func (keeper Keeper) QuorumCheckQueueIterator(ctx sdk.Context, endTime time.Time
 	return store.Iterator(types.QuorumCheckQueuePrefix, storetypes.PrefixEndBytes(types.QuorumCheckByTimeKey(endTime)))
 }
 
+// ExecutionQueueIterator returns an sdk.Iterator for all the proposals in the Execution Queue that are due by executionTime
+func (keeper Keeper) ExecutionQueueIterator(ctx sdk.Context, executionTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ExecutionQueuePrefix, storetypes.PrefixEndBytes(types.ExecutionQueueByTimeKey(executionTime)))
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than a pre-defined MaxMetadataLen.
 func (keeper Keeper) assertMetadataLength(metadata string) error {
@@ -266,3 +316,15 @@ func (keeper Keeper) assertMetadataLength(metadata string) error {
 	}
 	return nil
 }
+
+// assertMetadataHash returns an error if the given metadata hash is malformed,
+// or missing while the params require every new proposal to have one.
+func (keeper Keeper) assertMetadataHash(ctx sdk.Context, metadataHash string) error {
+	if metadataHash == "" {
+		if keeper.GetParams(ctx).RequireMetadataHash {
+			return types.ErrMissingMetadataHash
+		}
+		return nil
+	}
+	return types.ValidateMetadataHash(metadataHash)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// GetLaw gets the law with the given id from the store
+func (keeper Keeper) GetLaw(ctx sdk.Context, lawID uint64) (law v1.Law, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LawKey(lawID))
+	if bz == nil {
+		return law, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &law)
+
+	return law, true
+}
+
+// SetLaw sets a law to the gov store
+func (keeper Keeper) SetLaw(ctx sdk.Context, law v1.Law) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&law)
+	store.Set(types.LawKey(law.Id), bz)
+}
+
+// GetAllLaws returns all the laws from the store
+func (keeper Keeper) GetAllLaws(ctx sdk.Context) (laws []*v1.Law) {
+	keeper.IterateLaws(ctx, func(law v1.Law) bool {
+		laws = append(laws, &law)
+		return false
+	})
+	return
+}
+
+// IterateLaws iterates over all the laws and performs a callback function
+func (keeper Keeper) IterateLaws(ctx sdk.Context, cb func(law v1.Law) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LawsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var law v1.Law
+		keeper.cdc.MustUnmarshal(iterator.Value(), &law)
+
+		if cb(law) {
+			break
+		}
+	}
+}
+
+// GetLawID gets the id of the next law to be enacted. Law ids start at 1.
+func (keeper Keeper) GetLawID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.LawIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return types.GetLawIDFromBytes(bz)
+}
+
+// SetLawID sets the id of the next law to be enacted
+func (keeper Keeper) SetLawID(ctx sdk.Context, lawID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.LawIDKey, types.GetLawIDBytes(lawID))
+}
+
+// EnactLaw stores a new law in force enacted by the proposal with the given
+// id, and marks the laws it supersedes as superseded. It returns the id of the
+// new law.
+func (keeper Keeper) EnactLaw(ctx sdk.Context, title, text, textHash string, supersedes []uint64, proposalID uint64) (uint64, error) {
+	lawID := keeper.GetLawID(ctx)
+
+	superseded := make([]v1.Law, 0, len(supersedes))
+	for _, id := range supersedes {
+		law, err := keeper.getLawInForce(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		law.Status = v1.LawStatusSuperseded
+		law.SupersededBy = lawID
+		superseded = append(superseded, law)
+	}
+
+	if text != "" {
+		textHash = v1.LawTextHash(text)
+	} else {
+		textHash = strings.ToLower(textHash)
+	}
+	keeper.SetLaw(ctx, v1.NewLaw(lawID, title, text, textHash, ctx.BlockHeight(), proposalID))
+	for _, law := range superseded {
+		keeper.SetLaw(ctx, law)
+	}
+	keeper.SetLawID(ctx, lawID+1)
+
+	return lawID, nil
+}
+
+// RepealLaw marks the law in force with the given id as repealed.
+func (keeper Keeper) RepealLaw(ctx sdk.Context, lawID uint64) error {
+	law, err := keeper.getLawInForce(ctx, lawID)
+	if err != nil {
+		return err
+	}
+	law.Status = v1.LawStatusRepealed
+	keeper.SetLaw(ctx, law)
+	return nil
+}
+
+// getLawInForce gets the law with the given id from the store, and returns an
+// error if it does not exist or is not in force.
+func (keeper Keeper) getLawInForce(ctx sdk.Context, lawID uint64) (v1.Law, error) {
+	law, found := keeper.GetLaw(ctx, lawID)
+	if !found {
+		return law, types.ErrUnknownLaw.Wrapf("%d", lawID)
+	}
+	if !law.IsInForce() {
+		return law, types.ErrLawNotInForce.Wrapf("law %d is %s", lawID, law.Status)
+	}
+	return law, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Hikari-Chain/hikari-chain/x/gov/exported"
 	v5 "github.com/Hikari-Chain/hikari-chain/x/gov/migrations/v5"
+	v6 "github.com/Hikari-Chain/hikari-chain/x/gov/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +26,8 @@ func NewMigrator(keeper *Keeper, legacySubspace exported.ParamSubspace) Migrator
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate5to6 migrates the store from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -86,6 +86,29 @@ func (keeper Keeper) GetMinDeposit(ctx sdk.Context) sdk.Coins {
 	return minDeposit
 }
 
+// GetExpeditedMinDeposit returns the minimum deposit currently required for
+// an expedited proposal, which is the (dynamic) minimum deposit multiplied by
+// the expedited multiplier of the min deposit throttler.
+func (keeper Keeper) GetExpeditedMinDeposit(ctx sdk.Context) sdk.Coins {
+	minDeposit := keeper.GetMinDeposit(ctx)
+	multiplier := math.LegacyMustNewDecFromStr(keeper.GetParams(ctx).MinDepositThrottler.ExpeditedMultiplier)
+
+	expeditedMinDeposit := sdk.Coins{}
+	for _, coin := range minDeposit {
+		expeditedMinDeposit = append(expeditedMinDeposit, sdk.NewCoin(coin.Denom, coin.Amount.ToLegacyDec().Mul(multiplier).TruncateInt()))
+	}
+	return expeditedMinDeposit
+}
+
+// GetProposalMinDeposit returns the minimum deposit currently required for
+// the given proposal to enter the voting period.
+func (keeper Keeper) GetProposalMinDeposit(ctx sdk.Context, proposal v1.Proposal) sdk.Coins {
+	if proposal.Expedited {
+		return keeper.GetExpeditedMinDeposit(ctx)
+	}
+	return keeper.GetMinDeposit(ctx)
+}
+
 // UpdateMinDeposit updates the minimum deposit required for a proposal
 func (keeper Keeper) UpdateMinDeposit(ctx sdk.Context, checkElapsedTime bool) {
 	logger := keeper.Logger(ctx)
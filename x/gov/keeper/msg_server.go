@@ -46,7 +46,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	var proposal v1.Proposal
+	if len(msg.Options) > 0 {
+		proposal, err = k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.Options, msg.TallyMode, msg.Metadata, msg.Title, msg.Summary, proposer, msg.MetadataHash)
+	} else {
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.MetadataHash, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +144,21 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// VoteMultipleChoice implements the MsgServer.VoteMultipleChoice method.
+func (k msgServer) VoteMultipleChoice(goCtx context.Context, msg *v1.MsgVoteMultipleChoice) (*v1.MsgVoteMultipleChoiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.AddMultipleChoiceVote(ctx, msg.ProposalId, accAddr, msg.Choices, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteMultipleChoiceResponse{}, nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -168,6 +188,24 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+// CancelProposal implements the MsgServer.CancelProposal method.
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 // validateDeposit validates the deposit amount, do not use for initial deposit.
 func validateDeposit(amount sdk.Coins) error {
 	if !amount.IsValid() || !amount.IsAllPositive() {
@@ -203,8 +241,45 @@ func (k msgServer) ProposeLaw(goCtx context.Context, msg *v1.MsgProposeLaw) (*v1
 	if k.authority != msg.Authority {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
-	// only a no-op for now
-	return &v1.MsgProposeLawResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposalID, ok := govtypes.ProposalIDFromContext(ctx)
+	if !ok {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("law can only be enacted by the execution of a passed proposal")
+	}
+	lawID, err := k.EnactLaw(ctx, msg.Title, msg.Text, msg.TextHash, msg.Supersedes, proposalID)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeEnactLaw,
+			sdk.NewAttribute(govtypes.AttributeKeyLawID, fmt.Sprintf("%d", lawID)),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return &v1.MsgProposeLawResponse{LawId: lawID}, nil
+}
+
+// RepealLaw implements the MsgServer.RepealLaw method.
+func (k msgServer) RepealLaw(goCtx context.Context, msg *v1.MsgRepealLaw) (*v1.MsgRepealLawResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RepealLaw(ctx, msg.LawId); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeRepealLaw,
+			sdk.NewAttribute(govtypes.AttributeKeyLawID, fmt.Sprintf("%d", msg.LawId)),
+		),
+	)
+
+	return &v1.MsgRepealLawResponse{}, nil
 }
 
 // ProposeConstitutionAmendment implements the MsgServer.ProposeConstitutionAmendment method.
@@ -216,14 +291,201 @@ func (k msgServer) ProposeConstitutionAmendment(goCtx context.Context, msg *v1.M
 		return nil, govtypes.ErrInvalidProposalMsg.Wrap("amendment cannot be empty")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	previousConstitution := k.GetConstitution(ctx)
 	constitution, err := k.ApplyConstitutionAmendment(ctx, msg.Amendment)
 	if err != nil {
 		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
 	}
-	k.SetConstitution(ctx, constitution)
+	// the proposal id is not set when the message is not executed as part of a proposal
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+	k.AddConstitutionVersion(ctx, constitution, msg.Amendment, proposalID)
+	// rebase the amendments of the other pending proposals onto the amended constitution
+	k.RebaseConstitutionAmendments(ctx, previousConstitution, proposalID)
 	return &v1.MsgProposeConstitutionAmendmentResponse{}, nil
 }
 
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(goCtx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	governorAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, governorAddr); found {
+		return nil, govtypes.ErrGovernorExists.Wrap(msg.Address)
+	}
+	ok, err := k.HasMinGovernorSelfDelegation(ctx, governorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, govtypes.ErrInsufficientGovernorStake.Wrapf("min self-delegation: %s", k.GetParams(ctx).MinGovernorSelfDelegation)
+	}
+
+	k.SetGovernor(ctx, v1.NewGovernor(msg.Address, msg.Description, ctx.BlockTime()))
+
+	// a governor always delegates its governance voting power to itself
+	if err := k.DelegateToGovernor(ctx, governorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeCreateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+		),
+	)
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(goCtx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	governorAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	governor, found := k.GetGovernor(ctx, governorAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernor.Wrap(msg.Address)
+	}
+
+	governor.Description = msg.Description
+	k.SetGovernor(ctx, governor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeEditGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+		),
+	)
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// UpdateGovernorStatus implements the MsgServer.UpdateGovernorStatus method.
+func (k msgServer) UpdateGovernorStatus(goCtx context.Context, msg *v1.MsgUpdateGovernorStatus) (*v1.MsgUpdateGovernorStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	governorAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	governor, found := k.GetGovernor(ctx, governorAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernor.Wrap(msg.Address)
+	}
+	if !msg.Status.IsValid() {
+		return nil, govtypes.ErrInvalidGovernorStatus.Wrap(msg.Status.String())
+	}
+	if governor.Status == msg.Status {
+		return nil, govtypes.ErrInvalidGovernorStatus.Wrapf("governor is already %s", msg.Status)
+	}
+
+	params := k.GetParams(ctx)
+	if governor.LastStatusChangeTime != nil {
+		nextChangeTime := governor.LastStatusChangeTime.Add(*params.GovernorStatusChangePeriod)
+		if ctx.BlockTime().Before(nextChangeTime) {
+			return nil, govtypes.ErrGovernorStatusChangePeriod.Wrapf("next status change allowed at %s", nextChangeTime)
+		}
+	}
+
+	if msg.Status == v1.GovernorStatusActive {
+		ok, err := k.HasMinGovernorSelfDelegation(ctx, governorAddr)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, govtypes.ErrInsufficientGovernorStake.Wrapf("min self-delegation: %s", params.MinGovernorSelfDelegation)
+		}
+	}
+
+	blockTime := ctx.BlockTime()
+	governor.Status = msg.Status
+	governor.LastStatusChangeTime = &blockTime
+	k.SetGovernor(ctx, governor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeUpdateGovernorStatus,
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.Address),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernorStatus, msg.Status.String()),
+		),
+	)
+
+	return &v1.MsgUpdateGovernorStatusResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(goCtx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, delegatorAddr); found {
+		return nil, govtypes.ErrGovernorSelfDelegation.Wrap(msg.DelegatorAddress)
+	}
+	governor, found := k.GetGovernor(ctx, governorAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernor.Wrap(msg.GovernorAddress)
+	}
+	if !governor.IsActive() {
+		return nil, govtypes.ErrInactiveGovernor.Wrap(msg.GovernorAddress)
+	}
+	if delegation, found := k.GetGovernanceDelegation(ctx, delegatorAddr); found && delegation.GovernorAddress == msg.GovernorAddress {
+		return nil, sdkerrors1.ErrInvalidRequest.Wrapf("already delegated to governor %s", msg.GovernorAddress)
+	}
+
+	if err := k.DelegateToGovernor(ctx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeDelegateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, msg.GovernorAddress),
+		),
+	)
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(goCtx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetGovernor(ctx, delegatorAddr); found {
+		return nil, govtypes.ErrGovernorSelfDelegation.Wrap(msg.DelegatorAddress)
+	}
+	delegation, found := k.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return nil, govtypes.ErrUnknownGovernanceDelegation.Wrap(msg.DelegatorAddress)
+	}
+
+	if err := k.UndelegateFromGovernor(ctx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeUndelegateGovernor,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(govtypes.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -5,12 +5,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"cosmossdk.io/math"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/Hikari-Chain/hikari-chain/x/gov"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
@@ -92,6 +95,21 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 			expErr:    true,
 			expErrMsg: "proposal message not recognized by router",
 		},
+		"constitution amendment not applying": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				suite.govKeeper.SetConstitution(suite.ctx, "Hello World")
+				return v1.NewMsgSubmitProposal(
+					[]sdk.Msg{v1.NewMsgProposeConstitutionAmendment(govAcct, "@@ -1 +1 @@\n-Hi World\n+Hello World")},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid constitution amendment",
+		},
 		"all good": {
 			preRun: func() (*v1.MsgSubmitProposal, error) {
 				return v1.NewMsgSubmitProposal(
@@ -461,6 +479,141 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 }
 
 // legacy msg server tests
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100000)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		v1.GetDefaultMinInitialDepositFloor(),
+		proposer.String(),
+		"",
+		"Proposal",
+		"description of proposal",
+	)
+	suite.Require().NoError(err)
+
+	submitProposal := func() uint64 {
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	cases := map[string]struct {
+		preRun   func() uint64
+		canceler sdk.AccAddress
+		expErr   error
+		postRun  func(proposalID uint64)
+	}{
+		"unknown proposal": {
+			preRun: func() uint64 {
+				return 0
+			},
+			canceler: proposer,
+			expErr:   types.ErrUnknownProposal,
+		},
+		"not the proposer": {
+			preRun:   submitProposal,
+			canceler: addrs[1],
+			expErr:   types.ErrInvalidProposer,
+		},
+		"proposal not in deposit or voting period": {
+			preRun: func() uint64 {
+				proposalID := submitProposal()
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				proposal.Status = v1.StatusPassed
+				suite.govKeeper.SetProposal(suite.ctx, proposal)
+				return proposalID
+			},
+			canceler: proposer,
+			expErr:   types.ErrInactiveProposal,
+		},
+		"deposit period": {
+			preRun: func() uint64 {
+				proposalID := submitProposal()
+				suite.Require().EqualValues(1, suite.govKeeper.GetInactiveProposalsNumber(suite.ctx))
+				return proposalID
+			},
+			canceler: proposer,
+			postRun: func(proposalID uint64) {
+				suite.Require().Zero(suite.govKeeper.GetInactiveProposalsNumber(suite.ctx))
+			},
+		},
+		"voting period": {
+			preRun: func() uint64 {
+				proposalID := submitProposal()
+				_, err := suite.msgSrvr.Deposit(suite.ctx, v1.NewMsgDeposit(addrs[1], proposalID, v1.GetDefaultMinDepositFloor()))
+				suite.Require().NoError(err)
+				_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(addrs[1], proposalID, v1.OptionYes, ""))
+				suite.Require().NoError(err)
+				suite.Require().EqualValues(1, suite.govKeeper.GetActiveProposalsNumber(suite.ctx))
+				return proposalID
+			},
+			canceler: proposer,
+			postRun: func(proposalID uint64) {
+				suite.Require().Zero(suite.govKeeper.GetActiveProposalsNumber(suite.ctx))
+				suite.Require().Empty(suite.govKeeper.GetVotes(suite.ctx, proposalID))
+			},
+		},
+		"voting period with quorum checks": {
+			preRun: func() uint64 {
+				params := suite.govKeeper.GetParams(suite.ctx)
+				params.QuorumCheckCount = 2
+				suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+				proposalID := submitProposal()
+				_, err := suite.msgSrvr.Deposit(suite.ctx, v1.NewMsgDeposit(addrs[1], proposalID, v1.GetDefaultMinDepositFloor()))
+				suite.Require().NoError(err)
+				// the first quorum check fails and schedules the next one
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(*params.QuorumTimeout))
+				gov.EndBlocker(suite.ctx, suite.govKeeper)
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+				return proposalID
+			},
+			canceler: proposer,
+			postRun: func(proposalID uint64) {
+				iterator := suite.govKeeper.QuorumCheckQueueIterator(suite.ctx, suite.ctx.BlockTime().Add(*v1.DefaultParams().VotingPeriod))
+				defer iterator.Close()
+				suite.Require().False(iterator.Valid())
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(*v1.DefaultParams().VotingPeriod))
+				suite.Require().NotPanics(func() {
+					gov.EndBlocker(suite.ctx, suite.govKeeper)
+				})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			suite.reset()
+			proposalID := tc.preRun()
+
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.canceler))
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposalID, res.ProposalId)
+			_, found := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.govKeeper.GetDeposits(suite.ctx, proposalID))
+			tc.postRun(proposalID)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
 	proposer := addrs[0]
@@ -1729,6 +1882,128 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestProposeLaw() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+
+	cases := map[string]struct {
+		msg          *v1.MsgProposeLaw
+		noProposalID bool
+		expErrMsg    string
+		expLawID     uint64
+		expTextHash  string
+	}{
+		"law with text": {
+			msg:         v1.NewMsgProposeLaw(govAcct, "title", "text", "", nil),
+			expLawID:    2,
+			expTextHash: v1.LawTextHash("text"),
+		},
+		"law with text hash only": {
+			msg:         v1.NewMsgProposeLaw(govAcct, "title", "", strings.ToUpper(v1.LawTextHash("text")), nil),
+			expLawID:    2,
+			expTextHash: v1.LawTextHash("text"),
+		},
+		"law superseding a law in force": {
+			msg:         v1.NewMsgProposeLaw(govAcct, "title", "text", "", []uint64{1}),
+			expLawID:    2,
+			expTextHash: v1.LawTextHash("text"),
+		},
+		"law superseding an unknown law": {
+			msg:       v1.NewMsgProposeLaw(govAcct, "title", "text", "", []uint64{3}),
+			expErrMsg: types.ErrUnknownLaw.Error(),
+		},
+		"invalid authority": {
+			msg:       v1.NewMsgProposeLaw(sdk.AccAddress("invalid"), "title", "text", "", nil),
+			expErrMsg: types.ErrInvalidSigner.Error(),
+		},
+		"not executed by a proposal": {
+			msg:          v1.NewMsgProposeLaw(govAcct, "title", "text", "", nil),
+			noProposalID: true,
+			expErrMsg:    "law can only be enacted by the execution of a passed proposal",
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			ctx := types.WithProposalID(suite.ctx, 5)
+			if tc.noProposalID {
+				ctx = suite.ctx
+			}
+			_, err := suite.govKeeper.EnactLaw(ctx, "first law", "first", "", nil, 4)
+			suite.Require().NoError(err)
+
+			res, err := suite.msgSrvr.ProposeLaw(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLawID, res.LawId)
+
+			law, found := suite.govKeeper.GetLaw(ctx, res.LawId)
+			suite.Require().True(found)
+			suite.Require().Equal(v1.LawStatusInForce, law.Status)
+			suite.Require().Equal(tc.expTextHash, law.TextHash)
+			suite.Require().Equal(uint64(5), law.ProposalId)
+			suite.Require().Equal(ctx.BlockHeight(), law.EnactmentHeight)
+
+			firstLaw, _ := suite.govKeeper.GetLaw(ctx, 1)
+			if len(tc.msg.Supersedes) > 0 {
+				suite.Require().Equal(v1.LawStatusSuperseded, firstLaw.Status)
+				suite.Require().Equal(res.LawId, firstLaw.SupersededBy)
+			} else {
+				suite.Require().Equal(v1.LawStatusInForce, firstLaw.Status)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRepealLaw() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+
+	cases := map[string]struct {
+		msg       *v1.MsgRepealLaw
+		expErrMsg string
+	}{
+		"repeal a law in force": {
+			msg: v1.NewMsgRepealLaw(govAcct, 1),
+		},
+		"repeal a superseded law": {
+			msg:       v1.NewMsgRepealLaw(govAcct, 2),
+			expErrMsg: types.ErrLawNotInForce.Error(),
+		},
+		"repeal an unknown law": {
+			msg:       v1.NewMsgRepealLaw(govAcct, 4),
+			expErrMsg: types.ErrUnknownLaw.Error(),
+		},
+		"invalid authority": {
+			msg:       v1.NewMsgRepealLaw(sdk.AccAddress("invalid"), 1),
+			expErrMsg: types.ErrInvalidSigner.Error(),
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			_, err := suite.govKeeper.EnactLaw(suite.ctx, "law", "law 1", "", nil, 1)
+			suite.Require().NoError(err)
+			_, err = suite.govKeeper.EnactLaw(suite.ctx, "law", "law 2", "", nil, 1)
+			suite.Require().NoError(err)
+			_, err = suite.govKeeper.EnactLaw(suite.ctx, "law", "law 3", "", []uint64{2}, 1)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.RepealLaw(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			law, found := suite.govKeeper.GetLaw(suite.ctx, tc.msg.LawId)
+			suite.Require().True(found)
+			suite.Require().Equal(v1.LawStatusRepealed, law.Status)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestProposeConstitutionAmendment() {
 	ctx := suite.ctx
 
@@ -1772,17 +2047,216 @@ func (suite *KeeperTestSuite) TestProposeConstitutionAmendment() {
 
 	for name, tc := range cases {
 		suite.Run(name, func() {
-			suite.govKeeper.SetConstitution(suite.ctx, "Hello World")
-			_, err := suite.msgSrvr.ProposeConstitutionAmendment(suite.ctx, tc.msg)
+			suite.govKeeper.AddConstitutionVersion(suite.ctx, "Hello World", "", 0)
+			ctx := types.WithProposalID(suite.ctx, 7)
+			_, err := suite.msgSrvr.ProposeConstitutionAmendment(ctx, tc.msg)
 			if tc.expErr {
 				suite.Require().Error(err)
 				if tc.expErrMsg != "" {
 					suite.Require().Contains(err.Error(), tc.expErrMsg)
 				}
+				latest, _ := suite.govKeeper.GetLatestConstitutionVersion(suite.ctx)
+				suite.Require().Equal(uint64(1), latest.Version)
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expResult, suite.govKeeper.GetConstitution(suite.ctx))
+				latest, found := suite.govKeeper.GetLatestConstitutionVersion(suite.ctx)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), latest.Version)
+				suite.Require().Equal(uint64(7), latest.ProposalId)
+				suite.Require().Equal(tc.msg.Amendment, latest.Amendment)
+				suite.Require().Equal(tc.expResult, latest.Constitution)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateGovernorReq() {
+	addrs := suite.addrs
+	description := v1.NewGovernorDescription("governor", "", "", "", "")
+
+	cases := map[string]struct {
+		preRun func()
+		bonded math.Int
+		msg    *v1.MsgCreateGovernor
+		expErr string
+	}{
+		"all good": {
+			bonded: v1.DefaultMinGovernorSelfDelegation,
+			msg:    v1.NewMsgCreateGovernor(addrs[0], description),
+		},
+		"insufficient self delegation": {
+			bonded: v1.DefaultMinGovernorSelfDelegation.SubRaw(1),
+			msg:    v1.NewMsgCreateGovernor(addrs[0], description),
+			expErr: "insufficient governor self-delegation",
+		},
+		"governor already exists": {
+			preRun: func() {
+				suite.govKeeper.SetGovernor(suite.ctx, v1.NewGovernor(addrs[0].String(), description, suite.ctx.BlockTime()))
+			},
+			bonded: v1.DefaultMinGovernorSelfDelegation,
+			msg:    v1.NewMsgCreateGovernor(addrs[0], description),
+			expErr: "governor already exists",
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			suite.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), gomock.Any()).Return(tc.bonded, nil).AnyTimes()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := suite.msgSrvr.CreateGovernor(suite.ctx, tc.msg)
+
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			governor, found := suite.govKeeper.GetGovernor(suite.ctx, addrs[0])
+			suite.Require().True(found)
+			suite.Require().True(governor.IsActive())
+			delegation, found := suite.govKeeper.GetGovernanceDelegation(suite.ctx, addrs[0])
+			suite.Require().True(found)
+			suite.Require().Equal(addrs[0].String(), delegation.GovernorAddress)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateGovernorStatusReq() {
+	addrs := suite.addrs
+	description := v1.NewGovernorDescription("governor", "", "", "", "")
+
+	cases := map[string]struct {
+		lastStatusChange time.Duration
+		status           v1.GovernorStatus
+		msgStatus        v1.GovernorStatus
+		expErr           string
+	}{
+		"deactivate": {
+			lastStatusChange: -v1.DefaultGovernorStatusChangePeriod,
+			status:           v1.GovernorStatusActive,
+			msgStatus:        v1.GovernorStatusInactive,
+		},
+		"activate": {
+			lastStatusChange: -v1.DefaultGovernorStatusChangePeriod,
+			status:           v1.GovernorStatusInactive,
+			msgStatus:        v1.GovernorStatusActive,
+		},
+		"status change period not elapsed": {
+			lastStatusChange: -v1.DefaultGovernorStatusChangePeriod + time.Second,
+			status:           v1.GovernorStatusActive,
+			msgStatus:        v1.GovernorStatusInactive,
+			expErr:           "governor status change period not elapsed",
+		},
+		"same status": {
+			lastStatusChange: -v1.DefaultGovernorStatusChangePeriod,
+			status:           v1.GovernorStatusActive,
+			msgStatus:        v1.GovernorStatusActive,
+			expErr:           "invalid governor status",
+		},
+		"unspecified status": {
+			lastStatusChange: -v1.DefaultGovernorStatusChangePeriod,
+			status:           v1.GovernorStatusActive,
+			msgStatus:        v1.GovernorStatusUnspecified,
+			expErr:           "invalid governor status",
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			suite.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), gomock.Any()).Return(v1.DefaultMinGovernorSelfDelegation, nil).AnyTimes()
+			governor := v1.NewGovernor(addrs[0].String(), description, suite.ctx.BlockTime().Add(tc.lastStatusChange))
+			governor.Status = tc.status
+			suite.govKeeper.SetGovernor(suite.ctx, governor)
+
+			_, err := suite.msgSrvr.UpdateGovernorStatus(suite.ctx, v1.NewMsgUpdateGovernorStatus(addrs[0], tc.msgStatus))
+
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			governor, _ = suite.govKeeper.GetGovernor(suite.ctx, addrs[0])
+			suite.Require().Equal(tc.msgStatus, governor.Status)
+			suite.Require().Equal(suite.ctx.BlockTime(), *governor.LastStatusChangeTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDelegateGovernorReq() {
+	addrs := suite.addrs
+	description := v1.NewGovernorDescription("governor", "", "", "", "")
+
+	cases := map[string]struct {
+		preRun func()
+		msg    *v1.MsgDelegateGovernor
+		expErr string
+	}{
+		"all good": {
+			msg: v1.NewMsgDelegateGovernor(addrs[1], addrs[0]),
+		},
+		"redelegate to another governor": {
+			preRun: func() {
+				suite.govKeeper.SetGovernor(suite.ctx, v1.NewGovernor(addrs[2].String(), description, suite.ctx.BlockTime()))
+				err := suite.govKeeper.DelegateToGovernor(suite.ctx, addrs[1], addrs[2])
+				suite.Require().NoError(err)
+			},
+			msg: v1.NewMsgDelegateGovernor(addrs[1], addrs[0]),
+		},
+		"already delegated to governor": {
+			preRun: func() {
+				err := suite.govKeeper.DelegateToGovernor(suite.ctx, addrs[1], addrs[0])
+				suite.Require().NoError(err)
+			},
+			msg:    v1.NewMsgDelegateGovernor(addrs[1], addrs[0]),
+			expErr: "already delegated to governor",
+		},
+		"unknown governor": {
+			msg:    v1.NewMsgDelegateGovernor(addrs[1], addrs[2]),
+			expErr: "unknown governor",
+		},
+		"inactive governor": {
+			preRun: func() {
+				governor := v1.NewGovernor(addrs[2].String(), description, suite.ctx.BlockTime())
+				governor.Status = v1.GovernorStatusInactive
+				suite.govKeeper.SetGovernor(suite.ctx, governor)
+			},
+			msg:    v1.NewMsgDelegateGovernor(addrs[1], addrs[2]),
+			expErr: "inactive governor",
+		},
+		"governor delegates to another governor": {
+			preRun: func() {
+				suite.govKeeper.SetGovernor(suite.ctx, v1.NewGovernor(addrs[2].String(), description, suite.ctx.BlockTime()))
+			},
+			msg:    v1.NewMsgDelegateGovernor(addrs[2], addrs[0]),
+			expErr: "governor cannot change its own governance delegation",
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			suite.govKeeper.SetGovernor(suite.ctx, v1.NewGovernor(addrs[0].String(), description, suite.ctx.BlockTime()))
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := suite.msgSrvr.DelegateGovernor(suite.ctx, tc.msg)
+
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
 			}
+			suite.Require().NoError(err)
+			delegation, found := suite.govKeeper.GetGovernanceDelegation(suite.ctx, sdk.MustAccAddressFromBech32(tc.msg.DelegatorAddress))
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg.GovernorAddress, delegation.GovernorAddress)
+
+			_, err = suite.msgSrvr.UndelegateGovernor(suite.ctx, v1.NewMsgUndelegateGovernor(sdk.MustAccAddressFromBech32(tc.msg.DelegatorAddress)))
+			suite.Require().NoError(err)
+			_, found = suite.govKeeper.GetGovernanceDelegation(suite.ctx, sdk.MustAccAddressFromBech32(tc.msg.DelegatorAddress))
+			suite.Require().False(found)
 		})
 	}
 }
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"time"
+	"slices"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -16,28 +17,134 @@ import (
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
-	err := keeper.assertMetadataLength(metadata)
+// SubmitProposal creates a new proposal given an array of messages. An
+// expedited proposal cannot propose laws or constitution amendments.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, metadataHash string, expedited bool) (v1.Proposal, error) {
+	err := keeper.assertProposalMetadata(ctx, metadata, title, summary, metadataHash)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
-	// assert summary is no longer than predefined max length of metadata
-	err = keeper.assertMetadataLength(summary)
+	msgsStr, err := keeper.validateProposalMsgs(ctx, messages)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
-	// assert title is no longer than predefined max length of metadata
-	err = keeper.assertMetadataLength(title)
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	proposal.MetadataHash = metadataHash
+	proposal.Expedited = expedited
+
+	if expedited {
+		kinds := keeper.ProposalKinds(proposal)
+		if kinds.HasKindLaw() || kinds.HasKindConstitutionAmendment() {
+			return v1.Proposal{}, types.ErrInvalidExpeditedProposal.Wrap("laws and constitution amendments cannot be expedited")
+		}
+	}
+
+	keeper.insertProposal(ctx, proposal, msgsStr)
+
+	return proposal, nil
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple-choice proposal given
+// its options, of which only the winning one has its messages executed.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx sdk.Context, options []*v1.MultipleChoiceOption, tallyMode v1.TallyMode, metadata, title, summary string, proposer sdk.AccAddress, metadataHash string) (v1.Proposal, error) {
+	err := keeper.assertProposalMetadata(ctx, metadata, title, summary, metadataHash)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := v1.MultipleChoiceOptions(options).ValidateBasic(); err != nil {
+		return v1.Proposal{}, err
+	}
+	if !v1.ValidTallyMode(tallyMode) {
+		return v1.Proposal{}, types.ErrInvalidMultipleChoiceOptions.Wrapf("invalid tally mode %s", tallyMode)
+	}
+
+	// the options are alternatives, so the messages of each option are
+	// validated on their own
+	msgsStr := ""
+	for _, opt := range options {
+		messages, err := opt.GetMsgs()
+		if err != nil {
+			return v1.Proposal{}, err
+		}
+		optMsgsStr, err := keeper.validateProposalMsgs(ctx, messages)
+		if err != nil {
+			return v1.Proposal{}, sdkerrors.Wrapf(err, "option %s", opt.Name)
+		}
+		msgsStr += optMsgsStr
+	}
+
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+
+	proposal, err := v1.NewProposal(nil, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
+	proposal.MetadataHash = metadataHash
+	proposal.Options = options
+	proposal.TallyMode = tallyMode
+
+	keeper.insertProposal(ctx, proposal, msgsStr)
+
+	return proposal, nil
+}
+
+// assertProposalMetadata returns an error if the metadata, title or summary
+// of a new proposal are too long, or if its metadata hash is invalid.
+func (keeper Keeper) assertProposalMetadata(ctx sdk.Context, metadata, title, summary, metadataHash string) error {
+	err := keeper.assertMetadataLength(metadata)
+	if err != nil {
+		return err
+	}
+
+	err = keeper.assertMetadataHash(ctx, metadataHash)
+	if err != nil {
+		return err
+	}
+
+	// assert summary is no longer than predefined max length of metadata
+	err = keeper.assertMetadataLength(summary)
+	if err != nil {
+		return err
+	}
+
+	// assert title is no longer than predefined max length of metadata
+	return keeper.assertMetadataLength(title)
+}
+
+// validateProposalMsgs checks that the messages of a new proposal can be
+// executed by the governance module account, and returns a comma-separated
+// string of all their type URLs.
+func (keeper Keeper) validateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) (string, error) {
 	// Will hold a comma-separated string of all Msg type URLs.
 	msgsStr := ""
 
+	// constitution is amended by the constitution amendments of the proposal,
+	// to check that they apply cleanly one after the other.
+	constitution := keeper.GetConstitution(ctx)
+	patchConfig := keeper.constitutionPatchConfig(ctx)
+
 	// Loop through all messages and confirm that each has a handler and the gov module account
 	// as the only signer
 	for _, msg := range messages {
@@ -46,27 +153,27 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 		// perform a basic validation of the message
 		if m, ok := msg.(sdk.HasValidateBasic); ok {
 			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+				return "", sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
 			}
 		}
 
 		signers, _, err := keeper.cdc.GetMsgV1Signers(msg)
 		if err != nil {
-			return v1.Proposal{}, err
+			return "", err
 		}
 		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
+			return "", types.ErrInvalidSigner
 		}
 
 		// assert that the governance module account is the only signer of the messages
 		if !bytes.Equal(signers[0], keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
+			return "", sdkerrors.Wrap(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
 		}
 
 		// use the msg service router to see that there is a valid route for that message.
 		handler := keeper.router.Handler(msg)
 		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+			return "", sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -78,45 +185,105 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			cacheCtx, _ := ctx.CacheContext()
 			if _, err := handler(cacheCtx, msg); err != nil {
 				if errors.Is(err, types.ErrNoProposalHandlerExists) {
-					return v1.Proposal{}, err
+					return "", err
 				}
-				return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+				return "", sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 			}
 		}
 
+		// Dry-run the constitution amendments against the current
+		// constitution, so that broken amendments are rejected up front
+		// rather than failing upon execution.
+		if msg, ok := msg.(*v1.MsgProposeConstitutionAmendment); ok {
+			constitution, err = types.ApplyUnifiedDiffWithConfig(constitution, msg.Amendment, patchConfig)
+			if err != nil {
+				return "", sdkerrors.Wrap(types.ErrInvalidConstitutionAmendment, err.Error())
+			}
+		}
 	}
 
-	proposalID, err := keeper.GetProposalID(ctx)
-	if err != nil {
-		return v1.Proposal{}, err
+	return msgsStr, nil
+}
+
+// insertProposal stores a new proposal in the deposit period and emits the
+// submit proposal event.
+func (keeper Keeper) insertProposal(ctx sdk.Context, proposal v1.Proposal, msgsStr string) {
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	keeper.SetProposalID(ctx, proposal.Id+1)
+
+	keeper.IncrementInactiveProposalsNumber(ctx)
+
+	// called right after a proposal is submitted
+	keeper.Hooks().AfterProposalSubmission(ctx, proposal.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+		),
+	)
+}
+
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The ProposalCancelRatio of the deposits is burned or sent
+// to the ProposalCancelDest, the rest is refunded to the depositors.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
 
-	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
 
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d can only be canceled during its deposit or voting period", proposalID)
+	}
+
+	params := keeper.GetParams(ctx)
+	cancelRatio, err := math.LegacyNewDecFromStr(params.ProposalCancelRatio)
 	if err != nil {
-		return v1.Proposal{}, err
+		return err
 	}
 
-	keeper.SetProposal(ctx, proposal)
-	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
-	keeper.SetProposalID(ctx, proposalID+1)
+	charges, err := keeper.ChargeAndDeleteDeposits(ctx, proposalID, params.ProposalCancelDest, cancelRatio)
+	if err != nil {
+		return err
+	}
 
-	keeper.IncrementInactiveProposalsNumber(ctx)
+	// DeleteProposal also removes the proposal from the inactive, active and
+	// quorum check queues
+	keeper.DeleteProposal(ctx, proposalID)
 
-	// called right after a proposal is submitted
-	keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
+	if proposal.Status == v1.StatusDepositPeriod {
+		keeper.DecrementInactiveProposalsNumber(ctx)
+		keeper.UpdateMinInitialDeposit(ctx, true)
+	} else {
+		keeper.DeleteVotes(ctx, proposalID)
+		keeper.DecrementActiveProposalsNumber(ctx)
+		keeper.UpdateMinDeposit(ctx, true)
+	}
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled",
+		"proposal", proposalID,
+		"proposer", proposer,
+		"cancellation_charges", charges.String(),
+	)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeSubmitProposal,
+			types.EventTypeCancelProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+			sdk.NewAttribute(types.AttributeKeyCancellationCharges, charges.String()),
 		),
 	)
 
-	return proposal, nil
+	return nil
 }
 
 // GetProposal gets a proposal from store by ProposalID.
@@ -152,6 +319,11 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal v1.Proposal) {
 	} else {
 		store.Delete(types.VotingPeriodProposalKey(proposal.Id))
 	}
+	if proposal.Status == v1.StatusVotingPeriod && proposal.VotingPowerSnapshot {
+		store.Set(types.VotingPowerSnapshotProposalKey(proposal.Id), []byte{1})
+	} else {
+		store.Delete(types.VotingPowerSnapshotProposalKey(proposal.Id))
+	}
 
 	store.Set(types.ProposalKey(proposal.Id), bz)
 }
@@ -171,19 +343,12 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	if proposal.VotingEndTime != nil {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
 		store.Delete(types.VotingPeriodProposalKey(proposalID))
-		// Delete from QuorumCheckQueue: as we do not know with certainty the value
-		// of the first part of the key (the time part), we need to iterate over it,
-		// starting by proposal.VotingStartTime, because we know for sure that the
-		// time part is greater than that.
-		keeper.IterateQuorumCheckQueue(ctx, *proposal.VotingStartTime,
-			func(p v1.Proposal, t time.Time, _ v1.QuorumCheckQueueEntry) bool {
-				if p.Id == proposalID {
-					// found the proposal, delete from queue and stop
-					keeper.RemoveFromQuorumCheckQueue(ctx, p.Id, t)
-					return true
-				}
-				return false
-			})
+		store.Delete(types.VotingPowerSnapshotProposalKey(proposalID))
+		keeper.removeProposalFromQuorumCheckQueue(ctx, proposal)
+	}
+
+	if proposal.ExecutionTime != nil {
+		keeper.RemoveFromExecutionQueue(ctx, proposalID, *proposal.ExecutionTime)
 	}
 
 	store.Delete(types.ProposalKey(proposalID))
@@ -288,24 +453,112 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal)
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
 	params := keeper.GetParams(ctx)
-	endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+	votingPeriod := params.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = params.ExpeditedVotingPeriod
+	}
+	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
+	if params.SnapshotVotingPower {
+		// the voting power delegated to the governors is snapshotted now, the
+		// one of the voters before their delegations are first modified and
+		// when they vote
+		if err := keeper.snapshotGovernorPowers(ctx, proposal.Id); err != nil {
+			keeper.Logger(ctx).Error("failed to snapshot governor voting power", "proposal", proposal.Id, "error", err)
+		} else {
+			proposal.VotingPowerSnapshot = true
+		}
+	}
 	keeper.SetProposal(ctx, proposal)
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
 	keeper.DecrementInactiveProposalsNumber(ctx)
 	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
-	if params.QuorumCheckCount > 0 {
-		// add proposal to quorum check queue
-		quorumTimeoutTime := proposal.VotingStartTime.Add(*params.QuorumTimeout)
-		keeper.InsertQuorumCheckQueue(ctx, proposal.Id, quorumTimeoutTime,
-			v1.NewQuorumCheckQueueEntry(quorumTimeoutTime, params.QuorumCheckCount),
-		)
+	// expedited proposals are not checked for quorum, as the extension of
+	// their voting period would defeat their purpose. They are added to the
+	// quorum check queue if they are converted to regular proposals.
+	if !proposal.Expedited {
+		keeper.insertQuorumCheck(ctx, proposal)
 	}
 	keeper.IncrementActiveProposalsNumber(ctx)
 }
 
+// ConvertExpeditedProposal converts an expedited proposal that did not pass
+// at the end of its voting period into a regular proposal. Its voting period
+// is extended by the difference between the regular and the expedited voting
+// periods, so that any extension granted in the meantime is preserved, and it
+// is added to the quorum check queue if quorum checks are enabled.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal v1.Proposal) v1.Proposal {
+	params := keeper.GetParams(ctx)
+	endTime := proposal.VotingEndTime.Add(*params.VotingPeriod - *params.ExpeditedVotingPeriod)
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	proposal.Expedited = false
+	proposal.VotingEndTime = &endTime
+	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.insertQuorumCheck(ctx, proposal)
+
+	return proposal
+}
+
+// insertQuorumCheck adds a proposal in voting period to the quorum check
+// queue if quorum checks are enabled. The first check happens once the
+// quorum timeout has elapsed since the start of the voting period, or right
+// away if it has already elapsed.
+func (keeper Keeper) insertQuorumCheck(ctx sdk.Context, proposal v1.Proposal) {
+	params := keeper.GetParams(ctx)
+	if params.QuorumCheckCount == 0 {
+		return
+	}
+	quorumTimeoutTime := proposal.VotingStartTime.Add(*params.QuorumTimeout)
+	if quorumTimeoutTime.Before(ctx.BlockTime()) {
+		quorumTimeoutTime = ctx.BlockTime()
+	}
+	keeper.InsertQuorumCheckQueue(ctx, proposal.Id, quorumTimeoutTime,
+		v1.NewQuorumCheckQueueEntry(quorumTimeoutTime, params.QuorumCheckCount),
+	)
+}
+
+// ResetQuorumCheck schedules again the quorum checks of a proposal in voting
+// period, e.g. once a vetoed proposal is restored. The pending quorum checks of
+// the proposal are removed and, unless the proposal is expedited, the first
+// check is added to the quorum check queue if quorum checks are enabled.
+func (keeper Keeper) ResetQuorumCheck(ctx sdk.Context, proposal v1.Proposal) {
+	keeper.removeProposalFromQuorumCheckQueue(ctx, proposal)
+	if !proposal.Expedited {
+		keeper.insertQuorumCheck(ctx, proposal)
+	}
+}
+
+// removeProposalFromQuorumCheckQueue removes a proposal in voting period from
+// the quorum check queue. As we do not know with certainty the value of the
+// first part of the key (the time part), which moves forward with each quorum
+// check, we need to iterate over the queue starting by
+// proposal.VotingStartTime, because we know for sure that the time part is
+// greater than that.
+func (keeper Keeper) removeProposalFromQuorumCheckQueue(ctx sdk.Context, proposal v1.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(
+		types.QuorumCheckByTimeKey(*proposal.VotingStartTime),
+		storetypes.PrefixEndBytes(types.QuorumCheckQueuePrefix),
+	)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if proposalID, _ := types.SplitQuorumQueueKey(iterator.Key()); proposalID == proposal.Id {
+			keys = append(keys, bytes.Clone(iterator.Key()))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // MarshalProposal marshals the proposal and returns binary encoded bytes.
 func (keeper Keeper) MarshalProposal(proposal v1.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
@@ -327,17 +580,23 @@ func (keeper Keeper) UnmarshalProposal(bz []byte, proposal *v1.Proposal) error {
 // ProposalKinds returns a v1.ProposalKinds useful to determine which kind of
 // messages are included in a proposal.
 func (k Keeper) ProposalKinds(p v1.Proposal) v1.ProposalKinds {
-	if len(p.Messages) == 0 {
+	// the messages of all the options of a multiple-choice proposal are
+	// considered, as any of them may be executed.
+	messages := slices.Clone(p.Messages)
+	for _, opt := range p.Options {
+		messages = append(messages, opt.Messages...)
+	}
+	if len(messages) == 0 {
 		return v1.ProposalKindAny
 	}
 	var kinds v1.ProposalKinds
-	for _, msg := range p.Messages {
+	for _, msg := range messages {
 		var sdkMsg sdk.Msg
 		if err := k.cdc.UnpackAny(msg, &sdkMsg); err == nil {
 			switch sdkMsg.(type) {
 			case *v1.MsgProposeConstitutionAmendment:
 				kinds |= v1.ProposalKindConstitutionAmendment
-			case *v1.MsgProposeLaw:
+			case *v1.MsgProposeLaw, *v1.MsgRepealLaw:
 				kinds |= v1.ProposalKindLaw
 			default:
 				kinds |= v1.ProposalKindAny
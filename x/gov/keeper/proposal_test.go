@@ -22,7 +22,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -40,7 +40,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 		},
 	)
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -61,7 +61,7 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	currentProposalNumber := suite.govKeeper.GetActiveProposalsNumber(suite.ctx)
 
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -97,7 +97,7 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	suite.reset()
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	suite.Require().NoError(err)
 	suite.Require().Nil(proposal.VotingStartTime)
 
@@ -160,11 +160,126 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitExpeditedProposal() {
+	suite.reset()
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{"regular proposal", TestProposal, nil},
+		{"law proposal", TestLawProposal, types.ErrInvalidExpeditedProposal},
+		{"constitution amendment proposal", TestAmendmentProposal, types.ErrInvalidExpeditedProposal},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tc.msgs, "", "title", "summary", proposer, "", true)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+			suite.Require().True(ok)
+			suite.Require().True(proposal.Expedited)
+			suite.Require().Equal(suite.govKeeper.GetExpeditedMinDeposit(suite.ctx), suite.govKeeper.GetProposalMinDeposit(suite.ctx, proposal))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertExpeditedProposal() {
+	suite.reset()
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.QuorumCheckCount = 10 // enable quorum check
+	quorumTimeout := *params.ExpeditedVotingPeriod
+	params.QuorumTimeout = &quorumTimeout
+	maxVotingPeriodExtension := *params.VotingPeriod - quorumTimeout
+	params.MaxVotingPeriodExtension = &maxVotingPeriodExtension
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", true)
+	suite.Require().NoError(err)
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+	// extend the voting period the way the core DAOs do
+	extension := time.Hour * 24
+	extendedEndTime := proposal.VotingEndTime.Add(extension)
+	suite.govKeeper.RemoveFromActiveProposalQueue(suite.ctx, proposal.Id, *proposal.VotingEndTime)
+	proposal.VotingEndTime = &extendedEndTime
+	suite.govKeeper.InsertActiveProposalQueue(suite.ctx, proposal.Id, *proposal.VotingEndTime)
+	suite.govKeeper.SetProposal(suite.ctx, proposal)
+
+	proposal = suite.govKeeper.ConvertExpeditedProposal(suite.ctx.WithBlockTime(extendedEndTime), proposal)
+
+	// the extension is preserved on top of the regular voting period
+	suite.Require().False(proposal.Expedited)
+	expectedEndTime := proposal.VotingStartTime.Add(*params.VotingPeriod + extension)
+	suite.Require().Equal(expectedEndTime, *proposal.VotingEndTime)
+	activeIterator := suite.govKeeper.ActiveProposalQueueIterator(suite.ctx, extendedEndTime)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+	activeIterator = suite.govKeeper.ActiveProposalQueueIterator(suite.ctx, expectedEndTime)
+	suite.Require().True(activeIterator.Valid())
+	suite.Require().Equal(proposal.Id, types.GetProposalIDFromBytes(activeIterator.Value()))
+	activeIterator.Close()
+
+	// the quorum timeout has already elapsed, so the first check is due now
+	quorumCheckEntry, ok := testutil.GetQuorumCheckQueueEntry(suite.ctx, suite.govKeeper, proposal.Id, extendedEndTime)
+	suite.Require().True(ok)
+	suite.Require().EqualValues(params.QuorumCheckCount, quorumCheckEntry.QuorumCheckCount)
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalMetadataHash() {
+	suite.reset()
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+	metadataHash := types.ComputeMetadataHash([]byte(`{"title":"title"}`))
+
+	testCases := []struct {
+		name                string
+		requireMetadataHash bool
+		metadataHash        string
+		expectedErr         error
+	}{
+		{"no hash", false, "", nil},
+		{"hash", false, metadataHash, nil},
+		{"malformed hash", false, "abc", types.ErrInvalidMetadataHash},
+		{"uppercase hash", false, strings.ToUpper(metadataHash), types.ErrInvalidMetadataHash},
+		{"required hash", true, metadataHash, nil},
+		{"missing required hash", true, "", types.ErrMissingMetadataHash},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.govKeeper.GetParams(suite.ctx)
+			params.RequireMetadataHash = tc.requireMetadataHash
+			suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "ipfs://CID", "title", "summary", proposer, tc.metadataHash, false)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.metadataHash, proposal.MetadataHash)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 
@@ -257,6 +372,13 @@ func TestProposalKinds(t *testing.T) {
 			},
 			expectedKinds: v1.ProposalKindLaw,
 		},
+		{
+			name: "Repeal law message",
+			proposal: v1.Proposal{
+				Messages: setMsgs(t, []sdk.Msg{&v1.MsgRepealLaw{}}),
+			},
+			expectedKinds: v1.ProposalKindLaw,
+		},
 		{
 			name: "Law+Send messages",
 			proposal: v1.Proposal{
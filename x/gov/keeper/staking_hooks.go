@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks wrapper struct for gov keeper, keeping the validator shares of
+// the governors current with the staking delegations of their governance
+// delegators.
+type StakingHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov keeper
+func (keeper *Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{keeper}
+}
+
+// BeforeDelegationSharesModified is called before a delegation's shares are
+// modified, its shares are removed from the validator shares of the governor
+// of the delegator, if any. The voting power the delegator had when the
+// voting period of the snapshotted proposals started is snapshotted first.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.snapshotVoterStartPower(sdk.UnwrapSDKContext(ctx), delAddr); err != nil {
+		return err
+	}
+	return h.updateGovernorShares(ctx, delAddr, valAddr, h.k.DecreaseGovernorShares)
+}
+
+// AfterDelegationModified is called after a delegation is created or its
+// shares are modified, its shares are added to the validator shares of the
+// governor of the delegator, if any.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.updateGovernorShares(ctx, delAddr, valAddr, h.k.IncreaseGovernorShares)
+}
+
+// updateGovernorShares calls update with the shares of the delegation of
+// delAddr to valAddr, if delAddr delegates its governance voting power to a
+// governor.
+func (h StakingHooks) updateGovernorShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	update func(ctx sdk.Context, governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares math.LegacyDec),
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	governanceDelegation, found := h.k.GetGovernanceDelegation(sdkCtx, delAddr)
+	if !found {
+		return nil
+	}
+
+	delegation, err := h.k.sk.GetDelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoDelegation) {
+			return nil
+		}
+		return err
+	}
+
+	governorAddr := sdk.MustAccAddressFromBech32(governanceDelegation.GovernorAddress)
+	update(sdkCtx, governorAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// BeforeDelegationCreated is called before a delegation is created, the
+// voting power the delegator had when the voting period of the snapshotted
+// proposals started is snapshotted.
+func (h StakingHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.snapshotVoterStartPower(sdk.UnwrapSDKContext(ctx), delAddr)
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(ctx context.Context, unbondingID uint64) error {
+	return nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,13 +20,18 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	if err != nil {
 		return false, false, math.LegacyZeroDec(), tallyResults, err
 	}
-	totalVotingPower, results, err := keeper.tallyVotes(ctx, proposal, currValidators, true)
+	totalVotingPower, results, ballots, err := keeper.tallyVotes(ctx, proposal, currValidators, true)
 	if err != nil {
 		return false, false, math.LegacyZeroDec(), tallyResults, err
 	}
 
 	params := keeper.GetParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
+	if proposal.IsMultipleChoice() {
+		for _, count := range ballots.pluralityCounts() {
+			tallyResults.OptionCounts = append(tallyResults.OptionCounts, count.TruncateInt().String())
+		}
+	}
 
 	// If there is no staked coins, the proposal fails
 	totalBonded, err := keeper.sk.TotalBondedTokens(ctx)
@@ -43,6 +50,17 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false, params.BurnVoteQuorum, percentVoting, tallyResults, nil
 	}
 
+	// A multiple-choice proposal passes if one of its options wins, in which
+	// case only the messages of this option are executed.
+	if proposal.IsMultipleChoice() {
+		winner, ok := ballots.winner(proposal.TallyMode)
+		if !ok {
+			return false, false, percentVoting, tallyResults, nil
+		}
+		tallyResults.WinningOption = proposal.Options[winner].Name
+		return true, false, percentVoting, tallyResults, nil
+	}
+
 	// Compute non-abstaining voting power, aka active voting power
 	activeVotingPower := totalVotingPower.Sub(results[v1.OptionAbstain])
 
@@ -83,41 +101,46 @@ func (keeper Keeper) HasReachedQuorum(ctx sdk.Context, proposal v1.Proposal) (bo
 		return false, nil
 	}
 
-	/* DISABLED on AtomOne - no possible increase of computation speed by
-	 iterating over validators since vote inheritance is disabled.
-	 Keeping as comment because this should be adapted with governors loop
-
-	// we check first if voting power of validators alone is enough to pass quorum
-	// and if so, we return true skipping the iteration over all votes
-	// can speed up computation in case quorum is already reached by validator votes alone
-	approxTotalVotingPower := math.LegacyZeroDec()
-	for _, val := range currValidators {
-		_, ok := keeper.GetVote(ctx, proposal.Id, sdk.AccAddress(val.GetOperator()))
-		if ok {
-			approxTotalVotingPower = approxTotalVotingPower.Add(math.LegacyNewDecFromInt(val.GetBondedTokens()))
-		}
-	}
-	// check and return whether or not the proposal has reached quorum
-	approxPercentVoting := approxTotalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
-	if approxPercentVoting.GTE(quorum) {
-		return true, nil
-	}
-	*/
-
-	// voting power of validators does not reach quorum, let's tally all votes
 	currValidators, err := keeper.getBondedValidatorsByAddress(ctx)
 	if err != nil {
 		return false, err
 	}
+	quorum, _ := keeper.getQuorumAndThreshold(ctx, proposal)
+
+	// we check first if the voting power of the governors alone is enough to
+	// pass quorum, and if so, we return true skipping the iteration over all
+	// votes. The governance delegators that voted themselves still participate
+	// with the same shares, so the voting power of the governors is a lower
+	// bound of the participation. This no longer holds if the voting power is
+	// snapshotted, as these delegators may be capped by their own snapshots.
+	if !proposal.VotingPowerSnapshot {
+		governorVotes, err := keeper.getGovernorVotes(ctx, proposal.Id)
+		if err != nil {
+			return false, err
+		}
+		approxTotalVotingPower := math.LegacyZeroDec()
+		for _, govVote := range governorVotes.votes {
+			for _, valShares := range govVote.valShares {
+				if val, ok := currValidators[valShares.ValidatorAddress]; ok {
+					votingPower := valShares.Shares.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares())
+					approxTotalVotingPower = approxTotalVotingPower.Add(votingPower)
+				}
+			}
+		}
+		approxPercentVoting := approxTotalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+		if approxPercentVoting.GTE(quorum) {
+			return true, nil
+		}
+	}
 
-	totalVotingPower, _, err := keeper.tallyVotes(ctx, proposal, currValidators, false)
+	// voting power of governors does not reach quorum, let's tally all votes
+	totalVotingPower, _, _, err := keeper.tallyVotes(ctx, proposal, currValidators, false)
 	if err != nil {
 		return false, err
 	}
 
 	// check and return whether or not the proposal has reached quorum
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
-	quorum, _ := keeper.getQuorumAndThreshold(ctx, proposal)
 	return percentVoting.GTE(quorum), nil
 }
 
@@ -134,38 +157,59 @@ func (keeper Keeper) getBondedValidatorsByAddress(ctx sdk.Context) (map[string]s
 
 // tallyVotes returns the total voting power and tally results of the votes
 // on a proposal. If `isFinal` is true, results will be stored in `results`
-// map and votes will be deleted. Otherwise, only the total voting power
-// will be returned and `results` will be nil.
+// map, the choices of the votes on a multiple-choice proposal in `ballots`,
+// and votes will be deleted, after being archived if the vote archive is
+// enabled, along with the voting power snapshots. Otherwise, only the total
+// voting power will be returned and `results` and `ballots` will be nil.
+// The voting power of each voter and governor is capped by its snapshot if
+// the voting power of the proposal is snapshotted.
 func (keeper Keeper) tallyVotes(
 	ctx sdk.Context, proposal v1.Proposal,
 	currValidators map[string]stakingtypes.ValidatorI, isFinal bool,
-) (totalVotingPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error) {
+) (totalVotingPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, ballots *choiceBallots, err error) {
 	totalVotingPower = math.LegacyZeroDec()
 	if isFinal {
 		results = make(map[v1.VoteOption]math.LegacyDec)
 		results[v1.OptionYes] = math.LegacyZeroDec()
 		results[v1.OptionAbstain] = math.LegacyZeroDec()
 		results[v1.OptionNo] = math.LegacyZeroDec()
+		if proposal.IsMultipleChoice() {
+			ballots = newChoiceBallots(len(proposal.Options))
+		}
+	}
+
+	governorVotes, err := keeper.getGovernorVotes(ctx, proposal.Id)
+	if err != nil {
+		return totalVotingPower, results, ballots, err
+	}
+
+	var archive *voteArchive
+	if isFinal && keeper.IsVoteArchiveEnabled(ctx) {
+		archive = newVoteArchive()
 	}
 
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+		archive.add(vote)
+
+		// the voter's own vote overrides the one of its governor
+		var govVote *governorVote
+		if delegation, found := keeper.GetGovernanceDelegation(ctx, voter); found {
+			govVote = governorVotes.get(delegation.GovernorAddress)
+		}
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
+		votingPower := math.LegacyZeroDec()
 		err = keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
+			if govVote != nil {
+				govVote.deductShares(valAddrStr, delegation.GetShares())
+			}
+
 			if val, ok := currValidators[valAddrStr]; ok {
 				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares())
-
-				if isFinal {
-					for _, option := range vote.Options {
-						weight, _ := math.LegacyNewDecFromStr(option.Weight)
-						subPower := votingPower.Mul(weight)
-						results[option.Option] = results[option.Option].Add(subPower)
-					}
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
+				votingPower = votingPower.Add(delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
 			}
 
 			return false
@@ -174,40 +218,148 @@ func (keeper Keeper) tallyVotes(
 			return true
 		}
 
+		votingPower = keeper.capVoterPower(ctx, proposal, voter, votingPower)
+		if votingPower.IsPositive() {
+			if isFinal {
+				for _, option := range vote.Options {
+					weight, _ := math.LegacyNewDecFromStr(option.Weight)
+					subPower := votingPower.Mul(weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
+				ballots.add(vote.Choices, votingPower)
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+			archive.addPower(vote.Voter, votingPower)
+		}
+
 		if isFinal {
 			keeper.deleteVote(ctx, vote.ProposalId, voter)
 		}
 		return false
 	})
 	if err != nil {
-		return totalVotingPower, results, err
+		return totalVotingPower, results, ballots, err
 	}
 
-	/* DISABLED on AtomOne - Voting can only be done with your own stake
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
-			continue
-		}
+	// iterate over the governors that voted to tally the voting power of
+	// their governance delegators that didn't vote themselves
+	for _, govVote := range governorVotes.votes {
+		votingPower := math.LegacyZeroDec()
+		for _, valShares := range govVote.valShares {
+			val, ok := currValidators[valShares.ValidatorAddress]
+			if !ok {
+				continue
+			}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			sharesAfterDeductions := valShares.Shares
+			if deductions, ok := govVote.deductions[valShares.ValidatorAddress]; ok {
+				sharesAfterDeductions = sharesAfterDeductions.Sub(deductions)
+			}
+			if !sharesAfterDeductions.IsPositive() {
+				continue
+			}
+			votingPower = votingPower.Add(sharesAfterDeductions.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+		}
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+		votingPower = keeper.capGovernorPower(ctx, proposal, sdk.MustAccAddressFromBech32(govVote.governor), votingPower)
+		if !votingPower.IsPositive() {
+			continue
+		}
+		if isFinal {
+			for _, option := range govVote.options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				subPower := votingPower.Mul(weight)
+				results[option.Option] = results[option.Option].Add(subPower)
+			}
+			ballots.add(govVote.choices, votingPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+		archive.addPower(govVote.governor, votingPower)
+	}
+
+	keeper.archiveVotes(ctx, archive)
+	if isFinal {
+		keeper.deleteVotingPowerSnapshots(ctx, proposal.Id)
+	}
+
+	return totalVotingPower, results, ballots, nil
+}
+
+// governorVote is the vote of an active governor on a proposal, along with
+// the validator shares delegated by its governance delegators and the shares
+// of the delegators that voted themselves.
+type governorVote struct {
+	governor   string
+	options    v1.WeightedVoteOptions
+	choices    v1.WeightedChoices
+	valShares  []v1.GovernorValShares
+	deductions map[string]math.LegacyDec
+}
+
+// deductShares records shares of a validator of a governance delegator that
+// voted itself, which must not be counted with the governor vote.
+func (v *governorVote) deductShares(valAddr string, shares math.LegacyDec) {
+	deductions, ok := v.deductions[valAddr]
+	if !ok {
+		deductions = math.LegacyZeroDec()
 	}
-	*/
+	v.deductions[valAddr] = deductions.Add(shares)
+}
 
-	return totalVotingPower, results, nil
+// governorVotes holds the votes of the governors on a proposal, in the
+// governors store order.
+type governorVotes struct {
+	votes     []*governorVote
+	byAddress map[string]*governorVote
+}
+
+func (v governorVotes) get(governorAddr string) *governorVote {
+	return v.byAddress[governorAddr]
+}
+
+// getGovernorVotes returns the votes on a proposal of the active governors
+// that meet the min_governor_self_delegation param.
+func (keeper Keeper) getGovernorVotes(ctx sdk.Context, proposalID uint64) (votes governorVotes, err error) {
+	votes.byAddress = make(map[string]*governorVote)
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+		if !governor.IsActive() {
+			return false
+		}
+		governorAddr := governor.GetAddress()
+		vote, found := keeper.GetVote(ctx, proposalID, governorAddr)
+		if !found {
+			return false
+		}
+		var ok bool
+		ok, err = keeper.HasMinGovernorSelfDelegation(ctx, governorAddr)
+		if err != nil {
+			return true
+		}
+		if !ok {
+			return false
+		}
+
+		govVote := &governorVote{
+			governor:   governor.GovernorAddress,
+			options:    vote.Options,
+			choices:    vote.Choices,
+			deductions: make(map[string]math.LegacyDec),
+		}
+		keeper.IterateGovernorValShares(ctx, governorAddr, func(valShares v1.GovernorValShares) bool {
+			govVote.valShares = append(govVote.valShares, valShares)
+			return false
+		})
+		votes.votes = append(votes.votes, govVote)
+		votes.byAddress[governor.GovernorAddress] = govVote
+		return false
+	})
+	return votes, err
 }
 
 // getQuorumAndThreshold returns the appropriate quorum and threshold according
 // to proposal kind. If the proposal contains multiple kinds, the highest
-// quorum and threshold is returned.
+// quorum and threshold is returned. Expedited proposals, which cannot contain
+// laws or constitution amendments, use the expedited threshold.
 func (keeper Keeper) getQuorumAndThreshold(ctx sdk.Context, proposal v1.Proposal) (quorum math.LegacyDec, threshold math.LegacyDec) {
 	params := keeper.GetParams(ctx)
 	kinds := keeper.ProposalKinds(proposal)
@@ -215,6 +367,9 @@ func (keeper Keeper) getQuorumAndThreshold(ctx sdk.Context, proposal v1.Proposal
 	// start with the default quorum and threshold
 	quorum = keeper.GetQuorum(ctx)
 	threshold = math.LegacyMustNewDecFromStr(params.Threshold)
+	if proposal.Expedited {
+		threshold = math.LegacyMustNewDecFromStr(params.ExpeditedThreshold)
+	}
 
 	// Check for Constitution Amendment and update if higher
 	if kinds.HasKindConstitutionAmendment() {
@@ -248,3 +403,20 @@ func (keeper Keeper) getQuorumAndThreshold(ctx sdk.Context, proposal v1.Proposal
 
 	return quorum, threshold
 }
+
+// GetExecutionDelay returns the delay between the end of the voting period of
+// a proposal that passed and the execution of its messages, which is the
+// longest of the execution delays of the proposal kinds.
+func (keeper Keeper) GetExecutionDelay(ctx sdk.Context, proposal v1.Proposal) time.Duration {
+	params := keeper.GetParams(ctx)
+	kinds := keeper.ProposalKinds(proposal)
+
+	delay := *params.ExecutionDelay
+	if kinds.HasKindConstitutionAmendment() && *params.ConstitutionAmendmentExecutionDelay > delay {
+		delay = *params.ConstitutionAmendmentExecutionDelay
+	}
+	if kinds.HasKindLaw() && *params.LawExecutionDelay > delay {
+		delay = *params.LawExecutionDelay
+	}
+	return delay
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"slices"
+
+	"cosmossdk.io/math"
+
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// choiceBallots collects the weighted choices of the votes on a
+// multiple-choice proposal being tallied for the last time, along with the
+// voting power they are tallied with. Votes with identical choices are
+// aggregated into a single ballot. It is nil when the proposal is not a
+// multiple-choice proposal, in which case its methods are no-ops.
+type choiceBallots struct {
+	numOptions int
+	ballots    []*choiceBallot
+	byChoices  map[string]*choiceBallot
+}
+
+// choiceBallot is the voting power of the votes with the same weighted
+// choices, ranked by decreasing weight.
+type choiceBallot struct {
+	ranking []rankedChoice
+	power   math.LegacyDec
+}
+
+type rankedChoice struct {
+	option int
+	weight math.LegacyDec
+}
+
+func newChoiceBallots(numOptions int) *choiceBallots {
+	return &choiceBallots{numOptions: numOptions, byChoices: make(map[string]*choiceBallot)}
+}
+
+// add adds voting power to the ballot of the given choices. Choices of options
+// the proposal doesn't have are ignored.
+func (b *choiceBallots) add(choices v1.WeightedChoices, votingPower math.LegacyDec) {
+	if b == nil || len(choices) == 0 {
+		return
+	}
+	key := choices.String()
+	ballot, ok := b.byChoices[key]
+	if !ok {
+		ballot = &choiceBallot{power: math.LegacyZeroDec()}
+		for _, choice := range choices {
+			weight, err := math.LegacyNewDecFromStr(choice.Weight)
+			if err != nil || int(choice.Option) >= b.numOptions {
+				continue
+			}
+			ballot.ranking = append(ballot.ranking, rankedChoice{option: int(choice.Option), weight: weight})
+		}
+		// the first listed option ranks first among options of equal weight
+		slices.SortStableFunc(ballot.ranking, func(x, y rankedChoice) int {
+			switch {
+			case x.weight.GT(y.weight):
+				return -1
+			case x.weight.LT(y.weight):
+				return 1
+			default:
+				return 0
+			}
+		})
+		b.byChoices[key] = ballot
+		b.ballots = append(b.ballots, ballot)
+	}
+	ballot.power = ballot.power.Add(votingPower)
+}
+
+// pluralityCounts returns the voting power given to each option, the voting
+// power of each ballot being split across its options according to their
+// weights.
+func (b *choiceBallots) pluralityCounts() []math.LegacyDec {
+	if b == nil {
+		return nil
+	}
+	counts := zeroCounts(b.numOptions)
+	for _, ballot := range b.ballots {
+		for _, choice := range ballot.ranking {
+			counts[choice.option] = counts[choice.option].Add(ballot.power.Mul(choice.weight))
+		}
+	}
+	return counts
+}
+
+// winner returns the index of the winning option according to the tally
+// mode, and false if no option won because no voting power was cast.
+func (b *choiceBallots) winner(mode v1.TallyMode) (int, bool) {
+	if b == nil {
+		return 0, false
+	}
+	if mode == v1.TallyModeInstantRunoff {
+		return b.instantRunoffWinner()
+	}
+	return b.pluralityWinner()
+}
+
+// pluralityWinner returns the option with the most voting power, the first
+// listed option winning a tie.
+func (b *choiceBallots) pluralityWinner() (int, bool) {
+	counts := b.pluralityCounts()
+	winner := 0
+	for option, count := range counts {
+		if count.GT(counts[winner]) {
+			winner = option
+		}
+	}
+	return winner, counts[winner].IsPositive()
+}
+
+// instantRunoffWinner counts the full voting power of each ballot towards its
+// highest ranked option still in the race, and eliminates the option with the
+// least voting power until an option has a majority of the voting power of
+// the ballots that are not exhausted. The last listed option is eliminated
+// first in case of a tie.
+func (b *choiceBallots) instantRunoffWinner() (int, bool) {
+	eliminated := make([]bool, b.numOptions)
+	for remaining := b.numOptions; remaining > 0; remaining-- {
+		counts := zeroCounts(b.numOptions)
+		activePower := math.LegacyZeroDec()
+		for _, ballot := range b.ballots {
+			for _, choice := range ballot.ranking {
+				if !eliminated[choice.option] {
+					counts[choice.option] = counts[choice.option].Add(ballot.power)
+					activePower = activePower.Add(ballot.power)
+					break
+				}
+			}
+		}
+		if !activePower.IsPositive() {
+			return 0, false
+		}
+
+		leader, loser := -1, -1
+		for option, count := range counts {
+			if eliminated[option] {
+				continue
+			}
+			if leader == -1 || count.GT(counts[leader]) {
+				leader = option
+			}
+			if loser == -1 || !count.GT(counts[loser]) {
+				loser = option
+			}
+		}
+		if remaining == 1 || counts[leader].MulInt64(2).GT(activePower) {
+			return leader, true
+		}
+		eliminated[loser] = true
+	}
+	return 0, false
+}
+
+func zeroCounts(numOptions int) []math.LegacyDec {
+	counts := make([]math.LegacyDec, numOptions)
+	for i := range counts {
+		counts[i] = math.LegacyZeroDec()
+	}
+	return counts
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+func TestChoiceBallotsWinner(t *testing.T) {
+	type ballot struct {
+		choices string
+		power   int64
+	}
+	tests := []struct {
+		name           string
+		numOptions     int
+		ballots        []ballot
+		expectedCounts []int64
+		plurality      int
+		instantRunoff  int
+		noWinner       bool
+	}{
+		{
+			name:       "no votes",
+			numOptions: 2,
+			noWinner:   true,
+		},
+		{
+			name:           "single choices",
+			numOptions:     3,
+			ballots:        []ballot{{"0=1", 30}, {"1=1", 50}, {"2=1", 20}},
+			expectedCounts: []int64{30, 50, 20},
+			plurality:      1,
+			instantRunoff:  1,
+		},
+		{
+			name:           "weighted choices",
+			numOptions:     2,
+			ballots:        []ballot{{"0=0.4,1=0.6", 100}, {"0=1", 30}},
+			expectedCounts: []int64{70, 60},
+			plurality:      0,
+			// the first ballot goes in full to option 1
+			instantRunoff: 1,
+		},
+		{
+			name:       "runoff overturns plurality",
+			numOptions: 3,
+			ballots: []ballot{
+				{"0=1", 40},
+				{"1=0.7,2=0.3", 35},
+				{"2=0.6,1=0.4", 25},
+			},
+			expectedCounts: []int64{40, 34, 25},
+			plurality:      0,
+			// option 2 is eliminated and its ballot transferred to option 1
+			instantRunoff: 1,
+		},
+		{
+			name:       "runoff confirms plurality",
+			numOptions: 3,
+			ballots: []ballot{
+				{"0=1", 40},
+				{"1=1", 35},
+				{"2=0.9,0=0.1", 25},
+			},
+			expectedCounts: []int64{42, 35, 22},
+			plurality:      0,
+			instantRunoff:  0,
+		},
+		{
+			name:           "tie goes to the first option",
+			numOptions:     2,
+			ballots:        []ballot{{"1=1", 50}, {"0=1", 50}},
+			expectedCounts: []int64{50, 50},
+			plurality:      0,
+			instantRunoff:  0,
+		},
+		{
+			name:           "aggregated ballots and unknown options",
+			numOptions:     2,
+			ballots:        []ballot{{"1=1", 10}, {"1=1", 15}, {"0=0.5,5=0.5", 40}},
+			expectedCounts: []int64{20, 25},
+			plurality:      1,
+			instantRunoff:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ballots := newChoiceBallots(tt.numOptions)
+			for _, b := range tt.ballots {
+				choices, err := v1.WeightedChoicesFromString(b.choices)
+				require.NoError(t, err)
+				ballots.add(choices, math.LegacyNewDec(b.power))
+			}
+
+			if tt.expectedCounts != nil {
+				counts := ballots.pluralityCounts()
+				require.Len(t, counts, tt.numOptions)
+				for i, count := range counts {
+					require.Equal(t, tt.expectedCounts[i], count.TruncateInt64(), "option %d", i)
+				}
+			}
+
+			winner, ok := ballots.winner(v1.TallyModePlurality)
+			require.Equal(t, !tt.noWinner, ok)
+			if ok {
+				require.Equal(t, tt.plurality, winner)
+			}
+
+			winner, ok = ballots.winner(v1.TallyModeInstantRunoff)
+			require.Equal(t, !tt.noWinner, ok)
+			if ok {
+				require.Equal(t, tt.instantRunoff, winner)
+			}
+		})
+	}
+
+	var nilBallots *choiceBallots
+	nilBallots.add(v1.WeightedChoices{v1.NewWeightedChoice(0, math.LegacyOneDec())}, math.LegacyOneDec())
+	require.Nil(t, nilBallots.pluralityCounts())
+	_, ok := nilBallots.winner(v1.TallyModePlurality)
+	require.False(t, ok)
+}
@@ -88,6 +88,18 @@ func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 				}
 				return nil
 			}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().
+		GetDelegatorBonded(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, delegator sdk.AccAddress) (sdkmath.Int, error) {
+				bonded := sdkmath.ZeroInt()
+				for _, d := range s.delegations {
+					if d.DelegatorAddress == delegator.String() {
+						bonded = bonded.Add(d.Shares.TruncateInt())
+					}
+				}
+				return bonded, nil
+			}).AnyTimes()
 	return s
 }
 
@@ -108,6 +120,21 @@ func (s *tallyFixture) delegate(delegator sdk.AccAddress, validator sdk.ValAddre
 	s.delegations = append(s.delegations, delegation)
 }
 
+// governor creates a governor with the given status, delegating its
+// governance voting power to itself.
+func (s *tallyFixture) governor(addr sdk.AccAddress, status v1.GovernorStatus) {
+	governor := v1.NewGovernor(addr.String(), v1.NewGovernorDescription("moniker", "", "", "", ""), s.ctx.BlockTime())
+	governor.Status = status
+	s.keeper.SetGovernor(s.ctx, governor)
+	s.delegateGovernor(addr, addr)
+}
+
+// delegateGovernor calls govKeeper.DelegateToGovernor()
+func (s *tallyFixture) delegateGovernor(delegator, governor sdk.AccAddress) {
+	err := s.keeper.DelegateToGovernor(s.ctx, delegator, governor)
+	require.NoError(s.t, err)
+}
+
 // vote calls govKeeper.Vote()
 func (s *tallyFixture) vote(voter sdk.AccAddress, vote v1.VoteOption) {
 	err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
@@ -255,6 +282,90 @@ func TestTally(t *testing.T) {
 				NoCount:      "1",
 			},
 		},
+		{
+			name: "governor votes yes, inherits the voting power of its delegators: prop passes",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.delegate(s.delAddrs[2], s.valAddrs[2], 3)
+				s.governor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:     "10",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "delegator votes no, overrides the vote of its governor: prop passes",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.delegate(s.delAddrs[2], s.valAddrs[2], 3)
+				s.governor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.vote(s.delAddrs[2], v1.VoteOption_VOTE_OPTION_NO)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:     "7",
+				AbstainCount: "0",
+				NoCount:      "3",
+			},
+		},
+		{
+			name: "inactive governor votes yes, doesn't inherit delegations",
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.governor(s.delAddrs[0], v1.GovernorStatusInactive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "4",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
+		{
+			name: "governor below min self delegation votes yes, doesn't inherit delegations",
+			setup: func(s *tallyFixture) {
+				params := s.keeper.GetParams(s.ctx)
+				params.MinGovernorSelfDelegation = "4"
+				require.NoError(s.t, s.keeper.SetParams(s.ctx, params))
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 3)
+				s.governor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.validatorVote(s.valAddrs[3], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			proposalMsgs: TestProposal,
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:     "4",
+				AbstainCount: "0",
+				NoCount:      "0",
+			},
+		},
 		{
 			name: "quorum reached with only abstain: prop fails",
 			setup: func(s *tallyFixture) {
@@ -509,6 +620,8 @@ func TestTally(t *testing.T) {
 			params := v1.DefaultParams()
 			// Ensure params value are different than false
 			params.BurnVoteQuorum = true
+			// Allow the governors of the tests to vote with the small delegations
+			params.MinGovernorSelfDelegation = "1"
 			err := govKeeper.SetParams(ctx, params)
 			require.NoError(t, err)
 			var (
@@ -519,7 +632,7 @@ func TestTally(t *testing.T) {
 				delAddrs      = addrs[numVals:]
 			)
 			// Submit and activate a proposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0], "", false)
 			require.NoError(t, err)
 			govKeeper.ActivateVotingPeriod(ctx, proposal)
 			if tt.endorse {
@@ -626,10 +739,57 @@ func TestHasReachedQuorum(t *testing.T) {
 			proposalMsgs:   TestLawProposal,
 			expectedQuorum: true,
 		},
+		{
+			name:         "governor votes alone: quorum",
+			proposalMsgs: TestProposal,
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 500000)
+				s.delegate(s.delAddrs[2], s.valAddrs[2], 500000)
+				s.governor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			expectedQuorum: true,
+		},
+		{
+			name:         "governor votes overridden by its delegators: quorum",
+			proposalMsgs: TestProposal,
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 500000)
+				s.delegate(s.delAddrs[2], s.valAddrs[2], 500000)
+				s.governor(s.delAddrs[0], v1.GovernorStatusActive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.delegateGovernor(s.delAddrs[2], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+				s.vote(s.delAddrs[1], v1.VoteOption_VOTE_OPTION_NO)
+				s.vote(s.delAddrs[2], v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedQuorum: true,
+		},
+		{
+			name:         "inactive governor votes alone: no quorum",
+			proposalMsgs: TestProposal,
+			setup: func(s *tallyFixture) {
+				s.delegate(s.delAddrs[0], s.valAddrs[0], 3)
+				s.delegate(s.delAddrs[1], s.valAddrs[1], 500000)
+				s.governor(s.delAddrs[0], v1.GovernorStatusInactive)
+				s.delegateGovernor(s.delAddrs[1], s.delAddrs[0])
+				s.vote(s.delAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			expectedQuorum: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Allow the governors of the tests to vote with the small delegations
+			params.MinGovernorSelfDelegation = "1"
+			err := govKeeper.SetParams(ctx, params)
+			require.NoError(t, err)
 			var (
 				numVals       = 10
 				numDelegators = 5
@@ -638,7 +798,7 @@ func TestHasReachedQuorum(t *testing.T) {
 				delAddrs      = addrs[numVals:]
 			)
 			// Submit and activate a proposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, tt.proposalMsgs, "", "title", "summary", delAddrs[0], "", false)
 			require.NoError(t, err)
 			govKeeper.ActivateVotingPeriod(ctx, proposal)
 			suite := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
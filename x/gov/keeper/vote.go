@@ -25,12 +25,24 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return err
 	}
 
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.IsMultipleChoice() {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is a multiple-choice proposal", proposalID)
+	}
+
 	for _, option := range options {
 		if !v1.ValidWeightedVoteOption(*option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
 	}
 
+	if err := keeper.snapshotVoterPower(ctx, proposal, voterAddr); err != nil {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
 	keeper.SetVote(ctx, vote)
 
@@ -49,6 +61,59 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	return nil
 }
 
+// AddMultipleChoiceVote adds a vote on the options of a specific
+// multiple-choice proposal
+func (keeper Keeper) AddMultipleChoiceVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, choices v1.WeightedChoices, metadata string) error {
+	// Check if proposal is in voting period.
+	store := ctx.KVStore(keeper.storeKey)
+	if !store.Has(types.VotingPeriodProposalKey(proposalID)) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	err := keeper.assertMetadataLength(metadata)
+	if err != nil {
+		return err
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.IsMultipleChoice() {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is not a multiple-choice proposal", proposalID)
+	}
+
+	if err := choices.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, choice := range choices {
+		if int(choice.Option) >= len(proposal.Options) {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d has no option %d", proposalID, choice.Option)
+		}
+	}
+
+	if err := keeper.snapshotVoterPower(ctx, proposal, voterAddr); err != nil {
+		return err
+	}
+
+	vote := v1.Vote{ProposalId: proposalID, Voter: voterAddr.String(), Choices: choices, Metadata: metadata}
+	keeper.SetVote(ctx, vote)
+
+	// called after a vote on a proposal is cast
+	keeper.Hooks().AfterProposalVote(ctx, proposalID, voterAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, voterAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, choices.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 // GetAllVotes returns all the votes from the store
 func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes v1.Votes) {
 	keeper.IterateAllVotes(ctx, func(vote v1.Vote) bool {
@@ -127,11 +192,13 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
 
-// DeleteVotes deletes all votes from a proposal with given proposalID
+// DeleteVotes deletes all votes from a proposal with given proposalID, along
+// with the voting power snapshots of the proposal
 func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
 	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
+	keeper.deleteVotingPowerSnapshots(ctx, proposalID)
 }
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// IsVoteArchiveEnabled returns true if the votes of a proposal are archived
+// when it is tallied at the end of its voting period, which requires both the
+// ArchiveVotes keeper config and the archive_votes param.
+func (keeper Keeper) IsVoteArchiveEnabled(ctx sdk.Context) bool {
+	return keeper.config.ArchiveVotes && keeper.GetParams(ctx).ArchiveVotes
+}
+
+// GetArchivedVote gets the archived vote from an address on a specific
+// proposal
+func (keeper Keeper) GetArchivedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote v1.ArchivedVote, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ArchivedVoteKey(proposalID, voterAddr))
+	if bz == nil {
+		return vote, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &vote)
+
+	return vote, true
+}
+
+// SetArchivedVote sets an archived vote to the gov store, along with its
+// index by voter and the archived votes queue entry of its proposal.
+func (keeper Keeper) SetArchivedVote(ctx sdk.Context, vote v1.ArchivedVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&vote)
+	addr := sdk.MustAccAddressFromBech32(vote.Voter)
+
+	store.Set(types.ArchivedVoteKey(vote.ProposalId, addr), bz)
+	store.Set(types.ArchivedVoteByVoterKey(addr, vote.ProposalId), []byte{0x01})
+	store.Set(types.ArchivedVotesQueueKey(vote.ProposalId, vote.ArchiveTime), []byte{0x01})
+}
+
+// GetAllArchivedVotes returns all the archived votes from the store
+func (keeper Keeper) GetAllArchivedVotes(ctx sdk.Context) (votes []*v1.ArchivedVote) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ArchivedVotesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote v1.ArchivedVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, &vote)
+	}
+	return
+}
+
+// IterateArchivedVotes iterates over the archived votes of a proposal and
+// performs a callback function
+func (keeper Keeper) IterateArchivedVotes(ctx sdk.Context, proposalID uint64, cb func(vote v1.ArchivedVote) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ArchivedVotesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote v1.ArchivedVote
+		keeper.cdc.MustUnmarshal(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// deleteArchivedVotes deletes the archived votes of a proposal from the
+// store, along with their index by voter.
+func (keeper Keeper) deleteArchivedVotes(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	keeper.IterateArchivedVotes(ctx, proposalID, func(vote v1.ArchivedVote) bool {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+		store.Delete(types.ArchivedVoteKey(proposalID, voter))
+		store.Delete(types.ArchivedVoteByVoterKey(voter, proposalID))
+		return false
+	})
+}
+
+// ArchivedVotesQueueIterator returns an sdk.Iterator for all the proposals in
+// the archived votes queue whose votes were archived by archiveTime
+func (keeper Keeper) ArchivedVotesQueueIterator(ctx sdk.Context, archiveTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ArchivedVotesQueuePrefix, storetypes.PrefixEndBytes(types.ArchivedVotesByTimeKey(archiveTime)))
+}
+
+// PruneArchivedVotes deletes the archived votes of the proposals whose votes
+// were archived more than vote_archive_retention_period ago. A zero retention
+// period keeps the archived votes forever.
+func (keeper Keeper) PruneArchivedVotes(ctx sdk.Context) {
+	retentionPeriod := keeper.GetParams(ctx).VoteArchiveRetentionPeriod
+	if retentionPeriod == nil || *retentionPeriod == 0 {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := keeper.ArchivedVotesQueueIterator(ctx, ctx.BlockTime().Add(-*retentionPeriod))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitArchivedVotesQueueKey(iterator.Key())
+		keeper.deleteArchivedVotes(ctx, proposalID)
+		store.Delete(iterator.Key())
+	}
+}
+
+// voteArchive collects the votes of a proposal being tallied for the last
+// time, along with the voting power they are tallied with. It is nil when
+// votes are not archived, in which case its methods are no-ops.
+type voteArchive struct {
+	votes   []v1.ArchivedVote
+	byVoter map[string]int
+	power   []math.LegacyDec
+}
+
+func newVoteArchive() *voteArchive {
+	return &voteArchive{byVoter: make(map[string]int)}
+}
+
+// add records a vote, with no voting power yet.
+func (a *voteArchive) add(vote v1.Vote) {
+	if a == nil {
+		return
+	}
+	a.byVoter[vote.Voter] = len(a.votes)
+	a.votes = append(a.votes, v1.ArchivedVote{
+		ProposalId: vote.ProposalId,
+		Voter:      vote.Voter,
+		Options:    vote.Options,
+		Metadata:   vote.Metadata,
+		Choices:    vote.Choices,
+	})
+	a.power = append(a.power, math.LegacyZeroDec())
+}
+
+// addPower adds voting power to the recorded vote of voter.
+func (a *voteArchive) addPower(voter string, votingPower math.LegacyDec) {
+	if a == nil {
+		return
+	}
+	if i, ok := a.byVoter[voter]; ok {
+		a.power[i] = a.power[i].Add(votingPower)
+	}
+}
+
+// archiveVotes stores the votes of a voteArchive as archived at the current
+// block time.
+func (keeper Keeper) archiveVotes(ctx sdk.Context, archive *voteArchive) {
+	if archive == nil {
+		return
+	}
+	for i, vote := range archive.votes {
+		vote.VotingPower = archive.power[i].String()
+		vote.ArchiveTime = ctx.BlockTime()
+		keeper.SetArchivedVote(ctx, vote)
+	}
+}
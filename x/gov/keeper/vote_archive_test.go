@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+func TestTallyArchivesVotes(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        bool
+		param         bool
+		expectArchive bool
+	}{
+		{
+			name:          "archive enabled",
+			config:        true,
+			param:         true,
+			expectArchive: true,
+		},
+		{
+			name:   "archive disabled by config",
+			config: false,
+			param:  true,
+		},
+		{
+			name:   "archive disabled by param",
+			config: true,
+			param:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := types.DefaultConfig()
+			config.ArchiveVotes = tt.config
+			govKeeper, mocks, _, ctx := setupGovKeeperWithConfig(t, config, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.ArchiveVotes = tt.param
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+			var (
+				addrs    = simtestutil.CreateRandomAccounts(4)
+				valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+				delAddrs = addrs[2:]
+			)
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], "", false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			s.delegate(delAddrs[0], valAddrs[0], 2)
+			s.delegate(delAddrs[0], valAddrs[1], 3)
+			s.vote(delAddrs[0], v1.OptionYes)
+			s.validatorVote(valAddrs[1], v1.OptionNo)
+			s.vote(delAddrs[1], v1.OptionAbstain)
+
+			_, _, _, _, err = govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			require.Empty(t, govKeeper.GetVotes(ctx, proposal.Id))
+			var archived []v1.ArchivedVote
+			govKeeper.IterateArchivedVotes(ctx, proposal.Id, func(vote v1.ArchivedVote) bool {
+				archived = append(archived, vote)
+				return false
+			})
+			if !tt.expectArchive {
+				require.Empty(t, archived)
+				return
+			}
+			require.Len(t, archived, 3)
+			expectedPower := map[string]string{
+				delAddrs[0].String():                 "5.000000000000000000",
+				sdk.AccAddress(valAddrs[1]).String(): "1.000000000000000000",
+				delAddrs[1].String():                 "0.000000000000000000",
+			}
+			for _, vote := range archived {
+				require.Equal(t, expectedPower[vote.Voter], vote.VotingPower, vote.Voter)
+				require.Equal(t, ctx.BlockTime(), vote.ArchiveTime)
+			}
+			vote, found := govKeeper.GetArchivedVote(ctx, proposal.Id, delAddrs[0])
+			require.True(t, found)
+			require.Equal(t, v1.NewNonSplitVoteOption(v1.OptionYes), v1.WeightedVoteOptions(vote.Options))
+		})
+	}
+}
+
+func TestPruneArchivedVotes(t *testing.T) {
+	config := types.DefaultConfig()
+	config.ArchiveVotes = true
+	govKeeper, _, _, ctx := setupGovKeeperWithConfig(t, config)
+	addrs := simtestutil.CreateRandomAccounts(2)
+	archiveTime := ctx.BlockTime()
+	for proposalID := uint64(1); proposalID <= 2; proposalID++ {
+		for _, addr := range addrs {
+			govKeeper.SetArchivedVote(ctx, v1.ArchivedVote{
+				ProposalId:  proposalID,
+				Voter:       addr.String(),
+				Options:     v1.NewNonSplitVoteOption(v1.OptionYes),
+				VotingPower: "1.000000000000000000",
+				ArchiveTime: archiveTime.Add(time.Duration(proposalID) * time.Hour),
+			})
+		}
+	}
+	retention := v1.DefaultParams().VoteArchiveRetentionPeriod
+	countVotes := func(ctx sdk.Context) int {
+		return len(govKeeper.GetAllArchivedVotes(ctx))
+	}
+
+	// retention period not elapsed
+	govKeeper.PruneArchivedVotes(ctx.WithBlockTime(archiveTime.Add(*retention)))
+	require.Equal(t, 4, countVotes(ctx))
+
+	// retention period elapsed for the votes of proposal 1 only
+	govKeeper.PruneArchivedVotes(ctx.WithBlockTime(archiveTime.Add(*retention + time.Hour)))
+	require.Equal(t, 2, countVotes(ctx))
+	_, found := govKeeper.GetArchivedVote(ctx, 1, addrs[0])
+	require.False(t, found)
+	_, found = govKeeper.GetArchivedVote(ctx, 2, addrs[0])
+	require.True(t, found)
+
+	// a zero retention period keeps the archived votes forever
+	params := govKeeper.GetParams(ctx)
+	zero := time.Duration(0)
+	params.VoteArchiveRetentionPeriod = &zero
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	govKeeper.PruneArchivedVotes(ctx.WithBlockTime(archiveTime.Add(*retention * 2)))
+	require.Equal(t, 2, countVotes(ctx))
+}
@@ -20,7 +20,7 @@ func TestVotes(t *testing.T) {
 	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	metadata := "metadata"
@@ -94,7 +94,7 @@ func TestDeleteVotes(t *testing.T) {
 	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), "", false)
 	proposalID := proposal.Id
 	metadata := "metadata"
 	require.NoError(t, err)
@@ -110,3 +110,42 @@ func TestDeleteVotes(t *testing.T) {
 	votesAfter := govKeeper.GetVotes(ctx, proposalID)
 	require.Len(t, votesAfter, 0)
 }
+
+func TestMultipleChoiceVotes(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+
+	optionA, err := v1.NewMultipleChoiceOption("A", TestProposal)
+	require.NoError(t, err)
+	optionB, err := v1.NewMultipleChoiceOption("B", nil)
+	require.NoError(t, err)
+	proposal, err := govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.MultipleChoiceOption{optionA, optionB}, v1.TallyModeInstantRunoff, "", "title", "description", addrs[0], "")
+	require.NoError(t, err)
+	require.True(t, proposal.IsMultipleChoice())
+	require.Equal(t, v1.TallyModeInstantRunoff, proposal.TallyMode)
+	proposalID := proposal.Id
+
+	choices := v1.WeightedChoices{v1.NewWeightedChoice(1, math.LegacyOneDec())}
+	require.Error(t, govKeeper.AddMultipleChoiceVote(ctx, proposalID, addrs[0], choices, ""), "proposal not on voting period")
+
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	require.Error(t, govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""), "yes/no vote on multiple-choice proposal")
+	require.Error(t, govKeeper.AddMultipleChoiceVote(ctx, proposalID, addrs[0], v1.WeightedChoices{v1.NewWeightedChoice(2, math.LegacyOneDec())}, ""), "unknown option")
+	require.Error(t, govKeeper.AddMultipleChoiceVote(ctx, proposalID, addrs[0], v1.WeightedChoices{v1.NewWeightedChoice(0, math.LegacyNewDecWithPrec(5, 1))}, ""), "weights not summing up to 1")
+
+	require.NoError(t, govKeeper.AddMultipleChoiceVote(ctx, proposalID, addrs[0], choices, "metadata"))
+	vote, found := govKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, addrs[0].String(), vote.Voter)
+	require.Empty(t, vote.Options)
+	require.Len(t, vote.Choices, 1)
+	require.Equal(t, uint32(1), vote.Choices[0].Option)
+
+	// the yes/no proposals still reject multiple-choice votes
+	proposal, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", addrs[0], "", false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	require.Error(t, govKeeper.AddMultipleChoiceVote(ctx, proposal.Id, addrs[1], choices, ""))
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov/types"
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+// GetVotingPowerSnapshot gets the snapshotted voting power of a voter on a
+// specific proposal
+func (keeper Keeper) GetVotingPowerSnapshot(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (snapshot v1.VotingPowerSnapshot, found bool) {
+	return keeper.getSnapshot(ctx, types.VotingPowerSnapshotKey(proposalID, voterAddr))
+}
+
+// SetVotingPowerSnapshot sets the snapshotted voting power of a voter to the
+// gov store
+func (keeper Keeper) SetVotingPowerSnapshot(ctx sdk.Context, snapshot v1.VotingPowerSnapshot) {
+	addr := sdk.MustAccAddressFromBech32(snapshot.Address)
+	keeper.setSnapshot(ctx, types.VotingPowerSnapshotKey(snapshot.ProposalId, addr), snapshot)
+}
+
+// GetGovernorPowerSnapshot gets the snapshotted voting power delegated to a
+// governor on a specific proposal
+func (keeper Keeper) GetGovernorPowerSnapshot(ctx sdk.Context, proposalID uint64, governorAddr sdk.AccAddress) (snapshot v1.VotingPowerSnapshot, found bool) {
+	return keeper.getSnapshot(ctx, types.GovernorPowerSnapshotKey(proposalID, governorAddr))
+}
+
+// SetGovernorPowerSnapshot sets the snapshotted voting power delegated to a
+// governor to the gov store
+func (keeper Keeper) SetGovernorPowerSnapshot(ctx sdk.Context, snapshot v1.VotingPowerSnapshot) {
+	addr := sdk.MustAccAddressFromBech32(snapshot.Address)
+	keeper.setSnapshot(ctx, types.GovernorPowerSnapshotKey(snapshot.ProposalId, addr), snapshot)
+}
+
+// GetAllVotingPowerSnapshots returns all the voting power snapshots of the
+// voters from the store
+func (keeper Keeper) GetAllVotingPowerSnapshots(ctx sdk.Context) []*v1.VotingPowerSnapshot {
+	return keeper.getAllSnapshots(ctx, types.VotingPowerSnapshotsKeyPrefix)
+}
+
+// GetAllGovernorPowerSnapshots returns all the voting power snapshots of the
+// governors from the store
+func (keeper Keeper) GetAllGovernorPowerSnapshots(ctx sdk.Context) []*v1.VotingPowerSnapshot {
+	return keeper.getAllSnapshots(ctx, types.GovernorPowerSnapshotsKeyPrefix)
+}
+
+// deleteVotingPowerSnapshots deletes the voting power snapshots of the voters
+// and of the governors on a proposal from the store.
+func (keeper Keeper) deleteVotingPowerSnapshots(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{types.VotingPowerSnapshotsKey(proposalID), types.GovernorPowerSnapshotsKey(proposalID)} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+func (keeper Keeper) getSnapshot(ctx sdk.Context, key []byte) (snapshot v1.VotingPowerSnapshot, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return snapshot, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &snapshot)
+
+	return snapshot, true
+}
+
+func (keeper Keeper) setSnapshot(ctx sdk.Context, key []byte, snapshot v1.VotingPowerSnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&snapshot)
+	store.Set(key, bz)
+}
+
+func (keeper Keeper) getAllSnapshots(ctx sdk.Context, prefix []byte) (snapshots []*v1.VotingPowerSnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot v1.VotingPowerSnapshot
+		keeper.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, &snapshot)
+	}
+	return
+}
+
+// snapshotVoterPower snapshots the current voting power of a voter on a
+// proposal whose voting power is snapshotted. A voter keeps the lowest of its
+// snapshots, so that it can't raise its voting power by voting again, or
+// above the voting power it had when the voting period started.
+func (keeper Keeper) snapshotVoterPower(ctx sdk.Context, proposal v1.Proposal, voterAddr sdk.AccAddress) error {
+	if !proposal.VotingPowerSnapshot {
+		return nil
+	}
+
+	currValidators, err := keeper.getBondedValidatorsByAddress(ctx)
+	if err != nil {
+		return err
+	}
+	votingPower, err := keeper.getVoterPower(ctx, voterAddr, currValidators)
+	if err != nil {
+		return err
+	}
+
+	if snapshot, found := keeper.GetVotingPowerSnapshot(ctx, proposal.Id, voterAddr); found {
+		votingPower = math.LegacyMinDec(votingPower, math.LegacyMustNewDecFromStr(snapshot.VotingPower))
+	}
+	keeper.SetVotingPowerSnapshot(ctx, v1.VotingPowerSnapshot{
+		ProposalId:  proposal.Id,
+		Address:     voterAddr.String(),
+		VotingPower: votingPower.String(),
+	})
+	return nil
+}
+
+// snapshotVoterStartPower snapshots the current voting power of a voter on
+// the proposals in voting period whose voting power is snapshotted and on
+// which it has no snapshot yet. It is called before the delegations of the
+// voter are modified, so that the voting power snapshotted is the one the
+// voter had when the voting period of these proposals started, and stake
+// acquired afterwards is not counted.
+func (keeper Keeper) snapshotVoterStartPower(ctx sdk.Context, voterAddr sdk.AccAddress) error {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.VotingPowerSnapshotProposalKeyPrefix)
+	var proposalIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.GetProposalIDFromBytes(iterator.Key()[len(types.VotingPowerSnapshotProposalKeyPrefix):])
+		if _, found := keeper.GetVotingPowerSnapshot(ctx, proposalID, voterAddr); !found {
+			proposalIDs = append(proposalIDs, proposalID)
+		}
+	}
+	iterator.Close()
+	if len(proposalIDs) == 0 {
+		return nil
+	}
+
+	currValidators, err := keeper.getBondedValidatorsByAddress(ctx)
+	if err != nil {
+		return err
+	}
+	votingPower, err := keeper.getVoterPower(ctx, voterAddr, currValidators)
+	if err != nil {
+		return err
+	}
+	for _, proposalID := range proposalIDs {
+		keeper.SetVotingPowerSnapshot(ctx, v1.VotingPowerSnapshot{
+			ProposalId:  proposalID,
+			Address:     voterAddr.String(),
+			VotingPower: votingPower.String(),
+		})
+	}
+	return nil
+}
+
+// snapshotGovernorPowers snapshots the voting power delegated to each
+// governor on a proposal entering its voting period. The governors that have
+// no voting power delegated are not snapshotted.
+func (keeper Keeper) snapshotGovernorPowers(ctx sdk.Context, proposalID uint64) error {
+	currValidators, err := keeper.getBondedValidatorsByAddress(ctx)
+	if err != nil {
+		return err
+	}
+
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+		votingPower := math.LegacyZeroDec()
+		keeper.IterateGovernorValShares(ctx, governor.GetAddress(), func(valShares v1.GovernorValShares) bool {
+			if val, ok := currValidators[valShares.ValidatorAddress]; ok {
+				votingPower = votingPower.Add(valShares.Shares.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+			}
+			return false
+		})
+		if votingPower.IsPositive() {
+			keeper.SetGovernorPowerSnapshot(ctx, v1.VotingPowerSnapshot{
+				ProposalId:  proposalID,
+				Address:     governor.GovernorAddress,
+				VotingPower: votingPower.String(),
+			})
+		}
+		return false
+	})
+	return nil
+}
+
+// getVoterPower returns the voting power of the delegations of a voter to the
+// bonded validators.
+func (keeper Keeper) getVoterPower(ctx sdk.Context, voterAddr sdk.AccAddress, currValidators map[string]stakingtypes.ValidatorI) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+	err := keeper.sk.IterateDelegations(ctx, voterAddr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		if val, ok := currValidators[delegation.GetValidatorAddr()]; ok {
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+		}
+		return false
+	})
+	return votingPower, err
+}
+
+// capVoterPower returns the voting power a voter is tallied with on a
+// proposal whose voting power is snapshotted, the minimum of its snapshotted
+// and current voting power. A voter without snapshot keeps its current voting
+// power.
+func (keeper Keeper) capVoterPower(ctx sdk.Context, proposal v1.Proposal, voterAddr sdk.AccAddress, votingPower math.LegacyDec) math.LegacyDec {
+	if !proposal.VotingPowerSnapshot {
+		return votingPower
+	}
+	snapshot, found := keeper.GetVotingPowerSnapshot(ctx, proposal.Id, voterAddr)
+	if !found {
+		return votingPower
+	}
+	return math.LegacyMinDec(votingPower, math.LegacyMustNewDecFromStr(snapshot.VotingPower))
+}
+
+// capGovernorPower returns the voting power delegated to a governor it is
+// tallied with on a proposal whose voting power is snapshotted, the minimum
+// of its snapshotted and current voting power. A governor without snapshot
+// had no voting power delegated when the voting period started, and is
+// therefore tallied with no delegated voting power.
+func (keeper Keeper) capGovernorPower(ctx sdk.Context, proposal v1.Proposal, governorAddr sdk.AccAddress, votingPower math.LegacyDec) math.LegacyDec {
+	if !proposal.VotingPowerSnapshot {
+		return votingPower
+	}
+	snapshot, found := keeper.GetGovernorPowerSnapshot(ctx, proposal.Id, governorAddr)
+	if !found {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyMinDec(votingPower, math.LegacyMustNewDecFromStr(snapshot.VotingPower))
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+func TestTallyVotingPowerSnapshot(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	params := v1.DefaultParams()
+	params.SnapshotVotingPower = true
+	params.MinGovernorSelfDelegation = "1"
+	err := govKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+	var (
+		numVals       = 4
+		numDelegators = 6
+		addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+		valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+		delAddrs      = addrs[numVals:]
+	)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], "", false)
+	require.NoError(t, err)
+	s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+	// The voting power is also computed when the voting period starts and
+	// when voting, not only when tallying.
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+				for i, val := range s.validators {
+					fn(int64(i), val)
+				}
+				return nil
+			}).AnyTimes()
+
+	// Governor delAddrs[0] has 2000 of voting power when the voting period
+	// starts, 1000 of its own and 1000 delegated by delAddrs[1].
+	s.delegate(delAddrs[0], valAddrs[0], 1000)
+	s.governor(delAddrs[0], v1.GovernorStatusActive)
+	s.delegate(delAddrs[1], valAddrs[1], 1000)
+	s.delegateGovernor(delAddrs[1], delAddrs[0])
+
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, found := govKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, found)
+	require.True(t, proposal.VotingPowerSnapshot)
+	snapshot, found := govKeeper.GetGovernorPowerSnapshot(ctx, proposal.Id, delAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, "2000.000000000000000000", snapshot.VotingPower)
+
+	// delAddrs[4] delegates to the governor after the voting period started,
+	// which doesn't increase the voting power of the governor.
+	s.delegate(delAddrs[4], valAddrs[2], 5000)
+	s.delegateGovernor(delAddrs[4], delAddrs[0])
+	s.vote(delAddrs[0], v1.OptionYes)
+
+	// delAddrs[3] increases its stake after voting, which doesn't increase its
+	// voting power.
+	s.delegate(delAddrs[3], valAddrs[3], 1000)
+	s.vote(delAddrs[3], v1.OptionYes)
+	s.delegate(delAddrs[3], valAddrs[3], 5000)
+	snapshot, found = govKeeper.GetVotingPowerSnapshot(ctx, proposal.Id, delAddrs[3])
+	require.True(t, found)
+	assert.Equal(t, "1000.000000000000000000", snapshot.VotingPower)
+
+	// Governor delAddrs[2] had no voting power delegated when the voting
+	// period started, only its own voting power is counted.
+	s.delegate(delAddrs[2], valAddrs[2], 1000)
+	s.governor(delAddrs[2], v1.GovernorStatusActive)
+	s.delegate(delAddrs[5], valAddrs[2], 1000)
+	s.delegateGovernor(delAddrs[5], delAddrs[2])
+	s.vote(delAddrs[2], v1.OptionNo)
+
+	_, _, _, tally, err := govKeeper.Tally(ctx, proposal)
+
+	require.NoError(t, err)
+	assert.Equal(t, v1.TallyResult{
+		YesCount:     "4000",
+		AbstainCount: "0",
+		NoCount:      "1000",
+	}, tally)
+	assert.Empty(t, govKeeper.GetAllVotingPowerSnapshots(ctx), "voting power snapshots not removed after tally")
+	assert.Empty(t, govKeeper.GetAllGovernorPowerSnapshots(ctx), "governor power snapshots not removed after tally")
+}
+
+func TestTallyVotingPowerSnapshotStakeAddedBeforeVote(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	params := v1.DefaultParams()
+	params.SnapshotVotingPower = true
+	err := govKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+	var (
+		numVals       = 2
+		numDelegators = 2
+		addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+		valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+		delAddrs      = addrs[numVals:]
+	)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], "", false)
+	require.NoError(t, err)
+	s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+				for i, val := range s.validators {
+					fn(int64(i), val)
+				}
+				return nil
+			}).AnyTimes()
+	hooks := govKeeper.StakingHooks()
+
+	s.delegate(delAddrs[0], valAddrs[0], 1000)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, found := govKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, found)
+	require.True(t, proposal.VotingPowerSnapshot)
+
+	// delAddrs[0] increases its stake after the voting period started and
+	// before voting, the voting power it had when the voting period started
+	// being snapshotted by the staking hooks.
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddrs[0], valAddrs[0]))
+	s.delegate(delAddrs[0], valAddrs[0], 5000)
+	require.NoError(t, hooks.BeforeDelegationCreated(ctx, delAddrs[0], valAddrs[1]))
+	s.delegate(delAddrs[0], valAddrs[1], 5000)
+	s.vote(delAddrs[0], v1.OptionYes)
+	snapshot, found := govKeeper.GetVotingPowerSnapshot(ctx, proposal.Id, delAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, "1000.000000000000000000", snapshot.VotingPower)
+
+	// delAddrs[1] had no stake when the voting period started.
+	require.NoError(t, hooks.BeforeDelegationCreated(ctx, delAddrs[1], valAddrs[1]))
+	s.delegate(delAddrs[1], valAddrs[1], 3000)
+	s.vote(delAddrs[1], v1.OptionNo)
+
+	_, _, _, tally, err := govKeeper.Tally(ctx, proposal)
+
+	require.NoError(t, err)
+	assert.Equal(t, v1.TallyResult{
+		YesCount:     "1000",
+		AbstainCount: "0",
+		NoCount:      "0",
+	}, tally)
+	assert.Empty(t, govKeeper.GetAllVotingPowerSnapshots(ctx), "voting power snapshots not removed after tally")
+}
@@ -0,0 +1,62 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+var (
+	ParamsKey                     = []byte{0x30}
+	ConstitutionKey               = []byte{0x40}
+	ConstitutionVersionsKeyPrefix = []byte{0x89}
+)
+
+// Addition of the governor, vote archive and metadata hash parameters, and seeding of the
+// constitution history with the current constitution as its first version.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	paramsBz := store.Get(ParamsKey)
+
+	var params govv1.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	defaultParams := govv1.DefaultParams()
+	params.MinGovernorSelfDelegation = defaultParams.MinGovernorSelfDelegation
+	params.GovernorStatusChangePeriod = defaultParams.GovernorStatusChangePeriod
+	params.ArchiveVotes = defaultParams.ArchiveVotes
+	params.VoteArchiveRetentionPeriod = defaultParams.VoteArchiveRetentionPeriod
+	params.RequireMetadataHash = defaultParams.RequireMetadataHash
+	params.ExecutionDelay = defaultParams.ExecutionDelay
+	params.LawExecutionDelay = defaultParams.LawExecutionDelay
+	params.ConstitutionAmendmentExecutionDelay = defaultParams.ConstitutionAmendmentExecutionDelay
+	params.ProposalCancelRatio = defaultParams.ProposalCancelRatio
+	params.ProposalCancelDest = defaultParams.ProposalCancelDest
+	params.ExpeditedVotingPeriod = defaultParams.ExpeditedVotingPeriod
+	params.ExpeditedThreshold = defaultParams.ExpeditedThreshold
+	if params.MinDepositThrottler != nil {
+		params.MinDepositThrottler.ExpeditedMultiplier = defaultParams.MinDepositThrottler.ExpeditedMultiplier
+	}
+	params.SnapshotVotingPower = defaultParams.SnapshotVotingPower
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(ParamsKey, bz)
+
+	constitutionVersion := govv1.ConstitutionVersion{
+		Version:      1,
+		Height:       ctx.BlockHeight(),
+		Constitution: string(store.Get(ConstitutionKey)),
+	}
+	bz, err = cdc.Marshal(&constitutionVersion)
+	if err != nil {
+		return err
+	}
+	store.Set(append(ConstitutionVersionsKeyPrefix, sdk.Uint64ToBigEndian(1)...), bz)
+	return nil
+}
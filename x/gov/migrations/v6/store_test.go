@@ -0,0 +1,64 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/Hikari-Chain/hikari-chain/x/gov"
+	v6 "github.com/Hikari-Chain/hikari-chain/x/gov/migrations/v6"
+	govv1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	oldParams := govv1.DefaultParams()
+	oldParams.MinGovernorSelfDelegation = ""
+	oldParams.GovernorStatusChangePeriod = nil
+	oldParams.ArchiveVotes = false
+	oldParams.VoteArchiveRetentionPeriod = nil
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&oldParams))
+	store.Set(v6.ConstitutionKey, []byte("constitution"))
+
+	// Run migrations.
+	err := v6.MigrateStore(ctx, govKey, cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var params govv1.Params
+	bz := store.Get(v6.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.Equal(t, govv1.DefaultParams().MinGovernorSelfDelegation, params.MinGovernorSelfDelegation)
+	require.Equal(t, govv1.DefaultParams().GovernorStatusChangePeriod, params.GovernorStatusChangePeriod)
+	require.Equal(t, govv1.DefaultParams().ArchiveVotes, params.ArchiveVotes)
+	require.Equal(t, govv1.DefaultParams().VoteArchiveRetentionPeriod, params.VoteArchiveRetentionPeriod)
+	require.Equal(t, govv1.DefaultParams().RequireMetadataHash, params.RequireMetadataHash)
+	require.Equal(t, govv1.DefaultParams().ExecutionDelay, params.ExecutionDelay)
+	require.Equal(t, govv1.DefaultParams().LawExecutionDelay, params.LawExecutionDelay)
+	require.Equal(t, govv1.DefaultParams().ConstitutionAmendmentExecutionDelay, params.ConstitutionAmendmentExecutionDelay)
+	require.Equal(t, govv1.DefaultParams().ProposalCancelRatio, params.ProposalCancelRatio)
+	require.Equal(t, govv1.DefaultParams().ProposalCancelDest, params.ProposalCancelDest)
+	require.Equal(t, govv1.DefaultParams().ExpeditedVotingPeriod, params.ExpeditedVotingPeriod)
+	require.Equal(t, govv1.DefaultParams().ExpeditedThreshold, params.ExpeditedThreshold)
+	require.Equal(t, govv1.DefaultParams().MinDepositThrottler.ExpeditedMultiplier, params.MinDepositThrottler.ExpeditedMultiplier)
+	require.Equal(t, govv1.DefaultParams().SnapshotVotingPower, params.SnapshotVotingPower)
+	require.NoError(t, params.ValidateBasic())
+
+	// Check the constitution history
+	var constitutionVersion govv1.ConstitutionVersion
+	bz = store.Get(append(v6.ConstitutionVersionsKeyPrefix, sdk.Uint64ToBigEndian(1)...))
+	require.NoError(t, cdc.Unmarshal(bz, &constitutionVersion))
+	require.Equal(t, uint64(1), constitutionVersion.Version)
+	require.Equal(t, "constitution", constitutionVersion.Constitution)
+	require.Empty(t, constitutionVersion.Amendment)
+}
@@ -50,6 +50,16 @@ const (
 	MaxConstitutionAmendmentQuorum                          = "max_constitution_amendment_quorum"
 	MinLawQuorum                                            = "min_law_quorum"
 	MaxLawQuorum                                            = "max_law_quorum"
+	MinGovernorSelfDelegation                               = "min_governor_self_delegation"
+	GovernorStatusChangePeriod                              = "governor_status_change_period"
+	VoteArchiveRetentionPeriod                              = "vote_archive_retention_period"
+	ExecutionDelay                                          = "execution_delay"
+	LawExecutionDelay                                       = "law_execution_delay"
+	ConstitutionAmendmentExecutionDelay                     = "constitution_amendment_execution_delay"
+	ProposalCancelRatio                                     = "proposal_cancel_ratio"
+	ExpeditedVotingPeriod                                   = "expedited_voting_period"
+	ExpeditedThreshold                                      = "expedited_threshold"
+	ExpeditedMinDepositMultiplier                           = "expedited_min_deposit_multiplier"
 )
 
 // GenDepositParamsDepositPeriod returns randomized DepositParamsDepositPeriod
@@ -164,6 +174,51 @@ func GenBurnDepositNoThreshold(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 500, 950)), 3)
 }
 
+// GenMinGovernorSelfDelegation returns a randomized MinGovernorSelfDelegation
+func GenMinGovernorSelfDelegation(r *rand.Rand) math.Int {
+	return math.NewInt(int64(simulation.RandIntBetween(r, 1, 1e3)))
+}
+
+// GenGovernorStatusChangePeriod returns a randomized GovernorStatusChangePeriod
+func GenGovernorStatusChangePeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+}
+
+// GenVoteArchiveRetentionPeriod returns a randomized VoteArchiveRetentionPeriod
+func GenVoteArchiveRetentionPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 2*60*60*24*2)) * time.Second
+}
+
+// GenExecutionDelay returns a randomized ExecutionDelay, LawExecutionDelay or
+// ConstitutionAmendmentExecutionDelay
+func GenExecutionDelay(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24)) * time.Second
+}
+
+// GenProposalCancelRatio returns a randomized ProposalCancelRatio between 0 and 1
+func GenProposalCancelRatio(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
+// GenExpeditedVotingPeriod returns a randomized ExpeditedVotingPeriod
+// strictly less than votingPeriod.
+func GenExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod.Seconds()))) * time.Second
+}
+
+// GenExpeditedThreshold returns a randomized ExpeditedThreshold strictly
+// greater than minDec and less than or equal to 1.
+func GenExpeditedThreshold(r *rand.Rand, minDec math.LegacyDec) math.LegacyDec {
+	min := int(minDec.Mul(math.LegacyNewDec(1000)).RoundInt64())
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, min+1, 1001)), 3)
+}
+
+// GenExpeditedMinDepositMultiplier returns a randomized
+// ExpeditedMinDepositMultiplier between 1 and 10
+func GenExpeditedMinDepositMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 101)), 1)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -344,6 +399,36 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var maxLawQuorum math.LegacyDec
 	simState.AppParams.GetOrGenerate(MaxLawQuorum, &maxLawQuorum, simState.Rand, func(r *rand.Rand) { maxLawQuorum = GenMaxQuorum(r) })
 
+	var minGovernorSelfDelegation math.Int
+	simState.AppParams.GetOrGenerate(MinGovernorSelfDelegation, &minGovernorSelfDelegation, simState.Rand, func(r *rand.Rand) { minGovernorSelfDelegation = GenMinGovernorSelfDelegation(r) })
+
+	var governorStatusChangePeriod time.Duration
+	simState.AppParams.GetOrGenerate(GovernorStatusChangePeriod, &governorStatusChangePeriod, simState.Rand, func(r *rand.Rand) { governorStatusChangePeriod = GenGovernorStatusChangePeriod(r) })
+
+	var voteArchiveRetentionPeriod time.Duration
+	simState.AppParams.GetOrGenerate(VoteArchiveRetentionPeriod, &voteArchiveRetentionPeriod, simState.Rand, func(r *rand.Rand) { voteArchiveRetentionPeriod = GenVoteArchiveRetentionPeriod(r) })
+
+	var executionDelay time.Duration
+	simState.AppParams.GetOrGenerate(ExecutionDelay, &executionDelay, simState.Rand, func(r *rand.Rand) { executionDelay = GenExecutionDelay(r) })
+
+	var lawExecutionDelay time.Duration
+	simState.AppParams.GetOrGenerate(LawExecutionDelay, &lawExecutionDelay, simState.Rand, func(r *rand.Rand) { lawExecutionDelay = GenExecutionDelay(r) })
+
+	var constitutionAmendmentExecutionDelay time.Duration
+	simState.AppParams.GetOrGenerate(ConstitutionAmendmentExecutionDelay, &constitutionAmendmentExecutionDelay, simState.Rand, func(r *rand.Rand) { constitutionAmendmentExecutionDelay = GenExecutionDelay(r) })
+
+	var proposalCancelRatio math.LegacyDec
+	simState.AppParams.GetOrGenerate(ProposalCancelRatio, &proposalCancelRatio, simState.Rand, func(r *rand.Rand) { proposalCancelRatio = GenProposalCancelRatio(r) })
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(ExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand, func(r *rand.Rand) { expeditedVotingPeriod = GenExpeditedVotingPeriod(r, votingPeriod) })
+
+	var expeditedThreshold math.LegacyDec
+	simState.AppParams.GetOrGenerate(ExpeditedThreshold, &expeditedThreshold, simState.Rand, func(r *rand.Rand) { expeditedThreshold = GenExpeditedThreshold(r, threshold) })
+
+	var expeditedMinDepositMultiplier math.LegacyDec
+	simState.AppParams.GetOrGenerate(ExpeditedMinDepositMultiplier, &expeditedMinDepositMultiplier, simState.Rand, func(r *rand.Rand) { expeditedMinDepositMultiplier = GenExpeditedMinDepositMultiplier(r) })
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID, startingParticipationEma, startingParticipationEma, startingParticipationEma,
 		v1.NewParams(depositPeriod, votingPeriod, threshold.String(), amendmentsThreshold.String(), lawThreshold.String(),
@@ -356,6 +441,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 			burnDepositNoThreshold.String(), maxQuorum.String(), minQuorum.String(),
 			maxConstitutionAmendmentQuorum.String(), minConstitutionAmendmentQuorum.String(),
 			maxLawQuorum.String(), minQuorum.String(),
+			minGovernorSelfDelegation.String(), governorStatusChangePeriod,
+			simState.Rand.Intn(2) == 0, voteArchiveRetentionPeriod,
+			simState.Rand.Intn(2) == 0,
+			executionDelay, lawExecutionDelay, constitutionAmendmentExecutionDelay,
+			proposalCancelRatio.String(), v1.DefaultProposalCancelDestAddress,
+			expeditedVotingPeriod, expeditedThreshold.String(), expeditedMinDepositMultiplier.String(),
+			simState.Rand.Intn(2) == 0,
 		),
 	)
 
@@ -93,6 +93,7 @@ func TestRandomizedGenState(t *testing.T) {
 		DecreaseSensitivityTargetDistance: 3,
 		IncreaseRatio:                     "0.206000000000000000",
 		DecreaseRatio:                     "0.050000000000000000",
+		ExpeditedMultiplier:               "9.300000000000000000",
 	}, *govGenesis.Params.MinDepositThrottler)
 	require.Equal(t, v1.MinInitialDepositThrottler{
 		FloorValue:                        sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(201))),
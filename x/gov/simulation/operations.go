@@ -213,6 +213,7 @@ func simulateMsgSubmitProposal(ak types.AccountKeeper, bk types.BankKeeper, k *k
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to generate a submit proposal msg"), nil, err
 		}
+		msg.MetadataHash = types.ComputeMetadataHash([]byte(msg.Metadata))
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
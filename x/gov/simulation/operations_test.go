@@ -15,6 +15,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	_ "github.com/cosmos/cosmos-sdk/x/params"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -360,6 +361,7 @@ func createTestSuite(t *testing.T, isCheckTx bool) (suite, sdk.Context) {
 				configurator.ParamsModule(),
 				configurator.BankModule(),
 				configurator.StakingModule(),
+				configurator.DistributionModule(),
 				configurator.ConsensusModule(),
 				govtestutil.GovModule(),
 			),
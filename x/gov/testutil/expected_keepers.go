@@ -35,3 +35,8 @@ type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
 	TokensFromConsensusPower(ctx context.Context, power int64) math.Int
 }
+
+// DistributionKeeper extends gov's actual expected DistributionKeeper.
+type DistributionKeeper interface {
+	types.DistributionKeeper
+}
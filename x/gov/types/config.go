@@ -4,11 +4,40 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	// ArchiveVotes enables archiving the votes of a proposal when it is
+	// tallied at the end of its voting period, if the archive_votes param is
+	// also set.
+	ArchiveVotes bool
+
+	// ConstitutionAmendmentMaxOffset defines the maximum number of lines the
+	// hunks of a constitution amendment can be moved away from their position
+	// to find matching lines in the constitution.
+	ConstitutionAmendmentMaxOffset int
+
+	// ConstitutionAmendmentFuzz defines the maximum number of leading and
+	// trailing context lines of the hunks of a constitution amendment that can
+	// be ignored to find matching lines in the constitution.
+	ConstitutionAmendmentFuzz int
+
+	// ConstitutionAmendmentGasPerLine defines the gas charged for each line
+	// processed when applying or rebasing a constitution amendment.
+	ConstitutionAmendmentGasPerLine uint64
+
+	// ConstitutionAmendmentMaxRebasesPerBlock defines the maximum number of
+	// proposals in deposit period whose constitution amendments are rebased in
+	// a block when the constitution is amended. The amendments of the next
+	// proposals are flagged as conflicting instead.
+	ConstitutionAmendmentMaxRebasesPerBlock int
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:                          255,
+		ConstitutionAmendmentMaxOffset:          100,
+		ConstitutionAmendmentFuzz:               2,
+		ConstitutionAmendmentGasPerLine:         10,
+		ConstitutionAmendmentMaxRebasesPerBlock: 20,
 	}
 }
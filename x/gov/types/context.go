@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type proposalIDContextKey struct{}
+
+// WithProposalID returns a copy of ctx carrying the id of the proposal whose
+// messages are executed with it.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDContextKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns the id of the proposal whose messages are
+// executed with ctx, or false if ctx does not carry one.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDContextKey{}).(uint64)
+	return proposalID, ok
+}
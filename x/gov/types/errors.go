@@ -23,4 +23,21 @@ var (
 	ErrMinDepositTooSmall           = errors.Register(ModuleName, 160, "minimum deposit is too small")
 	ErrInvalidConstitutionAmendment = errors.Register(ModuleName, 170, "invalid constitution amendment")
 	ErrUnknownProposal              = errors.Register(ModuleName, 180, "unknown proposal")
+	ErrGovernorExists               = errors.Register(ModuleName, 190, "governor already exists")
+	ErrUnknownGovernor              = errors.Register(ModuleName, 200, "unknown governor")
+	ErrInactiveGovernor             = errors.Register(ModuleName, 210, "inactive governor")
+	ErrInvalidGovernorStatus        = errors.Register(ModuleName, 220, "invalid governor status")
+	ErrGovernorStatusChangePeriod   = errors.Register(ModuleName, 230, "governor status change period not elapsed")
+	ErrInsufficientGovernorStake    = errors.Register(ModuleName, 240, "insufficient governor self-delegation")
+	ErrGovernorSelfDelegation       = errors.Register(ModuleName, 250, "governor cannot change its own governance delegation")
+	ErrUnknownGovernanceDelegation  = errors.Register(ModuleName, 260, "unknown governance delegation")
+	ErrInvalidGovernorDescription   = errors.Register(ModuleName, 270, "invalid governor description")
+	ErrUnknownLaw                   = errors.Register(ModuleName, 280, "unknown law")
+	ErrLawNotInForce                = errors.Register(ModuleName, 290, "law not in force")
+	ErrUnknownConstitutionVersion   = errors.Register(ModuleName, 300, "unknown constitution version")
+	ErrInvalidMetadataHash          = errors.Register(ModuleName, 310, "invalid metadata hash")
+	ErrMissingMetadataHash          = errors.Register(ModuleName, 320, "missing metadata hash")
+	ErrInvalidMultipleChoiceOptions = errors.Register(ModuleName, 330, "invalid multiple-choice options")
+	ErrInvalidProposer              = errors.Register(ModuleName, 340, "invalid proposer")
+	ErrInvalidExpeditedProposal     = errors.Register(ModuleName, 350, "invalid expedited proposal")
 )
@@ -2,15 +2,26 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal          = "submit_proposal"
-	EventTypeProposalDeposit         = "proposal_deposit"
-	EventTypeProposalVote            = "proposal_vote"
-	EventTypeInactiveProposal        = "inactive_proposal"
-	EventTypeActiveProposal          = "active_proposal"
-	EventTypeSignalProposal          = "signal_proposal"
-	EventTypeQuorumCheck             = "quorum_check"
-	EventTypeMinDepositChange        = "min_deposit_change"
-	EventTypeMinInitialDepositChange = "min_initial_deposit_change"
+	EventTypeSubmitProposal                = "submit_proposal"
+	EventTypeProposalDeposit               = "proposal_deposit"
+	EventTypeProposalVote                  = "proposal_vote"
+	EventTypeInactiveProposal              = "inactive_proposal"
+	EventTypeActiveProposal                = "active_proposal"
+	EventTypeSignalProposal                = "signal_proposal"
+	EventTypeQuorumCheck                   = "quorum_check"
+	EventTypeMinDepositChange              = "min_deposit_change"
+	EventTypeMinInitialDepositChange       = "min_initial_deposit_change"
+	EventTypeCreateGovernor                = "create_governor"
+	EventTypeEditGovernor                  = "edit_governor"
+	EventTypeUpdateGovernorStatus          = "update_governor_status"
+	EventTypeDelegateGovernor              = "delegate_governor"
+	EventTypeUndelegateGovernor            = "undelegate_governor"
+	EventTypeEnactLaw                      = "enact_law"
+	EventTypeRepealLaw                     = "repeal_law"
+	EventTypeRebaseConstitutionAmendment   = "rebase_constitution_amendment"
+	EventTypeConstitutionAmendmentConflict = "constitution_amendment_conflict"
+	EventTypeExecuteProposal               = "execute_proposal"
+	EventTypeCancelProposal                = "cancel_proposal"
 
 	AttributeKeyVoter                        = "voter"
 	AttributeKeyProposalResult               = "proposal_result"
@@ -18,10 +29,12 @@ const (
 	AttributeKeyProposalID                   = "proposal_id"
 	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart            = "voting_period_start"
-	AttributeValueProposalDropped            = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed             = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected           = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed             = "proposal_failed"   // error on proposal handler
+	AttributeValueProposalDropped            = "proposal_dropped"            // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"             // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"           // didn't meet vote quorum
+	AttributeValueProposalFailed             = "proposal_failed"             // error on proposal handler
+	AttributeValueProposalPendingExecution   = "proposal_pending_execution"  // passed, execution delayed
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected" // didn't pass, converted to a regular proposal
 	AttributeKeyProposalType                 = "proposal_type"
 	AttributeSignalTitle                     = "signal_title"
 	AttributeSignalDescription               = "signal_description"
@@ -32,4 +45,12 @@ const (
 	AttributeKeyLastMinDeposit               = "last_min_deposit"              // last min deposit value
 	AttributeKeyNewMinInitialDeposit         = "new_min_initial_deposit"       // new min initial deposit value
 	AttributeKeyLastMinInitialDeposit        = "last_min_initial_deposit"      // last min initial deposit value
+	AttributeKeyGovernor                     = "governor"
+	AttributeKeyDelegator                    = "delegator"
+	AttributeKeyGovernorStatus               = "governor_status"
+	AttributeKeyLawID                        = "law_id"
+	AttributeKeyConflictReason               = "conflict_reason"
+	AttributeKeyExecutionTime                = "execution_time"
+	AttributeKeyProposer                     = "proposer"
+	AttributeKeyCancellationCharges          = "cancellation_charges"
 )
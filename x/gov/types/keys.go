@@ -33,11 +33,41 @@ const (
 //
 // - 0x04<proposalID_Bytes>: []byte{0x01} if proposalID is in the voting period
 //
+// - 0x0a<executionTime_Bytes><proposalID_Bytes>: pendingExecutionProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x80<governorAddrLen (1 Byte)><governorAddr_Bytes>: Governor
+//
+// - 0x81<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovernanceDelegation
+//
+// - 0x82<governorAddrLen (1 Byte)><governorAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{0x01}
+//
+// - 0x83<governorAddrLen (1 Byte)><governorAddr_Bytes><validatorAddrLen (1 Byte)><validatorAddr_Bytes>: GovernorValShares
+//
+// - 0x84<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: ArchivedVote
+//
+// - 0x85<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x86<archiveTime_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x87<lawID_Bytes>: Law
+//
+// - 0x88: nextLawID
+//
+// - 0x89<version_Bytes>: ConstitutionVersion
+//
+// - 0x8a<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VotingPowerSnapshot
+//
+// - 0x8b<proposalID_Bytes><governorAddrLen (1 Byte)><governorAddr_Bytes>: VotingPowerSnapshot
+//
+// - 0x8c: blockHeight_Bytes + number of constitution amendments rebased in the block
+//
+// - 0x8d<proposalID_Bytes>: []byte{0x01} if the voting power of proposalID in voting period is snapshotted
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -49,6 +79,7 @@ var (
 	LastMinDepositKey             = []byte{0x07}
 	InactiveProposalsNumberKey    = []byte{0x08}
 	LastMinInitialDepositKey      = []byte{0x09}
+	ExecutionQueuePrefix          = []byte{0x0a}
 
 	DepositsKeyPrefix = []byte{0x10}
 
@@ -64,6 +95,27 @@ var (
 	KeyParticipationEMA                      = []byte{0x50}
 	KeyConstitutionAmendmentParticipationEMA = []byte{0x60}
 	KeyLawParticipationEMA                   = []byte{0x70}
+
+	GovernorsKeyPrefix                       = []byte{0x80}
+	GovernanceDelegationsKeyPrefix           = []byte{0x81}
+	GovernanceDelegationsByGovernorKeyPrefix = []byte{0x82}
+	GovernorValSharesKeyPrefix               = []byte{0x83}
+
+	ArchivedVotesKeyPrefix        = []byte{0x84}
+	ArchivedVotesByVoterKeyPrefix = []byte{0x85}
+	ArchivedVotesQueuePrefix      = []byte{0x86}
+
+	LawsKeyPrefix = []byte{0x87}
+	LawIDKey      = []byte{0x88}
+
+	ConstitutionVersionsKeyPrefix = []byte{0x89}
+
+	VotingPowerSnapshotsKeyPrefix   = []byte{0x8a}
+	GovernorPowerSnapshotsKeyPrefix = []byte{0x8b}
+
+	ConstitutionAmendmentRebasesKey = []byte{0x8c}
+
+	VotingPowerSnapshotProposalKeyPrefix = []byte{0x8d}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -120,6 +172,16 @@ func QuorumCheckQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(QuorumCheckByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// ExecutionQueueByTimeKey gets the execution queue key by executionTime
+func ExecutionQueueByTimeKey(executionTime time.Time) []byte {
+	return append(ExecutionQueuePrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// ExecutionQueueKey returns the key for a proposalID in the executionQueue
+func ExecutionQueueKey(proposalID uint64, executionTime time.Time) []byte {
+	return append(ExecutionQueueByTimeKey(executionTime), GetProposalIDBytes(proposalID)...)
+}
+
 // GetActiveProposalsNumberBytes returns the byte representation of the activeProposalsNumber
 func GetActiveProposalsNumberBytes(activeProposalsNumber uint64) (activeProposalsNumberBz []byte) {
 	activeProposalsNumberBz = make([]byte, 8)
@@ -164,6 +226,129 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// GovernorKey gets the key of a specific governor from the store
+func GovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernorsKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationKey gets the key of the governance delegation of a
+// delegator from the store
+func GovernanceDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernanceDelegationsByGovernorKey gets the first part of the governance
+// delegations index key based on the governor address
+func GovernanceDelegationsByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationByGovernorKey gets the governance delegations index key
+// of a specific delegator of a governor
+func GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKey(governorAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernorValSharesByGovernorKey gets the first part of the governor
+// validator shares key based on the governor address
+func GovernorValSharesByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernorValSharesKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernorValSharesKey gets the key of the shares of a specific validator
+// delegated by the governance delegators of a governor
+func GovernorValSharesKey(governorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) []byte {
+	return append(GovernorValSharesByGovernorKey(governorAddr), address.MustLengthPrefix(validatorAddr.Bytes())...)
+}
+
+// ArchivedVotesKey gets the first part of the archived votes key based on the
+// proposalID
+func ArchivedVotesKey(proposalID uint64) []byte {
+	return append(ArchivedVotesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ArchivedVoteKey key of a specific archived vote from the store
+func ArchivedVoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(ArchivedVotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// ArchivedVotesByVoterKey gets the first part of the archived votes index key
+// based on the voter address
+func ArchivedVotesByVoterKey(voterAddr sdk.AccAddress) []byte {
+	return append(ArchivedVotesByVoterKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// ArchivedVoteByVoterKey gets the archived votes index key of a specific
+// proposal of a voter
+func ArchivedVoteByVoterKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(ArchivedVotesByVoterKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// ArchivedVotesByTimeKey gets the archived votes queue key by archiveTime
+func ArchivedVotesByTimeKey(archiveTime time.Time) []byte {
+	return append(ArchivedVotesQueuePrefix, sdk.FormatTimeBytes(archiveTime)...)
+}
+
+// ArchivedVotesQueueKey returns the key for a proposalID in the archived votes
+// queue
+func ArchivedVotesQueueKey(proposalID uint64, archiveTime time.Time) []byte {
+	return append(ArchivedVotesByTimeKey(archiveTime), GetProposalIDBytes(proposalID)...)
+}
+
+// GetLawIDBytes returns the byte representation of the lawID
+func GetLawIDBytes(lawID uint64) (lawIDBz []byte) {
+	lawIDBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(lawIDBz, lawID)
+	return
+}
+
+// GetLawIDFromBytes returns lawID in uint64 format from a byte array
+func GetLawIDFromBytes(bz []byte) (lawID uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// LawKey gets a specific law from the store
+func LawKey(lawID uint64) []byte {
+	return append(LawsKeyPrefix, GetLawIDBytes(lawID)...)
+}
+
+// ConstitutionVersionKey gets a specific constitution version from the store
+func ConstitutionVersionKey(version uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return append(ConstitutionVersionsKeyPrefix, bz...)
+}
+
+// VotingPowerSnapshotsKey gets the first part of the voting power snapshots
+// key based on the proposalID
+func VotingPowerSnapshotsKey(proposalID uint64) []byte {
+	return append(VotingPowerSnapshotsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VotingPowerSnapshotKey key of the voting power snapshot of a specific voter
+// from the store
+func VotingPowerSnapshotKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VotingPowerSnapshotsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// GovernorPowerSnapshotsKey gets the first part of the governor power
+// snapshots key based on the proposalID
+func GovernorPowerSnapshotsKey(proposalID uint64) []byte {
+	return append(GovernorPowerSnapshotsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// GovernorPowerSnapshotKey key of the voting power snapshot of a specific
+// governor from the store
+func GovernorPowerSnapshotKey(proposalID uint64, governorAddr sdk.AccAddress) []byte {
+	return append(GovernorPowerSnapshotsKey(proposalID), address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// VotingPowerSnapshotProposalKey gets if the voting power of a proposal in
+// voting period is snapshotted.
+func VotingPowerSnapshotProposalKey(proposalID uint64) []byte {
+	return append(VotingPowerSnapshotProposalKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -188,6 +373,18 @@ func SplitQuorumQueueKey(key []byte) (proposalID uint64, endTime time.Time) {
 	return splitKeyWithTime(key)
 }
 
+// SplitExecutionQueueKey split the execution queue key and returns the
+// proposal id and executionTime
+func SplitExecutionQueueKey(key []byte) (proposalID uint64, executionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitArchivedVotesQueueKey split the archived votes queue key and returns
+// the proposal id and archiveTime
+func SplitArchivedVotesQueueKey(key []byte) (proposalID uint64, archiveTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -198,6 +395,36 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitGovernanceDelegationByGovernorKey split the governance delegations
+// index key and returns the governor and delegator addresses
+func SplitGovernanceDelegationByGovernorKey(key []byte) (governorAddr, delegatorAddr sdk.AccAddress) {
+	// <prefix (1 Byte)><governorAddrLen (1 Byte)><governorAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	governorAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+governorAddrLen)
+	governorAddr = sdk.AccAddress(key[2 : 2+governorAddrLen])
+	delegatorAddr = sdk.AccAddress(key[3+governorAddrLen:])
+	return
+}
+
+// SplitKeyArchivedVote split the archived votes key and returns the proposal
+// id and voter address
+func SplitKeyArchivedVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
+	return splitKeyWithAddress(key)
+}
+
+// SplitArchivedVoteByVoterKey split the archived votes index key and returns
+// the voter address and proposal id
+func SplitArchivedVoteByVoterKey(key []byte) (voterAddr sdk.AccAddress, proposalID uint64) {
+	// <prefix (1 Byte)><voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID (8 bytes)>
+	kv.AssertKeyAtLeastLength(key, 2)
+	voterAddrLen := int(key[1])
+	kv.AssertKeyLength(key[2:], voterAddrLen+8)
+	voterAddr = sdk.AccAddress(key[2 : 2+voterAddrLen])
+	proposalID = GetProposalIDFromBytes(key[2+voterAddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
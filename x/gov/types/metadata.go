@@ -1,5 +1,11 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
 // ProposalMetadata is the metadata of a proposal
 // This metadata is supposed to live off-chain when submitted in a proposal
 type ProposalMetadata struct {
@@ -10,3 +16,25 @@ type ProposalMetadata struct {
 	ProposalForumUrl  string   `json:"proposal_forum_url"` //nolint:revive // named 'Url' instead of 'URL' for avoiding the camel case split
 	VoteOptionContext string   `json:"vote_option_context"`
 }
+
+// MetadataHashLen is the length of a hex-encoded SHA-256 metadata hash.
+const MetadataHashLen = 2 * sha256.Size
+
+// ComputeMetadataHash returns the hex-encoded SHA-256 hash of the off-chain
+// metadata, as found in the file or document the metadata field points to.
+func ComputeMetadataHash(metadata []byte) string {
+	hash := sha256.Sum256(metadata)
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateMetadataHash returns an error if the metadata hash is not a
+// lowercase hex-encoded SHA-256 hash.
+func ValidateMetadataHash(metadataHash string) error {
+	if len(metadataHash) != MetadataHashLen {
+		return ErrInvalidMetadataHash.Wrapf("expected %d hex characters, got %d", MetadataHashLen, len(metadataHash))
+	}
+	if _, err := hex.DecodeString(metadataHash); err != nil || strings.ToLower(metadataHash) != metadataHash {
+		return ErrInvalidMetadataHash.Wrapf("%s is not a lowercase hex string", metadataHash)
+	}
+	return nil
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+
+	storetypes "cosmossdk.io/store/types"
 )
 
 // Hunk represents a single change hunk in a unified diff.
@@ -197,76 +200,169 @@ func validateHunk(hunk *Hunk) error {
 	return nil
 }
 
-// applyHunks applies the parsed hunks to the source lines.
-func applyHunks(srcStr string, hunks []Hunk) ([]string, error) {
+// PatchConfig configures how the hunks of a unified diff are matched against
+// the source they are applied to. The zero value requires every hunk to match
+// the source exactly at the position given by its header.
+type PatchConfig struct {
+	// MaxOffset is the maximum number of lines a hunk can be moved away from
+	// the position given by its header, after accounting for the offset of the
+	// previous hunk, to find matching lines in the source.
+	MaxOffset int
+
+	// Fuzz is the maximum number of leading and trailing context lines of a
+	// hunk that can be ignored to find matching lines in the source, as in
+	// GNU patch.
+	Fuzz int
+
+	// GasMeter, if set, is charged GasPerLine for each line compared, copied
+	// or diffed, so that applying and merging diffs is safe in state machine
+	// code.
+	GasMeter storetypes.GasMeter
+
+	// GasPerLine is the gas charged for each line processed.
+	GasPerLine uint64
+}
+
+// consumeGas charges the gas of the given number of lines to the gas meter of
+// the config, if any.
+func (config PatchConfig) consumeGas(lines int) {
+	if config.GasMeter != nil && lines > 0 {
+		config.GasMeter.ConsumeGas(config.GasPerLine*uint64(lines), "unified diff")
+	}
+}
+
+// applyHunks applies the parsed hunks to the source lines. Each hunk is
+// searched for around the position given by its header, shifted by the offset
+// the previous hunk was found at, first with all its context lines and then
+// ignoring up to config.Fuzz leading and trailing context lines.
+func applyHunks(srcStr string, hunks []Hunk, config PatchConfig) ([]string, error) {
 	srcLines := strings.Split(srcStr, "\n")
-	result := make([]string, 0)
+	result := make([]string, 0, len(srcLines))
 	srcIndex := 0
+	offset := 0
 
-	for _, hunk := range hunks {
+	for i, hunk := range hunks {
+		// A zero-length source range at line 0, e.g. "@@ -0,0 +1 @@", inserts
+		// its lines at the start of the source.
+		if hunk.SrcSpan == 0 && hunk.SrcLine < 0 {
+			hunk.SrcLine = 0
+		}
 		// Validate hunk.SrcLine is within bounds
-		if hunk.SrcLine > len(srcLines) {
+		if hunk.SrcLine > len(srcLines)+config.MaxOffset {
 			return nil, fmt.Errorf("hunk starts at line %d but source only has %d lines", hunk.SrcLine+1, len(srcLines))
 		}
 
-		// Add unchanged lines before the hunk
-		for srcIndex < hunk.SrcLine {
-			if srcIndex >= len(srcLines) {
-				return nil, fmt.Errorf("source index %d exceeds source length %d", srcIndex, len(srcLines))
-			}
-			result = append(result, srcLines[srcIndex])
-			srcIndex++
+		start, lines, found := locateHunk(srcLines, srcIndex, hunk, offset, config)
+		if !found {
+			return nil, fmt.Errorf("hunk %d does not match the source at line %d", i+1, hunk.SrcLine+offset+1)
 		}
+		offset = start - hunk.SrcLine
 
-		// Apply hunk lines
-		for _, line := range hunk.Lines {
-			if len(line) == 0 {
-				continue
-			}
-			prefix := line[0]
-			content := line[1:]
-
-			switch prefix {
+		// Add unchanged lines before the hunk
+		config.consumeGas(start - srcIndex)
+		result = append(result, srcLines[srcIndex:start]...)
+		srcIndex = start
+
+		// Apply hunk lines, the context and deletion lines matching the
+		// source as checked by locateHunk
+		config.consumeGas(len(lines))
+		for _, line := range lines {
+			switch line[0] {
 			case ' ':
-				// Context line, should match source
-				if srcIndex >= len(srcLines) {
-					return nil, fmt.Errorf("context line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", srcIndex, len(srcLines))
-				}
-				if srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("context line mismatch at line %d", srcIndex+1)
-				}
-				result = append(result, content)
+				result = append(result, srcLines[srcIndex])
 				srcIndex++
 			case '-':
-				// Deletion, skip source line
-				if srcIndex >= len(srcLines) {
-					return nil, fmt.Errorf("deletion line at hunk position exceeds source length (srcIndex: %d, srcLines: %d)", srcIndex, len(srcLines))
-				}
-				if srcLines[srcIndex] != content {
-					return nil, fmt.Errorf("deletion line mismatch at line %d", srcIndex+1)
-				}
 				srcIndex++
 			case '+':
-				// Insertion, add to result
-				result = append(result, content)
-			default:
-				return nil, fmt.Errorf("invalid diff line: %s", line)
+				result = append(result, line[1:])
 			}
 		}
 	}
 
 	// Add any remaining lines
-	for srcIndex < len(srcLines) {
-		result = append(result, srcLines[srcIndex])
-		srcIndex++
-	}
+	config.consumeGas(len(srcLines) - srcIndex)
+	result = append(result, srcLines[srcIndex:]...)
 
 	return result, nil
 }
 
+// locateHunk searches the source lines, from minStart onwards, for the lines
+// the hunk applies to. It returns the index of the first of these lines and
+// the lines of the hunk to apply there, without the context lines ignored to
+// find the match. The search tries the offsets closest to the expected position
+// first, preceding lines before following lines, and increases the fuzz only
+// if no offset matches, so that the outcome is deterministic.
+func locateHunk(srcLines []string, minStart int, hunk Hunk, offset int, config PatchConfig) (start int, lines []string, found bool) {
+	for _, line := range hunk.Lines {
+		// Empty lines do not contribute to the hunk
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	leading := 0
+	for leading < len(lines) && lines[leading][0] == ' ' {
+		leading++
+	}
+	trailing := 0
+	for trailing < len(lines)-leading && lines[len(lines)-1-trailing][0] == ' ' {
+		trailing++
+	}
+
+	for fuzz := 0; fuzz <= config.Fuzz; fuzz++ {
+		trimLeading, trimTrailing := min(fuzz, leading), min(fuzz, trailing)
+		if fuzz > 0 && trimLeading < fuzz && trimTrailing < fuzz {
+			// no more context lines to ignore
+			break
+		}
+		fuzzed := lines[trimLeading : len(lines)-trimTrailing]
+		expected := hunk.SrcLine + offset + trimLeading
+
+		for delta := 0; delta <= config.MaxOffset; delta++ {
+			for _, start := range []int{expected - delta, expected + delta} {
+				if start < minStart || start > len(srcLines) {
+					continue
+				}
+				if matchHunk(srcLines[start:], fuzzed, config) {
+					return start, fuzzed, true
+				}
+				if delta == 0 {
+					break
+				}
+			}
+		}
+	}
+
+	return 0, nil, false
+}
+
+// matchHunk returns whether the context and deletion lines of the hunk lines
+// match the source lines.
+func matchHunk(srcLines, lines []string, config PatchConfig) bool {
+	srcIndex := 0
+	for _, line := range lines {
+		if line[0] == '+' {
+			continue
+		}
+		config.consumeGas(1)
+		if srcIndex >= len(srcLines) || srcLines[srcIndex] != line[1:] {
+			return false
+		}
+		srcIndex++
+	}
+	return true
+}
+
 // ApplyUnifiedDiff applies a unified diff patch to the src string and returns the result.
+// Every hunk must match the source exactly at the position given by its header.
 // Does not make use of any external libraries to ensure deterministic behavior.
 func ApplyUnifiedDiff(src, diffStr string) (string, error) {
+	return ApplyUnifiedDiffWithConfig(src, diffStr, PatchConfig{})
+}
+
+// ApplyUnifiedDiffWithConfig applies a unified diff patch to the src string and
+// returns the result, matching the hunks against the source as set by config.
+func ApplyUnifiedDiffWithConfig(src, diffStr string, config PatchConfig) (string, error) {
 	// Parse the unified diff into hunks
 	hunks, err := ParseUnifiedDiff(diffStr)
 	if err != nil {
@@ -274,10 +370,381 @@ func ApplyUnifiedDiff(src, diffStr string) (string, error) {
 	}
 
 	// Apply the hunks to the source lines
-	resultLines, err := applyHunks(src, hunks)
+	resultLines, err := applyHunks(src, hunks, config)
 	if err != nil {
 		return "", err
 	}
 
 	return strings.Join(resultLines, "\n"), nil
 }
+
+// diffContextLines is the number of unchanged lines around the changes
+// included in the hunks of a generated unified diff.
+const diffContextLines = 3
+
+// lineEdit is an edit of a line-based diff: a line kept (' '), deleted ('-')
+// or inserted ('+').
+type lineEdit struct {
+	op   byte
+	line string
+}
+
+// diffLines returns the shortest edit script turning the src lines into the
+// dst lines, computed with the Myers diff algorithm. Its cost grows with the
+// number of lines times the number of edits, so the gas of each round of the
+// algorithm, i.e. of each edit, is charged to config as it goes: the lines
+// compared and the diagonals visited and recorded.
+func diffLines(src, dst []string, config PatchConfig) []lineEdit {
+	n, m := len(src), len(dst)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+
+	// trace records, before each round d, the diagonals -d-1 to d+1 of v,
+	// the only ones needed to backtrack the edit script.
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		done := false
+		compared := 0
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && src[x] == dst[y] {
+				x++
+				y++
+				compared++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+		config.consumeGas(2*d + 3 + compared)
+		if done {
+			break
+		}
+	}
+
+	var edits []lineEdit
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		// the diagonal k of v is at index k+d+1 of the recorded window
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v[d+k] < v[d+k+2]) {
+			prevK = k + 1
+		}
+		prevX := v[d+1+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			edits = append(edits, lineEdit{op: ' ', line: src[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, lineEdit{op: '+', line: dst[y-1]})
+			} else {
+				edits = append(edits, lineEdit{op: '-', line: src[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	// the edits were collected from the end
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// ComputeUnifiedDiff returns the unified diff turning src into dst, which
+// can be applied to src with ApplyUnifiedDiff. It returns an empty string if
+// src and dst are identical. Unlike the client side diff generation, it does
+// not make use of any external library to ensure deterministic behavior.
+func ComputeUnifiedDiff(src, dst string) string {
+	return computeUnifiedDiff(src, dst, PatchConfig{})
+}
+
+// computeUnifiedDiff is ComputeUnifiedDiff, charging the gas of the diff to
+// config.
+func computeUnifiedDiff(src, dst string, config PatchConfig) string {
+	edits := diffLines(strings.Split(src, "\n"), strings.Split(dst, "\n"), config)
+
+	// srcPos and dstPos are the 0-based src and dst line numbers of each edit
+	srcPos := make([]int, len(edits)+1)
+	dstPos := make([]int, len(edits)+1)
+	for i, edit := range edits {
+		srcPos[i+1], dstPos[i+1] = srcPos[i], dstPos[i]
+		if edit.op != '+' {
+			srcPos[i+1]++
+		}
+		if edit.op != '-' {
+			dstPos[i+1]++
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			i++
+			continue
+		}
+
+		// the hunk starts with the context preceding the change, and extends
+		// over the following changes separated by less than twice the context.
+		start := max(i-diffContextLines, 0)
+		end := i
+		for {
+			for end < len(edits) && edits[end].op != ' ' {
+				end++
+			}
+			next := end
+			for next < len(edits) && edits[next].op == ' ' {
+				next++
+			}
+			if next < len(edits) && next-end <= 2*diffContextLines {
+				end = next
+				continue
+			}
+			end = min(end+diffContextLines, next)
+			break
+		}
+
+		if sb.Len() == 0 {
+			sb.WriteString("--- src\n+++ dst\n")
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n",
+			srcPos[start]+1, srcPos[end]-srcPos[start],
+			dstPos[start]+1, dstPos[end]-dstPos[start],
+		)
+		for _, edit := range edits[start:end] {
+			sb.WriteByte(edit.op)
+			sb.WriteString(edit.line)
+			sb.WriteByte('\n')
+		}
+		i = end
+	}
+
+	return sb.String()
+}
+
+// PropagateLineOrigins returns the origins of the lines of dst, given the
+// origins of the lines of src: the lines kept from src keep their origin and
+// the lines inserted in dst get the given origin. The lines are the strings
+// separated by newlines, as in ApplyUnifiedDiff.
+func PropagateLineOrigins(src, dst string, srcOrigins []uint64, origin uint64) []uint64 {
+	edits := diffLines(strings.Split(src, "\n"), strings.Split(dst, "\n"), PatchConfig{})
+
+	dstOrigins := make([]uint64, 0, len(edits))
+	srcIndex := 0
+	for _, edit := range edits {
+		switch edit.op {
+		case ' ':
+			if srcIndex < len(srcOrigins) {
+				dstOrigins = append(dstOrigins, srcOrigins[srcIndex])
+			} else {
+				dstOrigins = append(dstOrigins, origin)
+			}
+			srcIndex++
+		case '-':
+			srcIndex++
+		case '+':
+			dstOrigins = append(dstOrigins, origin)
+		}
+	}
+	return dstOrigins
+}
+
+// MergeConflict is a region of the base of a three-way merge that both sides
+// changed, differently.
+type MergeConflict struct {
+	// BaseLine is the 0-based index of the first line of the region in base.
+	BaseLine int
+	// Base holds the lines of the region in base.
+	Base []string
+	// Ours holds the lines of the region as changed by the first side.
+	Ours []string
+	// Theirs holds the lines of the region as changed by the second side.
+	Theirs []string
+}
+
+// MergeUnifiedDiffs merges the unified diffs ours and theirs, both written
+// against base, into a single unified diff against base applying the changes
+// of both. The diffs are applied as set by config. If both diffs change the
+// same region of base, or adjacent regions, differently, the diffs cannot be
+// merged and the conflicting regions are returned instead. The merged diff is
+// empty if applying it would leave base unchanged.
+func MergeUnifiedDiffs(base, ours, theirs string, config PatchConfig) (merged string, conflicts []MergeConflict, err error) {
+	oursResult, err := ApplyUnifiedDiffWithConfig(base, ours, config)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid first diff: %v", err)
+	}
+	theirsResult, err := ApplyUnifiedDiffWithConfig(base, theirs, config)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid second diff: %v", err)
+	}
+
+	mergedLines, conflicts := mergeLines(
+		strings.Split(base, "\n"), strings.Split(oursResult, "\n"), strings.Split(theirsResult, "\n"), config,
+	)
+	if len(conflicts) > 0 {
+		return "", conflicts, nil
+	}
+
+	return computeUnifiedDiff(base, strings.Join(mergedLines, "\n"), config), nil, nil
+}
+
+// lineChange is a change of a region of the lines of a base: the lines from
+// start to end (excluded) are replaced by the given lines.
+type lineChange struct {
+	start int
+	end   int
+	lines []string
+}
+
+// lineChanges returns the changes turning the src lines into the dst lines,
+// in ascending order.
+func lineChanges(src, dst []string, config PatchConfig) []lineChange {
+	config.consumeGas(len(src) + len(dst))
+
+	var (
+		changes  []lineChange
+		change   *lineChange
+		srcIndex int
+	)
+	for _, edit := range diffLines(src, dst, config) {
+		if edit.op == ' ' {
+			if change != nil {
+				change.end = srcIndex
+				changes = append(changes, *change)
+				change = nil
+			}
+			srcIndex++
+			continue
+		}
+		if change == nil {
+			change = &lineChange{start: srcIndex}
+		}
+		if edit.op == '-' {
+			srcIndex++
+		} else {
+			change.lines = append(change.lines, edit.line)
+		}
+	}
+	if change != nil {
+		change.end = srcIndex
+		changes = append(changes, *change)
+	}
+	return changes
+}
+
+// mergeLines performs a three-way merge of the ours and theirs revisions of
+// the base lines. The changes of both sides are grouped into blocks of base
+// where changes overlap or touch each other. A block changed by one side only
+// takes that change, and a block changed by both sides must be changed
+// identically, otherwise it is reported as a conflict.
+func mergeLines(base, ours, theirs []string, config PatchConfig) (merged []string, conflicts []MergeConflict) {
+	oursChanges := lineChanges(base, ours, config)
+	theirsChanges := lineChanges(base, theirs, config)
+
+	baseIndex := 0
+	for len(oursChanges) > 0 || len(theirsChanges) > 0 {
+		// start the block with the first change of either side, and extend it
+		// with the changes starting within or right after it
+		var blockOurs, blockTheirs []lineChange
+		var start, end int
+		if len(theirsChanges) == 0 || (len(oursChanges) > 0 && oursChanges[0].start <= theirsChanges[0].start) {
+			start, end = oursChanges[0].start, oursChanges[0].end
+		} else {
+			start, end = theirsChanges[0].start, theirsChanges[0].end
+		}
+		for extended := true; extended; {
+			extended = false
+			if len(oursChanges) > 0 && oursChanges[0].start <= end {
+				end = max(end, oursChanges[0].end)
+				blockOurs = append(blockOurs, oursChanges[0])
+				oursChanges = oursChanges[1:]
+				extended = true
+			}
+			if len(theirsChanges) > 0 && theirsChanges[0].start <= end {
+				end = max(end, theirsChanges[0].end)
+				blockTheirs = append(blockTheirs, theirsChanges[0])
+				theirsChanges = theirsChanges[1:]
+				extended = true
+			}
+		}
+
+		config.consumeGas(start - baseIndex + end - start)
+		merged = append(merged, base[baseIndex:start]...)
+		baseIndex = end
+
+		oursBlock := applyLineChanges(base, start, end, blockOurs)
+		theirsBlock := applyLineChanges(base, start, end, blockTheirs)
+		switch {
+		case len(blockTheirs) == 0:
+			merged = append(merged, oursBlock...)
+		case len(blockOurs) == 0 || slices.Equal(oursBlock, theirsBlock):
+			merged = append(merged, theirsBlock...)
+		default:
+			conflicts = append(conflicts, MergeConflict{
+				BaseLine: start,
+				Base:     base[start:end],
+				Ours:     oursBlock,
+				Theirs:   theirsBlock,
+			})
+		}
+	}
+
+	config.consumeGas(len(base) - baseIndex)
+	merged = append(merged, base[baseIndex:]...)
+
+	return merged, conflicts
+}
+
+// applyLineChanges returns the base lines from start to end (excluded) with
+// the given changes, all within that region, applied.
+func applyLineChanges(base []string, start, end int, changes []lineChange) []string {
+	result := []string{}
+	index := start
+	for _, change := range changes {
+		result = append(result, base[index:change.start]...)
+		result = append(result, change.lines...)
+		index = change.end
+	}
+	return append(result, base[index:end]...)
+}
+
+// RebaseUnifiedDiff rebases the unified diff diffStr, written against base,
+// onto updated, a later revision of base, by merging the changes of diffStr
+// with the changes from base to updated. The diff is applied as set by
+// config. If diffStr changes regions of base changed in updated, or adjacent
+// to them, differently, the diff cannot be rebased and an error is returned.
+// The returned diff applies to updated with ApplyUnifiedDiff.
+func RebaseUnifiedDiff(base, updated, diffStr string, config PatchConfig) (string, error) {
+	result, err := ApplyUnifiedDiffWithConfig(base, diffStr, config)
+	if err != nil {
+		return "", err
+	}
+
+	updatedLines := strings.Split(updated, "\n")
+	mergedLines, conflicts := mergeLines(
+		strings.Split(base, "\n"), updatedLines, strings.Split(result, "\n"), config,
+	)
+	if len(conflicts) > 0 {
+		return "", fmt.Errorf("conflicting changes at line %d", conflicts[0].BaseLine+1)
+	}
+
+	rebased := computeUnifiedDiff(updated, strings.Join(mergedLines, "\n"), config)
+	if rebased == "" {
+		return "", fmt.Errorf("changes already applied")
+	}
+	return rebased, nil
+}
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
 )
 
 func TestApplyUnifiedDiff(t *testing.T) {
@@ -196,6 +200,25 @@ func TestApplyUnifiedDiff(t *testing.T) {
 `,
 			wantErr: true,
 		},
+		{
+			name: "Insertion into empty source",
+			src:  "",
+			diffStr: `@@ -0,0 +1,2 @@
++Line one
++Line two
+`,
+			expected: "Line one\nLine two\n",
+			wantErr:  false,
+		},
+		{
+			name: "Insertion at start without context",
+			src:  "Line two\nLine three",
+			diffStr: `@@ -0,0 +1 @@
++Line one
+`,
+			expected: "Line one\nLine two\nLine three",
+			wantErr:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -411,3 +434,358 @@ func TestParseHunkHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		dst      string
+		expected string
+	}{
+		{
+			name:     "Identical",
+			src:      "Line one\nLine two",
+			dst:      "Line one\nLine two",
+			expected: "",
+		},
+		{
+			name: "Addition",
+			src:  "Line one\nLine two",
+			dst:  "Line one\nLine two\nLine three",
+			expected: `--- src
++++ dst
+@@ -1,2 +1,3 @@
+ Line one
+ Line two
++Line three
+`,
+		},
+		{
+			name: "Modification",
+			src:  "Line one\nLine two\nLine three",
+			dst:  "Line one\nLine two modified\nLine three",
+			expected: `--- src
++++ dst
+@@ -1,3 +1,3 @@
+ Line one
+-Line two
++Line two modified
+ Line three
+`,
+		},
+		{
+			name: "Distant changes in separate hunks",
+			src:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n10",
+			dst:  "one\n2\n3\n4\n5\n6\n7\n8\n9\nten",
+			expected: `--- src
++++ dst
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -7,4 +7,4 @@
+ 7
+ 8
+ 9
+-10
++ten
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := ComputeUnifiedDiff(tt.src, tt.dst)
+			require.Equal(t, tt.expected, diff)
+			if diff != "" {
+				result, err := ApplyUnifiedDiff(tt.src, diff)
+				require.NoError(t, err)
+				require.Equal(t, tt.dst, result)
+			}
+		})
+	}
+}
+
+func TestPropagateLineOrigins(t *testing.T) {
+	origins := PropagateLineOrigins("", "one\ntwo\nthree", nil, 1)
+	require.Equal(t, []uint64{1, 1, 1}, origins)
+
+	origins = PropagateLineOrigins("one\ntwo\nthree", "one\n2\nthree", origins, 2)
+	require.Equal(t, []uint64{1, 2, 1}, origins)
+
+	origins = PropagateLineOrigins("one\n2\nthree", "zero\none\n2\nthree", origins, 3)
+	require.Equal(t, []uint64{3, 1, 2, 1}, origins)
+}
+
+func TestRebaseUnifiedDiff(t *testing.T) {
+	base := "one\ntwo\nthree\nfour\nfive"
+	tests := []struct {
+		name     string
+		updated  string
+		diffStr  string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "lines inserted before the hunk",
+			updated:  "zero\none\ntwo\nthree\nfour\nfive",
+			diffStr:  "@@ -4,2 +4,2 @@\n four\n-five\n+5",
+			expected: "zero\none\ntwo\nthree\nfour\n5",
+		},
+		{
+			name:     "lines deleted before the hunk",
+			updated:  "three\nfour\nfive",
+			diffStr:  "@@ -5 +5 @@\n-five\n+5",
+			expected: "three\nfour\n5",
+		},
+		{
+			name:     "insertion anchored on kept lines",
+			updated:  "zero\none\ntwo\nthree\nfour\nfive",
+			diffStr:  "@@ -3,0 +3,1 @@\n+two and a half",
+			expected: "zero\none\ntwo\ntwo and a half\nthree\nfour\nfive",
+		},
+		{
+			name:    "hunk line changed",
+			updated: "one\ntwo\nthree\nFOUR\nfive",
+			diffStr: "@@ -4,2 +4,2 @@\n four\n-five\n+5",
+			wantErr: true,
+		},
+		{
+			name:    "lines inserted within the hunk",
+			updated: "one\ntwo\nthree\nfour\nfour and a half\nfive",
+			diffStr: "@@ -4,2 +4,2 @@\n four\n-five\n+5",
+			wantErr: true,
+		},
+		{
+			name:    "changes already applied",
+			updated: "one\ntwo\nthree\nfour\n5",
+			diffStr: "@@ -4,2 +4,2 @@\n four\n-five\n+5",
+			wantErr: true,
+		},
+		{
+			name:    "diff not applying to base",
+			updated: base,
+			diffStr: "@@ -1 +1 @@\n-zero\n+0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rebased, err := RebaseUnifiedDiff(base, tt.updated, tt.diffStr, PatchConfig{})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			result, err := ApplyUnifiedDiff(tt.updated, rebased)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestApplyUnifiedDiffWithConfig(t *testing.T) {
+	src := "one\ntwo\nthree\nfour\nfive\nsix"
+	tests := []struct {
+		name     string
+		diffStr  string
+		config   PatchConfig
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:    "offset hunk without max offset",
+			diffStr: "@@ -1,2 +1,2 @@\n three\n-four\n+4",
+			wantErr: true,
+		},
+		{
+			name:     "offset hunk within max offset",
+			diffStr:  "@@ -1,2 +1,2 @@\n three\n-four\n+4",
+			config:   PatchConfig{MaxOffset: 2},
+			expected: "one\ntwo\nthree\n4\nfive\nsix",
+		},
+		{
+			name:    "offset hunk beyond max offset",
+			diffStr: "@@ -1,2 +1,2 @@\n three\n-four\n+4",
+			config:  PatchConfig{MaxOffset: 1},
+			wantErr: true,
+		},
+		{
+			name:     "offset of the previous hunk carried over",
+			diffStr:  "@@ -1,2 +1,2 @@\n two\n-three\n+3\n@@ -4,2 +4,2 @@\n five\n-six\n+6",
+			config:   PatchConfig{MaxOffset: 1},
+			expected: "one\ntwo\n3\nfour\nfive\n6",
+		},
+		{
+			name:    "mismatching context without fuzz",
+			diffStr: "@@ -2,3 +2,3 @@\n TWO\n-three\n+3\n four",
+			wantErr: true,
+		},
+		{
+			name:     "mismatching context within fuzz",
+			diffStr:  "@@ -2,3 +2,3 @@\n TWO\n-three\n+3\n four",
+			config:   PatchConfig{Fuzz: 1},
+			expected: "one\ntwo\n3\nfour\nfive\nsix",
+		},
+		{
+			name:    "mismatching context beyond fuzz",
+			diffStr: "@@ -1,4 +1,4 @@\n ONE\n TWO\n-three\n+3\n four",
+			config:  PatchConfig{Fuzz: 1},
+			wantErr: true,
+		},
+		{
+			name:     "mismatching context within fuzz and offset",
+			diffStr:  "@@ -3,4 +3,4 @@\n ONE\n TWO\n-three\n+3\n four",
+			config:   PatchConfig{MaxOffset: 2, Fuzz: 2},
+			expected: "one\ntwo\n3\nfour\nfive\nsix",
+		},
+		{
+			name:    "fuzz does not ignore deletions",
+			diffStr: "@@ -3 +3 @@\n-THREE\n+3",
+			config:  PatchConfig{MaxOffset: 5, Fuzz: 2},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ApplyUnifiedDiffWithConfig(src, tt.diffStr, tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestApplyUnifiedDiffWithConfigClosestOffset(t *testing.T) {
+	// the hunk matches 2 lines before and 1 line after its position, the
+	// closest match wins
+	src := "x\na\nb\nx\nc"
+	result, err := ApplyUnifiedDiffWithConfig(src, "@@ -3 +3 @@\n-x\n+y", PatchConfig{MaxOffset: 2})
+	require.NoError(t, err)
+	require.Equal(t, "x\na\nb\ny\nc", result)
+
+	// at the same distance, the preceding match wins
+	src = "a\nx\nb\nx\nc"
+	result, err = ApplyUnifiedDiffWithConfig(src, "@@ -3 +3 @@\n-x\n+y", PatchConfig{MaxOffset: 1})
+	require.NoError(t, err)
+	require.Equal(t, "a\ny\nb\nx\nc", result)
+}
+
+func TestApplyUnifiedDiffWithConfigGas(t *testing.T) {
+	src := "one\ntwo\nthree\nfour\nfive\nsix"
+	diffStr := "@@ -1,2 +1,2 @@\n three\n-four\n+4"
+
+	gasMeter := storetypes.NewGasMeter(1000)
+	_, err := ApplyUnifiedDiffWithConfig(src, diffStr, PatchConfig{MaxOffset: 2, GasMeter: gasMeter, GasPerLine: 10})
+	require.NoError(t, err)
+	// 4 lines compared in 3 attempts, 3 hunk lines and 4 lines copied
+	require.Equal(t, uint64(110), gasMeter.GasConsumed())
+
+	gasMeter = storetypes.NewGasMeter(50)
+	require.Panics(t, func() {
+		_, _ = ApplyUnifiedDiffWithConfig(src, diffStr, PatchConfig{MaxOffset: 2, GasMeter: gasMeter, GasPerLine: 10})
+	})
+}
+
+func TestDiffLinesGas(t *testing.T) {
+	var src, rewritten []string
+	for i := 0; i < 100; i++ {
+		src = append(src, fmt.Sprintf("line %d", i))
+		rewritten = append(rewritten, fmt.Sprintf("new line %d", i))
+	}
+	changed := slices.Clone(src)
+	changed[50] = "changed line"
+
+	// a single change costs about one comparison per line
+	gasMeter := storetypes.NewGasMeter(1000)
+	diffLines(src, changed, PatchConfig{GasMeter: gasMeter, GasPerLine: 1})
+	require.Less(t, gasMeter.GasConsumed(), uint64(200))
+
+	// the cost grows with the number of lines times the number of edits: the
+	// 200 edits rewriting every line cost the diagonals of 200 rounds
+	gasMeter = storetypes.NewGasMeter(100000)
+	diffLines(src, rewritten, PatchConfig{GasMeter: gasMeter, GasPerLine: 1})
+	require.Greater(t, gasMeter.GasConsumed(), uint64(40000))
+
+	gasMeter = storetypes.NewGasMeter(1000)
+	require.Panics(t, func() {
+		diffLines(src, rewritten, PatchConfig{GasMeter: gasMeter, GasPerLine: 1})
+	})
+}
+
+func TestMergeUnifiedDiffs(t *testing.T) {
+	base := "one\ntwo\nthree\nfour\nfive"
+	tests := []struct {
+		name      string
+		ours      string
+		theirs    string
+		expected  string
+		conflicts []MergeConflict
+	}{
+		{
+			name:     "independent changes",
+			ours:     "@@ -1 +1 @@\n-one\n+1",
+			theirs:   "@@ -5 +5 @@\n-five\n+5",
+			expected: "1\ntwo\nthree\nfour\n5",
+		},
+		{
+			name:     "identical changes",
+			ours:     "@@ -3 +3 @@\n-three\n+3",
+			theirs:   "@@ -3 +3 @@\n-three\n+3",
+			expected: "one\ntwo\n3\nfour\nfive",
+		},
+		{
+			name:     "insertion and deletion",
+			ours:     "@@ -1,0 +1 @@\n+zero",
+			theirs:   "@@ -3,2 +3 @@\n three\n-four",
+			expected: "zero\none\ntwo\nthree\nfive",
+		},
+		{
+			name:   "overlapping changes",
+			ours:   "@@ -2,2 +2,2 @@\n-two\n-three\n+2\n+3",
+			theirs: "@@ -3 +3 @@\n-three\n+THREE",
+			conflicts: []MergeConflict{{
+				BaseLine: 1,
+				Base:     []string{"two", "three"},
+				Ours:     []string{"2", "3"},
+				Theirs:   []string{"two", "THREE"},
+			}},
+		},
+		{
+			name:   "adjacent changes",
+			ours:   "@@ -2 +2 @@\n-two\n+2",
+			theirs: "@@ -3 +3 @@\n-three\n+3",
+			conflicts: []MergeConflict{{
+				BaseLine: 1,
+				Base:     []string{"two", "three"},
+				Ours:     []string{"2", "three"},
+				Theirs:   []string{"two", "3"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts, err := MergeUnifiedDiffs(base, tt.ours, tt.theirs, PatchConfig{})
+			require.NoError(t, err)
+			require.Equal(t, tt.conflicts, conflicts)
+			if len(tt.conflicts) > 0 {
+				require.Empty(t, merged)
+				return
+			}
+			result, err := ApplyUnifiedDiff(base, merged)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, _, err := MergeUnifiedDiffs(base, "@@ -1 +1 @@\n-zero\n+0", "@@ -1 +1 @@\n-one\n+1", PatchConfig{})
+	require.Error(t, err)
+}
@@ -13,12 +13,20 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "hikari/v1/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "hikari/v1/MsgDeposit")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "hikari/v1/MsgCancelProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "hikari/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "hikari/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteMultipleChoice{}, "hikari/v1/MsgVoteMultipleChoice")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "hikari/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "hikari/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeConstitutionAmendment{}, "atomone/x/gov/v1/MsgProposeAmendment")
 	legacy.RegisterAminoMsg(cdc, &MsgProposeLaw{}, "hikari/x/gov/v1/MsgProposeLaw")
+	legacy.RegisterAminoMsg(cdc, &MsgRepealLaw{}, "hikari/x/gov/v1/MsgRepealLaw")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGovernor{}, "hikari/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgEditGovernor{}, "hikari/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGovernorStatus{}, "hikari/v1/MsgUpdateGovernorStatus")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernor{}, "hikari/v1/MsgDelegateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernor{}, "hikari/v1/MsgUndelegateGovernor")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -27,11 +35,19 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteMultipleChoice{},
 		&MsgDeposit{},
+		&MsgCancelProposal{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
 		&MsgProposeConstitutionAmendment{},
 		&MsgProposeLaw{},
+		&MsgRepealLaw{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgUpdateGovernorStatus{},
+		&MsgDelegateGovernor{},
+		&MsgUndelegateGovernor{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,7 +6,10 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -105,6 +108,155 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate governors, governance delegations and validator shares
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, err := sdk.AccAddressFromBech32(g.GovernorAddress); err != nil {
+				return fmt.Errorf("invalid governor address %s: %w", g.GovernorAddress, err)
+			}
+			if _, ok := governors[g.GovernorAddress]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.GovernorAddress)
+			}
+			if !g.Status.IsValid() {
+				return fmt.Errorf("governor %s has invalid status: %s", g.GovernorAddress, g.Status)
+			}
+			if err := g.Description.EnsureLength(); err != nil {
+				return fmt.Errorf("governor %s has invalid description: %w", g.GovernorAddress, err)
+			}
+
+			governors[g.GovernorAddress] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid delegator address %s: %w", d.DelegatorAddress, err)
+			}
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+			if _, ok := governors[d.DelegatorAddress]; ok && d.DelegatorAddress != d.GovernorAddress {
+				return fmt.Errorf("governor %s must delegate to itself", d.DelegatorAddress)
+			}
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		type valSharesKey struct {
+			Governor  string
+			Validator string
+		}
+		valShares := make(map[valSharesKey]struct{})
+		for _, v := range data.GovernorValShares {
+			if _, ok := governors[v.GovernorAddress]; !ok {
+				return fmt.Errorf("governor validator shares %v have non-existent governor: %s", v, v.GovernorAddress)
+			}
+			if _, err := sdk.ValAddressFromBech32(v.ValidatorAddress); err != nil {
+				return fmt.Errorf("invalid validator address %s: %w", v.ValidatorAddress, err)
+			}
+			if v.Shares.IsNil() || !v.Shares.IsPositive() {
+				return fmt.Errorf("governor validator shares %v must be positive", v)
+			}
+			vk := valSharesKey{v.GovernorAddress, v.ValidatorAddress}
+			if _, ok := valShares[vk]; ok {
+				return fmt.Errorf("duplicate governor validator shares: %v", v)
+			}
+
+			valShares[vk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate archived votes
+	errGroup.Go(func() error {
+		type archivedVoteKey struct {
+			ProposalID uint64
+			Voter      string
+		}
+		archivedVotes := make(map[archivedVoteKey]struct{})
+		for _, v := range data.ArchivedVotes {
+			if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+				return fmt.Errorf("invalid archived vote voter address %s: %w", v.Voter, err)
+			}
+			votingPower, err := math.LegacyNewDecFromStr(v.VotingPower)
+			if err != nil {
+				return fmt.Errorf("archived vote %v has invalid voting power: %w", v, err)
+			}
+			if votingPower.IsNegative() {
+				return fmt.Errorf("archived vote %v has negative voting power", v)
+			}
+			vk := archivedVoteKey{v.ProposalId, v.Voter}
+			if _, ok := archivedVotes[vk]; ok {
+				return fmt.Errorf("duplicate archived vote: %v", v)
+			}
+
+			archivedVotes[vk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate and invalid voting power snapshots
+	errGroup.Go(func() error {
+		if err := validateVotingPowerSnapshots(data.VotingPowerSnapshots, proposalIds); err != nil {
+			return fmt.Errorf("invalid voting power snapshots: %w", err)
+		}
+		if err := validateVotingPowerSnapshots(data.GovernorPowerSnapshots, proposalIds); err != nil {
+			return fmt.Errorf("invalid governor power snapshots: %w", err)
+		}
+
+		return nil
+	})
+
+	// weed out duplicate and invalid laws
+	errGroup.Go(func() error {
+		lawIDs := make(map[uint64]struct{})
+		for _, l := range data.Laws {
+			if l.Id == 0 {
+				return fmt.Errorf("law id cannot be 0: %v", l)
+			}
+			if _, ok := lawIDs[l.Id]; ok {
+				return fmt.Errorf("duplicate law id: %d", l.Id)
+			}
+			if data.NextLawId != 0 && l.Id >= data.NextLawId {
+				return fmt.Errorf("law id %d must be lower than next law id %d", l.Id, data.NextLawId)
+			}
+			if err := ValidateLawText(l.Title, l.Text, l.TextHash); err != nil {
+				return fmt.Errorf("invalid law %d: %w", l.Id, err)
+			}
+			if !l.Status.IsValid() {
+				return fmt.Errorf("law %d has invalid status %s", l.Id, l.Status)
+			}
+			if (l.Status == LawStatusSuperseded) != (l.SupersededBy != 0) {
+				return fmt.Errorf("law %d has status %s but superseded by law %d", l.Id, l.Status, l.SupersededBy)
+			}
+
+			lawIDs[l.Id] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify the constitution history is made of consecutive versions ending
+	// with the current constitution
+	errGroup.Go(func() error {
+		for i, cv := range data.ConstitutionHistory {
+			if cv.Version != uint64(i+1) {
+				return fmt.Errorf("constitution version %d must be %d: versions must be consecutive starting from 1", cv.Version, i+1)
+			}
+		}
+		if n := len(data.ConstitutionHistory); n > 0 && data.ConstitutionHistory[n-1].Constitution != data.Constitution {
+			return fmt.Errorf("latest constitution version %d does not match the constitution", n)
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
@@ -113,6 +265,39 @@ func ValidateGenesis(data *GenesisState) error {
 	return errGroup.Wait()
 }
 
+// validateVotingPowerSnapshots checks that the snapshots are unique per
+// proposal and address, and have a non-negative voting power.
+func validateVotingPowerSnapshots(snapshots []*VotingPowerSnapshot, proposalIds map[uint64]struct{}) error {
+	type snapshotKey struct {
+		ProposalID uint64
+		Address    string
+	}
+	keys := make(map[snapshotKey]struct{})
+	for _, s := range snapshots {
+		if _, ok := proposalIds[s.ProposalId]; !ok {
+			return fmt.Errorf("snapshot %v has non-existent proposal id: %d", s, s.ProposalId)
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+			return fmt.Errorf("invalid snapshot address %s: %w", s.Address, err)
+		}
+		votingPower, err := math.LegacyNewDecFromStr(s.VotingPower)
+		if err != nil {
+			return fmt.Errorf("snapshot %v has invalid voting power: %w", s, err)
+		}
+		if votingPower.IsNegative() {
+			return fmt.Errorf("snapshot %v has negative voting power", s)
+		}
+		sk := snapshotKey{s.ProposalId, s.Address}
+		if _, ok := keys[sk]; ok {
+			return fmt.Errorf("duplicate snapshot: %v", s)
+		}
+
+		keys[sk] = struct{}{}
+	}
+
+	return nil
+}
+
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
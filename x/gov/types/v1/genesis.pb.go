@@ -66,6 +66,32 @@ type GenesisState struct {
 	// If unset or set to 0, the quorum for the next law proposal will be set to
 	// the params.LawMinQuorum value.
 	LawParticipationEma string `protobuf:"bytes,14,opt,name=law_participation_ema,json=lawParticipationEma,proto3" json:"law_participation_ema,omitempty"`
+	// governors defines all the governors present at genesis.
+	Governors []*Governor `protobuf:"bytes,15,rep,name=governors,proto3" json:"governors,omitempty"`
+	// governance_delegations defines all the governance delegations present at
+	// genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,16,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
+	// governor_val_shares defines the validator shares delegated by the
+	// governance delegators of each governor. They are only set from the
+	// exported staking delegations, since the staking hooks that keep them
+	// current are not called when importing an exported staking genesis.
+	GovernorValShares []*GovernorValShares `protobuf:"bytes,17,rep,name=governor_val_shares,json=governorValShares,proto3" json:"governor_val_shares,omitempty"`
+	// archived_votes defines the archived votes present at genesis.
+	ArchivedVotes []*ArchivedVote `protobuf:"bytes,18,rep,name=archived_votes,json=archivedVotes,proto3" json:"archived_votes,omitempty"`
+	// laws defines all the laws present at genesis.
+	Laws []*Law `protobuf:"bytes,19,rep,name=laws,proto3" json:"laws,omitempty"`
+	// next_law_id defines the id of the next law to be enacted.
+	NextLawId uint64 `protobuf:"varint,20,opt,name=next_law_id,json=nextLawId,proto3" json:"next_law_id,omitempty"`
+	// constitution_history defines all the versions of the constitution present
+	// at genesis, the last one being the current constitution. If empty, the
+	// constitution is set as the first version.
+	ConstitutionHistory []*ConstitutionVersion `protobuf:"bytes,21,rep,name=constitution_history,json=constitutionHistory,proto3" json:"constitution_history,omitempty"`
+	// voting_power_snapshots defines the snapshotted voting power of the
+	// voters on the proposals in voting period present at genesis.
+	VotingPowerSnapshots []*VotingPowerSnapshot `protobuf:"bytes,22,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots,omitempty"`
+	// governor_power_snapshots defines the snapshotted voting power delegated
+	// to the governors on the proposals in voting period present at genesis.
+	GovernorPowerSnapshots []*VotingPowerSnapshot `protobuf:"bytes,23,rep,name=governor_power_snapshots,json=governorPowerSnapshots,proto3" json:"governor_power_snapshots,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -202,6 +228,69 @@ func (m *GenesisState) GetLawParticipationEma() string {
 	return ""
 }
 
+func (m *GenesisState) GetGovernors() []*Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernorValShares() []*GovernorValShares {
+	if m != nil {
+		return m.GovernorValShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedVotes() []*ArchivedVote {
+	if m != nil {
+		return m.ArchivedVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLaws() []*Law {
+	if m != nil {
+		return m.Laws
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLawId() uint64 {
+	if m != nil {
+		return m.NextLawId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConstitutionHistory() []*ConstitutionVersion {
+	if m != nil {
+		return m.ConstitutionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerSnapshots() []*VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernorPowerSnapshots() []*VotingPowerSnapshot {
+	if m != nil {
+		return m.GovernorPowerSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hikari.gov.v1.GenesisState")
 }
@@ -209,41 +298,54 @@ func init() {
 func init() { proto.RegisterFile("hikari/gov/v1/genesis.proto", fileDescriptor_61760c44ffd60323) }
 
 var fileDescriptor_61760c44ffd60323 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xfd, 0xe9, 0x56, 0xf7, 0x8f, 0x86, 0x47, 0x99, 0xe9, 0x20, 0xaa, 0x26, 0x21,
-	0x95, 0x43, 0x13, 0xda, 0x89, 0x13, 0xa7, 0x95, 0xa2, 0x32, 0x89, 0x49, 0x55, 0x40, 0x3b, 0x70,
-	0x89, 0xbc, 0xc4, 0x4a, 0x2d, 0x12, 0x3b, 0xaa, 0xbd, 0x8c, 0x7d, 0x01, 0xce, 0x7c, 0x18, 0x3e,
-	0x04, 0xc7, 0x89, 0x13, 0x47, 0xd4, 0x7e, 0x11, 0x14, 0x3b, 0x69, 0xd3, 0xd0, 0x03, 0xc7, 0xf7,
-	0x7d, 0x9f, 0xe7, 0x97, 0xc7, 0x6f, 0x6c, 0x70, 0x3a, 0xa3, 0x5f, 0xf0, 0x9c, 0xda, 0x01, 0x4f,
-	0xec, 0x64, 0x60, 0x07, 0x84, 0x11, 0x41, 0x85, 0x15, 0xcf, 0xb9, 0xe4, 0xb0, 0xa9, 0x87, 0x56,
-	0xc0, 0x13, 0x2b, 0x19, 0x74, 0x4e, 0x4a, 0x5a, 0x9e, 0x68, 0x5d, 0xe7, 0xa9, 0xc7, 0x45, 0xc4,
-	0x85, 0xab, 0x2a, 0x5b, 0x17, 0x7a, 0x74, 0xf6, 0xed, 0x00, 0x34, 0x26, 0x1a, 0xfa, 0x51, 0x62,
-	0x49, 0xe0, 0x2b, 0xf0, 0x58, 0x48, 0x3c, 0x97, 0x94, 0x05, 0xa9, 0x3e, 0xe6, 0x02, 0x87, 0x2e,
-	0xf5, 0x91, 0xd1, 0x35, 0x7a, 0x7b, 0x0e, 0xcc, 0x67, 0xd3, 0x6c, 0x74, 0xe9, 0xc3, 0x21, 0x38,
-	0xf4, 0x49, 0xcc, 0x05, 0x95, 0x02, 0xed, 0x74, 0x77, 0x7b, 0xf5, 0xe1, 0x13, 0x6b, 0x23, 0x98,
-	0x35, 0xd6, 0x63, 0x67, 0xa5, 0x83, 0x2f, 0xc1, 0x7e, 0xc2, 0x25, 0x11, 0x68, 0x57, 0x19, 0x8e,
-	0x4b, 0x86, 0x6b, 0x2e, 0x89, 0xa3, 0x15, 0xf0, 0x35, 0xa8, 0xe5, 0x39, 0x04, 0xda, 0x53, 0xf2,
-	0x93, 0x92, 0x3c, 0x0f, 0xe3, 0xac, 0x95, 0x70, 0x02, 0x5a, 0xd9, 0xd7, 0xdc, 0x18, 0xcf, 0x71,
-	0x24, 0xd0, 0x7e, 0xd7, 0xe8, 0xd5, 0x87, 0xcf, 0xb6, 0x67, 0x9b, 0x2a, 0xcd, 0x68, 0x07, 0x19,
-	0x4e, 0xd3, 0x2f, 0xb6, 0xe0, 0x18, 0x34, 0x13, 0xae, 0xd7, 0xa1, 0x39, 0x55, 0xc5, 0x39, 0xfd,
-	0x37, 0x72, 0xba, 0x96, 0x35, 0xa6, 0x91, 0x14, 0x3a, 0xf0, 0x02, 0x34, 0x24, 0x0e, 0xc3, 0xfb,
-	0x1c, 0x72, 0xa0, 0x20, 0x9d, 0x12, 0xe4, 0x53, 0x2a, 0x29, 0x30, 0xea, 0x72, 0xdd, 0x80, 0x7d,
-	0x50, 0xcd, 0xcc, 0x87, 0xca, 0xdc, 0x2e, 0x6f, 0x41, 0x0d, 0x9d, 0x4c, 0x04, 0xcf, 0x40, 0xc3,
-	0xe3, 0x4c, 0x48, 0x2a, 0x6f, 0x25, 0xe5, 0x0c, 0xd5, 0xba, 0x46, 0xaf, 0xe6, 0x6c, 0xf4, 0xe0,
-	0x04, 0x1c, 0x85, 0x58, 0x48, 0x37, 0xa2, 0xcc, 0xcd, 0x4e, 0x8d, 0x80, 0x82, 0x3f, 0x2f, 0xc1,
-	0x3f, 0x60, 0x21, 0xaf, 0x28, 0xcb, 0xff, 0x64, 0x2b, 0xdc, 0xa8, 0xe1, 0x35, 0x40, 0x2b, 0x10,
-	0x65, 0x54, 0x52, 0x1c, 0xae, 0x80, 0xf5, 0xff, 0x01, 0xb6, 0x33, 0xe0, 0xa5, 0x36, 0xe7, 0xdc,
-	0x37, 0xe0, 0x51, 0x9c, 0x5e, 0x38, 0x8f, 0xc6, 0x38, 0x4d, 0xec, 0x92, 0x08, 0xa3, 0x46, 0x7a,
-	0x92, 0x51, 0xeb, 0xd7, 0x8f, 0x3e, 0xc8, 0xee, 0xf2, 0x98, 0x78, 0xce, 0xd1, 0x86, 0xf0, 0x5d,
-	0x84, 0x61, 0x00, 0x7a, 0xc5, 0xd3, 0xba, 0x38, 0x22, 0xcc, 0x8f, 0x08, 0x53, 0x37, 0xa2, 0xc4,
-	0x6c, 0x6e, 0x65, 0xbe, 0x28, 0xfa, 0x2f, 0x72, 0xfb, 0xb4, 0xfc, 0xa1, 0x11, 0x68, 0x87, 0xf8,
-	0x6e, 0x0b, 0xb5, 0xb5, 0x95, 0x7a, 0x1c, 0xe2, 0xbb, 0x32, 0x63, 0x74, 0xf5, 0x73, 0x61, 0x1a,
-	0x0f, 0x0b, 0xd3, 0xf8, 0xb3, 0x30, 0x8d, 0xef, 0x4b, 0xb3, 0xf2, 0xb0, 0x34, 0x2b, 0xbf, 0x97,
-	0x66, 0xe5, 0xf3, 0x79, 0x40, 0xe5, 0xec, 0xf6, 0xc6, 0xf2, 0x78, 0x64, 0xbf, 0x57, 0x3b, 0xec,
-	0xbf, 0x9d, 0x61, 0xca, 0x6c, 0xbd, 0xd0, 0xbe, 0xa7, 0x8a, 0xaf, 0xea, 0xd9, 0xcb, 0xfb, 0x98,
-	0x08, 0x3b, 0x19, 0xdc, 0x54, 0xd5, 0xf3, 0x3e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x1a,
-	0x2f, 0xe7, 0x40, 0x04, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0xc7, 0x09, 0x5f, 0xa3, 0xee, 0xc7, 0xc0, 0xfd, 0xc0, 0x83, 0xad, 0xaa, 0x98, 0x36, 0x75,
+	0x17, 0x6d, 0x07, 0x88, 0xab, 0x5d, 0x51, 0x3a, 0x15, 0x24, 0x90, 0xaa, 0xb0, 0x55, 0x13, 0x9a,
+	0x14, 0x99, 0xc4, 0x4a, 0xac, 0x25, 0x71, 0x14, 0x9b, 0x14, 0x5e, 0x60, 0xd7, 0x7b, 0x98, 0x3d,
+	0xc4, 0x2e, 0xd1, 0xae, 0x76, 0x39, 0xc1, 0x8b, 0x4c, 0x71, 0x92, 0x36, 0x4d, 0x33, 0x89, 0xcb,
+	0x73, 0xce, 0xff, 0xff, 0xf3, 0xb1, 0x7d, 0xe2, 0x80, 0x5d, 0x8b, 0x7e, 0xc3, 0x3e, 0xed, 0x99,
+	0x2c, 0xe8, 0x05, 0xfb, 0x3d, 0x93, 0xb8, 0x84, 0x53, 0xde, 0xf5, 0x7c, 0x26, 0x18, 0x2c, 0x47,
+	0xc5, 0xae, 0xc9, 0x82, 0x6e, 0xb0, 0xbf, 0xb3, 0x9d, 0xd1, 0xb2, 0x20, 0xd2, 0xed, 0xbc, 0xd0,
+	0x19, 0x77, 0x18, 0xd7, 0x64, 0xd4, 0x8b, 0x82, 0xa8, 0xb4, 0xf7, 0xbd, 0x04, 0x4a, 0xc3, 0x08,
+	0x7a, 0x29, 0xb0, 0x20, 0xf0, 0x3d, 0xa8, 0x71, 0x81, 0x7d, 0x41, 0x5d, 0x33, 0xd4, 0x7b, 0x8c,
+	0x63, 0x5b, 0xa3, 0x06, 0x52, 0x5a, 0x4a, 0x7b, 0x55, 0x85, 0x49, 0x6d, 0x14, 0x97, 0xce, 0x0c,
+	0x78, 0x00, 0x36, 0x0c, 0xe2, 0x31, 0x4e, 0x05, 0x47, 0xcb, 0xad, 0x95, 0x76, 0xf1, 0xa0, 0xd1,
+	0x9d, 0x6b, 0xac, 0x3b, 0x88, 0xca, 0xea, 0x54, 0x07, 0xdf, 0x81, 0xb5, 0x80, 0x09, 0xc2, 0xd1,
+	0x8a, 0x34, 0x54, 0x33, 0x86, 0x31, 0x13, 0x44, 0x8d, 0x14, 0xf0, 0x08, 0x14, 0x92, 0x3e, 0x38,
+	0x5a, 0x95, 0xf2, 0xed, 0x8c, 0x3c, 0x69, 0x46, 0x9d, 0x29, 0xe1, 0x10, 0x54, 0xe2, 0xd5, 0x34,
+	0x0f, 0xfb, 0xd8, 0xe1, 0x68, 0xad, 0xa5, 0xb4, 0x8b, 0x07, 0x2f, 0xf3, 0x7b, 0x1b, 0x49, 0x4d,
+	0x7f, 0x19, 0x29, 0x6a, 0xd9, 0x48, 0xa7, 0xe0, 0x00, 0x94, 0x03, 0x16, 0x1d, 0x47, 0xc4, 0x59,
+	0x97, 0x9c, 0xdd, 0xc5, 0x96, 0xc3, 0x63, 0x99, 0x61, 0x4a, 0x41, 0x2a, 0x03, 0x8f, 0x41, 0x49,
+	0x60, 0xdb, 0xbe, 0x4b, 0x20, 0xcf, 0x24, 0x64, 0x27, 0x03, 0xf9, 0x14, 0x4a, 0x52, 0x8c, 0xa2,
+	0x98, 0x25, 0x60, 0x07, 0xac, 0xc7, 0xe6, 0x0d, 0x69, 0xae, 0x67, 0x4f, 0x41, 0x16, 0xd5, 0x58,
+	0x04, 0xf7, 0x40, 0x49, 0x67, 0x2e, 0x17, 0x54, 0xdc, 0x08, 0xca, 0x5c, 0x54, 0x68, 0x29, 0xed,
+	0x82, 0x3a, 0x97, 0x83, 0x43, 0xb0, 0x69, 0x63, 0x2e, 0x34, 0x87, 0xba, 0x5a, 0xbc, 0x6b, 0x04,
+	0x24, 0xfc, 0x55, 0x06, 0x7e, 0x8e, 0xb9, 0xb8, 0xa0, 0x6e, 0x72, 0x93, 0x15, 0x7b, 0x2e, 0x86,
+	0x63, 0x80, 0xa6, 0x20, 0xea, 0x52, 0x41, 0xb1, 0x3d, 0x05, 0x16, 0x9f, 0x02, 0xac, 0xc7, 0xc0,
+	0xb3, 0xc8, 0x9c, 0x70, 0x3f, 0x80, 0x2d, 0x2f, 0x1c, 0x38, 0x9d, 0x7a, 0x38, 0xec, 0x58, 0x23,
+	0x0e, 0x46, 0xa5, 0x70, 0x27, 0xfd, 0xca, 0xef, 0x9f, 0x1d, 0x10, 0xcf, 0xf2, 0x80, 0xe8, 0xea,
+	0xe6, 0x9c, 0xf0, 0xa3, 0x83, 0xa1, 0x09, 0xda, 0xe9, 0xdd, 0x6a, 0xd8, 0x21, 0xae, 0xe1, 0x10,
+	0x57, 0x4e, 0x44, 0x86, 0x59, 0xce, 0x65, 0xbe, 0x49, 0xfb, 0x8f, 0x13, 0xfb, 0x28, 0xbb, 0x50,
+	0x1f, 0xd4, 0x6d, 0x3c, 0xc9, 0xa1, 0x56, 0x72, 0xa9, 0x55, 0x1b, 0x4f, 0x16, 0x18, 0x47, 0xa0,
+	0x60, 0xb2, 0x80, 0xf8, 0x2e, 0xf3, 0x39, 0x7a, 0x9e, 0x3b, 0xe6, 0xc3, 0xb8, 0xae, 0xce, 0x94,
+	0xf0, 0x0a, 0x34, 0xa2, 0x00, 0xbb, 0x3a, 0xd1, 0x0c, 0x62, 0x13, 0x53, 0x22, 0x39, 0xda, 0x94,
+	0x8c, 0xd7, 0xb9, 0x8c, 0x50, 0x3c, 0x98, 0x6a, 0xd5, 0xba, 0x99, 0x93, 0xe5, 0x70, 0x04, 0xaa,
+	0xc9, 0x42, 0x5a, 0x80, 0x6d, 0x8d, 0x5b, 0xd8, 0x27, 0x1c, 0x6d, 0x49, 0x70, 0xeb, 0x3f, 0xcd,
+	0x8d, 0xb1, 0x7d, 0x29, 0x75, 0xea, 0x96, 0x99, 0x4d, 0xc1, 0x3e, 0xa8, 0x60, 0x5f, 0xb7, 0x68,
+	0x40, 0x0c, 0x2d, 0xfa, 0xfe, 0xa1, 0x84, 0x65, 0x3f, 0xa6, 0xe3, 0x58, 0x24, 0xdf, 0x81, 0x32,
+	0x4e, 0x45, 0x1c, 0xbe, 0x05, 0xab, 0x36, 0x9e, 0x70, 0x54, 0x95, 0x4e, 0xb8, 0x30, 0x56, 0x13,
+	0x55, 0xd6, 0x61, 0x13, 0x14, 0x5d, 0x72, 0x2b, 0xb4, 0xf0, 0x66, 0xa8, 0x81, 0x6a, 0xf2, 0xfd,
+	0x2a, 0x84, 0xa9, 0x73, 0x3c, 0x39, 0x33, 0xe0, 0x67, 0x50, 0x9b, 0x9b, 0x0e, 0x8b, 0x72, 0xc1,
+	0xfc, 0x3b, 0x54, 0x97, 0xdc, 0xbd, 0x0c, 0xf7, 0x24, 0x25, 0x1d, 0x13, 0x9f, 0x87, 0xc7, 0x56,
+	0x4d, 0xfb, 0x4f, 0x23, 0x3b, 0xfc, 0x02, 0x1a, 0xc9, 0x73, 0xc1, 0x26, 0xc4, 0xd7, 0xb8, 0x8b,
+	0x3d, 0x6e, 0x31, 0xc1, 0x51, 0x23, 0x17, 0x1c, 0xbf, 0x1b, 0xa1, 0xf6, 0x32, 0x96, 0xaa, 0xb5,
+	0x60, 0x31, 0xc9, 0xe1, 0x57, 0x80, 0xa6, 0xd7, 0x91, 0x65, 0x6f, 0x3f, 0x99, 0xdd, 0x48, 0x18,
+	0xf3, 0xf4, 0xfe, 0xc5, 0xaf, 0x87, 0xa6, 0x72, 0xff, 0xd0, 0x54, 0xfe, 0x3e, 0x34, 0x95, 0x1f,
+	0x8f, 0xcd, 0xa5, 0xfb, 0xc7, 0xe6, 0xd2, 0x9f, 0xc7, 0xe6, 0xd2, 0xd5, 0xa1, 0x49, 0x85, 0x75,
+	0x73, 0xdd, 0xd5, 0x99, 0xd3, 0x3b, 0x95, 0xfc, 0xce, 0x89, 0x85, 0xa9, 0xdb, 0x8b, 0x16, 0xeb,
+	0xe8, 0x32, 0xb8, 0x95, 0xbf, 0x1d, 0x71, 0xe7, 0x11, 0xde, 0x0b, 0xf6, 0xaf, 0xd7, 0xe5, 0xef,
+	0xe5, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x2f, 0x9c, 0x10, 0xc0, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -266,6 +368,139 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernorPowerSnapshots) > 0 {
+		for iNdEx := len(m.GovernorPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernorPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for iNdEx := len(m.ConstitutionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConstitutionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.NextLawId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLawId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Laws) > 0 {
+		for iNdEx := len(m.Laws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Laws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ArchivedVotes) > 0 {
+		for iNdEx := len(m.ArchivedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.GovernorValShares) > 0 {
+		for iNdEx := len(m.GovernorValShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernorValShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.LawParticipationEma) > 0 {
 		i -= len(m.LawParticipationEma)
 		copy(dAtA[i:], m.LawParticipationEma)
@@ -494,6 +729,57 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernorValShares) > 0 {
+		for _, e := range m.GovernorValShares {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedVotes) > 0 {
+		for _, e := range m.ArchivedVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Laws) > 0 {
+		for _, e := range m.Laws {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLawId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextLawId))
+	}
+	if len(m.ConstitutionHistory) > 0 {
+		for _, e := range m.ConstitutionHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernorPowerSnapshots) > 0 {
+		for _, e := range m.GovernorPowerSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -997,6 +1283,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.LawParticipationEma = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, &Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorValShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorValShares = append(m.GovernorValShares, &GovernorValShares{})
+			if err := m.GovernorValShares[len(m.GovernorValShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedVotes = append(m.ArchivedVotes, &ArchivedVote{})
+			if err := m.ArchivedVotes[len(m.ArchivedVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Laws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Laws = append(m.Laws, &Law{})
+			if err := m.Laws[len(m.Laws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLawId", wireType)
+			}
+			m.NextLawId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLawId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionHistory = append(m.ConstitutionHistory, &ConstitutionVersion{})
+			if err := m.ConstitutionHistory[len(m.ConstitutionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, &VotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorPowerSnapshots = append(m.GovernorPowerSnapshots, &VotingPowerSnapshot{})
+			if err := m.GovernorPowerSnapshots[len(m.GovernorPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -21,6 +21,13 @@ func TestEmptyGenesis(t *testing.T) {
 }
 
 func TestValidateGenesis(t *testing.T) {
+	var (
+		governorAddr  = sdk.AccAddress("governor").String()
+		delegatorAddr = sdk.AccAddress("delegator").String()
+		validatorAddr = sdk.ValAddress("validator").String()
+		governor      = v1.NewGovernor(governorAddr, v1.NewGovernorDescription("governor", "", "", "", ""), time.Now())
+	)
+
 	testCases := []struct {
 		name         string
 		genesisState func() *v1.GenesisState
@@ -177,6 +184,17 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "minimum deposit decrease ratio too large: 1.000000000000000000",
 		},
+		{
+			name: "min deposit throttler expedited multiplier is less than 1",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				mdt := *params.MinDepositThrottler
+				mdt.ExpeditedMultiplier = "0.5"
+				params.MinDepositThrottler = &mdt
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "expedited minimum deposit multiplier must be greater than or equal to 1: 0.500000000000000000",
+		},
 		{
 			name: "min deposit is deprecated",
 			genesisState: func() *v1.GenesisState {
@@ -341,6 +359,69 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "law threshold must be positive",
 		},
+		{
+			name: "invalid min governor self delegation",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.MinGovernorSelfDelegation = "-1"
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "minimum governor self delegation must be non-negative",
+		},
+		{
+			name: "governor status change period is nil",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.GovernorStatusChangePeriod = nil
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "governor status change period must not be nil",
+		},
+		{
+			name: "vote archive retention period is nil",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.VoteArchiveRetentionPeriod = nil
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "vote archive retention period must not be nil",
+		},
+		{
+			name: "expedited voting period is nil",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ExpeditedVotingPeriod = nil
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "expedited voting period must not be nil",
+		},
+		{
+			name: "expedited voting period is equal to voting period",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ExpeditedVotingPeriod = params.VotingPeriod
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "must be strictly less than the voting period",
+		},
+		{
+			name: "expedited threshold is equal to threshold",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ExpeditedThreshold = params.Threshold
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "expedited threshold must be strictly greater than governance threshold",
+		},
+		{
+			name: "expedited threshold is too large",
+			genesisState: func() *v1.GenesisState {
+				params := v1.DefaultParams()
+				params.ExpeditedThreshold = "1.1"
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, v1.DefaultParticipationEma, v1.DefaultParticipationEma, v1.DefaultParticipationEma, params)
+			},
+			expErrMsg: "expedited threshold too large",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -423,6 +504,293 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Governors = append(state.Governors, &governor)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: governorAddr, GovernorAddress: governorAddr},
+					&v1.GovernanceDelegation{DelegatorAddress: delegatorAddr, GovernorAddress: governorAddr},
+				)
+				state.GovernorValShares = append(state.GovernorValShares, &v1.GovernorValShares{
+					GovernorAddress:  governorAddr,
+					ValidatorAddress: validatorAddr,
+					Shares:           math.LegacyOneDec(),
+				})
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Governors = append(state.Governors, &governor, &governor)
+
+				return state
+			},
+			expErrMsg: "duplicate governor",
+		},
+		{
+			name: "governor with invalid status",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				g := governor
+				g.Status = v1.GovernorStatusUnspecified
+				state.Governors = append(state.Governors, &g)
+
+				return state
+			},
+			expErrMsg: "has invalid status",
+		},
+		{
+			name: "governance delegation to non-existent governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegatorAddr, GovernorAddress: governorAddr},
+				)
+
+				return state
+			},
+			expErrMsg: "has non-existent governor",
+		},
+		{
+			name: "governor delegates to another governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				other := v1.NewGovernor(delegatorAddr, v1.NewGovernorDescription("other", "", "", "", ""), time.Now())
+				state.Governors = append(state.Governors, &governor, &other)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegatorAddr, GovernorAddress: governorAddr},
+				)
+
+				return state
+			},
+			expErrMsg: "must delegate to itself",
+		},
+		{
+			name: "duplicate governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Governors = append(state.Governors, &governor)
+				state.GovernanceDelegations = append(state.GovernanceDelegations,
+					&v1.GovernanceDelegation{DelegatorAddress: delegatorAddr, GovernorAddress: governorAddr},
+					&v1.GovernanceDelegation{DelegatorAddress: delegatorAddr, GovernorAddress: governorAddr},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate governance delegation",
+		},
+		{
+			name: "non-positive governor validator shares",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Governors = append(state.Governors, &governor)
+				state.GovernorValShares = append(state.GovernorValShares, &v1.GovernorValShares{
+					GovernorAddress:  governorAddr,
+					ValidatorAddress: validatorAddr,
+					Shares:           math.LegacyZeroDec(),
+				})
+
+				return state
+			},
+			expErrMsg: "must be positive",
+		},
+		{
+			name: "valid archived votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.ArchivedVotes = append(state.ArchivedVotes,
+					&v1.ArchivedVote{ProposalId: 1, Voter: delegatorAddr, VotingPower: "1.000000000000000000"},
+					&v1.ArchivedVote{ProposalId: 2, Voter: delegatorAddr, VotingPower: "0.000000000000000000"},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "duplicate archived votes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.ArchivedVotes = append(state.ArchivedVotes,
+					&v1.ArchivedVote{ProposalId: 1, Voter: delegatorAddr, VotingPower: "1.000000000000000000"},
+					&v1.ArchivedVote{ProposalId: 1, Voter: delegatorAddr, VotingPower: "2.000000000000000000"},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate archived vote",
+		},
+		{
+			name: "archived vote with negative voting power",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.ArchivedVotes = append(state.ArchivedVotes,
+					&v1.ArchivedVote{ProposalId: 1, Voter: delegatorAddr, VotingPower: "-1.000000000000000000"},
+				)
+
+				return state
+			},
+			expErrMsg: "has negative voting power",
+		},
+		{
+			name: "valid voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "1.000000000000000000"},
+				)
+				state.GovernorPowerSnapshots = append(state.GovernorPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "2.000000000000000000"},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "duplicate voting power snapshots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "1.000000000000000000"},
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "2.000000000000000000"},
+				)
+
+				return state
+			},
+			expErrMsg: "duplicate snapshot",
+		},
+		{
+			name: "governor power snapshot of non-existent proposal",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.GovernorPowerSnapshots = append(state.GovernorPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "1.000000000000000000"},
+				)
+
+				return state
+			},
+			expErrMsg: "invalid governor power snapshots: snapshot",
+		},
+		{
+			name: "voting power snapshot with negative voting power",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.VotingPowerSnapshots = append(state.VotingPowerSnapshots,
+					&v1.VotingPowerSnapshot{ProposalId: 1, Address: delegatorAddr, VotingPower: "-1.000000000000000000"},
+				)
+
+				return state
+			},
+			expErrMsg: "has negative voting power",
+		},
+		{
+			name: "valid laws",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				superseded := v1.NewLaw(1, "law", "text", "", 10, 1)
+				superseded.Status = v1.LawStatusSuperseded
+				superseded.SupersededBy = 2
+				state.Laws = append(state.Laws, &superseded)
+				law := v1.NewLaw(2, "law", "", v1.LawTextHash("text"), 20, 2)
+				state.Laws = append(state.Laws, &law)
+				state.NextLawId = 3
+
+				return state
+			},
+		},
+		{
+			name: "duplicate laws",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				law := v1.NewLaw(1, "law", "text", "", 10, 1)
+				state.Laws = append(state.Laws, &law, &law)
+
+				return state
+			},
+			expErrMsg: "duplicate law id",
+		},
+		{
+			name: "law id not lower than next law id",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				law := v1.NewLaw(2, "law", "text", "", 10, 1)
+				state.Laws = append(state.Laws, &law)
+				state.NextLawId = 2
+
+				return state
+			},
+			expErrMsg: "must be lower than next law id",
+		},
+		{
+			name: "law with mismatching text hash",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				law := v1.NewLaw(1, "law", "text", v1.LawTextHash("other text"), 10, 1)
+				state.Laws = append(state.Laws, &law)
+
+				return state
+			},
+			expErrMsg: "does not match the hash of the law text",
+		},
+		{
+			name: "superseded law without superseding law",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				law := v1.NewLaw(1, "law", "text", "", 10, 1)
+				law.Status = v1.LawStatusSuperseded
+				state.Laws = append(state.Laws, &law)
+
+				return state
+			},
+			expErrMsg: "has status",
+		},
+		{
+			name: "valid constitution history",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Constitution = "constitution\namended"
+				state.ConstitutionHistory = []*v1.ConstitutionVersion{
+					{Version: 1, Constitution: "constitution"},
+					{Version: 2, ProposalId: 1, Height: 10, Amendment: "--- src\n+++ dst\n@@ -1 +1,2 @@\n constitution\n+amended\n", Constitution: "constitution\namended"},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "non consecutive constitution versions",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Constitution = "constitution"
+				state.ConstitutionHistory = []*v1.ConstitutionVersion{
+					{Version: 1, Constitution: "constitution"},
+					{Version: 3, Constitution: "constitution"},
+				}
+
+				return state
+			},
+			expErrMsg: "versions must be consecutive",
+		},
+		{
+			name: "latest constitution version mismatch",
+			genesisState: func() *v1.GenesisState {
+				state := v1.DefaultGenesisState()
+				state.Constitution = "constitution"
+				state.ConstitutionHistory = []*v1.ConstitutionVersion{
+					{Version: 1, Constitution: "other constitution"},
+				}
+
+				return state
+			},
+			expErrMsg: "does not match the constitution",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,10 +4,11 @@
 package v1
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -68,6 +69,39 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_81545436827712cf, []int{0}
 }
 
+// TallyMode defines the method used to pick the winning option of a
+// multiple-choice proposal.
+type TallyMode int32
+
+const (
+	// TALLY_MODE_PLURALITY defines a tally where the option with the most
+	// voting power wins.
+	TallyMode_TALLY_MODE_PLURALITY TallyMode = 0
+	// TALLY_MODE_INSTANT_RUNOFF defines a tally where the options with the
+	// least voting power are eliminated one after the other, the voting power
+	// of each vote going to its highest weighted option still in the race,
+	// until an option has a majority of the voting power.
+	TallyMode_TALLY_MODE_INSTANT_RUNOFF TallyMode = 1
+)
+
+var TallyMode_name = map[int32]string{
+	0: "TALLY_MODE_PLURALITY",
+	1: "TALLY_MODE_INSTANT_RUNOFF",
+}
+
+var TallyMode_value = map[string]int32{
+	"TALLY_MODE_PLURALITY":      0,
+	"TALLY_MODE_INSTANT_RUNOFF": 1,
+}
+
+func (x TallyMode) String() string {
+	return proto.EnumName(TallyMode_name, int32(x))
+}
+
+func (TallyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{1}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -92,6 +126,10 @@ const (
 	// PROPOSAL_STATUS_VETOED defines a proposal status of a proposal that has
 	// been vetoed.
 	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 6
+	// PROPOSAL_STATUS_PASSED_PENDING_EXECUTION defines a proposal status of a
+	// proposal that has passed and whose messages are executed once its
+	// execution delay has elapsed.
+	ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION ProposalStatus = 7
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,16 +140,18 @@ var ProposalStatus_name = map[int32]string{
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
 	6: "PROPOSAL_STATUS_VETOED",
+	7: "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
-	"PROPOSAL_STATUS_VETOED":         6,
+	"PROPOSAL_STATUS_UNSPECIFIED":              0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":           1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":            2,
+	"PROPOSAL_STATUS_PASSED":                   3,
+	"PROPOSAL_STATUS_REJECTED":                 4,
+	"PROPOSAL_STATUS_FAILED":                   5,
+	"PROPOSAL_STATUS_VETOED":                   6,
+	"PROPOSAL_STATUS_PASSED_PENDING_EXECUTION": 7,
 }
 
 func (x ProposalStatus) String() string {
@@ -119,7 +159,77 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{1}
+	return fileDescriptor_81545436827712cf, []int{2}
+}
+
+// GovernorStatus is the status of a governor.
+type GovernorStatus int32
+
+const (
+	// GOVERNOR_STATUS_UNSPECIFIED defines an invalid governor status.
+	GovernorStatusUnspecified GovernorStatus = 0
+	// GOVERNOR_STATUS_ACTIVE defines an active governor, whose votes are
+	// counted for its governance delegators.
+	GovernorStatusActive GovernorStatus = 1
+	// GOVERNOR_STATUS_INACTIVE defines an inactive governor, whose votes only
+	// count for its own stake.
+	GovernorStatusInactive GovernorStatus = 2
+)
+
+var GovernorStatus_name = map[int32]string{
+	0: "GOVERNOR_STATUS_UNSPECIFIED",
+	1: "GOVERNOR_STATUS_ACTIVE",
+	2: "GOVERNOR_STATUS_INACTIVE",
+}
+
+var GovernorStatus_value = map[string]int32{
+	"GOVERNOR_STATUS_UNSPECIFIED": 0,
+	"GOVERNOR_STATUS_ACTIVE":      1,
+	"GOVERNOR_STATUS_INACTIVE":    2,
+}
+
+func (x GovernorStatus) String() string {
+	return proto.EnumName(GovernorStatus_name, int32(x))
+}
+
+func (GovernorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{3}
+}
+
+// LawStatus is the status of a law.
+type LawStatus int32
+
+const (
+	// LAW_STATUS_UNSPECIFIED defines an invalid law status.
+	LawStatusUnspecified LawStatus = 0
+	// LAW_STATUS_IN_FORCE defines a law that is in force.
+	LawStatusInForce LawStatus = 1
+	// LAW_STATUS_SUPERSEDED defines a law that was superseded by a later law.
+	LawStatusSuperseded LawStatus = 2
+	// LAW_STATUS_REPEALED defines a law that was repealed.
+	LawStatusRepealed LawStatus = 3
+)
+
+var LawStatus_name = map[int32]string{
+	0: "LAW_STATUS_UNSPECIFIED",
+	1: "LAW_STATUS_IN_FORCE",
+	2: "LAW_STATUS_SUPERSEDED",
+	3: "LAW_STATUS_REPEALED",
+}
+
+var LawStatus_value = map[string]int32{
+	"LAW_STATUS_UNSPECIFIED": 0,
+	"LAW_STATUS_IN_FORCE":    1,
+	"LAW_STATUS_SUPERSEDED":  2,
+	"LAW_STATUS_REPEALED":    3,
+}
+
+func (x LawStatus) String() string {
+	return proto.EnumName(LawStatus_name, int32(x))
+}
+
+func (LawStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{4}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -178,6 +288,118 @@ func (m *WeightedVoteOption) GetWeight() string {
 	return ""
 }
 
+// MultipleChoiceOption defines a named option of a multiple-choice proposal,
+// along with the messages executed if the option wins.
+type MultipleChoiceOption struct {
+	// name is the name of the option, unique within the proposal.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// messages are the arbitrary messages to be executed if the option wins.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MultipleChoiceOption) Reset()         { *m = MultipleChoiceOption{} }
+func (m *MultipleChoiceOption) String() string { return proto.CompactTextString(m) }
+func (*MultipleChoiceOption) ProtoMessage()    {}
+func (*MultipleChoiceOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{1}
+}
+func (m *MultipleChoiceOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultipleChoiceOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultipleChoiceOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultipleChoiceOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleChoiceOption.Merge(m, src)
+}
+func (m *MultipleChoiceOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultipleChoiceOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleChoiceOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleChoiceOption proto.InternalMessageInfo
+
+func (m *MultipleChoiceOption) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MultipleChoiceOption) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// WeightedChoice defines a unit of vote on a multiple-choice proposal.
+type WeightedChoice struct {
+	// option is the index of the option in the options of the proposal, it must
+	// not contain duplicate options.
+	Option uint32 `protobuf:"varint,1,opt,name=option,proto3" json:"option,omitempty"`
+	// weight is the vote weight associated with the option.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedChoice) Reset()         { *m = WeightedChoice{} }
+func (m *WeightedChoice) String() string { return proto.CompactTextString(m) }
+func (*WeightedChoice) ProtoMessage()    {}
+func (*WeightedChoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{2}
+}
+func (m *WeightedChoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedChoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedChoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedChoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedChoice.Merge(m, src)
+}
+func (m *WeightedChoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedChoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedChoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedChoice proto.InternalMessageInfo
+
+func (m *WeightedChoice) GetOption() uint32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+func (m *WeightedChoice) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -186,14 +408,14 @@ type Deposit struct {
 	// depositor defines the deposit addresses from the proposals.
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
-	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{1}
+	return fileDescriptor_81545436827712cf, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +458,7 @@ func (m *Deposit) GetDepositor() string {
 	return ""
 }
 
-func (m *Deposit) GetAmount() []types.Coin {
+func (m *Deposit) GetAmount() []types1.Coin {
 	if m != nil {
 		return m.Amount
 	}
@@ -247,7 +469,7 @@ func (m *Deposit) GetAmount() []types.Coin {
 // was updated in the store, both its value and a timestamp
 type LastMinDeposit struct {
 	// value is the value of the minimum deposit
-	Value []types.Coin `protobuf:"bytes,1,rep,name=value,proto3" json:"value"`
+	Value []types1.Coin `protobuf:"bytes,1,rep,name=value,proto3" json:"value"`
 	// time is the time the minimum deposit was last updated
 	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
 }
@@ -256,7 +478,7 @@ func (m *LastMinDeposit) Reset()         { *m = LastMinDeposit{} }
 func (m *LastMinDeposit) String() string { return proto.CompactTextString(m) }
 func (*LastMinDeposit) ProtoMessage()    {}
 func (*LastMinDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{2}
+	return fileDescriptor_81545436827712cf, []int{4}
 }
 func (m *LastMinDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +507,7 @@ func (m *LastMinDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastMinDeposit proto.InternalMessageInfo
 
-func (m *LastMinDeposit) GetValue() []types.Coin {
+func (m *LastMinDeposit) GetValue() []types1.Coin {
 	if m != nil {
 		return m.Value
 	}
@@ -304,7 +526,7 @@ type Proposal struct {
 	// id defines the unique id of the proposal.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// messages are the arbitrary messages to be executed if the proposal passes.
-	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 	// status defines the proposal status.
 	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hikari.gov.v1.ProposalStatus" json:"status,omitempty"`
 	// final_tally_result is the final tally result of the proposal. When
@@ -316,7 +538,7 @@ type Proposal struct {
 	// deposit_end_time is the end time for deposition.
 	DepositEndTime *time.Time `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
 	// total_deposit is the total deposit on the proposal.
-	TotalDeposit []types.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
+	TotalDeposit []types1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
 	// voting_start_time is the starting time to vote on a proposal.
 	VotingStartTime *time.Time `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time,omitempty"`
 	// voting_end_time is the end time of voting on a proposal.
@@ -338,19 +560,45 @@ type Proposal struct {
 	// endorsed is a boolean indicating whether the proposal has been endorsed
 	// by the Steering DAO.
 	Endorsed bool `protobuf:"varint,14,opt,name=endorsed,proto3" json:"endorsed,omitempty"`
-	// annotation is an optional field that contains annotations
-	// added by the Steering DAO.
+	// annotation is an optional field that contains the latest annotation
+	// added by the Steering DAO. The full annotation history of the proposal
+	// is kept by the x/coredaos module.
 	Annotation string `protobuf:"bytes,15,opt,name=annotation,proto3" json:"annotation,omitempty"`
 	// times_voting_period_extended is the number of times the voting period
 	// has been extended from one of the core DAOs.
 	TimesVotingPeriodExtended uint32 `protobuf:"varint,16,opt,name=times_voting_period_extended,json=timesVotingPeriodExtended,proto3" json:"times_voting_period_extended,omitempty"`
+	// constitution_amendment_conflict is true if the constitution amendments of
+	// the proposal could not be rebased onto an amendment of the constitution
+	// enacted while the proposal was pending, and thus no longer apply cleanly.
+	ConstitutionAmendmentConflict bool `protobuf:"varint,17,opt,name=constitution_amendment_conflict,json=constitutionAmendmentConflict,proto3" json:"constitution_amendment_conflict,omitempty"`
+	// metadata_hash is the hex-encoded SHA-256 hash of the off-chain metadata
+	// the metadata field points to, if provided at submission.
+	MetadataHash string `protobuf:"bytes,18,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty"`
+	// options are the options of a multiple-choice proposal, which has no
+	// messages of its own. Only the messages of the winning option are executed.
+	Options []*MultipleChoiceOption `protobuf:"bytes,19,rep,name=options,proto3" json:"options,omitempty"`
+	// tally_mode is the method used to pick the winning option of a
+	// multiple-choice proposal.
+	TallyMode TallyMode `protobuf:"varint,20,opt,name=tally_mode,json=tallyMode,proto3,enum=hikari.gov.v1.TallyMode" json:"tally_mode,omitempty"`
+	// execution_time is the time at which the messages of a proposal that
+	// passed are executed, set when the execution of the proposal is delayed.
+	ExecutionTime *time.Time `protobuf:"bytes,21,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
+	// expedited defines if the proposal is expedited. An expedited proposal
+	// that does not pass is converted to a regular proposal, which clears this
+	// flag.
+	Expedited bool `protobuf:"varint,22,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// voting_power_snapshot defines if the voting power of the voters on the
+	// proposal is snapshotted, which is decided when its voting period starts.
+	// The voting power tallied for each voter is then the minimum of its
+	// snapshotted and current voting power.
+	VotingPowerSnapshot bool `protobuf:"varint,23,opt,name=voting_power_snapshot,json=votingPowerSnapshot,proto3" json:"voting_power_snapshot,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{3}
+	return fileDescriptor_81545436827712cf, []int{5}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +634,7 @@ func (m *Proposal) GetId() uint64 {
 	return 0
 }
 
-func (m *Proposal) GetMessages() []*types1.Any {
+func (m *Proposal) GetMessages() []*types.Any {
 	if m != nil {
 		return m.Messages
 	}
@@ -421,7 +669,7 @@ func (m *Proposal) GetDepositEndTime() *time.Time {
 	return nil
 }
 
-func (m *Proposal) GetTotalDeposit() []types.Coin {
+func (m *Proposal) GetTotalDeposit() []types1.Coin {
 	if m != nil {
 		return m.TotalDeposit
 	}
@@ -491,6 +739,55 @@ func (m *Proposal) GetTimesVotingPeriodExtended() uint32 {
 	return 0
 }
 
+func (m *Proposal) GetConstitutionAmendmentConflict() bool {
+	if m != nil {
+		return m.ConstitutionAmendmentConflict
+	}
+	return false
+}
+
+func (m *Proposal) GetMetadataHash() string {
+	if m != nil {
+		return m.MetadataHash
+	}
+	return ""
+}
+
+func (m *Proposal) GetOptions() []*MultipleChoiceOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Proposal) GetTallyMode() TallyMode {
+	if m != nil {
+		return m.TallyMode
+	}
+	return TallyMode_TALLY_MODE_PLURALITY
+}
+
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetVotingPowerSnapshot() bool {
+	if m != nil {
+		return m.VotingPowerSnapshot
+	}
+	return false
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -499,13 +796,19 @@ type TallyResult struct {
 	AbstainCount string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
 	// no_count is the number of no votes on a proposal.
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	// option_counts are the voting power given to each option of a
+	// multiple-choice proposal, in the order of the options.
+	OptionCounts []string `protobuf:"bytes,4,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
+	// winning_option is the name of the winning option of a multiple-choice
+	// proposal, empty if no option won.
+	WinningOption string `protobuf:"bytes,5,opt,name=winning_option,json=winningOption,proto3" json:"winning_option,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{4}
+	return fileDescriptor_81545436827712cf, []int{6}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,6 +858,20 @@ func (m *TallyResult) GetNoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetOptionCounts() []string {
+	if m != nil {
+		return m.OptionCounts
+	}
+	return nil
+}
+
+func (m *TallyResult) GetWinningOption() string {
+	if m != nil {
+		return m.WinningOption
+	}
+	return ""
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -566,13 +883,15 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// choices is the weighted choices of a vote on a multiple-choice proposal.
+	Choices []*WeightedChoice `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{5}
+	return fileDescriptor_81545436827712cf, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,31 +948,46 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
-// QuorumCheckQueueEntry defines a quorum check queue entry.
-type QuorumCheckQueueEntry struct {
-	// quorum_timeout_time is the time after which quorum checks start happening
-	// and voting period is extended if proposal reaches quorum.
-	QuorumTimeoutTime *time.Time `protobuf:"bytes,1,opt,name=quorum_timeout_time,json=quorumTimeoutTime,proto3,stdtime" json:"quorum_timeout_time,omitempty"`
-	// quorum_check_count is the number of times quorum will be checked.
-	// This is a snapshot of the parameter value with the same name when the
-	// proposal is initially added to the queue.
-	QuorumCheckCount uint64 `protobuf:"varint,2,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
-	// quorum_checks_done is the number of quorum checks that have been done.
-	QuorumChecksDone uint64 `protobuf:"varint,3,opt,name=quorum_checks_done,json=quorumChecksDone,proto3" json:"quorum_checks_done,omitempty"`
+func (m *Vote) GetChoices() []*WeightedChoice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
 }
 
-func (m *QuorumCheckQueueEntry) Reset()         { *m = QuorumCheckQueueEntry{} }
-func (m *QuorumCheckQueueEntry) String() string { return proto.CompactTextString(m) }
-func (*QuorumCheckQueueEntry) ProtoMessage()    {}
-func (*QuorumCheckQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{6}
+// ArchivedVote is a vote archived when its proposal was tallied at the end of
+// its voting period, along with the voting power it was tallied with.
+type ArchivedVote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// voting_power is the voting power of the voter at tally time, including
+	// the voting power inherited from its governance delegators if the voter
+	// is a governor.
+	VotingPower string `protobuf:"bytes,5,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// archive_time is the time the vote was archived.
+	ArchiveTime time.Time `protobuf:"bytes,6,opt,name=archive_time,json=archiveTime,proto3,stdtime" json:"archive_time"`
+	// choices is the weighted choices of a vote on a multiple-choice proposal.
+	Choices []*WeightedChoice `protobuf:"bytes,7,rep,name=choices,proto3" json:"choices,omitempty"`
+}
+
+func (m *ArchivedVote) Reset()         { *m = ArchivedVote{} }
+func (m *ArchivedVote) String() string { return proto.CompactTextString(m) }
+func (*ArchivedVote) ProtoMessage()    {}
+func (*ArchivedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{8}
 }
-func (m *QuorumCheckQueueEntry) XXX_Unmarshal(b []byte) error {
+func (m *ArchivedVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ArchivedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuorumCheckQueueEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ArchivedVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -663,60 +997,93 @@ func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QuorumCheckQueueEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuorumCheckQueueEntry.Merge(m, src)
+func (m *ArchivedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedVote.Merge(m, src)
 }
-func (m *QuorumCheckQueueEntry) XXX_Size() int {
+func (m *ArchivedVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuorumCheckQueueEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuorumCheckQueueEntry.DiscardUnknown(m)
+func (m *ArchivedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuorumCheckQueueEntry proto.InternalMessageInfo
+var xxx_messageInfo_ArchivedVote proto.InternalMessageInfo
 
-func (m *QuorumCheckQueueEntry) GetQuorumTimeoutTime() *time.Time {
+func (m *ArchivedVote) GetProposalId() uint64 {
 	if m != nil {
-		return m.QuorumTimeoutTime
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ArchivedVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ArchivedVote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
 	return nil
 }
 
-func (m *QuorumCheckQueueEntry) GetQuorumCheckCount() uint64 {
+func (m *ArchivedVote) GetMetadata() string {
 	if m != nil {
-		return m.QuorumCheckCount
+		return m.Metadata
 	}
-	return 0
+	return ""
 }
 
-func (m *QuorumCheckQueueEntry) GetQuorumChecksDone() uint64 {
+func (m *ArchivedVote) GetVotingPower() string {
 	if m != nil {
-		return m.QuorumChecksDone
+		return m.VotingPower
 	}
-	return 0
+	return ""
 }
 
-// DepositParams defines the params for deposits on governance proposals.
-type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+func (m *ArchivedVote) GetArchiveTime() time.Time {
+	if m != nil {
+		return m.ArchiveTime
+	}
+	return time.Time{}
 }
 
-func (m *DepositParams) Reset()         { *m = DepositParams{} }
-func (m *DepositParams) String() string { return proto.CompactTextString(m) }
-func (*DepositParams) ProtoMessage()    {}
-func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{7}
+func (m *ArchivedVote) GetChoices() []*WeightedChoice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
 }
-func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+
+// VotingPowerSnapshot defines the snapshotted voting power of an address on a
+// proposal in voting period. The voting power of a voter is snapshotted before
+// its delegations are first modified during the voting period and when it
+// votes, and the voting power delegated to a governor by its governance
+// delegators when the voting period starts.
+type VotingPowerSnapshot struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the address of the voter or of the governor.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// voting_power is the snapshotted voting power.
+	VotingPower string `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{9}
+}
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -726,21 +1093,151 @@ func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DepositParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositParams.Merge(m, src)
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
 }
-func (m *DepositParams) XXX_Size() int {
+func (m *VotingPowerSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *VotingPowerSnapshot) GetProposalId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VotingPowerSnapshot) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+// QuorumCheckQueueEntry defines a quorum check queue entry.
+type QuorumCheckQueueEntry struct {
+	// quorum_timeout_time is the time after which quorum checks start happening
+	// and voting period is extended if proposal reaches quorum.
+	QuorumTimeoutTime *time.Time `protobuf:"bytes,1,opt,name=quorum_timeout_time,json=quorumTimeoutTime,proto3,stdtime" json:"quorum_timeout_time,omitempty"`
+	// quorum_check_count is the number of times quorum will be checked.
+	// This is a snapshot of the parameter value with the same name when the
+	// proposal is initially added to the queue.
+	QuorumCheckCount uint64 `protobuf:"varint,2,opt,name=quorum_check_count,json=quorumCheckCount,proto3" json:"quorum_check_count,omitempty"`
+	// quorum_checks_done is the number of quorum checks that have been done.
+	QuorumChecksDone uint64 `protobuf:"varint,3,opt,name=quorum_checks_done,json=quorumChecksDone,proto3" json:"quorum_checks_done,omitempty"`
+}
+
+func (m *QuorumCheckQueueEntry) Reset()         { *m = QuorumCheckQueueEntry{} }
+func (m *QuorumCheckQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*QuorumCheckQueueEntry) ProtoMessage()    {}
+func (*QuorumCheckQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{10}
+}
+func (m *QuorumCheckQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumCheckQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumCheckQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumCheckQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumCheckQueueEntry.Merge(m, src)
+}
+func (m *QuorumCheckQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumCheckQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumCheckQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumCheckQueueEntry proto.InternalMessageInfo
+
+func (m *QuorumCheckQueueEntry) GetQuorumTimeoutTime() *time.Time {
+	if m != nil {
+		return m.QuorumTimeoutTime
+	}
+	return nil
+}
+
+func (m *QuorumCheckQueueEntry) GetQuorumCheckCount() uint64 {
+	if m != nil {
+		return m.QuorumCheckCount
+	}
+	return 0
+}
+
+func (m *QuorumCheckQueueEntry) GetQuorumChecksDone() uint64 {
+	if m != nil {
+		return m.QuorumChecksDone
+	}
+	return 0
+}
+
+// DepositParams defines the params for deposits on governance proposals.
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types1.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+}
+
+func (m *DepositParams) Reset()         { *m = DepositParams{} }
+func (m *DepositParams) String() string { return proto.CompactTextString(m) }
+func (*DepositParams) ProtoMessage()    {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{11}
+}
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+func (m *DepositParams) GetMinDeposit() []types1.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
 	return nil
 }
@@ -762,7 +1259,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{8}
+	return fileDescriptor_81545436827712cf, []int{12}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +1318,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{9}
+	return fileDescriptor_81545436827712cf, []int{13}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,7 +1395,7 @@ func (m *TallyParams) GetLawThreshold() string {
 type MinDepositThrottler struct {
 	// Floor value for the minimum deposit required for a proposal to enter the
 	// voting period.
-	FloorValue []types.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
+	FloorValue []types1.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
 	// Duration that dictates after how long the dynamic minimum deposit should be
 	// recalculated for time-based decreases.
 	UpdatePeriod *time.Duration `protobuf:"bytes,2,opt,name=update_period,json=updatePeriod,proto3,stdduration" json:"update_period,omitempty"`
@@ -915,13 +1412,16 @@ type MinDepositThrottler struct {
 	// The higher the number, the lower the sensitivity. A value of 1 represents
 	// the highest sensitivity.
 	DecreaseSensitivityTargetDistance uint64 `protobuf:"varint,6,opt,name=decrease_sensitivity_target_distance,json=decreaseSensitivityTargetDistance,proto3" json:"decrease_sensitivity_target_distance,omitempty"`
+	// The multiplier applied to the minimum deposit of regular proposals to
+	// get the minimum deposit of expedited proposals.
+	ExpeditedMultiplier string `protobuf:"bytes,7,opt,name=expedited_multiplier,json=expeditedMultiplier,proto3" json:"expedited_multiplier,omitempty"`
 }
 
 func (m *MinDepositThrottler) Reset()         { *m = MinDepositThrottler{} }
 func (m *MinDepositThrottler) String() string { return proto.CompactTextString(m) }
 func (*MinDepositThrottler) ProtoMessage()    {}
 func (*MinDepositThrottler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{10}
+	return fileDescriptor_81545436827712cf, []int{14}
 }
 func (m *MinDepositThrottler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1450,7 @@ func (m *MinDepositThrottler) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MinDepositThrottler proto.InternalMessageInfo
 
-func (m *MinDepositThrottler) GetFloorValue() []types.Coin {
+func (m *MinDepositThrottler) GetFloorValue() []types1.Coin {
 	if m != nil {
 		return m.FloorValue
 	}
@@ -992,10 +1492,17 @@ func (m *MinDepositThrottler) GetDecreaseSensitivityTargetDistance() uint64 {
 	return 0
 }
 
+func (m *MinDepositThrottler) GetExpeditedMultiplier() string {
+	if m != nil {
+		return m.ExpeditedMultiplier
+	}
+	return ""
+}
+
 type MinInitialDepositThrottler struct {
 	// Floor value for the minimum initial deposit required for a proposal to
 	// enter the deposit period.
-	FloorValue []types.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
+	FloorValue []types1.Coin `protobuf:"bytes,1,rep,name=floor_value,json=floorValue,proto3" json:"floor_value"`
 	// Duration that dictates after how long the dynamic minimum deposit should be
 	// recalculated for time-based decreases.
 	UpdatePeriod *time.Duration `protobuf:"bytes,2,opt,name=update_period,json=updatePeriod,proto3,stdduration" json:"update_period,omitempty"`
@@ -1019,7 +1526,7 @@ func (m *MinInitialDepositThrottler) Reset()         { *m = MinInitialDepositThr
 func (m *MinInitialDepositThrottler) String() string { return proto.CompactTextString(m) }
 func (*MinInitialDepositThrottler) ProtoMessage()    {}
 func (*MinInitialDepositThrottler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{11}
+	return fileDescriptor_81545436827712cf, []int{15}
 }
 func (m *MinInitialDepositThrottler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1555,7 @@ func (m *MinInitialDepositThrottler) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MinInitialDepositThrottler proto.InternalMessageInfo
 
-func (m *MinInitialDepositThrottler) GetFloorValue() []types.Coin {
+func (m *MinInitialDepositThrottler) GetFloorValue() []types1.Coin {
 	if m != nil {
 		return m.FloorValue
 	}
@@ -1100,7 +1607,7 @@ type Params struct {
 	// While setting this value returns an error, when queried it is set to the
 	// value of the current minimum deposit value as determined by the dynamic
 	// system for backward compatibility.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"` // Deprecated: Do not use.
+	MinDeposit []types1.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"` // Deprecated: Do not use.
 	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	// months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
@@ -1156,13 +1663,55 @@ type Params struct {
 	ConstitutionAmendmentQuorumRange *QuorumRange `protobuf:"bytes,27,opt,name=constitution_amendment_quorum_range,json=constitutionAmendmentQuorumRange,proto3" json:"constitution_amendment_quorum_range,omitempty"`
 	// Achievable quorum for law proposals
 	LawQuorumRange *QuorumRange `protobuf:"bytes,28,opt,name=law_quorum_range,json=lawQuorumRange,proto3" json:"law_quorum_range,omitempty"`
+	// Minimum amount of bonded tokens an account must self-delegate to its
+	// validators to become an active governor.
+	MinGovernorSelfDelegation string `protobuf:"bytes,29,opt,name=min_governor_self_delegation,json=minGovernorSelfDelegation,proto3" json:"min_governor_self_delegation,omitempty"`
+	// Minimum duration between two status changes of a governor.
+	GovernorStatusChangePeriod *time.Duration `protobuf:"bytes,30,opt,name=governor_status_change_period,json=governorStatusChangePeriod,proto3,stdduration" json:"governor_status_change_period,omitempty"`
+	// Whether the votes of a proposal are archived when it is tallied at the
+	// end of its voting period. Votes are only archived if the archive is also
+	// enabled in the keeper config.
+	ArchiveVotes bool `protobuf:"varint,31,opt,name=archive_votes,json=archiveVotes,proto3" json:"archive_votes,omitempty"`
+	// Duration after which the archived votes of a proposal are pruned. Zero
+	// means the archived votes are never pruned.
+	VoteArchiveRetentionPeriod *time.Duration `protobuf:"bytes,32,opt,name=vote_archive_retention_period,json=voteArchiveRetentionPeriod,proto3,stdduration" json:"vote_archive_retention_period,omitempty"`
+	// Whether every new proposal must commit to its off-chain metadata with a
+	// metadata hash.
+	RequireMetadataHash bool `protobuf:"varint,33,opt,name=require_metadata_hash,json=requireMetadataHash,proto3" json:"require_metadata_hash,omitempty"`
+	// Delay between the end of the voting period of a proposal that passed and
+	// the execution of its messages. Zero means the messages are executed
+	// right away.
+	ExecutionDelay *time.Duration `protobuf:"bytes,34,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
+	// Execution delay of the proposals that enact or repeal a law.
+	LawExecutionDelay *time.Duration `protobuf:"bytes,35,opt,name=law_execution_delay,json=lawExecutionDelay,proto3,stdduration" json:"law_execution_delay,omitempty"`
+	// Execution delay of the proposals that amend the constitution.
+	ConstitutionAmendmentExecutionDelay *time.Duration `protobuf:"bytes,36,opt,name=constitution_amendment_execution_delay,json=constitutionAmendmentExecutionDelay,proto3,stdduration" json:"constitution_amendment_execution_delay,omitempty"`
+	// The cancel ratio which will not be returned back to the depositors when a
+	// proposal is canceled by its proposer. Default value: 0.5.
+	ProposalCancelRatio string `protobuf:"bytes,37,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// The address which will receive (proposal_cancel_ratio * deposit) proposal
+	// deposits. If empty, the deposits are burned. If set to the distribution
+	// module address, the deposits are sent to the community pool.
+	ProposalCancelDest string `protobuf:"bytes,38,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	// Duration of the voting period of an expedited proposal.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,39,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for an expedited proposal to pass.
+	ExpeditedThreshold string `protobuf:"bytes,40,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	// Whether the voting power of the voters on the proposals entering the
+	// voting period is snapshotted, to prevent moving stake at the end of the
+	// voting period to swing a vote. The voting power of a voter is
+	// snapshotted before its delegations are first modified during the voting
+	// period and when it votes, and the voting power delegated to each
+	// governor when the voting period starts. The voting power tallied is then
+	// the minimum of the snapshotted and current voting power.
+	SnapshotVotingPower bool `protobuf:"varint,41,opt,name=snapshot_voting_power,json=snapshotVotingPower,proto3" json:"snapshot_voting_power,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{12}
+	return fileDescriptor_81545436827712cf, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1741,7 @@ func (m *Params) XXX_DiscardUnknown() {
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
 // Deprecated: Do not use.
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *Params) GetMinDeposit() []types1.Coin {
 	if m != nil {
 		return m.MinDeposit
 	}
@@ -1350,6 +1899,97 @@ func (m *Params) GetLawQuorumRange() *QuorumRange {
 	return nil
 }
 
+func (m *Params) GetMinGovernorSelfDelegation() string {
+	if m != nil {
+		return m.MinGovernorSelfDelegation
+	}
+	return ""
+}
+
+func (m *Params) GetGovernorStatusChangePeriod() *time.Duration {
+	if m != nil {
+		return m.GovernorStatusChangePeriod
+	}
+	return nil
+}
+
+func (m *Params) GetArchiveVotes() bool {
+	if m != nil {
+		return m.ArchiveVotes
+	}
+	return false
+}
+
+func (m *Params) GetVoteArchiveRetentionPeriod() *time.Duration {
+	if m != nil {
+		return m.VoteArchiveRetentionPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetRequireMetadataHash() bool {
+	if m != nil {
+		return m.RequireMetadataHash
+	}
+	return false
+}
+
+func (m *Params) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return nil
+}
+
+func (m *Params) GetLawExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.LawExecutionDelay
+	}
+	return nil
+}
+
+func (m *Params) GetConstitutionAmendmentExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ConstitutionAmendmentExecutionDelay
+	}
+	return nil
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetSnapshotVotingPower() bool {
+	if m != nil {
+		return m.SnapshotVotingPower
+	}
+	return false
+}
+
 type QuorumRange struct {
 	// Maximum achievable quorum
 	Max string `protobuf:"bytes,1,opt,name=max,proto3" json:"max,omitempty"`
@@ -1361,7 +2001,7 @@ func (m *QuorumRange) Reset()         { *m = QuorumRange{} }
 func (m *QuorumRange) String() string { return proto.CompactTextString(m) }
 func (*QuorumRange) ProtoMessage()    {}
 func (*QuorumRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81545436827712cf, []int{13}
+	return fileDescriptor_81545436827712cf, []int{17}
 }
 func (m *QuorumRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,589 +2044,907 @@ func (m *QuorumRange) GetMin() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("hikari.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("hikari.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "hikari.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "hikari.gov.v1.Deposit")
-	proto.RegisterType((*LastMinDeposit)(nil), "hikari.gov.v1.LastMinDeposit")
-	proto.RegisterType((*Proposal)(nil), "hikari.gov.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "hikari.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "hikari.gov.v1.Vote")
-	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "hikari.gov.v1.QuorumCheckQueueEntry")
-	proto.RegisterType((*DepositParams)(nil), "hikari.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "hikari.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "hikari.gov.v1.TallyParams")
-	proto.RegisterType((*MinDepositThrottler)(nil), "hikari.gov.v1.MinDepositThrottler")
-	proto.RegisterType((*MinInitialDepositThrottler)(nil), "hikari.gov.v1.MinInitialDepositThrottler")
-	proto.RegisterType((*Params)(nil), "hikari.gov.v1.Params")
-	proto.RegisterType((*QuorumRange)(nil), "hikari.gov.v1.QuorumRange")
+// GovernorDescription defines a governor description.
+type GovernorDescription struct {
+	// moniker defines a human-readable name for the governor.
+	Moniker string `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// identity defines an optional identity signature (ex. UPort or Keybase).
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	// website defines an optional website link.
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// security_contact defines an optional email for security contact.
+	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
+	// details define other optional details.
+	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func init() { proto.RegisterFile("hikari/gov/v1/gov.proto", fileDescriptor_81545436827712cf) }
-
-var fileDescriptor_81545436827712cf = []byte{
-	// 1877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x58, 0xb2, 0x6c, 0x3f, 0x59, 0xf2, 0xb8, 0xed, 0xc4, 0x63, 0x3b, 0x96, 0x1d, 0xb1,
-	0x45, 0x39, 0x21, 0x91, 0x70, 0xb2, 0xec, 0x61, 0xa1, 0x8a, 0x92, 0x2d, 0x65, 0xa3, 0x10, 0x5b,
-	0xca, 0x48, 0x6b, 0x58, 0x0e, 0x4c, 0xb5, 0x35, 0x1d, 0x79, 0x2a, 0x9a, 0x6e, 0x65, 0xa6, 0xa5,
-	0x58, 0x57, 0xfe, 0x82, 0xad, 0xe2, 0x02, 0x9c, 0x38, 0x72, 0xe4, 0xb0, 0xc5, 0x91, 0x1b, 0x55,
-	0x7b, 0x5c, 0xf6, 0x04, 0x97, 0x40, 0x25, 0x07, 0xaa, 0xf2, 0x2f, 0x70, 0xa1, 0xfa, 0x63, 0xf4,
-	0x65, 0x29, 0xb6, 0x53, 0x50, 0x45, 0x71, 0xb1, 0xd5, 0xfd, 0x7e, 0xbf, 0xf7, 0x5e, 0xf7, 0xfb,
-	0xe8, 0x27, 0xc1, 0xfa, 0x99, 0xf7, 0x02, 0x07, 0x5e, 0xbe, 0xc9, 0xba, 0xf9, 0xee, 0xbe, 0xf8,
-	0x97, 0x6b, 0x07, 0x8c, 0x33, 0x94, 0x52, 0x82, 0x9c, 0xd8, 0xe9, 0xee, 0x6f, 0x66, 0x1a, 0x2c,
-	0xf4, 0x59, 0x98, 0x3f, 0xc5, 0x21, 0xc9, 0x77, 0xf7, 0x4f, 0x09, 0xc7, 0xfb, 0xf9, 0x06, 0xf3,
-	0xa8, 0x82, 0x6f, 0xae, 0x35, 0x59, 0x93, 0xc9, 0x8f, 0x79, 0xf1, 0x49, 0xef, 0xee, 0x34, 0x19,
-	0x6b, 0xb6, 0x48, 0x5e, 0xae, 0x4e, 0x3b, 0xcf, 0xf3, 0xdc, 0xf3, 0x49, 0xc8, 0xb1, 0xdf, 0xd6,
-	0x80, 0x8d, 0x71, 0x00, 0xa6, 0x3d, 0x2d, 0xca, 0x8c, 0x8b, 0xdc, 0x4e, 0x80, 0xb9, 0xc7, 0x22,
-	0x8b, 0x1b, 0xca, 0x23, 0x47, 0x19, 0x55, 0x0b, 0x2d, 0x5a, 0xc1, 0xbe, 0x47, 0x59, 0x5e, 0xfe,
-	0x55, 0x5b, 0x59, 0x06, 0xe8, 0xa7, 0xc4, 0x6b, 0x9e, 0x71, 0xe2, 0x9e, 0x30, 0x4e, 0x2a, 0x6d,
-	0xa1, 0x09, 0xed, 0x43, 0x82, 0xc9, 0x4f, 0x96, 0xb1, 0x6b, 0xec, 0xa5, 0x1f, 0x6c, 0xe4, 0x46,
-	0x4e, 0x9d, 0x1b, 0x40, 0x6d, 0x0d, 0x44, 0xdf, 0x85, 0xc4, 0x2b, 0xa9, 0xc8, 0x9a, 0xdd, 0x35,
-	0xf6, 0x16, 0x0f, 0xd2, 0xdf, 0x7e, 0x75, 0x1f, 0xb4, 0xf5, 0x22, 0x69, 0xd8, 0x5a, 0x9a, 0xfd,
-	0x9d, 0x01, 0xf3, 0x45, 0xd2, 0x66, 0xa1, 0xc7, 0xd1, 0x0e, 0x24, 0xdb, 0x01, 0x6b, 0xb3, 0x10,
-	0xb7, 0x1c, 0xcf, 0x95, 0xb6, 0xe2, 0x36, 0x44, 0x5b, 0x65, 0x17, 0x7d, 0x02, 0x8b, 0xae, 0xc2,
-	0xb2, 0x40, 0xeb, 0xb5, 0xbe, 0xfd, 0xea, 0xfe, 0x9a, 0xd6, 0x5b, 0x70, 0xdd, 0x80, 0x84, 0x61,
-	0x8d, 0x07, 0x1e, 0x6d, 0xda, 0x03, 0x28, 0xfa, 0x11, 0x24, 0xb0, 0xcf, 0x3a, 0x94, 0x5b, 0xb1,
-	0xdd, 0xd8, 0x5e, 0xf2, 0xc1, 0x46, 0x4e, 0x33, 0x44, 0x98, 0x72, 0x3a, 0x4c, 0xb9, 0x43, 0xe6,
-	0xd1, 0x83, 0xc5, 0xaf, 0x5f, 0xef, 0xcc, 0xfc, 0xfe, 0x9f, 0x7f, 0xb8, 0x6b, 0xd8, 0x9a, 0x93,
-	0xfd, 0xa5, 0x01, 0xe9, 0xa7, 0x38, 0xe4, 0x47, 0x1e, 0x8d, 0x3c, 0xfd, 0x14, 0xe6, 0xba, 0xb8,
-	0xd5, 0x21, 0x96, 0x71, 0x0d, 0x7d, 0x8a, 0x82, 0x3e, 0x86, 0xb8, 0x08, 0xaf, 0xf4, 0x3f, 0xf9,
-	0x60, 0x33, 0xa7, 0xe2, 0x97, 0x8b, 0xe2, 0x97, 0xab, 0x47, 0xb1, 0x3f, 0x88, 0x7f, 0xf9, 0xf7,
-	0x1d, 0xc3, 0x96, 0xe8, 0xec, 0x9f, 0x12, 0xb0, 0x50, 0xd5, 0x37, 0x81, 0xd2, 0x30, 0xdb, 0xbf,
-	0x9f, 0x59, 0xcf, 0x45, 0xdf, 0x87, 0x05, 0x9f, 0x84, 0x21, 0x6e, 0x92, 0xd0, 0x9a, 0x95, 0x1e,
-	0xad, 0x5d, 0x50, 0x5b, 0xa0, 0x3d, 0xbb, 0x8f, 0x42, 0x3f, 0x80, 0x44, 0xc8, 0x31, 0xef, 0x84,
-	0x56, 0x4c, 0x46, 0x74, 0x7b, 0x2c, 0xa2, 0x91, 0xa9, 0x9a, 0x04, 0xd9, 0x1a, 0x8c, 0x1e, 0x03,
-	0x7a, 0xee, 0x51, 0xdc, 0x72, 0x38, 0x6e, 0xb5, 0x7a, 0x4e, 0x40, 0xc2, 0x4e, 0x8b, 0x5b, 0x71,
-	0x7d, 0x92, 0x51, 0x15, 0x75, 0x01, 0xb1, 0x25, 0xc2, 0x36, 0x25, 0x6b, 0x68, 0x07, 0x15, 0x20,
-	0x19, 0x76, 0x4e, 0x7d, 0x8f, 0x3b, 0xf2, 0x32, 0xe6, 0xae, 0x78, 0x19, 0xa0, 0x48, 0x62, 0x1b,
-	0x3d, 0x01, 0x53, 0x87, 0xd8, 0x21, 0xd4, 0x55, 0x7a, 0x12, 0x57, 0xd4, 0x93, 0xd6, 0xcc, 0x12,
-	0x75, 0xa5, 0xae, 0x32, 0xa4, 0x38, 0xe3, 0xb8, 0xe5, 0xe8, 0x7d, 0x6b, 0xfe, 0x1a, 0x81, 0x5d,
-	0x92, 0xd4, 0x28, 0x37, 0x9e, 0xc2, 0x4a, 0x97, 0x71, 0x8f, 0x36, 0x9d, 0x90, 0xe3, 0x40, 0x9f,
-	0x6f, 0xe1, 0x8a, 0x7e, 0x2d, 0x2b, 0x6a, 0x4d, 0x30, 0xa5, 0x63, 0x8f, 0x41, 0x6f, 0x0d, 0xce,
-	0xb8, 0x78, 0x45, 0x5d, 0x29, 0x45, 0x8c, 0x8e, 0xb8, 0x29, 0x92, 0x84, 0x63, 0x17, 0x73, 0x6c,
-	0x81, 0xa8, 0x1d, 0xbb, 0xbf, 0x46, 0x6b, 0x30, 0xc7, 0x3d, 0xde, 0x22, 0x56, 0x52, 0x0a, 0xd4,
-	0x02, 0x59, 0x30, 0x1f, 0x76, 0x7c, 0x1f, 0x07, 0x3d, 0x6b, 0x49, 0xee, 0x47, 0x4b, 0xf4, 0x31,
-	0x2c, 0xa8, 0xb2, 0x24, 0x81, 0x95, 0xba, 0xa4, 0x0e, 0xfb, 0x48, 0xe1, 0x01, 0xa1, 0x2e, 0x0b,
-	0x42, 0xe2, 0x5a, 0xe9, 0x5d, 0x63, 0x6f, 0xc1, 0xee, 0xaf, 0x51, 0x06, 0x00, 0x53, 0xca, 0xb8,
-	0x6c, 0x5d, 0xd6, 0xb2, 0x34, 0x37, 0xb4, 0x83, 0x7e, 0x0c, 0xb7, 0x64, 0x53, 0x74, 0xf4, 0x6d,
-	0xb4, 0x49, 0xe0, 0x31, 0xd7, 0x21, 0xe7, 0x9c, 0x50, 0x97, 0xb8, 0x96, 0xb9, 0x6b, 0xec, 0xa5,
-	0xec, 0x0d, 0x89, 0x39, 0x91, 0x90, 0xaa, 0x44, 0x94, 0x34, 0x20, 0xfb, 0x5b, 0x03, 0x92, 0xc3,
-	0x09, 0xf8, 0x3d, 0x58, 0xec, 0x91, 0xd0, 0x69, 0xc8, 0xb6, 0x60, 0x5c, 0xe8, 0x51, 0x65, 0xca,
-	0xed, 0x85, 0x1e, 0x09, 0x0f, 0x85, 0x1c, 0x3d, 0x84, 0x14, 0x3e, 0x0d, 0x39, 0xf6, 0xa8, 0x26,
-	0xcc, 0x4e, 0x24, 0x2c, 0x69, 0x90, 0x22, 0xdd, 0x81, 0x05, 0xca, 0x34, 0x3e, 0x36, 0x11, 0x3f,
-	0x4f, 0x99, 0x84, 0x66, 0xff, 0x68, 0x40, 0x5c, 0x34, 0xd1, 0xcb, 0x5b, 0x60, 0x0e, 0xe6, 0xba,
-	0x8c, 0x93, 0xcb, 0xdb, 0x9f, 0x82, 0xa1, 0x1f, 0xc2, 0xbc, 0xea, 0xc8, 0xa1, 0x15, 0x97, 0x29,
-	0x7d, 0x7b, 0xac, 0x4c, 0x2f, 0xb6, 0x7b, 0x3b, 0x62, 0x8c, 0xa4, 0xcc, 0xdc, 0x68, 0xca, 0x3c,
-	0x89, 0x2f, 0xc4, 0xcc, 0x78, 0xf6, 0xcf, 0x06, 0xdc, 0x78, 0xd6, 0x61, 0x41, 0xc7, 0x3f, 0x3c,
-	0x23, 0x8d, 0x17, 0xcf, 0x3a, 0xa4, 0x43, 0x4a, 0x94, 0x07, 0x3d, 0x54, 0x85, 0xd5, 0x97, 0x52,
-	0x20, 0x93, 0x96, 0x75, 0x74, 0x21, 0x18, 0x57, 0x4c, 0xde, 0x15, 0x45, 0xae, 0x2b, 0xae, 0x4c,
-	0xe0, 0x7b, 0x80, 0xb4, 0xc6, 0x86, 0xb0, 0x35, 0x14, 0x89, 0xb8, 0x6d, 0xbe, 0x1c, 0x38, 0xa1,
-	0x6e, 0x7f, 0x0c, 0x1d, 0x3a, 0x2e, 0xa3, 0x44, 0xc6, 0x61, 0x14, 0x1d, 0x16, 0x19, 0x25, 0xd9,
-	0xbf, 0x19, 0x90, 0xd2, 0x05, 0x5c, 0xc5, 0x01, 0xf6, 0x43, 0xf4, 0x05, 0x24, 0x7d, 0x8f, 0xf6,
-	0xfb, 0xc1, 0xa5, 0x8d, 0x7e, 0x5b, 0xf4, 0x83, 0x77, 0xaf, 0x77, 0x6e, 0x0c, 0xb1, 0xee, 0x31,
-	0xdf, 0xe3, 0xc4, 0x6f, 0xf3, 0x9e, 0x0d, 0xfe, 0xe0, 0xf5, 0xf0, 0x01, 0xf9, 0xf8, 0x3c, 0x02,
-	0xe9, 0x54, 0xd6, 0xef, 0xc1, 0xc6, 0x85, 0x9b, 0x29, 0xea, 0xf7, 0xfc, 0xe0, 0xa3, 0x77, 0xaf,
-	0x77, 0x6e, 0x5d, 0x24, 0x0e, 0x8c, 0xfc, 0x5a, 0x5c, 0x9c, 0xe9, 0xe3, 0xf3, 0xe8, 0x24, 0x52,
-	0x9e, 0xad, 0xc3, 0x92, 0xae, 0x08, 0x75, 0xb2, 0x22, 0xa4, 0x46, 0x8a, 0x48, 0xc7, 0xe4, 0x3d,
-	0x96, 0xe3, 0x52, 0xf3, 0x52, 0x77, 0xa8, 0xae, 0xb2, 0xff, 0x9a, 0xd5, 0xf5, 0xa4, 0xb5, 0xee,
-	0x41, 0x42, 0xdd, 0xaa, 0x2e, 0x26, 0x73, 0xf4, 0xc1, 0xb7, 0x0c, 0x5b, 0xcb, 0xd1, 0x3d, 0x58,
-	0xe4, 0x67, 0x01, 0x09, 0xcf, 0x58, 0xcb, 0x9d, 0x32, 0x1d, 0x0c, 0x00, 0xa8, 0x0e, 0xdb, 0x0d,
-	0x46, 0x43, 0xee, 0xf1, 0x8e, 0xf0, 0xc5, 0xc1, 0x3e, 0xa1, 0xae, 0x4f, 0x28, 0x77, 0xb4, 0xb9,
-	0xd8, 0x14, 0x73, 0x5b, 0xc3, 0xb4, 0x42, 0xc4, 0x52, 0xc9, 0x8a, 0x7e, 0x06, 0xbb, 0x53, 0xb4,
-	0x0e, 0x5c, 0x8b, 0x4f, 0x74, 0x2d, 0x33, 0x51, 0x6d, 0xbd, 0xef, 0x6f, 0x1e, 0xa0, 0x85, 0x5f,
-	0x45, 0xce, 0xcd, 0x4d, 0x71, 0x6e, 0xb1, 0x85, 0x5f, 0x69, 0x57, 0x1e, 0x42, 0x4a, 0x10, 0x06,
-	0x76, 0x13, 0x13, 0xed, 0x2e, 0xb5, 0xf0, 0xab, 0xbe, 0x95, 0xec, 0x6f, 0x62, 0xb0, 0x3a, 0x98,
-	0x47, 0xea, 0x67, 0x01, 0xe3, 0xbc, 0x45, 0x02, 0x54, 0x82, 0xe4, 0xf3, 0x16, 0x63, 0x81, 0x73,
-	0xfd, 0xf1, 0x04, 0x24, 0xf1, 0x44, 0xce, 0x28, 0x45, 0x48, 0x75, 0xda, 0x2e, 0xe6, 0xe4, 0xca,
-	0xc9, 0xa9, 0x53, 0x44, 0xb1, 0x54, 0x8a, 0xa0, 0x4f, 0x60, 0x9d, 0xe3, 0xa0, 0x49, 0xb8, 0x83,
-	0x1b, 0xdc, 0xeb, 0x12, 0x27, 0xea, 0x63, 0xa1, 0xae, 0xc3, 0x1b, 0x4a, 0x5c, 0x90, 0xd2, 0x68,
-	0xe4, 0x10, 0xc3, 0x49, 0xda, 0xa3, 0x8d, 0x80, 0xe0, 0x90, 0x38, 0x52, 0xfd, 0x94, 0x50, 0xa4,
-	0x22, 0x94, 0x2d, 0x40, 0x82, 0xe6, 0x92, 0x11, 0xda, 0xdc, 0x64, 0x5a, 0x84, 0x52, 0xb4, 0x0a,
-	0x7c, 0xd4, 0xa7, 0x85, 0x84, 0x86, 0x1e, 0xf7, 0xba, 0x1e, 0xef, 0x39, 0xda, 0x75, 0xd7, 0x0b,
-	0x39, 0xa6, 0x0d, 0x35, 0x5a, 0xc4, 0xed, 0xdb, 0x11, 0xb6, 0x36, 0x80, 0xd6, 0x25, 0xb2, 0xa8,
-	0x81, 0xd9, 0x5f, 0xc5, 0x60, 0xf3, 0xc8, 0xa3, 0x65, 0xea, 0x71, 0xaf, 0x3f, 0x16, 0xfc, 0x8f,
-	0x86, 0xe8, 0x0e, 0x98, 0xfa, 0x9c, 0xe3, 0xb1, 0x59, 0x56, 0xfb, 0xff, 0x37, 0x51, 0xf9, 0xcb,
-	0x12, 0x24, 0x74, 0xab, 0xfa, 0xec, 0x9a, 0xad, 0x3d, 0xd9, 0x8f, 0x80, 0x65, 0x8c, 0x34, 0xf2,
-	0xa3, 0x0f, 0x6b, 0xe4, 0xf1, 0xc9, 0x8d, 0xfa, 0x62, 0x63, 0x8e, 0x7d, 0x40, 0x63, 0x1e, 0x6a,
-	0xc4, 0xf1, 0xeb, 0x34, 0xe2, 0xb9, 0xcb, 0x1a, 0xf1, 0x4f, 0x60, 0x43, 0xdc, 0x9a, 0xa7, 0xd2,
-	0xba, 0x7f, 0x68, 0x15, 0xd3, 0xf9, 0x29, 0xa6, 0x6e, 0xfa, 0xe3, 0x85, 0xa0, 0xc2, 0xbb, 0x07,
-	0xe6, 0x69, 0x27, 0xa0, 0x62, 0x9a, 0x23, 0x51, 0xaf, 0x4c, 0xc9, 0x91, 0x30, 0x2d, 0xf6, 0xc5,
-	0x30, 0xa2, 0xdb, 0x63, 0x01, 0xb6, 0x25, 0xb2, 0x3f, 0x16, 0xf5, 0x6f, 0x3b, 0x20, 0x82, 0xad,
-	0x27, 0xc9, 0x4d, 0x01, 0x8a, 0x92, 0x35, 0xba, 0x56, 0x85, 0x40, 0x9f, 0xc2, 0xca, 0x50, 0xbc,
-	0xb5, 0xc7, 0xcb, 0x13, 0xcf, 0xbb, 0x3c, 0x88, 0xae, 0x72, 0xf4, 0xd2, 0xe7, 0xc7, 0xfc, 0x6f,
-	0x3d, 0x3f, 0x2b, 0xff, 0x81, 0xe7, 0x07, 0x7d, 0xc0, 0xf3, 0xb3, 0x7a, 0xf9, 0xf3, 0x83, 0x1e,
-	0x41, 0x7a, 0x74, 0xb8, 0xb3, 0xd6, 0xae, 0x96, 0xaa, 0xa9, 0x91, 0xb1, 0x0e, 0xfd, 0x02, 0xb6,
-	0x44, 0x01, 0x4d, 0x98, 0xe9, 0x43, 0xf1, 0x35, 0xe0, 0xc6, 0xd5, 0x94, 0x5a, 0x3e, 0x3e, 0xbf,
-	0x30, 0xf3, 0x0b, 0x05, 0x53, 0x46, 0xc6, 0x9b, 0x53, 0x46, 0xc6, 0x13, 0x18, 0x1e, 0xde, 0xc4,
-	0x95, 0xa8, 0x96, 0x6d, 0xad, 0x4b, 0x3f, 0xb2, 0x63, 0x93, 0xf3, 0x84, 0xf7, 0xd7, 0x5e, 0xf5,
-	0x27, 0x3c, 0xca, 0x2d, 0xd8, 0x9e, 0x54, 0x39, 0x03, 0xfd, 0x96, 0xd4, 0x7f, 0xe7, 0xa2, 0xfe,
-	0x29, 0x6f, 0x88, 0xbd, 0xe9, 0x4f, 0x7f, 0x5f, 0xca, 0xb0, 0x21, 0x0b, 0x26, 0x32, 0x43, 0xd9,
-	0x50, 0x70, 0x37, 0x26, 0x06, 0xf7, 0xa6, 0x20, 0x68, 0x45, 0xc7, 0x6c, 0x10, 0xe6, 0x23, 0x58,
-	0xd2, 0xd7, 0x17, 0x60, 0xda, 0x24, 0xd6, 0xe6, 0xc4, 0x2f, 0xfa, 0x2a, 0x91, 0x6c, 0x81, 0xb8,
-	0xa0, 0x39, 0xf9, 0x72, 0x20, 0x44, 0x3d, 0xf8, 0xce, 0x7b, 0x6b, 0x49, 0x5b, 0xd9, 0xba, 0xb6,
-	0x95, 0xdd, 0xf7, 0xd4, 0x9a, 0x32, 0x5d, 0x07, 0x73, 0x50, 0x16, 0xda, 0xce, 0xad, 0x6b, 0xdb,
-	0x49, 0xf7, 0xcb, 0x46, 0xca, 0xb3, 0xcf, 0x20, 0x39, 0x6c, 0x64, 0x17, 0x62, 0x3e, 0x3e, 0x9f,
-	0xf0, 0x65, 0x52, 0x70, 0x85, 0x48, 0x22, 0x3c, 0x3a, 0x65, 0xe8, 0x15, 0xa2, 0xbb, 0x2f, 0x00,
-	0x86, 0x7e, 0x78, 0xdb, 0x82, 0xf5, 0x93, 0x4a, 0xbd, 0xe4, 0x54, 0xaa, 0xf5, 0x72, 0xe5, 0xd8,
-	0xf9, 0xfc, 0xb8, 0x56, 0x2d, 0x1d, 0x96, 0x1f, 0x95, 0x4b, 0x45, 0x73, 0x06, 0xad, 0xc2, 0xf2,
-	0xb0, 0xf0, 0x8b, 0x52, 0xcd, 0x34, 0xd0, 0x3a, 0xac, 0x0e, 0x6f, 0x16, 0x0e, 0x6a, 0xf5, 0x42,
-	0xf9, 0xd8, 0x9c, 0x45, 0x08, 0xd2, 0xc3, 0x82, 0xe3, 0x8a, 0x19, 0xbb, 0xfb, 0xce, 0x80, 0xf4,
-	0xe8, 0x2f, 0x3d, 0x68, 0x07, 0xb6, 0xaa, 0x76, 0xa5, 0x5a, 0xa9, 0x15, 0x9e, 0x3a, 0xb5, 0x7a,
-	0xa1, 0xfe, 0x79, 0x6d, 0xcc, 0x6a, 0x16, 0x32, 0xe3, 0x80, 0x62, 0xa9, 0x5a, 0xa9, 0x95, 0xeb,
-	0x4e, 0xb5, 0x64, 0x97, 0x2b, 0x45, 0xd3, 0x40, 0xb7, 0x61, 0x7b, 0x1c, 0x73, 0x52, 0xa9, 0x97,
-	0x8f, 0x3f, 0x8b, 0x20, 0xb3, 0x68, 0x13, 0x6e, 0x8e, 0x43, 0xaa, 0x85, 0x5a, 0xad, 0x54, 0x34,
-	0x63, 0xe8, 0x16, 0x58, 0xe3, 0x32, 0xbb, 0xf4, 0xa4, 0x74, 0x58, 0x2f, 0x15, 0xcd, 0xf8, 0x24,
-	0xe6, 0xa3, 0x42, 0xf9, 0x69, 0xa9, 0x68, 0xce, 0x4d, 0x92, 0x9d, 0x94, 0xea, 0x95, 0x52, 0xd1,
-	0x4c, 0x1c, 0x1c, 0x7d, 0xfd, 0x26, 0x63, 0x7c, 0xf3, 0x26, 0x63, 0xfc, 0xe3, 0x4d, 0xc6, 0xf8,
-	0xf2, 0x6d, 0x66, 0xe6, 0x9b, 0xb7, 0x99, 0x99, 0xbf, 0xbe, 0xcd, 0xcc, 0xfc, 0xfc, 0x61, 0xd3,
-	0xe3, 0x67, 0x9d, 0xd3, 0x5c, 0x83, 0xf9, 0xf9, 0xc7, 0x32, 0x19, 0xee, 0x1f, 0x9e, 0x61, 0x8f,
-	0xe6, 0x55, 0x66, 0xdc, 0x6f, 0xc8, 0xc5, 0xb9, 0xfc, 0xf1, 0x97, 0xf7, 0xda, 0x24, 0xcc, 0x77,
-	0xf7, 0x4f, 0x13, 0xb2, 0x1b, 0x3d, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xa1, 0x75,
-	0x81, 0x1a, 0x16, 0x00, 0x00,
+func (m *GovernorDescription) Reset()         { *m = GovernorDescription{} }
+func (m *GovernorDescription) String() string { return proto.CompactTextString(m) }
+func (*GovernorDescription) ProtoMessage()    {}
+func (*GovernorDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{18}
+}
+func (m *GovernorDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernorDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorDescription.Merge(m, src)
+}
+func (m *GovernorDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorDescription.DiscardUnknown(m)
 }
 
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GovernorDescription proto.InternalMessageInfo
+
+func (m *GovernorDescription) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GovernorDescription) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
 }
 
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+func (m *GovernorDescription) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *GovernorDescription) GetSecurityContact() string {
+	if m != nil {
+		return m.SecurityContact
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GovernorDescription) GetDetails() string {
+	if m != nil {
+		return m.Details
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Governor defines an account that other accounts can delegate their
+// governance voting power to, without delegating their stake to it.
+type Governor struct {
+	// governor_address defines the address of the governor account.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// description defines the description of the governor.
+	Description GovernorDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
+	// status is the status of the governor.
+	Status GovernorStatus `protobuf:"varint,3,opt,name=status,proto3,enum=hikari.gov.v1.GovernorStatus" json:"status,omitempty"`
+	// last_status_change_time is the time of the last status change of the
+	// governor.
+	LastStatusChangeTime *time.Time `protobuf:"bytes,4,opt,name=last_status_change_time,json=lastStatusChangeTime,proto3,stdtime" json:"last_status_change_time,omitempty"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{19}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Governor) GetDescription() GovernorDescription {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return GovernorDescription{}
 }
 
-func (m *LastMinDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Governor) GetStatus() GovernorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return GovernorStatusUnspecified
 }
 
-func (m *LastMinDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Governor) GetLastStatusChangeTime() *time.Time {
+	if m != nil {
+		return m.LastStatusChangeTime
+	}
+	return nil
 }
 
-func (m *LastMinDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Time != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
-		if err1 != nil {
-			return 0, err1
+// GovernorValShares holds the number of shares of a validator that are
+// delegated by the governance delegators of a governor.
+type GovernorValShares struct {
+	// governor_address defines the address of the governor account.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// validator_address defines the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares defines the number of validator shares delegated by the governance
+	// delegators of the governor.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *GovernorValShares) Reset()         { *m = GovernorValShares{} }
+func (m *GovernorValShares) String() string { return proto.CompactTextString(m) }
+func (*GovernorValShares) ProtoMessage()    {}
+func (*GovernorValShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{20}
+}
+func (m *GovernorValShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorValShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorValShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Value) > 0 {
-		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *GovernorValShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorValShares.Merge(m, src)
+}
+func (m *GovernorValShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorValShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorValShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorValShares proto.InternalMessageInfo
+
+func (m *GovernorValShares) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GovernorValShares) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GovernanceDelegation defines the delegation of the governance voting power
+// of an account to a governor.
+type GovernanceDelegation struct {
+	// delegator_address defines the address of the delegator account.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address defines the address of the governor account.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimesVotingPeriodExtended != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.TimesVotingPeriodExtended))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{21}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Annotation) > 0 {
-		i -= len(m.Annotation)
-		copy(dAtA[i:], m.Annotation)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Annotation)))
-		i--
-		dAtA[i] = 0x7a
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if m.Endorsed {
-		i--
-		if m.Endorsed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// Law defines a law enacted by a passed law proposal.
+type Law struct {
+	// id defines the unique id of the law.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the law.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// text is the full text of the law. It may be empty if only the hash of the
+	// text was enacted.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// text_hash is the hex encoded SHA-256 hash of the text of the law.
+	TextHash string `protobuf:"bytes,4,opt,name=text_hash,json=textHash,proto3" json:"text_hash,omitempty"`
+	// enactment_height is the block height at which the law was enacted.
+	EnactmentHeight int64 `protobuf:"varint,5,opt,name=enactment_height,json=enactmentHeight,proto3" json:"enactment_height,omitempty"`
+	// proposal_id is the id of the proposal that enacted the law.
+	ProposalId uint64 `protobuf:"varint,6,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// status is the status of the law.
+	Status LawStatus `protobuf:"varint,7,opt,name=status,proto3,enum=hikari.gov.v1.LawStatus" json:"status,omitempty"`
+	// superseded_by is the id of the law that superseded this law, if its
+	// status is LAW_STATUS_SUPERSEDED.
+	SupersededBy uint64 `protobuf:"varint,8,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+}
+
+func (m *Law) Reset()         { *m = Law{} }
+func (m *Law) String() string { return proto.CompactTextString(m) }
+func (*Law) ProtoMessage()    {}
+func (*Law) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{22}
+}
+func (m *Law) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Law) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Law.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x70
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *Law) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Law.Merge(m, src)
+}
+func (m *Law) XXX_Size() int {
+	return m.Size()
+}
+func (m *Law) XXX_DiscardUnknown() {
+	xxx_messageInfo_Law.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Law proto.InternalMessageInfo
+
+func (m *Law) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x62
+	return 0
+}
+
+func (m *Law) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *Law) GetText() string {
+	if m != nil {
+		return m.Text
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.VotingEndTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.VotingStartTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return ""
+}
+
+func (m *Law) GetTextHash() string {
+	if m != nil {
+		return m.TextHash
 	}
-	if m.DepositEndTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *Law) GetEnactmentHeight() int64 {
+	if m != nil {
+		return m.EnactmentHeight
 	}
-	if m.SubmitTime != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintGov(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *Law) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if m.FinalTallyResult != nil {
-		{
-			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *Law) GetStatus() LawStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+	return LawStatusUnspecified
+}
+
+func (m *Law) GetSupersededBy() uint64 {
+	if m != nil {
+		return m.SupersededBy
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return 0
+}
+
+// ConstitutionVersion defines a version of the constitution, set at genesis or
+// by the execution of a constitution amendment proposal.
+type ConstitutionVersion struct {
+	// version defines the number of the version, starting at 1.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// proposal_id defines the id of the proposal that amended the constitution
+	// to this version, 0 if the version was not set by a proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// height defines the block height at which the version was set.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// amendment defines the unified diff applied to the previous version to
+	// produce this version. It is empty for the first version.
+	Amendment string `protobuf:"bytes,4,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	// constitution defines the text of the constitution at this version.
+	Constitution string `protobuf:"bytes,5,opt,name=constitution,proto3" json:"constitution,omitempty"`
+}
+
+func (m *ConstitutionVersion) Reset()         { *m = ConstitutionVersion{} }
+func (m *ConstitutionVersion) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionVersion) ProtoMessage()    {}
+func (*ConstitutionVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{23}
+}
+func (m *ConstitutionVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstitutionVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstitutionVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ConstitutionVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionVersion.Merge(m, src)
+}
+func (m *ConstitutionVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstitutionVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionVersion.DiscardUnknown(m)
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConstitutionVersion proto.InternalMessageInfo
+
+func (m *ConstitutionVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConstitutionVersion) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x12
+func (m *ConstitutionVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ConstitutionVersion) GetAmendment() string {
+	if m != nil {
+		return m.Amendment
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConstitutionVersion) GetConstitution() string {
+	if m != nil {
+		return m.Constitution
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConstitutionBlameLine defines a line of the constitution along with the
+// version that introduced it.
+type ConstitutionBlameLine struct {
+	// line defines the text of the line.
+	Line string `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	// version defines the version that introduced the line.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// proposal_id defines the id of the proposal that introduced the line, 0 if
+	// the line was not introduced by a proposal.
+	ProposalId uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *ConstitutionBlameLine) Reset()         { *m = ConstitutionBlameLine{} }
+func (m *ConstitutionBlameLine) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionBlameLine) ProtoMessage()    {}
+func (*ConstitutionBlameLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{24}
+}
+func (m *ConstitutionBlameLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstitutionBlameLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstitutionBlameLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ConstitutionBlameLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionBlameLine.Merge(m, src)
+}
+func (m *ConstitutionBlameLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstitutionBlameLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionBlameLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstitutionBlameLine proto.InternalMessageInfo
+
+func (m *ConstitutionBlameLine) GetLine() string {
+	if m != nil {
+		return m.Line
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QuorumCheckQueueEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConstitutionBlameLine) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuorumCheckQueueEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConstitutionBlameLine) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *QuorumCheckQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+// ConstitutionAmendmentStatus is the apply status of the constitution
+// amendments of a proposal in deposit or voting period.
+type ConstitutionAmendmentStatus struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// proposal_status defines the status of the proposal.
+	ProposalStatus ProposalStatus `protobuf:"varint,2,opt,name=proposal_status,json=proposalStatus,proto3,enum=hikari.gov.v1.ProposalStatus" json:"proposal_status,omitempty"`
+	// applies is true if the amendments of the proposal apply cleanly to the
+	// current constitution.
+	Applies bool `protobuf:"varint,3,opt,name=applies,proto3" json:"applies,omitempty"`
+	// conflict is true if the proposal was flagged as conflicting with an
+	// amendment of the constitution enacted while it was pending.
+	Conflict bool `protobuf:"varint,4,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	// error is the reason the amendments do not apply, if any.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ConstitutionAmendmentStatus) Reset()         { *m = ConstitutionAmendmentStatus{} }
+func (m *ConstitutionAmendmentStatus) String() string { return proto.CompactTextString(m) }
+func (*ConstitutionAmendmentStatus) ProtoMessage()    {}
+func (*ConstitutionAmendmentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81545436827712cf, []int{25}
+}
+func (m *ConstitutionAmendmentStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstitutionAmendmentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstitutionAmendmentStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConstitutionAmendmentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstitutionAmendmentStatus.Merge(m, src)
+}
+func (m *ConstitutionAmendmentStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstitutionAmendmentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstitutionAmendmentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstitutionAmendmentStatus proto.InternalMessageInfo
+
+func (m *ConstitutionAmendmentStatus) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ConstitutionAmendmentStatus) GetProposalStatus() ProposalStatus {
+	if m != nil {
+		return m.ProposalStatus
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *ConstitutionAmendmentStatus) GetApplies() bool {
+	if m != nil {
+		return m.Applies
+	}
+	return false
+}
+
+func (m *ConstitutionAmendmentStatus) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+func (m *ConstitutionAmendmentStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("hikari.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("hikari.gov.v1.TallyMode", TallyMode_name, TallyMode_value)
+	proto.RegisterEnum("hikari.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("hikari.gov.v1.GovernorStatus", GovernorStatus_name, GovernorStatus_value)
+	proto.RegisterEnum("hikari.gov.v1.LawStatus", LawStatus_name, LawStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "hikari.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*MultipleChoiceOption)(nil), "hikari.gov.v1.MultipleChoiceOption")
+	proto.RegisterType((*WeightedChoice)(nil), "hikari.gov.v1.WeightedChoice")
+	proto.RegisterType((*Deposit)(nil), "hikari.gov.v1.Deposit")
+	proto.RegisterType((*LastMinDeposit)(nil), "hikari.gov.v1.LastMinDeposit")
+	proto.RegisterType((*Proposal)(nil), "hikari.gov.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "hikari.gov.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "hikari.gov.v1.Vote")
+	proto.RegisterType((*ArchivedVote)(nil), "hikari.gov.v1.ArchivedVote")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "hikari.gov.v1.VotingPowerSnapshot")
+	proto.RegisterType((*QuorumCheckQueueEntry)(nil), "hikari.gov.v1.QuorumCheckQueueEntry")
+	proto.RegisterType((*DepositParams)(nil), "hikari.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "hikari.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "hikari.gov.v1.TallyParams")
+	proto.RegisterType((*MinDepositThrottler)(nil), "hikari.gov.v1.MinDepositThrottler")
+	proto.RegisterType((*MinInitialDepositThrottler)(nil), "hikari.gov.v1.MinInitialDepositThrottler")
+	proto.RegisterType((*Params)(nil), "hikari.gov.v1.Params")
+	proto.RegisterType((*QuorumRange)(nil), "hikari.gov.v1.QuorumRange")
+	proto.RegisterType((*GovernorDescription)(nil), "hikari.gov.v1.GovernorDescription")
+	proto.RegisterType((*Governor)(nil), "hikari.gov.v1.Governor")
+	proto.RegisterType((*GovernorValShares)(nil), "hikari.gov.v1.GovernorValShares")
+	proto.RegisterType((*GovernanceDelegation)(nil), "hikari.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*Law)(nil), "hikari.gov.v1.Law")
+	proto.RegisterType((*ConstitutionVersion)(nil), "hikari.gov.v1.ConstitutionVersion")
+	proto.RegisterType((*ConstitutionBlameLine)(nil), "hikari.gov.v1.ConstitutionBlameLine")
+	proto.RegisterType((*ConstitutionAmendmentStatus)(nil), "hikari.gov.v1.ConstitutionAmendmentStatus")
+}
+
+func init() { proto.RegisterFile("hikari/gov/v1/gov.proto", fileDescriptor_81545436827712cf) }
+
+var fileDescriptor_81545436827712cf = []byte{
+	// 3283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6c, 0x1b, 0x49,
+	0x7a, 0x76, 0x93, 0xd4, 0x83, 0xbf, 0x44, 0x8a, 0x2a, 0xbd, 0x5a, 0x94, 0xf5, 0x30, 0x3d, 0x3b,
+	0x91, 0x9d, 0xb1, 0xb4, 0xd2, 0x4c, 0x76, 0x83, 0xcd, 0x63, 0x41, 0x91, 0x94, 0x4d, 0x47, 0x12,
+	0x39, 0x4d, 0x5a, 0x5e, 0x07, 0x41, 0x1a, 0x25, 0x76, 0x49, 0x6c, 0xb8, 0x1f, 0x74, 0x77, 0x51,
+	0x12, 0xaf, 0x39, 0x05, 0x3e, 0x2d, 0x90, 0xcb, 0x5e, 0x0c, 0x04, 0xc9, 0x25, 0xc8, 0x69, 0x11,
+	0xcc, 0x39, 0xb7, 0x04, 0x7b, 0x5c, 0xf8, 0x94, 0x2c, 0x02, 0x27, 0x98, 0x39, 0x04, 0x58, 0x20,
+	0xc8, 0x21, 0x40, 0x2e, 0x39, 0x24, 0xa8, 0x47, 0x37, 0x9b, 0x64, 0xcb, 0xa2, 0x26, 0xbb, 0x40,
+	0x90, 0x8b, 0xcd, 0xae, 0xfa, 0xfe, 0x67, 0xfd, 0xf5, 0xff, 0x55, 0x7f, 0x09, 0x56, 0xda, 0xe6,
+	0x6b, 0xec, 0x99, 0xbb, 0x17, 0xee, 0xe5, 0xee, 0xe5, 0x1e, 0xfb, 0x6f, 0xa7, 0xe3, 0xb9, 0xd4,
+	0x45, 0x19, 0x31, 0xb1, 0xc3, 0x46, 0x2e, 0xf7, 0xf2, 0x1b, 0x2d, 0xd7, 0xb7, 0x5d, 0x7f, 0xf7,
+	0x0c, 0xfb, 0x64, 0xf7, 0x72, 0xef, 0x8c, 0x50, 0xbc, 0xb7, 0xdb, 0x72, 0x4d, 0x47, 0xc0, 0xf3,
+	0x8b, 0x17, 0xee, 0x85, 0xcb, 0x7f, 0xee, 0xb2, 0x5f, 0x72, 0x74, 0xf3, 0xc2, 0x75, 0x2f, 0x2c,
+	0xb2, 0xcb, 0xbf, 0xce, 0xba, 0xe7, 0xbb, 0xd4, 0xb4, 0x89, 0x4f, 0xb1, 0xdd, 0x91, 0x80, 0xd5,
+	0x61, 0x00, 0x76, 0x7a, 0x72, 0x6a, 0x63, 0x78, 0xca, 0xe8, 0x7a, 0x98, 0x9a, 0x6e, 0x20, 0x71,
+	0x55, 0x68, 0xa4, 0x0b, 0xa1, 0xe2, 0x43, 0x4e, 0xcd, 0x63, 0xdb, 0x74, 0xdc, 0x5d, 0xfe, 0xaf,
+	0x18, 0x2a, 0xb8, 0x80, 0x5e, 0x12, 0xf3, 0xa2, 0x4d, 0x89, 0x71, 0xea, 0x52, 0x52, 0xeb, 0x30,
+	0x4e, 0x68, 0x0f, 0x26, 0x5d, 0xfe, 0x4b, 0x55, 0xb6, 0x94, 0xed, 0xec, 0xfe, 0xea, 0xce, 0x80,
+	0xd5, 0x3b, 0x7d, 0xa8, 0x26, 0x81, 0xe8, 0x53, 0x98, 0xbc, 0xe2, 0x8c, 0xd4, 0xc4, 0x96, 0xb2,
+	0x9d, 0x3e, 0xc8, 0xbe, 0xff, 0xea, 0x09, 0x48, 0xe9, 0x65, 0xd2, 0xd2, 0xe4, 0x6c, 0xe1, 0x8f,
+	0x60, 0xf1, 0xb8, 0x6b, 0x51, 0xb3, 0x63, 0x91, 0x52, 0xdb, 0x35, 0x5b, 0x81, 0x48, 0x04, 0x29,
+	0x07, 0xdb, 0x84, 0x0b, 0x4c, 0x6b, 0xfc, 0x37, 0xfa, 0x2e, 0x4c, 0xdb, 0xc4, 0xf7, 0xf1, 0x05,
+	0xf1, 0xd5, 0xc4, 0x56, 0x72, 0x7b, 0x66, 0x7f, 0x71, 0x47, 0x58, 0xbf, 0x13, 0x58, 0xbf, 0x53,
+	0x74, 0x7a, 0x5a, 0x88, 0x2a, 0xd4, 0x21, 0x1b, 0x98, 0x23, 0xb8, 0xa3, 0xe5, 0x01, 0x53, 0x32,
+	0x77, 0xd6, 0xf7, 0xcf, 0x15, 0x98, 0x2a, 0x93, 0x8e, 0xeb, 0x9b, 0x14, 0x6d, 0xc2, 0x4c, 0xc7,
+	0x73, 0x3b, 0xae, 0x8f, 0x2d, 0xdd, 0x34, 0x38, 0xc3, 0x94, 0x06, 0xc1, 0x50, 0xd5, 0x40, 0xdf,
+	0x83, 0xb4, 0x21, 0xb0, 0xae, 0x27, 0xf9, 0xaa, 0xef, 0xbf, 0x7a, 0xb2, 0x28, 0xf9, 0x16, 0x0d,
+	0xc3, 0x23, 0xbe, 0xdf, 0xa0, 0x9e, 0xe9, 0x5c, 0x68, 0x7d, 0x28, 0xfa, 0x5d, 0x98, 0xc4, 0xb6,
+	0xdb, 0x75, 0xa8, 0x9a, 0xe4, 0x66, 0xae, 0xee, 0x48, 0x0a, 0x16, 0x56, 0x3b, 0x32, 0xac, 0x76,
+	0x4a, 0xae, 0xe9, 0x1c, 0xa4, 0x7f, 0xf6, 0x61, 0xf3, 0xde, 0x5f, 0xfd, 0xeb, 0x4f, 0x1f, 0x2b,
+	0x9a, 0xa4, 0x29, 0xfc, 0x89, 0x02, 0xd9, 0x23, 0xec, 0xd3, 0x63, 0xd3, 0x09, 0x34, 0xfd, 0x01,
+	0x4c, 0x5c, 0x62, 0xab, 0xcb, 0xdc, 0x39, 0x3e, 0x3f, 0x41, 0x82, 0xbe, 0x80, 0x14, 0x0b, 0x47,
+	0xae, 0xff, 0xcc, 0x7e, 0x7e, 0xc4, 0xe3, 0xcd, 0x20, 0x56, 0x0f, 0x52, 0x3f, 0xfe, 0xe7, 0x4d,
+	0x45, 0xe3, 0xe8, 0xc2, 0xdf, 0xa6, 0x61, 0xba, 0x2e, 0x3d, 0x81, 0xb2, 0x90, 0x08, 0xfd, 0x93,
+	0x30, 0x8d, 0xbb, 0x2f, 0x24, 0xfa, 0x2d, 0x98, 0xf4, 0x29, 0xa6, 0x5d, 0x5f, 0x4d, 0xf2, 0x08,
+	0x5c, 0x1f, 0x8a, 0xc0, 0x40, 0x54, 0x83, 0x83, 0x34, 0x09, 0x46, 0xcf, 0x00, 0x9d, 0x9b, 0x0e,
+	0xb6, 0x74, 0x8a, 0x2d, 0xab, 0xa7, 0x7b, 0xc4, 0xef, 0x5a, 0x54, 0x4d, 0x49, 0x4b, 0x06, 0x59,
+	0x34, 0x19, 0x44, 0xe3, 0x08, 0x2d, 0xc7, 0xa9, 0x22, 0x23, 0xa8, 0x08, 0x33, 0x7e, 0xf7, 0xcc,
+	0x36, 0xa9, 0xce, 0x9d, 0x31, 0x31, 0xa6, 0x33, 0x40, 0x10, 0xb1, 0x61, 0xf4, 0x1c, 0x72, 0x72,
+	0x89, 0x75, 0xe2, 0x18, 0x82, 0xcf, 0xe4, 0x98, 0x7c, 0xb2, 0x92, 0xb2, 0xe2, 0x18, 0x9c, 0x57,
+	0x15, 0x32, 0xd4, 0xa5, 0xd8, 0xd2, 0xe5, 0xb8, 0x3a, 0x75, 0x87, 0x85, 0x9d, 0xe5, 0xa4, 0x41,
+	0x6c, 0x1c, 0xc1, 0xfc, 0xa5, 0x4b, 0x4d, 0xe7, 0x42, 0xf7, 0x29, 0xf6, 0xa4, 0x7d, 0xd3, 0x63,
+	0xea, 0x35, 0x27, 0x48, 0x1b, 0x8c, 0x92, 0x2b, 0xf6, 0x0c, 0xe4, 0x50, 0xdf, 0xc6, 0xf4, 0x98,
+	0xbc, 0x32, 0x82, 0x30, 0x30, 0x31, 0xcf, 0x82, 0x84, 0x62, 0x03, 0x53, 0xac, 0x02, 0xcf, 0x02,
+	0xe1, 0x37, 0x5a, 0x84, 0x09, 0x6a, 0x52, 0x8b, 0xa8, 0x33, 0x7c, 0x42, 0x7c, 0x20, 0x15, 0xa6,
+	0xfc, 0xae, 0x6d, 0x63, 0xaf, 0xa7, 0xce, 0xf2, 0xf1, 0xe0, 0x13, 0x7d, 0x01, 0xd3, 0x62, 0x5b,
+	0x12, 0x4f, 0xcd, 0xdc, 0xb2, 0x0f, 0x43, 0x24, 0xd3, 0x80, 0x38, 0x86, 0xeb, 0xf9, 0xc4, 0x50,
+	0xb3, 0x5b, 0xca, 0xf6, 0xb4, 0x16, 0x7e, 0xa3, 0x0d, 0x00, 0xec, 0x38, 0x2e, 0xe5, 0xa9, 0x56,
+	0x9d, 0xe3, 0xe2, 0x22, 0x23, 0xe8, 0x87, 0x70, 0x9f, 0x27, 0x71, 0x5d, 0x7a, 0xa3, 0x43, 0x3c,
+	0xd3, 0x35, 0x74, 0x72, 0x4d, 0x89, 0x63, 0x10, 0x43, 0xcd, 0xf1, 0xec, 0xb3, 0xca, 0x31, 0xa7,
+	0x1c, 0x52, 0xe7, 0x88, 0x8a, 0x04, 0xa0, 0x43, 0xd8, 0x6c, 0xb9, 0x8e, 0x4f, 0x4d, 0xda, 0x65,
+	0x0c, 0x75, 0x6c, 0x13, 0xc7, 0xb0, 0x89, 0x43, 0xf5, 0x96, 0xeb, 0x9c, 0x5b, 0x66, 0x8b, 0xaa,
+	0xf3, 0x5c, 0xa7, 0xf5, 0x28, 0xac, 0x18, 0xa0, 0x4a, 0x12, 0x84, 0x1e, 0x42, 0x26, 0x70, 0x9b,
+	0xde, 0xc6, 0x7e, 0x5b, 0x45, 0x5c, 0xd7, 0xd9, 0x60, 0xf0, 0x19, 0xf6, 0xdb, 0xe8, 0xf7, 0x60,
+	0x4a, 0xe4, 0x41, 0x5f, 0x5d, 0xe0, 0x81, 0xf4, 0x70, 0x68, 0x73, 0xc4, 0xe5, 0x68, 0x2d, 0xa0,
+	0x41, 0xdf, 0x07, 0x10, 0x1b, 0xcc, 0x76, 0x0d, 0xa2, 0x2e, 0xf2, 0x1d, 0xaa, 0xc6, 0x6d, 0xaf,
+	0x63, 0xd7, 0x20, 0x5a, 0x9a, 0x06, 0x3f, 0xd1, 0x53, 0xc8, 0x92, 0x6b, 0xd2, 0x12, 0x16, 0xf2,
+	0x60, 0x59, 0x1a, 0x37, 0x58, 0x42, 0x3a, 0x1e, 0x2c, 0xf7, 0x21, 0x4d, 0xae, 0x3b, 0xc4, 0x30,
+	0x29, 0x31, 0xd4, 0x65, 0xee, 0x97, 0xfe, 0x00, 0xda, 0x87, 0xa5, 0x60, 0x19, 0xdc, 0x2b, 0xe2,
+	0xe9, 0xbe, 0x83, 0x3b, 0x7e, 0xdb, 0xa5, 0xea, 0x0a, 0x47, 0x2e, 0x88, 0xc9, 0x3a, 0x9b, 0x6b,
+	0xc8, 0xa9, 0xc2, 0x7f, 0x2a, 0x30, 0x13, 0x4d, 0x00, 0xbf, 0x09, 0xe9, 0x1e, 0xf1, 0xf5, 0x16,
+	0x4f, 0xcb, 0xca, 0x48, 0x8d, 0xa8, 0x3a, 0x54, 0x9b, 0xee, 0x11, 0xbf, 0xc4, 0xe6, 0xd1, 0xe7,
+	0x90, 0xc1, 0x67, 0x3e, 0xc5, 0xa6, 0x23, 0x09, 0x12, 0xb1, 0x04, 0xb3, 0x12, 0x24, 0x88, 0x1e,
+	0xc1, 0xb4, 0xe3, 0x4a, 0x7c, 0x32, 0x16, 0x3f, 0xe5, 0xb8, 0x21, 0x7f, 0xe1, 0x7b, 0x01, 0xf7,
+	0xd5, 0xd4, 0x56, 0x32, 0x8e, 0xbf, 0x00, 0x71, 0x1a, 0x1f, 0x7d, 0x07, 0xb2, 0x57, 0xa6, 0xe3,
+	0x30, 0x37, 0xc8, 0x12, 0x38, 0xc1, 0x43, 0x21, 0x23, 0x47, 0xc5, 0xaa, 0x16, 0xfe, 0x5d, 0x81,
+	0x14, 0x2b, 0xe8, 0xb7, 0x97, 0xb7, 0x1d, 0x98, 0xb8, 0x74, 0x29, 0xb9, 0xbd, 0xb4, 0x09, 0x18,
+	0xfa, 0x9d, 0x7e, 0x94, 0xa5, 0x78, 0x94, 0x3d, 0x18, 0x8a, 0x91, 0xd1, 0xa3, 0x47, 0x3f, 0xc6,
+	0xa2, 0xe9, 0x60, 0x62, 0x28, 0x1d, 0x7c, 0x1f, 0xa6, 0x5a, 0x3c, 0x30, 0x7d, 0x75, 0x92, 0x33,
+	0x5e, 0xbf, 0x81, 0xb1, 0x08, 0x5f, 0x2d, 0x40, 0x3f, 0x4f, 0x4d, 0x27, 0x73, 0xa9, 0xc2, 0xbf,
+	0x25, 0x60, 0xb6, 0xe8, 0xb5, 0xda, 0xe6, 0xa5, 0x10, 0xfd, 0x6b, 0xb5, 0x3c, 0xf9, 0xbf, 0xb2,
+	0x3c, 0x35, 0x64, 0xf9, 0x1e, 0xcc, 0x46, 0x23, 0x5b, 0x78, 0x66, 0xe4, 0xf0, 0x32, 0x13, 0x09,
+	0x70, 0xf4, 0x14, 0x66, 0xb1, 0x30, 0x76, 0xdc, 0x12, 0x34, 0xcd, 0x4a, 0x07, 0xdf, 0x75, 0x33,
+	0x92, 0x92, 0xef, 0xb9, 0x88, 0xd7, 0xa7, 0xee, 0xe2, 0xf5, 0xc2, 0x3b, 0x05, 0x16, 0x4e, 0x47,
+	0xb7, 0xdc, 0xed, 0x6e, 0xdf, 0x87, 0x29, 0x2c, 0xdc, 0x7b, 0xab, 0xe3, 0x03, 0xe0, 0x88, 0x87,
+	0x92, 0xb7, 0x7a, 0xa8, 0xf0, 0x77, 0x0a, 0x2c, 0x7d, 0xd9, 0x75, 0xbd, 0xae, 0x5d, 0x6a, 0x93,
+	0xd6, 0xeb, 0x2f, 0xbb, 0xa4, 0x4b, 0x2a, 0x0e, 0xf5, 0x7a, 0xa8, 0x0e, 0x0b, 0x6f, 0xf8, 0x04,
+	0x77, 0x9d, 0xdb, 0x95, 0xd5, 0x52, 0x19, 0x33, 0x69, 0xcd, 0x0b, 0xe2, 0xa6, 0xa0, 0xe5, 0x4e,
+	0xfc, 0x0c, 0x90, 0xe4, 0xd8, 0x62, 0xb2, 0x22, 0xe9, 0x22, 0xa5, 0xe5, 0xde, 0xf4, 0x95, 0x10,
+	0xfb, 0x7e, 0x08, 0xed, 0xeb, 0x86, 0xeb, 0x10, 0x6e, 0xd2, 0x20, 0xda, 0x2f, 0xbb, 0x0e, 0x29,
+	0xfc, 0xa3, 0x02, 0x19, 0x59, 0xe5, 0xeb, 0xd8, 0xc3, 0xb6, 0x8f, 0x5e, 0xc1, 0x8c, 0x6d, 0x3a,
+	0xe1, 0xa1, 0xe1, 0xd6, 0xd3, 0xe0, 0x3a, 0x5b, 0xf9, 0x5f, 0x7e, 0xd8, 0x5c, 0x8a, 0x50, 0x7d,
+	0xe6, 0xda, 0x26, 0x25, 0x76, 0x87, 0xf6, 0x34, 0xb0, 0xfb, 0x47, 0x4c, 0x1b, 0x90, 0x8d, 0xaf,
+	0x03, 0x90, 0xac, 0x77, 0xf2, 0xd0, 0xb8, 0x3a, 0xe2, 0x99, 0xb2, 0xbc, 0xa4, 0x1c, 0x7c, 0xf2,
+	0xcb, 0x0f, 0x9b, 0xf7, 0x47, 0x09, 0xfb, 0x42, 0x7e, 0xc2, 0x1c, 0x97, 0xb3, 0xf1, 0x75, 0x60,
+	0x09, 0x9f, 0x2f, 0x34, 0x61, 0x56, 0x86, 0x90, 0xb0, 0xac, 0x0c, 0x99, 0x81, 0x4a, 0x2b, 0xd7,
+	0xe4, 0x23, 0x92, 0x53, 0x9c, 0xb3, 0x0c, 0x0e, 0xc9, 0xf5, 0xbf, 0x12, 0x32, 0xe9, 0x4b, 0xae,
+	0xdb, 0x30, 0x29, 0xbc, 0x2a, 0x33, 0x7e, 0x6e, 0x30, 0x6c, 0x54, 0x45, 0x93, 0xf3, 0xe8, 0x33,
+	0x48, 0xd3, 0xb6, 0x47, 0xfc, 0xb6, 0x6b, 0x19, 0x37, 0x5c, 0x21, 0xfa, 0x00, 0xd4, 0x84, 0xf5,
+	0x1b, 0x8a, 0xbb, 0x14, 0x97, 0xbc, 0x41, 0xdc, 0x5a, 0x6c, 0xb1, 0x17, 0xc1, 0x8a, 0x7e, 0x04,
+	0x5b, 0x37, 0x70, 0xed, 0xab, 0x96, 0x8a, 0x55, 0x6d, 0x23, 0x96, 0x6d, 0x33, 0xd4, 0x77, 0x17,
+	0xc0, 0xc2, 0x57, 0x81, 0x72, 0x13, 0x37, 0x28, 0x97, 0xb6, 0xf0, 0x95, 0x54, 0xe5, 0x73, 0xc8,
+	0x30, 0x82, 0xbe, 0xdc, 0xc9, 0x58, 0xb9, 0xb3, 0x16, 0xbe, 0x0a, 0xa5, 0x14, 0xfe, 0x29, 0x09,
+	0x0b, 0xfd, 0x4b, 0x4b, 0xb3, 0xed, 0xb9, 0x94, 0x5a, 0xc4, 0x43, 0x15, 0x98, 0x39, 0xb7, 0x5c,
+	0xd7, 0xd3, 0xef, 0x7e, 0x87, 0x01, 0x4e, 0x78, 0xca, 0x2f, 0x32, 0x65, 0xc8, 0x74, 0x3b, 0x06,
+	0xa6, 0x64, 0xec, 0xe0, 0x94, 0x21, 0x22, 0xa8, 0x44, 0x88, 0xa0, 0xef, 0xc1, 0x0a, 0xc5, 0xde,
+	0x05, 0xa1, 0x3a, 0x6e, 0x51, 0x96, 0x44, 0x83, 0xfc, 0xe4, 0xcb, 0x7d, 0xb8, 0x24, 0xa6, 0x8b,
+	0x7c, 0x36, 0xb8, 0x97, 0xb0, 0x1b, 0x4c, 0xd6, 0x74, 0x5a, 0x1e, 0xc1, 0x3e, 0xd1, 0x39, 0xfb,
+	0x1b, 0x96, 0x22, 0x13, 0xa0, 0x34, 0x06, 0x62, 0x64, 0x06, 0x19, 0x20, 0x8b, 0x4f, 0xf1, 0x99,
+	0x00, 0x25, 0xc8, 0x6a, 0xf0, 0x49, 0x48, 0xe6, 0x13, 0xc7, 0x37, 0xa9, 0x79, 0x69, 0xd2, 0x9e,
+	0x2e, 0x55, 0x37, 0x4c, 0x9f, 0x62, 0xa7, 0x25, 0x92, 0x7f, 0x4a, 0x7b, 0x10, 0x60, 0x1b, 0x7d,
+	0x68, 0x93, 0x23, 0xcb, 0x12, 0x88, 0x8a, 0xb0, 0x18, 0x9e, 0xa7, 0x74, 0x5b, 0x9c, 0x06, 0x4d,
+	0xe2, 0xa9, 0x53, 0xb1, 0xda, 0x2c, 0x84, 0xd8, 0xe3, 0x10, 0x5a, 0xf8, 0xb3, 0x24, 0xe4, 0x8f,
+	0x4d, 0xa7, 0xea, 0x98, 0xd4, 0x0c, 0xaf, 0x1f, 0xff, 0x47, 0x57, 0xf9, 0x11, 0xe4, 0xa4, 0xab,
+	0x86, 0x97, 0x77, 0x4e, 0x8c, 0xff, 0x7f, 0x59, 0xd8, 0xc2, 0xdf, 0x2c, 0xc2, 0xa4, 0xcc, 0x76,
+	0x4f, 0xef, 0x58, 0x1d, 0x66, 0xc2, 0x15, 0x50, 0x95, 0x81, 0x5a, 0x70, 0xfc, 0xed, 0x6a, 0x41,
+	0x2a, 0x3e, 0xd7, 0x8f, 0xe6, 0xf6, 0xe4, 0xb7, 0xc8, 0xed, 0x91, 0x5c, 0x9e, 0xba, 0x4b, 0x2e,
+	0x9f, 0xb8, 0x2d, 0x97, 0xff, 0x01, 0xac, 0x32, 0xaf, 0x99, 0x22, 0xac, 0x43, 0xa3, 0xc5, 0x9a,
+	0x4e, 0xdd, 0x20, 0x6a, 0xd9, 0x1e, 0xde, 0x08, 0x62, 0x79, 0xb7, 0x21, 0x77, 0xd6, 0xf5, 0x1c,
+	0x76, 0x6b, 0x24, 0x41, 0xba, 0xcd, 0xf0, 0x4b, 0x4a, 0x96, 0x8d, 0xb3, 0xe3, 0xa1, 0xcc, 0xb0,
+	0x45, 0x58, 0xe7, 0xc8, 0xf0, 0xc4, 0x14, 0x7a, 0xdb, 0x23, 0x8c, 0x5a, 0xde, 0x58, 0xf3, 0x0c,
+	0x14, 0x04, 0x6b, 0xe0, 0x56, 0x81, 0x40, 0x3f, 0x80, 0xf9, 0xc8, 0x7a, 0x4b, 0x8d, 0xe7, 0x62,
+	0xed, 0x9d, 0xeb, 0xaf, 0xae, 0x50, 0xf4, 0xd6, 0x0a, 0x96, 0xfb, 0x75, 0x55, 0xb0, 0xf9, 0x5f,
+	0x41, 0x05, 0x43, 0xdf, 0xa2, 0x82, 0x2d, 0xdc, 0x5e, 0xc1, 0xd0, 0x21, 0x64, 0x07, 0xcf, 0x87,
+	0xfc, 0x32, 0x3c, 0x46, 0xa8, 0x66, 0x06, 0x4e, 0x86, 0xe8, 0x8f, 0x61, 0x8d, 0x6d, 0xa0, 0x98,
+	0xde, 0x81, 0xcf, 0xee, 0x6d, 0x4b, 0xe3, 0x31, 0x55, 0x6d, 0x7c, 0x3d, 0xd2, 0x5b, 0x60, 0x0c,
+	0x6e, 0x38, 0x75, 0x2e, 0xdf, 0x70, 0xea, 0x3c, 0x85, 0xe8, 0xf9, 0x8f, 0xb9, 0x44, 0xa4, 0x6c,
+	0x7e, 0x7d, 0x9e, 0xd9, 0x2f, 0x0c, 0xf7, 0x0a, 0x46, 0x4b, 0xb8, 0xb6, 0x60, 0xc7, 0xd4, 0x75,
+	0x0b, 0xd6, 0xe3, 0x76, 0x4e, 0x9f, 0xbf, 0xca, 0xf9, 0x3f, 0x1a, 0xe5, 0x7f, 0x43, 0x0d, 0xd1,
+	0xf2, 0xf6, 0xcd, 0xf5, 0xa5, 0x0a, 0xab, 0x7c, 0xc3, 0x04, 0x62, 0x1c, 0x37, 0xb2, 0xb8, 0xab,
+	0xb1, 0x8b, 0xbb, 0xcc, 0x08, 0x24, 0xa3, 0x13, 0xb7, 0xbf, 0xcc, 0xc7, 0x30, 0x2b, 0xdd, 0xe7,
+	0x61, 0xe7, 0x82, 0xa8, 0xf9, 0xd8, 0x86, 0xa2, 0x08, 0x24, 0x8d, 0x21, 0x46, 0xef, 0x1b, 0x6f,
+	0xfa, 0x93, 0xa8, 0x07, 0x0f, 0x3f, 0xba, 0x97, 0xa4, 0x94, 0xb5, 0x3b, 0x4b, 0xd9, 0xfa, 0xc8,
+	0x5e, 0x13, 0xa2, 0x9b, 0x90, 0xeb, 0x6f, 0x0b, 0x29, 0xe7, 0xfe, 0x9d, 0xe5, 0x64, 0xc3, 0x6d,
+	0x23, 0xb8, 0xd6, 0xe0, 0x3e, 0x5b, 0xd8, 0x0b, 0xf7, 0x92, 0x78, 0x8e, 0xeb, 0xe9, 0x3e, 0xb1,
+	0xce, 0x75, 0x83, 0x58, 0xe4, 0x42, 0xb4, 0xcb, 0xd6, 0x63, 0xbb, 0x1b, 0x2c, 0x8d, 0x3e, 0x95,
+	0x24, 0x0d, 0x62, 0x9d, 0x97, 0x43, 0x02, 0x74, 0x06, 0xeb, 0x7d, 0x66, 0xbc, 0xb5, 0xab, 0xb7,
+	0xda, 0x4c, 0x54, 0x50, 0x11, 0x36, 0xc6, 0xdb, 0x11, 0xf9, 0x80, 0x8b, 0xe8, 0x13, 0x97, 0x38,
+	0x0f, 0x59, 0x1f, 0x1e, 0x42, 0x26, 0xb8, 0x17, 0xb3, 0xec, 0xe8, 0xab, 0x9b, 0x3c, 0x81, 0x06,
+	0x97, 0x65, 0x96, 0x7a, 0x7d, 0xa6, 0x08, 0x4f, 0xcd, 0x01, 0xd2, 0x23, 0x94, 0x38, 0x7c, 0xd1,
+	0xa4, 0x22, 0x5b, 0x63, 0x2a, 0xc2, 0xb8, 0xc8, 0x9e, 0x83, 0x16, 0xf0, 0x90, 0x8a, 0xec, 0xc3,
+	0x92, 0x47, 0xde, 0x74, 0x4d, 0x8f, 0xe8, 0x83, 0x9d, 0xbb, 0x07, 0xa2, 0x5b, 0x25, 0x27, 0x8f,
+	0xa3, 0x0d, 0xbc, 0x67, 0x30, 0xd7, 0x6f, 0xa4, 0x19, 0xc4, 0xc2, 0x3d, 0xb5, 0x30, 0x9e, 0x26,
+	0xfd, 0x06, 0x5c, 0x99, 0x91, 0xa1, 0x1a, 0x2c, 0xb0, 0x88, 0x18, 0xe6, 0xf6, 0x70, 0x3c, 0x6e,
+	0xf3, 0x16, 0xbe, 0xaa, 0x0c, 0x32, 0xa4, 0xf0, 0xe9, 0x0d, 0xd1, 0x3d, 0x2c, 0xe3, 0x93, 0xf1,
+	0x64, 0x3c, 0x8c, 0x8d, 0xea, 0x21, 0xa9, 0x07, 0xb0, 0x14, 0x56, 0xc6, 0x16, 0x3b, 0xe8, 0x58,
+	0xb2, 0xbe, 0x7d, 0x27, 0xfe, 0xc0, 0x1a, 0x80, 0x4b, 0x1c, 0x2b, 0x6a, 0xdc, 0x73, 0x58, 0x1c,
+	0xe6, 0x61, 0x10, 0x9f, 0xaa, 0x9f, 0xde, 0xd2, 0x7b, 0x40, 0x83, 0xcc, 0xca, 0xc4, 0xa7, 0xe8,
+	0x25, 0xac, 0xf4, 0xcf, 0xcf, 0x83, 0xa7, 0x99, 0xdf, 0x18, 0xcf, 0xec, 0xa5, 0x90, 0x3e, 0x9a,
+	0xd3, 0xd1, 0x0f, 0xa1, 0x7f, 0xd8, 0x8e, 0x24, 0xb4, 0xed, 0x58, 0x33, 0x51, 0x08, 0xed, 0x27,
+	0xb3, 0x7d, 0x58, 0x0a, 0xfa, 0xa1, 0xfa, 0x40, 0xa7, 0xe4, 0x91, 0x08, 0xb7, 0x60, 0x32, 0xd2,
+	0xb1, 0x29, 0x7c, 0x09, 0x33, 0xd1, 0xfd, 0xbe, 0x05, 0x49, 0x1b, 0x5f, 0xc7, 0x74, 0x45, 0x99,
+	0x4c, 0x36, 0xc5, 0x11, 0xa6, 0x73, 0xc3, 0xc5, 0x98, 0x4d, 0x15, 0xfe, 0x5a, 0x81, 0x85, 0x60,
+	0xf7, 0x97, 0x89, 0xdf, 0xf2, 0x4c, 0xf1, 0x30, 0xa7, 0xc2, 0x94, 0xed, 0x3a, 0xe6, 0x6b, 0xe2,
+	0xc9, 0xb7, 0xc0, 0xe0, 0x13, 0xe5, 0x61, 0xda, 0x34, 0xd8, 0xce, 0xa1, 0x3d, 0xc1, 0x58, 0x0b,
+	0xbf, 0x19, 0xd5, 0x15, 0x39, 0xf3, 0x4d, 0x2a, 0xba, 0x23, 0x69, 0x2d, 0xf8, 0x64, 0x27, 0x7b,
+	0x9f, 0xb4, 0xba, 0x1e, 0x3b, 0x34, 0xb7, 0x5c, 0x87, 0xe2, 0x16, 0x95, 0x5d, 0xb5, 0xb9, 0x60,
+	0xbc, 0x24, 0x86, 0x19, 0x13, 0x83, 0x50, 0x6c, 0x5a, 0xbe, 0xec, 0x38, 0x06, 0x9f, 0x85, 0x9f,
+	0x26, 0x60, 0x3a, 0x50, 0x16, 0x95, 0x20, 0x17, 0x26, 0xa7, 0xa0, 0x3d, 0xa5, 0xdc, 0x12, 0x22,
+	0x73, 0x01, 0x85, 0x1c, 0x46, 0x35, 0x98, 0x31, 0xfa, 0x56, 0xcb, 0xb3, 0xf2, 0x70, 0x65, 0x8d,
+	0xf1, 0x4f, 0xf4, 0x1a, 0x14, 0xe5, 0x70, 0xeb, 0x8b, 0xd9, 0xd3, 0x81, 0x4c, 0x18, 0xbe, 0x98,
+	0xbd, 0x84, 0x15, 0x0b, 0xfb, 0x74, 0x28, 0xcb, 0xf2, 0x2e, 0x57, 0x6a, 0xcc, 0x2e, 0xd7, 0x22,
+	0x63, 0x10, 0x4d, 0xb0, 0x0c, 0x50, 0xf8, 0x0f, 0x05, 0xe6, 0x03, 0x99, 0xa7, 0xd8, 0x6a, 0xb4,
+	0xb1, 0x47, 0xfc, 0x5f, 0x8d, 0xef, 0x4e, 0x60, 0xfe, 0x12, 0x5b, 0xa6, 0x81, 0x69, 0x84, 0x8b,
+	0x08, 0xb5, 0x07, 0xef, 0xbf, 0x7a, 0xb2, 0x2e, 0xb9, 0x9c, 0x06, 0x98, 0x41, 0x76, 0xb9, 0xcb,
+	0xa1, 0x71, 0x54, 0x85, 0x49, 0x9f, 0xab, 0x27, 0xdb, 0x30, 0x7b, 0xcc, 0xc5, 0xbf, 0xf8, 0xb0,
+	0xb9, 0x26, 0x18, 0xf9, 0xc6, 0xeb, 0x1d, 0xd3, 0xdd, 0xb5, 0x31, 0x6d, 0xef, 0x1c, 0x91, 0x0b,
+	0xdc, 0xea, 0x95, 0x49, 0x6b, 0xf8, 0xb9, 0x58, 0x30, 0x28, 0xfc, 0x85, 0x02, 0x8b, 0xc2, 0x6a,
+	0x96, 0x0a, 0x22, 0x15, 0xad, 0x02, 0xf3, 0xb2, 0x20, 0xde, 0xc1, 0xf2, 0x5c, 0x48, 0x12, 0xa8,
+	0x1a, 0xe7, 0xbf, 0xc4, 0x1d, 0xfd, 0x57, 0xf8, 0x6f, 0x05, 0x92, 0x47, 0xf8, 0x6a, 0xe4, 0x99,
+	0x36, 0x7c, 0x65, 0x4b, 0x44, 0x5f, 0xd9, 0x10, 0xa4, 0x28, 0xb9, 0x96, 0x4f, 0x14, 0x1a, 0xff,
+	0x8d, 0xd6, 0x20, 0xcd, 0xfe, 0x17, 0x65, 0x4a, 0xf6, 0xa8, 0xd9, 0x00, 0xaf, 0x4d, 0x8f, 0x20,
+	0x47, 0x1c, 0xdc, 0xa2, 0x3c, 0xe7, 0xb7, 0xc5, 0x23, 0x3b, 0xdb, 0x4f, 0x49, 0x6d, 0x2e, 0x1c,
+	0x7f, 0xc6, 0x87, 0x87, 0x3b, 0xc0, 0x93, 0x23, 0x1d, 0xe0, 0xef, 0x86, 0x51, 0x3d, 0x15, 0xfb,
+	0xca, 0x74, 0x84, 0xaf, 0x86, 0x02, 0xfa, 0x21, 0x64, 0xfc, 0x6e, 0x87, 0x78, 0x3e, 0x31, 0x88,
+	0xa1, 0x9f, 0xf5, 0xf8, 0xd3, 0x66, 0x4a, 0x9b, 0xed, 0x0f, 0x1e, 0xf4, 0x78, 0xf2, 0x29, 0x45,
+	0xaa, 0xca, 0x29, 0xf1, 0x7c, 0x99, 0x7c, 0x2e, 0xc5, 0x4f, 0xe9, 0x96, 0xe0, 0x73, 0x58, 0xd3,
+	0xc4, 0x88, 0xa6, 0xcb, 0x30, 0x29, 0x6d, 0x4d, 0x72, 0x5b, 0xe5, 0x17, 0xba, 0x0f, 0xe9, 0xb0,
+	0x02, 0x4a, 0x57, 0xf5, 0x07, 0x50, 0x01, 0x66, 0xa3, 0xd5, 0x4d, 0xe6, 0x9d, 0x81, 0xb1, 0xc2,
+	0x39, 0x2c, 0x45, 0x75, 0x3d, 0xb0, 0xb0, 0x4d, 0x8e, 0x4c, 0x87, 0xaf, 0x8c, 0x65, 0x3a, 0xe1,
+	0xdf, 0x4c, 0xb0, 0xdf, 0x51, 0x0b, 0x12, 0x1f, 0xb5, 0x20, 0x39, 0x6c, 0x41, 0xe1, 0xbd, 0x02,
+	0x6b, 0xa5, 0xb8, 0x52, 0x2b, 0x3c, 0x7c, 0x7b, 0xbb, 0xfe, 0x10, 0xe6, 0x42, 0x80, 0x5c, 0xb5,
+	0xc4, 0x38, 0xaf, 0xf7, 0xd9, 0xce, 0xc0, 0x37, 0xb3, 0x01, 0x77, 0x3a, 0x96, 0x29, 0x37, 0xe4,
+	0xb4, 0x16, 0x7c, 0xb2, 0x12, 0x10, 0xbe, 0x86, 0xa6, 0xc4, 0x0b, 0x6d, 0xf0, 0xcd, 0xa2, 0x97,
+	0x78, 0x9e, 0x2b, 0xdf, 0x44, 0x34, 0xf1, 0xf1, 0xf8, 0x35, 0x40, 0xe4, 0x0f, 0x5b, 0xd6, 0x60,
+	0xe5, 0xb4, 0xd6, 0xac, 0xe8, 0xb5, 0x7a, 0xb3, 0x5a, 0x3b, 0xd1, 0x5f, 0x9c, 0x34, 0xea, 0x95,
+	0x52, 0xf5, 0xb0, 0x5a, 0x29, 0xe7, 0xee, 0xa1, 0x05, 0x98, 0x8b, 0x4e, 0xbe, 0xaa, 0x34, 0x72,
+	0x0a, 0x5a, 0x81, 0x85, 0xe8, 0x60, 0xf1, 0xa0, 0xd1, 0x2c, 0x56, 0x4f, 0x72, 0x09, 0x84, 0x20,
+	0x1b, 0x9d, 0x38, 0xa9, 0xe5, 0x92, 0x8f, 0xcb, 0x90, 0x0e, 0x9f, 0x3d, 0x91, 0x0a, 0x8b, 0xcd,
+	0xe2, 0xd1, 0xd1, 0x2b, 0xfd, 0xb8, 0x56, 0xae, 0xe8, 0xf5, 0xa3, 0x17, 0x5a, 0xf1, 0xa8, 0xda,
+	0x7c, 0x95, 0xbb, 0x87, 0xd6, 0x61, 0x35, 0x32, 0x53, 0x3d, 0x69, 0x34, 0x8b, 0x27, 0x4d, 0x5d,
+	0x7b, 0x71, 0x52, 0x3b, 0x3c, 0xcc, 0x29, 0x8f, 0x7f, 0x92, 0x80, 0xec, 0xa0, 0x87, 0xd0, 0x26,
+	0xac, 0xd5, 0xb5, 0x5a, 0xbd, 0xd6, 0x28, 0x1e, 0xe9, 0x8d, 0x66, 0xb1, 0xf9, 0xa2, 0x31, 0xa4,
+	0x7b, 0x01, 0x36, 0x86, 0x01, 0xe5, 0x4a, 0xbd, 0xd6, 0xa8, 0x36, 0xf5, 0x7a, 0x45, 0xab, 0xd6,
+	0xca, 0x39, 0x05, 0x3d, 0x80, 0xf5, 0x61, 0xcc, 0x69, 0xad, 0x59, 0x3d, 0x79, 0x1a, 0x40, 0x12,
+	0x28, 0x0f, 0xcb, 0xc3, 0x90, 0x7a, 0xb1, 0xd1, 0xa8, 0x94, 0x73, 0x49, 0x74, 0x1f, 0xd4, 0xe1,
+	0x39, 0xad, 0xf2, 0xbc, 0x52, 0x6a, 0x56, 0xca, 0xb9, 0x54, 0x1c, 0xe5, 0x61, 0xb1, 0x7a, 0x54,
+	0x29, 0xe7, 0x26, 0xe2, 0xe6, 0x4e, 0x2b, 0xcd, 0x5a, 0xa5, 0x9c, 0x9b, 0x44, 0x9f, 0xc1, 0x76,
+	0xbc, 0x44, 0xbd, 0x5e, 0x39, 0x29, 0x33, 0xe5, 0x2a, 0x3f, 0xaa, 0x94, 0x5e, 0x30, 0x1f, 0xe7,
+	0xa6, 0x1e, 0xff, 0xbd, 0x02, 0xd9, 0xc1, 0x42, 0x86, 0x7e, 0x1f, 0xd6, 0x9e, 0xd6, 0x4e, 0x2b,
+	0xda, 0x49, 0x4d, 0x8b, 0x75, 0x4d, 0x7e, 0xfd, 0xed, 0xbb, 0xad, 0xd5, 0x41, 0xa2, 0x17, 0x8e,
+	0xdf, 0x21, 0x2d, 0xf3, 0xdc, 0x24, 0x06, 0xfa, 0x02, 0x96, 0x87, 0xe9, 0x8b, 0xa5, 0x66, 0xf5,
+	0xb4, 0x92, 0x53, 0xf2, 0xea, 0xdb, 0x77, 0x5b, 0x8b, 0x83, 0xa4, 0xa2, 0xcd, 0x8b, 0x7e, 0x1b,
+	0xd4, 0x61, 0xaa, 0xea, 0x89, 0xa4, 0x4b, 0xe4, 0xf3, 0x6f, 0xdf, 0x6d, 0x2d, 0x0f, 0xd2, 0x55,
+	0x1d, 0xd1, 0x3e, 0xce, 0xa7, 0xfe, 0xf4, 0x2f, 0x37, 0xee, 0x3d, 0xfe, 0x85, 0x02, 0xe9, 0x30,
+	0x77, 0x31, 0x1d, 0x8e, 0x8a, 0x2f, 0xe3, 0xd5, 0xe7, 0x3a, 0x84, 0xd0, 0xa8, 0xe6, 0x4f, 0x60,
+	0x21, 0x42, 0x55, 0x3d, 0xd1, 0x0f, 0x6b, 0x5a, 0x89, 0xa9, 0xbd, 0xf8, 0xf6, 0xdd, 0x56, 0x2e,
+	0x24, 0xa9, 0x3a, 0x87, 0xae, 0xd7, 0x22, 0xec, 0xdc, 0x17, 0x81, 0x37, 0x5e, 0xd4, 0x2b, 0x5a,
+	0xa3, 0x52, 0xae, 0x94, 0x73, 0x89, 0xfc, 0xca, 0xdb, 0x77, 0x5b, 0x0b, 0x21, 0x41, 0x23, 0xcc,
+	0x94, 0x68, 0x67, 0x40, 0x84, 0x56, 0xa9, 0x57, 0x8a, 0x6c, 0x49, 0x93, 0xf9, 0xa5, 0xb7, 0xef,
+	0xb6, 0xe6, 0xfb, 0xc9, 0x97, 0x74, 0x08, 0xb6, 0x88, 0x21, 0x8c, 0x3b, 0x38, 0xfe, 0xd9, 0xd7,
+	0x1b, 0xca, 0xcf, 0xbf, 0xde, 0x50, 0xfe, 0xe5, 0xeb, 0x0d, 0xe5, 0xc7, 0xdf, 0x6c, 0xdc, 0xfb,
+	0xf9, 0x37, 0x1b, 0xf7, 0xfe, 0xe1, 0x9b, 0x8d, 0x7b, 0x7f, 0xf8, 0xf9, 0x85, 0x49, 0xdb, 0xdd,
+	0xb3, 0x9d, 0x96, 0x6b, 0xef, 0x3e, 0xe3, 0x29, 0xe1, 0x49, 0xa9, 0x8d, 0x4d, 0x67, 0x57, 0xe4,
+	0x87, 0x27, 0x2d, 0xfe, 0x71, 0xcd, 0xff, 0xec, 0x8e, 0xf6, 0x3a, 0xc4, 0xdf, 0xbd, 0xdc, 0x3b,
+	0x9b, 0xe4, 0x27, 0x8f, 0xcf, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x8b, 0x5e, 0xab, 0x94,
+	0x27, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.QuorumChecksDone != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumChecksDone))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.QuorumCheckCount != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.QuorumTimeoutTime != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuorumTimeoutTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+func (m *MultipleChoiceOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1996,30 +2954,20 @@ func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultipleChoiceOption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultipleChoiceOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDepositPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2027,13 +2975,20 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+func (m *WeightedChoice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2043,30 +2998,32 @@ func (m *VotingParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *WeightedChoice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WeightedChoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2076,62 +3033,46 @@ func (m *TallyParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LawThreshold) > 0 {
-		i -= len(m.LawThreshold)
-		copy(dAtA[i:], m.LawThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LawQuorum) > 0 {
-		i -= len(m.LawQuorum)
-		copy(dAtA[i:], m.LawQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConstitutionAmendmentThreshold) > 0 {
-		i -= len(m.ConstitutionAmendmentThreshold)
-		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConstitutionAmendmentQuorum) > 0 {
-		i -= len(m.ConstitutionAmendmentQuorum)
-		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MinDepositThrottler) Marshal() (dAtA []byte, err error) {
+func (m *LastMinDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2141,54 +3082,30 @@ func (m *MinDepositThrottler) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+func (m *LastMinDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LastMinDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DecreaseSensitivityTargetDistance != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.DecreaseSensitivityTargetDistance))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DecreaseRatio) > 0 {
-		i -= len(m.DecreaseRatio)
-		copy(dAtA[i:], m.DecreaseRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IncreaseRatio) > 0 {
-		i -= len(m.IncreaseRatio)
-		copy(dAtA[i:], m.IncreaseRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TargetActiveProposals != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.TargetActiveProposals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.UpdatePeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
-		if err10 != nil {
-			return 0, err10
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.FloorValue) > 0 {
-		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2202,7 +3119,7 @@ func (m *MinDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MinInitialDepositThrottler) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2212,54 +3129,63 @@ func (m *MinInitialDepositThrottler) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MinInitialDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MinInitialDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DecreaseSensitivityTargetDistance != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.DecreaseSensitivityTargetDistance))
+	if m.VotingPowerSnapshot {
 		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DecreaseRatio) > 0 {
-		i -= len(m.DecreaseRatio)
-		copy(dAtA[i:], m.DecreaseRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
+		if m.VotingPowerSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IncreaseRatio) > 0 {
-		i -= len(m.IncreaseRatio)
-		copy(dAtA[i:], m.IncreaseRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xb8
 	}
-	if m.TargetProposals != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.TargetProposals))
+	if m.Expedited {
 		i--
-		dAtA[i] = 0x18
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.UpdatePeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
-		if err11 != nil {
-			return 0, err11
+	if m.ExecutionTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if len(m.FloorValue) > 0 {
-		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+	if m.TallyMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TallyMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2267,100 +3193,141 @@ func (m *MinInitialDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, err
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MetadataHash) > 0 {
+		i -= len(m.MetadataHash)
+		copy(dAtA[i:], m.MetadataHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MetadataHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LawQuorumRange != nil {
-		{
-			size, err := m.LawQuorumRange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+	if m.ConstitutionAmendmentConflict {
+		i--
+		if m.ConstitutionAmendmentConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0x88
 	}
-	if m.ConstitutionAmendmentQuorumRange != nil {
-		{
-			size, err := m.ConstitutionAmendmentQuorumRange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
-		}
+	if m.TimesVotingPeriodExtended != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TimesVotingPeriodExtended))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xda
+		dAtA[i] = 0x80
 	}
-	if m.QuorumRange != nil {
-		{
-			size, err := m.QuorumRange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Endorsed {
+		i--
+		if m.Endorsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x70
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0x6a
 	}
-	if len(m.BurnDepositNoThreshold) > 0 {
-		i -= len(m.BurnDepositNoThreshold)
-		copy(dAtA[i:], m.BurnDepositNoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.BurnDepositNoThreshold)))
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x62
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
 		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x5a
 	}
-	if m.MinInitialDepositThrottler != nil {
-		{
-			size, err := m.MinInitialDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VotingStartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DepositEndTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x2a
 	}
-	if m.MinDepositThrottler != nil {
+	if m.FinalTallyResult != nil {
 		{
-			size, err := m.MinDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2368,149 +3335,225 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGov(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0x22
 	}
-	if m.QuorumCheckCount != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
-		i--
-		dAtA[i] = 0x1
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xb0
+		dAtA[i] = 0x18
 	}
-	if m.MaxVotingPeriodExtension != nil {
-		n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
-		if err17 != nil {
-			return 0, err17
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= n17
-		i = encodeVarintGov(dAtA, i, uint64(n17))
-		i--
-		dAtA[i] = 0x1
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x8
 	}
-	if m.QuorumTimeout != nil {
-		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
-		if err18 != nil {
-			return 0, err18
-		}
-		i -= n18
-		i = encodeVarintGov(dAtA, i, uint64(n18))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LawThreshold) > 0 {
-		i -= len(m.LawThreshold)
-		copy(dAtA[i:], m.LawThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
-		i--
-		dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WinningOption) > 0 {
+		i -= len(m.WinningOption)
+		copy(dAtA[i:], m.WinningOption)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.WinningOption)))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x2a
 	}
-	if len(m.LawQuorum) > 0 {
-		i -= len(m.LawQuorum)
-		copy(dAtA[i:], m.LawQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if len(m.ConstitutionAmendmentThreshold) > 0 {
-		i -= len(m.ConstitutionAmendmentThreshold)
-		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x1a
 	}
-	if len(m.ConstitutionAmendmentQuorum) > 0 {
-		i -= len(m.ConstitutionAmendmentQuorum)
-		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x12
 	}
-	if len(m.MinDepositRatio) > 0 {
-		i -= len(m.MinDepositRatio)
-		copy(dAtA[i:], m.MinDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0xa
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Choices) > 0 {
+		for iNdEx := len(m.Choices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Choices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
-		i--
-		dAtA[i] = 0x70
 	}
-	if m.BurnVoteQuorum {
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x68
 	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x8
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.VotingPeriod != nil {
-		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err19 != nil {
-			return 0, err19
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Choices) > 0 {
+		for iNdEx := len(m.Choices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Choices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
-		i -= n19
-		i = encodeVarintGov(dAtA, i, uint64(n19))
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ArchiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ArchiveTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VotingPower)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.MaxDepositPeriod != nil {
-		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err20 != nil {
-			return 0, err20
-		}
-		i -= n20
-		i = encodeVarintGov(dAtA, i, uint64(n20))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2518,13 +3561,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2534,491 +3589,4735 @@ func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuorumRange) MarshalTo(dAtA []byte) (int, error) {
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuorumRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Min) > 0 {
-		i -= len(m.Min)
-		copy(dAtA[i:], m.Min)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Min)))
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Max) > 0 {
-		i -= len(m.Max)
-		copy(dAtA[i:], m.Max)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Max)))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuorumCheckQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuorumCheckQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumCheckQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
+	if m.QuorumChecksDone != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumChecksDone))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.QuorumCheckCount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.QuorumTimeoutTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuorumTimeoutTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.MaxDepositPeriod != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LastMinDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		for _, e := range m.Value {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.VotingPeriod != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	if len(m.LawThreshold) > 0 {
+		i -= len(m.LawThreshold)
+		copy(dAtA[i:], m.LawThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if len(m.LawQuorum) > 0 {
+		i -= len(m.LawQuorum)
+		copy(dAtA[i:], m.LawQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
+	if len(m.ConstitutionAmendmentThreshold) > 0 {
+		i -= len(m.ConstitutionAmendmentThreshold)
+		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ConstitutionAmendmentQuorum) > 0 {
+		i -= len(m.ConstitutionAmendmentQuorum)
+		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinDepositThrottler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MinDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpeditedMultiplier) > 0 {
+		i -= len(m.ExpeditedMultiplier)
+		copy(dAtA[i:], m.ExpeditedMultiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedMultiplier)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
+	if m.DecreaseSensitivityTargetDistance != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DecreaseSensitivityTargetDistance))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.DecreaseRatio) > 0 {
+		i -= len(m.DecreaseRatio)
+		copy(dAtA[i:], m.DecreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.IncreaseRatio) > 0 {
+		i -= len(m.IncreaseRatio)
+		copy(dAtA[i:], m.IncreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Endorsed {
-		n += 2
+	if m.TargetActiveProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TargetActiveProposals))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Annotation)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.UpdatePeriod != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TimesVotingPeriodExtended != 0 {
-		n += 2 + sovGov(uint64(m.TimesVotingPeriodExtended))
+	if len(m.FloorValue) > 0 {
+		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+func (m *MinInitialDepositThrottler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+func (m *MinInitialDepositThrottler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuorumCheckQueueEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MinInitialDepositThrottler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.QuorumTimeoutTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime)
-		n += 1 + l + sovGov(uint64(l))
+	if m.DecreaseSensitivityTargetDistance != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DecreaseSensitivityTargetDistance))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.QuorumCheckCount != 0 {
-		n += 1 + sovGov(uint64(m.QuorumCheckCount))
+	if len(m.DecreaseRatio) > 0 {
+		i -= len(m.DecreaseRatio)
+		copy(dAtA[i:], m.DecreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DecreaseRatio)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.QuorumChecksDone != 0 {
-		n += 1 + sovGov(uint64(m.QuorumChecksDone))
+	if len(m.IncreaseRatio) > 0 {
+		i -= len(m.IncreaseRatio)
+		copy(dAtA[i:], m.IncreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.IncreaseRatio)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *DepositParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TargetProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TargetProposals))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.UpdatePeriod != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.UpdatePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.FloorValue) > 0 {
+		for iNdEx := len(m.FloorValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FloorValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VotingParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TallyParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.ConstitutionAmendmentQuorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.SnapshotVotingPower {
+		i--
+		if m.SnapshotVotingPower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
 	}
-	l = len(m.ConstitutionAmendmentThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
 	}
-	l = len(m.LawQuorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ExpeditedVotingPeriod != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
 	}
-	l = len(m.LawThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
 	}
-	return n
-}
-
-func (m *MinDepositThrottler) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
 	}
-	var l int
-	_ = l
-	if len(m.FloorValue) > 0 {
-		for _, e := range m.FloorValue {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.ConstitutionAmendmentExecutionDelay != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConstitutionAmendmentExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConstitutionAmendmentExecutionDelay):])
+		if err15 != nil {
+			return 0, err15
 		}
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.UpdatePeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if m.LawExecutionDelay != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.LawExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.LawExecutionDelay):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.TargetActiveProposals != 0 {
-		n += 1 + sovGov(uint64(m.TargetActiveProposals))
+	if m.ExecutionDelay != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.IncreaseRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.RequireMetadataHash {
+		i--
+		if m.RequireMetadataHash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
-	l = len(m.DecreaseRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.VoteArchiveRetentionPeriod != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VoteArchiveRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VoteArchiveRetentionPeriod):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGov(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.DecreaseSensitivityTargetDistance != 0 {
-		n += 1 + sovGov(uint64(m.DecreaseSensitivityTargetDistance))
+	if m.ArchiveVotes {
+		i--
+		if m.ArchiveVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	return n
-}
-
-func (m *MinInitialDepositThrottler) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GovernorStatusChangePeriod != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.GovernorStatusChangePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	var l int
-	_ = l
-	if len(m.FloorValue) > 0 {
-		for _, e := range m.FloorValue {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.MinGovernorSelfDelegation) > 0 {
+		i -= len(m.MinGovernorSelfDelegation)
+		copy(dAtA[i:], m.MinGovernorSelfDelegation)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinGovernorSelfDelegation)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.LawQuorumRange != nil {
+		{
+			size, err := m.LawQuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	if m.UpdatePeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if m.ConstitutionAmendmentQuorumRange != nil {
+		{
+			size, err := m.ConstitutionAmendmentQuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	if m.TargetProposals != 0 {
-		n += 1 + sovGov(uint64(m.TargetProposals))
+	if m.QuorumRange != nil {
+		{
+			size, err := m.QuorumRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	l = len(m.IncreaseRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.BurnDepositNoThreshold) > 0 {
+		i -= len(m.BurnDepositNoThreshold)
+		copy(dAtA[i:], m.BurnDepositNoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.BurnDepositNoThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	l = len(m.DecreaseRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.MinInitialDepositThrottler != nil {
+		{
+			size, err := m.MinInitialDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if m.DecreaseSensitivityTargetDistance != 0 {
-		n += 1 + sovGov(uint64(m.DecreaseSensitivityTargetDistance))
+	if m.MinDepositThrottler != nil {
+		{
+			size, err := m.MinDepositThrottler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.QuorumCheckCount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.QuorumCheckCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.MaxVotingPeriodExtension != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriodExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension):])
+		if err25 != nil {
+			return 0, err25
 		}
+		i -= n25
+		i = encodeVarintGov(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if m.QuorumTimeout != nil {
+		n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.QuorumTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintGov(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.LawThreshold) > 0 {
+		i -= len(m.LawThreshold)
+		copy(dAtA[i:], m.LawThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.LawQuorum) > 0 {
+		i -= len(m.LawQuorum)
+		copy(dAtA[i:], m.LawQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.LawQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ConstitutionAmendmentThreshold) > 0 {
+		i -= len(m.ConstitutionAmendmentThreshold)
+		copy(dAtA[i:], m.ConstitutionAmendmentThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.ConstitutionAmendmentQuorum) > 0 {
+		i -= len(m.ConstitutionAmendmentQuorum)
+		copy(dAtA[i:], m.ConstitutionAmendmentQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConstitutionAmendmentQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	if len(m.MinDepositRatio) > 0 {
+		i -= len(m.MinDepositRatio)
+		copy(dAtA[i:], m.MinDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
+		i--
+		dAtA[i] = 0x7a
 	}
 	if m.BurnProposalDepositPrevote {
-		n += 2
-	}
-	l = len(m.MinDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.ConstitutionAmendmentQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.ConstitutionAmendmentThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.LawQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.LawThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.QuorumTimeout != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout)
-		n += 2 + l + sovGov(uint64(l))
+	if m.VotingPeriod != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintGov(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaxVotingPeriodExtension != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension)
-		n += 2 + l + sovGov(uint64(l))
+	if m.MaxDepositPeriod != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintGov(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QuorumCheckCount != 0 {
-		n += 2 + sovGov(uint64(m.QuorumCheckCount))
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.MinDepositThrottler != nil {
-		l = m.MinDepositThrottler.Size()
-		n += 2 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MinInitialDepositThrottler != nil {
-		l = m.MinInitialDepositThrottler.Size()
-		n += 2 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QuorumRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BurnDepositNoThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.QuorumRange != nil {
-		l = m.QuorumRange.Size()
-		n += 2 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ConstitutionAmendmentQuorumRange != nil {
-		l = m.ConstitutionAmendmentQuorumRange.Size()
-		n += 2 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GovernorDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.LawQuorumRange != nil {
-		l = m.LawQuorumRange.Size()
-		n += 2 + l + sovGov(uint64(l))
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastStatusChangeTime != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastStatusChangeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintGov(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorValShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorValShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorValShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Law) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Law) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Law) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SupersededBy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.SupersededBy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnactmentHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EnactmentHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TextHash) > 0 {
+		i -= len(m.TextHash)
+		copy(dAtA[i:], m.TextHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TextHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amendment) > 0 {
+		i -= len(m.Amendment)
+		copy(dAtA[i:], m.Amendment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Amendment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionBlameLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionBlameLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionBlameLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Line) > 0 {
+		i -= len(m.Line)
+		copy(dAtA[i:], m.Line)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Line)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstitutionAmendmentStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstitutionAmendmentStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstitutionAmendmentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Conflict {
+		i--
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Applies {
+		i--
+		if m.Applies {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalStatus != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MultipleChoiceOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedChoice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LastMinDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Endorsed {
+		n += 2
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TimesVotingPeriodExtended != 0 {
+		n += 2 + sovGov(uint64(m.TimesVotingPeriodExtended))
+	}
+	if m.ConstitutionAmendmentConflict {
+		n += 3
+	}
+	l = len(m.MetadataHash)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.TallyMode != 0 {
+		n += 2 + sovGov(uint64(m.TallyMode))
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 3
+	}
+	if m.VotingPowerSnapshot {
+		n += 3
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.WinningOption)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Choices) > 0 {
+		for _, e := range m.Choices {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchivedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ArchiveTime)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.Choices) > 0 {
+		for _, e := range m.Choices {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumCheckQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumTimeoutTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuorumTimeoutTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.QuorumCheckCount != 0 {
+		n += 1 + sovGov(uint64(m.QuorumCheckCount))
+	}
+	if m.QuorumChecksDone != 0 {
+		n += 1 + sovGov(uint64(m.QuorumChecksDone))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawQuorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MinDepositThrottler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FloorValue) > 0 {
+		for _, e := range m.FloorValue {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.UpdatePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TargetActiveProposals != 0 {
+		n += 1 + sovGov(uint64(m.TargetActiveProposals))
+	}
+	l = len(m.IncreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DecreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DecreaseSensitivityTargetDistance != 0 {
+		n += 1 + sovGov(uint64(m.DecreaseSensitivityTargetDistance))
+	}
+	l = len(m.ExpeditedMultiplier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MinInitialDepositThrottler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FloorValue) > 0 {
+		for _, e := range m.FloorValue {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.UpdatePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.UpdatePeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TargetProposals != 0 {
+		n += 1 + sovGov(uint64(m.TargetProposals))
+	}
+	l = len(m.IncreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DecreaseRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DecreaseSensitivityTargetDistance != 0 {
+		n += 1 + sovGov(uint64(m.DecreaseSensitivityTargetDistance))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	l = len(m.MinDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConstitutionAmendmentThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.LawThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.QuorumTimeout != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.QuorumTimeout)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxVotingPeriodExtension != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriodExtension)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.QuorumCheckCount != 0 {
+		n += 2 + sovGov(uint64(m.QuorumCheckCount))
+	}
+	if m.MinDepositThrottler != nil {
+		l = m.MinDepositThrottler.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MinInitialDepositThrottler != nil {
+		l = m.MinInitialDepositThrottler.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.BurnDepositNoThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.QuorumRange != nil {
+		l = m.QuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ConstitutionAmendmentQuorumRange != nil {
+		l = m.ConstitutionAmendmentQuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.LawQuorumRange != nil {
+		l = m.LawQuorumRange.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinGovernorSelfDelegation)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.GovernorStatusChangePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.GovernorStatusChangePeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ArchiveVotes {
+		n += 3
+	}
+	if m.VoteArchiveRetentionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VoteArchiveRetentionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.RequireMetadataHash {
+		n += 3
+	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.LawExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.LawExecutionDelay)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ConstitutionAmendmentExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConstitutionAmendmentExecutionDelay)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.SnapshotVotingPower {
+		n += 3
+	}
+	return n
+}
+
+func (m *QuorumRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.LastStatusChangeTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastStatusChangeTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorValShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Law) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TextHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EnactmentHeight != 0 {
+		n += 1 + sovGov(uint64(m.EnactmentHeight))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.SupersededBy != 0 {
+		n += 1 + sovGov(uint64(m.SupersededBy))
+	}
+	return n
+}
+
+func (m *ConstitutionVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	l = len(m.Amendment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConstitutionBlameLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *ConstitutionAmendmentStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.ProposalStatus != 0 {
+		n += 1 + sovGov(uint64(m.ProposalStatus))
+	}
+	if m.Applies {
+		n += 2
+	}
+	if m.Conflict {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultipleChoiceOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultipleChoiceOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultipleChoiceOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedChoice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedChoice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedChoice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastMinDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastMinDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, types1.Coin{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorsed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Endorsed = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimesVotingPeriodExtended", wireType)
+			}
+			m.TimesVotingPeriodExtended = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimesVotingPeriodExtended |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentConflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConstitutionAmendmentConflict = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &MultipleChoiceOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+			}
+			m.TallyMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyMode |= TallyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VotingPowerSnapshot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOption", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinningOption = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Choices = append(m.Choices, &WeightedChoice{})
+			if err := m.Choices[len(m.Choices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ArchiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Choices = append(m.Choices, &WeightedChoice{})
+			if err := m.Choices[len(m.Choices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumCheckQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumCheckQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeoutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumTimeoutTime == nil {
+				m.QuorumTimeoutTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuorumTimeoutTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
+			}
+			m.QuorumCheckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumChecksDone", wireType)
+			}
+			m.QuorumChecksDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumChecksDone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QuorumRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Max)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Min)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,17 +8340,87 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinDepositThrottler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorValue = append(m.FloorValue, types1.Coin{})
+			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatePeriod == nil {
+				m.UpdatePeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetActiveProposals", wireType)
 			}
-			m.Option = 0
+			m.TargetActiveProposals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3061,14 +8430,97 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.TargetActiveProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseSensitivityTargetDistance", wireType)
+			}
+			m.DecreaseSensitivityTargetDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecreaseSensitivityTargetDistance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,7 +8548,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.ExpeditedMultiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3119,7 +8571,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,36 +8594,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinInitialDepositThrottler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinInitialDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3181,27 +8614,29 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.FloorValue = append(m.FloorValue, types1.Coin{})
+			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3228,66 +8663,37 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.UpdatePeriod == nil {
+				m.UpdatePeriod = new(time.Duration)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetProposals", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastMinDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastMinDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3297,31 +8703,29 @@ func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value, types.Coin{})
-			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3331,28 +8735,43 @@ func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
+			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseSensitivityTargetDistance", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DecreaseSensitivityTargetDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecreaseSensitivityTargetDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3374,7 +8793,7 @@ func (m *LastMinDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3397,87 +8816,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3504,16 +8851,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3540,16 +8885,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3576,18 +8921,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3597,31 +8942,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3631,33 +8974,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3667,31 +9006,67 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3719,11 +9094,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3751,11 +9126,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3783,11 +9158,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3815,13 +9190,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.LawQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endorsed", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3831,17 +9206,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Endorsed = bool(v != 0)
-		case 15:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3851,29 +9238,33 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotation = string(dAtA[iNdEx:postIndex])
+			if m.QuorumTimeout == nil {
+				m.QuorumTimeout = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.QuorumTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimesVotingPeriodExtended", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPeriodExtension", wireType)
 			}
-			m.TimesVotingPeriodExtended = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3883,66 +9274,52 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimesVotingPeriodExtended |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGov
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			if m.MaxVotingPeriodExtension == nil {
+				m.MaxVotingPeriodExtension = new(time.Duration)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxVotingPeriodExtension, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.QuorumCheckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositThrottler", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3952,29 +9329,33 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			if m.MinDepositThrottler == nil {
+				m.MinDepositThrottler = &MinDepositThrottler{}
+			}
+			if err := m.MinDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositThrottler", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3984,27 +9365,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			if m.MinInitialDepositThrottler == nil {
+				m.MinInitialDepositThrottler = &MinInitialDepositThrottler{}
+			}
+			if err := m.MinInitialDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDepositNoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4032,63 +9417,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.BurnDepositNoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRange", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4098,16 +9433,33 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuorumRange == nil {
+				m.QuorumRange = &QuorumRange{}
+			}
+			if err := m.QuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorumRange", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4117,27 +9469,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.ConstitutionAmendmentQuorumRange == nil {
+				m.ConstitutionAmendmentQuorumRange = &QuorumRange{}
+			}
+			if err := m.ConstitutionAmendmentQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorumRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4164,14 +9520,16 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LawQuorumRange == nil {
+				m.LawQuorumRange = &QuorumRange{}
+			}
+			if err := m.LawQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGovernorSelfDelegation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4199,61 +9557,11 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.MinGovernorSelfDelegation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumCheckQueueEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumCheckQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeoutTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorStatusChangePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4280,18 +9588,18 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumTimeoutTime == nil {
-				m.QuorumTimeoutTime = new(time.Time)
+			if m.GovernorStatusChangePeriod == nil {
+				m.GovernorStatusChangePeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuorumTimeoutTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.GovernorStatusChangePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveVotes", wireType)
 			}
-			m.QuorumCheckCount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4301,16 +9609,53 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.ArchiveVotes = bool(v != 0)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteArchiveRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteArchiveRetentionPeriod == nil {
+				m.VoteArchiveRetentionPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VoteArchiveRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumChecksDone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireMetadataHash", wireType)
 			}
-			m.QuorumChecksDone = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4320,64 +9665,15 @@ func (m *QuorumCheckQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuorumChecksDone |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequireMetadataHash = bool(v != 0)
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4404,14 +9700,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LawExecutionDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4438,66 +9736,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
+			if m.LawExecutionDelay == nil {
+				m.LawExecutionDelay = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.LawExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentExecutionDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4524,66 +9772,16 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
+			if m.ConstitutionAmendmentExecutionDelay == nil {
+				m.ConstitutionAmendmentExecutionDelay = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConstitutionAmendmentExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4611,11 +9809,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4643,13 +9841,13 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4659,27 +9857,31 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4707,11 +9909,81 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotVotingPower", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotVotingPower = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4739,11 +10011,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawQuorum = string(dAtA[iNdEx:postIndex])
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4771,7 +10043,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LawThreshold = string(dAtA[iNdEx:postIndex])
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4794,7 +10066,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
+func (m *GovernorDescription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4817,17 +10089,17 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinDepositThrottler: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovernorDescription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovernorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4837,31 +10109,29 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FloorValue = append(m.FloorValue, types.Coin{})
-			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4871,33 +10141,29 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatePeriod == nil {
-				m.UpdatePeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetActiveProposals", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
-			m.TargetActiveProposals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4907,14 +10173,27 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetActiveProposals |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4942,11 +10221,11 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4974,27 +10253,8 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
+			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseSensitivityTargetDistance", wireType)
-			}
-			m.DecreaseSensitivityTargetDistance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DecreaseSensitivityTargetDistance |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5016,7 +10276,7 @@ func (m *MinDepositThrottler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
+func (m *Governor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5039,17 +10299,17 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinInitialDepositThrottler: wiretype end group for non-group")
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinInitialDepositThrottler: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloorValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5059,29 +10319,27 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FloorValue = append(m.FloorValue, types.Coin{})
-			if err := m.FloorValue[len(m.FloorValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5108,18 +10366,15 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdatePeriod == nil {
-				m.UpdatePeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.UpdatePeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetProposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.TargetProposals = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5129,16 +10384,16 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetProposals |= uint64(b&0x7F) << shift
+				m.Status |= GovernorStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncreaseRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusChangeTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5148,75 +10403,28 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncreaseRatio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseRatio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			if m.LastStatusChangeTime == nil {
+				m.LastStatusChangeTime = new(time.Time)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastStatusChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DecreaseRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecreaseSensitivityTargetDistance", wireType)
-			}
-			m.DecreaseSensitivityTargetDistance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DecreaseSensitivityTargetDistance |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5238,7 +10446,7 @@ func (m *MinInitialDepositThrottler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *GovernorValShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5261,17 +10469,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovernorValShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovernorValShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5281,31 +10489,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5315,33 +10521,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5351,31 +10553,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5403,11 +10653,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5435,65 +10685,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Law) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Law: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Law: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5503,15 +10751,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5539,11 +10786,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5571,11 +10818,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentQuorum = string(dAtA[iNdEx:postIndex])
+			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TextHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5603,13 +10850,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConstitutionAmendmentThreshold = string(dAtA[iNdEx:postIndex])
+			m.TextHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorum", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnactmentHeight", wireType)
 			}
-			var stringLen uint64
+			m.EnactmentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5619,29 +10866,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EnactmentHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LawQuorum = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawThreshold", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5651,29 +10885,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LawThreshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumTimeout", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5683,33 +10904,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= LawStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QuorumTimeout == nil {
-				m.QuorumTimeout = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.QuorumTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPeriodExtension", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
 			}
-			var msglen int
+			m.SupersededBy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5719,33 +10923,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SupersededBy |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxVotingPeriodExtension == nil {
-				m.MaxVotingPeriodExtension = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConstitutionVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxVotingPeriodExtension, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 22:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstitutionVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstitutionVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCheckCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.QuorumCheckCount = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5755,16 +10992,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QuorumCheckCount |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositThrottler", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5774,33 +11011,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MinDepositThrottler == nil {
-				m.MinDepositThrottler = &MinDepositThrottler{}
-			}
-			if err := m.MinDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositThrottler", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5810,31 +11030,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MinInitialDepositThrottler == nil {
-				m.MinInitialDepositThrottler = &MinInitialDepositThrottler{}
-			}
-			if err := m.MinInitialDepositThrottler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnDepositNoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5862,13 +11065,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnDepositNoThreshold = string(dAtA[iNdEx:postIndex])
+			m.Amendment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5878,33 +11081,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QuorumRange == nil {
-				m.QuorumRange = &QuorumRange{}
-			}
-			if err := m.QuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Constitution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 27:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConstitutionBlameLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstitutionBlameLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstitutionBlameLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstitutionAmendmentQuorumRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5914,33 +11163,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConstitutionAmendmentQuorumRange == nil {
-				m.ConstitutionAmendmentQuorumRange = &QuorumRange{}
-			}
-			if err := m.ConstitutionAmendmentQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Line = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LawQuorumRange", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5950,28 +11195,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LawQuorumRange == nil {
-				m.LawQuorumRange = &QuorumRange{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			if err := m.LawQuorumRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5993,7 +11240,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuorumRange) Unmarshal(dAtA []byte) error {
+func (m *ConstitutionAmendmentStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6016,17 +11263,17 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuorumRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConstitutionAmendmentStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuorumRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConstitutionAmendmentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -6036,27 +11283,73 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applies", wireType)
 			}
-			m.Max = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applies = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6084,7 +11377,7 @@ func (m *QuorumRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Min = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Max lengths of the governor description fields, same as the ones of the
+// staking validator description.
+const (
+	MaxGovernorMonikerLength         = 70
+	MaxGovernorIdentityLength        = 3000
+	MaxGovernorWebsiteLength         = 140
+	MaxGovernorSecurityContactLength = 140
+	MaxGovernorDetailsLength         = 280
+)
+
+// NewGovernor creates a new active Governor instance.
+func NewGovernor(address string, description GovernorDescription, statusChangeTime time.Time) Governor {
+	return Governor{
+		GovernorAddress:      address,
+		Description:          description,
+		Status:               GovernorStatusActive,
+		LastStatusChangeTime: &statusChangeTime,
+	}
+}
+
+// GetAddress returns the account address of the governor.
+func (g Governor) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(g.GovernorAddress)
+}
+
+// IsActive returns true if the governor is active.
+func (g Governor) IsActive() bool {
+	return g.Status == GovernorStatusActive
+}
+
+// NewGovernorDescription creates a new GovernorDescription instance.
+func NewGovernorDescription(moniker, identity, website, securityContact, details string) GovernorDescription {
+	return GovernorDescription{
+		Moniker:         moniker,
+		Identity:        identity,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// EnsureLength ensures the length of the governor description fields.
+func (d GovernorDescription) EnsureLength() error {
+	if len(d.Moniker) == 0 {
+		return fmt.Errorf("moniker cannot be blank")
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"moniker", d.Moniker, MaxGovernorMonikerLength},
+		{"identity", d.Identity, MaxGovernorIdentityLength},
+		{"website", d.Website, MaxGovernorWebsiteLength},
+		{"security contact", d.SecurityContact, MaxGovernorSecurityContactLength},
+		{"details", d.Details, MaxGovernorDetailsLength},
+	} {
+		if len(field.value) > field.max {
+			return fmt.Errorf("invalid %s length; got: %d, max: %d", field.name, len(field.value), field.max)
+		}
+	}
+	return nil
+}
+
+// IsValid returns true if the governor status is a valid status to update a
+// governor to.
+func (s GovernorStatus) IsValid() bool {
+	return s == GovernorStatusActive || s == GovernorStatusInactive
+}
+
+// GovernorStatusFromString returns a GovernorStatus from a string. It returns
+// an error if the string is not a status a governor can be updated to.
+func GovernorStatusFromString(str string) (GovernorStatus, error) {
+	switch strings.ToLower(str) {
+	case "active", "governor_status_active":
+		return GovernorStatusActive, nil
+	case "inactive", "governor_status_inactive":
+		return GovernorStatusInactive, nil
+	default:
+		return GovernorStatusUnspecified, fmt.Errorf("'%s' is not a valid governor status, available statuses: active/inactive", str)
+	}
+}
+
+// NewGovernanceDelegation creates a new GovernanceDelegation instance.
+func NewGovernanceDelegation(delegatorAddr, governorAddr string) GovernanceDelegation {
+	return GovernanceDelegation{
+		DelegatorAddress: delegatorAddr,
+		GovernorAddress:  governorAddr,
+	}
+}
+
+// NewGovernorValShares creates a new GovernorValShares instance.
+func NewGovernorValShares(governorAddr, validatorAddr string, shares math.LegacyDec) GovernorValShares {
+	return GovernorValShares{
+		GovernorAddress:  governorAddr,
+		ValidatorAddress: validatorAddr,
+		Shares:           shares,
+	}
+}
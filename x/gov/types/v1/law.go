@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// MaxLawTitleLength is the maximum length of the title of a law.
+const MaxLawTitleLength = 255
+
+// NewLaw creates a new law in force.
+func NewLaw(id uint64, title, text, textHash string, enactmentHeight int64, proposalID uint64) Law {
+	return Law{
+		Id:              id,
+		Title:           title,
+		Text:            text,
+		TextHash:        textHash,
+		EnactmentHeight: enactmentHeight,
+		ProposalId:      proposalID,
+		Status:          LawStatusInForce,
+	}
+}
+
+// IsInForce returns true if the law is in force.
+func (l Law) IsInForce() bool {
+	return l.Status == LawStatusInForce
+}
+
+// LawTextHash returns the hex encoded SHA-256 hash of the text of a law.
+func LawTextHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateLawText validates the title, text and text hash of a law. Either
+// text or textHash must be set, and textHash must match the hash of text if
+// both are set.
+func ValidateLawText(title, text, textHash string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("law title cannot be empty")
+	}
+	if len(title) > MaxLawTitleLength {
+		return fmt.Errorf("law title too long; got: %d, max: %d", len(title), MaxLawTitleLength)
+	}
+	if text == "" && textHash == "" {
+		return fmt.Errorf("law text and text hash cannot both be empty")
+	}
+	if textHash == "" {
+		return nil
+	}
+	bz, err := hex.DecodeString(textHash)
+	if err != nil || len(bz) != sha256.Size {
+		return fmt.Errorf("invalid law text hash %s: must be a hex encoded SHA-256 hash", textHash)
+	}
+	if text != "" && !strings.EqualFold(textHash, LawTextHash(text)) {
+		return fmt.Errorf("law text hash %s does not match the hash of the law text", textHash)
+	}
+	return nil
+}
+
+// IsValid returns true if the law status is a valid status of a stored law.
+func (s LawStatus) IsValid() bool {
+	return s == LawStatusInForce || s == LawStatusSuperseded || s == LawStatusRepealed
+}
+
+// LawStatusFromString returns a LawStatus from a string. It returns an error
+// if the string is not a valid law status.
+func LawStatusFromString(str string) (LawStatus, error) {
+	switch strings.ToLower(str) {
+	case "in_force", "in-force", "law_status_in_force":
+		return LawStatusInForce, nil
+	case "superseded", "law_status_superseded":
+		return LawStatusSuperseded, nil
+	case "repealed", "law_status_repealed":
+		return LawStatusRepealed, nil
+	default:
+		return LawStatusUnspecified, fmt.Errorf("'%s' is not a valid law status, available statuses: in_force/superseded/repealed", str)
+	}
+}
@@ -16,6 +16,8 @@ import (
 
 var (
 	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgProposeConstitutionAmendment{}, &MsgProposeLaw{}
+	_, _, _, _, _          sdk.Msg                            = &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgUpdateGovernorStatus{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
+	_, _, _                sdk.Msg                            = &MsgRepealLaw{}, &MsgVoteMultipleChoice{}, &MsgCancelProposal{}
 	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
@@ -80,11 +82,34 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.ErrInvalidCoins.Wrap(deposit.String())
 	}
 
+	if m.MetadataHash != "" {
+		if err := types.ValidateMetadataHash(m.MetadataHash); err != nil {
+			return err
+		}
+	}
+
 	// Check that either metadata or Msgs length is non nil.
-	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
+	if len(m.Messages) == 0 && len(m.Metadata) == 0 && len(m.Options) == 0 {
 		return types.ErrNoProposalMsgs.Wrap("either metadata or Msgs length must be non-nil")
 	}
 
+	if len(m.Options) > 0 {
+		if len(m.Messages) > 0 {
+			return types.ErrInvalidMultipleChoiceOptions.Wrap("messages of a multiple-choice proposal must be attached to its options")
+		}
+		if err := MultipleChoiceOptions(m.Options).ValidateBasic(); err != nil {
+			return err
+		}
+		if !ValidTallyMode(m.TallyMode) {
+			return types.ErrInvalidMultipleChoiceOptions.Wrapf("invalid tally mode %s", m.TallyMode)
+		}
+		if m.Expedited {
+			return types.ErrInvalidExpeditedProposal.Wrap("multiple-choice proposals cannot be expedited")
+		}
+	} else if m.TallyMode != TallyModePlurality {
+		return types.ErrInvalidMultipleChoiceOptions.Wrap("tally mode requires multiple-choice options")
+	}
+
 	msgs, err := m.GetMsgs()
 	if err != nil {
 		return err
@@ -103,7 +128,15 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, m.Messages); err != nil {
+		return err
+	}
+	for _, opt := range m.Options {
+		if err := opt.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -129,6 +162,22 @@ func (msg MsgDeposit) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
@@ -198,6 +247,28 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgVoteMultipleChoice creates a message to cast a vote on the options of
+// an active multiple-choice proposal
+//
+//nolint:interfacer
+func NewMsgVoteMultipleChoice(voter sdk.AccAddress, proposalID uint64, choices WeightedChoices, metadata string) *MsgVoteMultipleChoice {
+	return &MsgVoteMultipleChoice{proposalID, voter.String(), choices, metadata}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVoteMultipleChoice) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVoteMultipleChoice) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVoteMultipleChoice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	return WeightedChoices(msg.Choices).ValidateBasic()
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance
 //
 //nolint:interfacer
@@ -258,11 +329,154 @@ func (msg MsgProposeConstitutionAmendment) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgProposeLaw creates a new MsgProposeLaw instance
+//
+//nolint:interfacer
+func NewMsgProposeLaw(authority sdk.AccAddress, title, text, textHash string, supersedes []uint64) *MsgProposeLaw {
+	return &MsgProposeLaw{
+		Authority:  authority.String(),
+		Title:      title,
+		Text:       text,
+		TextHash:   textHash,
+		Supersedes: supersedes,
+	}
+}
+
 // ValidateBasic implements the sdk.Msg interface.
 func (msg MsgProposeLaw) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
 	}
 
+	if err := ValidateLawText(msg.Title, msg.Text, msg.TextHash); err != nil {
+		return types.ErrInvalidProposalContent.Wrap(err.Error())
+	}
+
+	seen := make(map[uint64]bool, len(msg.Supersedes))
+	for _, lawID := range msg.Supersedes {
+		if lawID == 0 {
+			return types.ErrInvalidProposalContent.Wrap("superseded law id cannot be 0")
+		}
+		if seen[lawID] {
+			return types.ErrInvalidProposalContent.Wrapf("duplicate superseded law id %d", lawID)
+		}
+		seen[lawID] = true
+	}
+
+	return nil
+}
+
+// NewMsgRepealLaw creates a new MsgRepealLaw instance
+//
+//nolint:interfacer
+func NewMsgRepealLaw(authority sdk.AccAddress, lawID uint64) *MsgRepealLaw {
+	return &MsgRepealLaw{
+		Authority: authority.String(),
+		LawId:     lawID,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRepealLaw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.LawId == 0 {
+		return types.ErrInvalidProposalContent.Wrap("law id cannot be 0")
+	}
+
+	return nil
+}
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance
+//
+//nolint:interfacer
+func NewMsgCreateGovernor(address sdk.AccAddress, description GovernorDescription) *MsgCreateGovernor {
+	return &MsgCreateGovernor{Address: address.String(), Description: description}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return types.ErrInvalidGovernorDescription.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgEditGovernor creates a new MsgEditGovernor instance
+//
+//nolint:interfacer
+func NewMsgEditGovernor(address sdk.AccAddress, description GovernorDescription) *MsgEditGovernor {
+	return &MsgEditGovernor{Address: address.String(), Description: description}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEditGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+	if err := msg.Description.EnsureLength(); err != nil {
+		return types.ErrInvalidGovernorDescription.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgUpdateGovernorStatus creates a new MsgUpdateGovernorStatus instance
+//
+//nolint:interfacer
+func NewMsgUpdateGovernorStatus(address sdk.AccAddress, status GovernorStatus) *MsgUpdateGovernorStatus {
+	return &MsgUpdateGovernorStatus{Address: address.String(), Status: status}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGovernorStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+	if !msg.Status.IsValid() {
+		return types.ErrInvalidGovernorStatus.Wrap(msg.Status.String())
+	}
+
+	return nil
+}
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance
+//
+//nolint:interfacer
+func NewMsgDelegateGovernor(delegator, governor sdk.AccAddress) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{DelegatorAddress: delegator.String(), GovernorAddress: governor.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance
+//
+//nolint:interfacer
+func NewMsgUndelegateGovernor(delegator sdk.AccAddress) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{DelegatorAddress: delegator.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
 	return nil
 }
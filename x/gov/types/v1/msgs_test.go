@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	govtypes "github.com/Hikari-Chain/hikari-chain/x/gov/types"
 	v1 "github.com/Hikari-Chain/hikari-chain/x/gov/types/v1"
 	"github.com/Hikari-Chain/hikari-chain/x/gov/types/v1beta1"
 )
@@ -65,6 +67,27 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	metadata := "metadata"
@@ -154,20 +177,24 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 		metadata       string
 		title          string
 		summary        string
+		metadataHash   string
 		expErr         bool
 	}{
-		{"invalid addr", "", coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", true},
-		{"empty msgs and metadata", addrs[0].String(), coinsPos, nil, "", "Title", "Summary", true},
-		{"empty title and summary", addrs[0].String(), coinsPos, nil, "", "", "", true},
-		{"invalid msg", addrs[0].String(), coinsPos, []sdk.Msg{msg1, msg2}, metadata, "Title", "Summary", true},
-		{"valid with no Msg", addrs[0].String(), coinsPos, nil, metadata, "Title", "Summary", false},
-		{"valid with no metadata", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, "", "Title", "Summary", false},
-		{"valid with everything", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", false},
+		{"invalid addr", "", coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", "", true},
+		{"empty msgs and metadata", addrs[0].String(), coinsPos, nil, "", "Title", "Summary", "", true},
+		{"empty title and summary", addrs[0].String(), coinsPos, nil, "", "", "", "", true},
+		{"invalid msg", addrs[0].String(), coinsPos, []sdk.Msg{msg1, msg2}, metadata, "Title", "Summary", "", true},
+		{"invalid metadata hash", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", "metadata", true},
+		{"valid with no Msg", addrs[0].String(), coinsPos, nil, metadata, "Title", "Summary", "", false},
+		{"valid with no metadata", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, "", "Title", "Summary", "", false},
+		{"valid with everything", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", "", false},
+		{"valid with metadata hash", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, "Title", "Summary", govtypes.ComputeMetadataHash([]byte(metadata)), false},
 	}
 
 	for _, tc := range tests {
 		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.title, tc.summary)
 		require.NoError(t, err)
+		msg.MetadataHash = tc.metadataHash
 		if tc.expErr {
 			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
 		} else {
@@ -176,6 +203,95 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposal_MultipleChoice(t *testing.T) {
+	msg1, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), addrs[0].String())
+	require.NoError(t, err)
+	msg2, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), "foo")
+	require.NoError(t, err)
+
+	newOption := func(name string, msgs ...sdk.Msg) *v1.MultipleChoiceOption {
+		opt, err := v1.NewMultipleChoiceOption(name, msgs)
+		require.NoError(t, err)
+		return opt
+	}
+
+	tests := []struct {
+		name      string
+		messages  []sdk.Msg
+		options   []*v1.MultipleChoiceOption
+		tallyMode v1.TallyMode
+		expedited bool
+		expErr    bool
+	}{
+		{"valid plurality", nil, []*v1.MultipleChoiceOption{newOption("A", msg1), newOption("B")}, v1.TallyModePlurality, false, false},
+		{"valid instant-runoff", nil, []*v1.MultipleChoiceOption{newOption("A"), newOption("B"), newOption("C")}, v1.TallyModeInstantRunoff, false, false},
+		{"single option", nil, []*v1.MultipleChoiceOption{newOption("A")}, v1.TallyModePlurality, false, true},
+		{"empty option name", nil, []*v1.MultipleChoiceOption{newOption("A"), newOption(" ")}, v1.TallyModePlurality, false, true},
+		{"duplicated option name", nil, []*v1.MultipleChoiceOption{newOption("A"), newOption("A")}, v1.TallyModePlurality, false, true},
+		{"invalid option msg", nil, []*v1.MultipleChoiceOption{newOption("A", msg2), newOption("B")}, v1.TallyModePlurality, false, true},
+		{"msgs outside of options", []sdk.Msg{msg1}, []*v1.MultipleChoiceOption{newOption("A"), newOption("B")}, v1.TallyModePlurality, false, true},
+		{"invalid tally mode", nil, []*v1.MultipleChoiceOption{newOption("A"), newOption("B")}, v1.TallyMode(0x13), false, true},
+		{"tally mode without options", []sdk.Msg{msg1}, nil, v1.TallyModeInstantRunoff, false, true},
+		{"expedited regular proposal", []sdk.Msg{msg1}, nil, v1.TallyModePlurality, true, false},
+		{"expedited multiple-choice proposal", nil, []*v1.MultipleChoiceOption{newOption("A"), newOption("B")}, v1.TallyModePlurality, true, true},
+	}
+
+	for _, tc := range tests {
+		msg, err := v1.NewMsgSubmitProposal(tc.messages, coinsPos, addrs[0].String(), "", "Title", "Summary")
+		require.NoError(t, err)
+		msg.Options = tc.options
+		msg.TallyMode = tc.tallyMode
+		msg.Expedited = tc.expedited
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgVoteMultipleChoice(t *testing.T) {
+	metadata := "metadata"
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		choices    v1.WeightedChoices
+		metadata   string
+		expectPass bool
+	}{
+		{addrs[0], v1.WeightedChoices{v1.NewWeightedChoice(0, math.LegacyOneDec())}, metadata, true},
+		{addrs[0], v1.WeightedChoices{
+			v1.NewWeightedChoice(2, math.LegacyNewDecWithPrec(7, 1)),
+			v1.NewWeightedChoice(0, math.LegacyNewDecWithPrec(3, 1)),
+		}, "", true},
+		{sdk.AccAddress{}, v1.WeightedChoices{v1.NewWeightedChoice(0, math.LegacyOneDec())}, "", false},
+		{addrs[0], v1.WeightedChoices{}, "", false},
+		{addrs[0], v1.WeightedChoices{ // weight sum > 1
+			v1.NewWeightedChoice(0, math.LegacyOneDec()),
+			v1.NewWeightedChoice(1, math.LegacyOneDec()),
+		}, "", false},
+		{addrs[0], v1.WeightedChoices{ // weight sum < 1
+			v1.NewWeightedChoice(0, math.LegacyNewDecWithPrec(5, 1)),
+		}, "", false},
+		{addrs[0], v1.WeightedChoices{ // duplicate option
+			v1.NewWeightedChoice(1, math.LegacyNewDecWithPrec(5, 1)),
+			v1.NewWeightedChoice(1, math.LegacyNewDecWithPrec(5, 1)),
+		}, "", false},
+		{addrs[0], v1.WeightedChoices{ // negative weight
+			v1.NewWeightedChoice(0, math.LegacyNewDec(2)),
+			v1.NewWeightedChoice(1, math.LegacyNewDec(-1)),
+		}, "", false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgVoteMultipleChoice(tc.voterAddr, 1, tc.choices, tc.metadata)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	pc := codec.NewProtoCodec(types.NewInterfaceRegistry())
@@ -237,3 +353,114 @@ func TestMsgProposeConstitutionAmendment_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgProposeLaw_ValidateBasic(t *testing.T) {
+	text := "law text"
+	textHash := v1.LawTextHash(text)
+	tests := []struct {
+		name       string
+		authority  sdk.AccAddress
+		title      string
+		text       string
+		textHash   string
+		supersedes []uint64
+		expErr     bool
+	}{
+		{"invalid authority", sdk.AccAddress{}, "title", text, "", nil, true},
+		{"empty title", addrs[0], "", text, "", nil, true},
+		{"title too long", addrs[0], strings.Repeat("a", v1.MaxLawTitleLength+1), text, "", nil, true},
+		{"empty text and text hash", addrs[0], "title", "", "", nil, true},
+		{"invalid text hash", addrs[0], "title", "", "abcd", nil, true},
+		{"mismatching text hash", addrs[0], "title", "other text", textHash, nil, true},
+		{"zero superseded law id", addrs[0], "title", text, "", []uint64{0}, true},
+		{"duplicate superseded law id", addrs[0], "title", text, "", []uint64{1, 1}, true},
+		{"valid text", addrs[0], "title", text, "", nil, false},
+		{"valid text hash", addrs[0], "title", "", strings.ToUpper(textHash), nil, false},
+		{"valid text and text hash", addrs[0], "title", text, textHash, []uint64{1, 2}, false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.NewMsgProposeLaw(tc.authority, tc.title, tc.text, tc.textHash, tc.supersedes)
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgRepealLaw_ValidateBasic(t *testing.T) {
+	require.Error(t, v1.NewMsgRepealLaw(sdk.AccAddress{}, 1).ValidateBasic())
+	require.Error(t, v1.NewMsgRepealLaw(addrs[0], 0).ValidateBasic())
+	require.NoError(t, v1.NewMsgRepealLaw(addrs[0], 1).ValidateBasic())
+}
+
+// test ValidateBasic for MsgCreateGovernor
+func TestMsgCreateGovernor(t *testing.T) {
+	tests := []struct {
+		address     sdk.AccAddress
+		description v1.GovernorDescription
+		expectPass  bool
+	}{
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", ""), true},
+		{sdk.AccAddress{}, v1.NewGovernorDescription("moniker", "", "", "", ""), false},
+		{addrs[0], v1.NewGovernorDescription("", "", "", "", ""), false},
+		{addrs[0], v1.NewGovernorDescription(strings.Repeat("a", v1.MaxGovernorMonikerLength+1), "", "", "", ""), false},
+		{addrs[0], v1.NewGovernorDescription("moniker", "", "", "", strings.Repeat("a", v1.MaxGovernorDetailsLength+1)), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCreateGovernor(tc.address, tc.description)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUpdateGovernorStatus
+func TestMsgUpdateGovernorStatus(t *testing.T) {
+	tests := []struct {
+		address    sdk.AccAddress
+		status     v1.GovernorStatus
+		expectPass bool
+	}{
+		{addrs[0], v1.GovernorStatusActive, true},
+		{addrs[0], v1.GovernorStatusInactive, true},
+		{sdk.AccAddress{}, v1.GovernorStatusActive, false},
+		{addrs[0], v1.GovernorStatusUnspecified, false},
+		{addrs[0], v1.GovernorStatus(0x13), false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgUpdateGovernorStatus(tc.address, tc.status)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgDelegateGovernor
+func TestMsgDelegateGovernor(t *testing.T) {
+	tests := []struct {
+		delegator  sdk.AccAddress
+		governor   sdk.AccAddress
+		expectPass bool
+	}{
+		{addrs[0], addrs[1], true},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgDelegateGovernor(tc.delegator, tc.governor)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
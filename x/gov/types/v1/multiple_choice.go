@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	govtypes "github.com/Hikari-Chain/hikari-chain/x/gov/types"
+)
+
+const (
+	// MinMultipleChoiceOptions is the minimum number of options of a
+	// multiple-choice proposal.
+	MinMultipleChoiceOptions = 2
+	// MaxMultipleChoiceOptions is the maximum number of options of a
+	// multiple-choice proposal.
+	MaxMultipleChoiceOptions = 16
+
+	TallyModePlurality     = TallyMode_TALLY_MODE_PLURALITY
+	TallyModeInstantRunoff = TallyMode_TALLY_MODE_INSTANT_RUNOFF
+)
+
+var _ types.UnpackInterfacesMessage = MultipleChoiceOption{}
+
+// NewMultipleChoiceOption creates a new MultipleChoiceOption instance
+func NewMultipleChoiceOption(name string, messages []sdk.Msg) (*MultipleChoiceOption, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+	return &MultipleChoiceOption{Name: name, Messages: msgs}, nil
+}
+
+// GetMsgs unpacks the messages of the option.
+func (o MultipleChoiceOption) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(o.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (o MultipleChoiceOption) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, o.Messages)
+}
+
+// MultipleChoiceOptions is the list of options of a multiple-choice proposal.
+type MultipleChoiceOptions []*MultipleChoiceOption
+
+// ValidateBasic checks the number and the names of the options, and performs
+// a basic validation of their messages.
+func (opts MultipleChoiceOptions) ValidateBasic() error {
+	if len(opts) < MinMultipleChoiceOptions || len(opts) > MaxMultipleChoiceOptions {
+		return govtypes.ErrInvalidMultipleChoiceOptions.Wrapf("expected between %d and %d options, got %d",
+			MinMultipleChoiceOptions, MaxMultipleChoiceOptions, len(opts))
+	}
+
+	names := make(map[string]bool, len(opts))
+	for idx, opt := range opts {
+		if strings.TrimSpace(opt.Name) == "" {
+			return govtypes.ErrInvalidMultipleChoiceOptions.Wrapf("option %d has no name", idx)
+		}
+		if names[opt.Name] {
+			return govtypes.ErrInvalidMultipleChoiceOptions.Wrapf("duplicated option name %s", opt.Name)
+		}
+		names[opt.Name] = true
+
+		msgs, err := opt.GetMsgs()
+		if err != nil {
+			return err
+		}
+		for msgIdx, msg := range msgs {
+			if msg, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := msg.ValidateBasic(); err != nil {
+					return govtypes.ErrInvalidProposalMsg.Wrap(fmt.Sprintf("option: %d, msg: %d, err: %s", idx, msgIdx, err.Error()))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidTallyMode returns true if the tally mode is valid and false otherwise.
+func ValidTallyMode(mode TallyMode) bool {
+	return mode == TallyModePlurality || mode == TallyModeInstantRunoff
+}
+
+// IsMultipleChoice returns true if the proposal is a multiple-choice proposal.
+func (p Proposal) IsMultipleChoice() bool {
+	return len(p.Options) > 0
+}
+
+// GetOptionMsgs returns the messages of the option of a multiple-choice
+// proposal with the given name.
+func (p Proposal) GetOptionMsgs(name string) ([]sdk.Msg, error) {
+	for _, opt := range p.Options {
+		if opt.Name == name {
+			return opt.GetMsgs()
+		}
+	}
+	return nil, govtypes.ErrInvalidMultipleChoiceOptions.Wrapf("proposal %d has no option %s", p.Id, name)
+}
+
+// NewWeightedChoice creates a new WeightedChoice instance
+func NewWeightedChoice(option uint32, weight math.LegacyDec) *WeightedChoice {
+	return &WeightedChoice{Option: option, Weight: weight.String()}
+}
+
+// WeightedChoices describes array of WeightedChoice
+type WeightedChoices []*WeightedChoice
+
+func (c WeightedChoices) String() (out string) {
+	for _, choice := range c {
+		out += fmt.Sprintf("%d=%s,", choice.Option, choice.Weight)
+	}
+	return strings.TrimSuffix(out, ",")
+}
+
+// ValidateBasic checks that the weights of the choices are positive and sum
+// up to 1, and that no option is chosen twice.
+func (c WeightedChoices) ValidateBasic() error {
+	if len(c) == 0 {
+		return govtypes.ErrInvalidVote.Wrap("no choices")
+	}
+
+	totalWeight := math.LegacyZeroDec()
+	usedOptions := make(map[uint32]bool)
+	for _, choice := range c {
+		weight, err := math.LegacyNewDecFromStr(choice.Weight)
+		if err != nil {
+			return govtypes.ErrInvalidVote.Wrapf("Invalid weight: %s", err)
+		}
+		if !weight.IsPositive() || weight.GT(math.LegacyOneDec()) {
+			return govtypes.ErrInvalidVote.Wrapf("Invalid weight: %s", choice.Weight)
+		}
+		totalWeight = totalWeight.Add(weight)
+		if usedOptions[choice.Option] {
+			return govtypes.ErrInvalidVote.Wrap("Duplicated option")
+		}
+		usedOptions[choice.Option] = true
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return govtypes.ErrInvalidVote.Wrap("Total weight must be 1.00")
+	}
+	return nil
+}
+
+// WeightedChoicesFromString returns weighted choices from a string of
+// comma-separated option=weight pairs, where options are the indexes of the
+// options of the proposal. It returns an error if the string is invalid.
+func WeightedChoicesFromString(str string) (WeightedChoices, error) {
+	choices := WeightedChoices{}
+	for _, choice := range strings.Split(str, ",") {
+		fields := strings.Split(choice, "=")
+		option, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 32)
+		if err != nil {
+			return choices, fmt.Errorf("'%s' is not a valid option index", fields[0])
+		}
+		if len(fields) < 2 {
+			return choices, fmt.Errorf("weight field does not exist for %s option", fields[0])
+		}
+		weight, err := math.LegacyNewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return choices, err
+		}
+		choices = append(choices, NewWeightedChoice(uint32(option), weight))
+	}
+	return choices, nil
+}
@@ -68,6 +68,20 @@ var (
 	DefaultMinInitialDepositDecreaseRatio                                   = math.LegacyNewDecWithPrec(5, 3)
 	DefaultTargetProposalsInDepositPeriod                     uint64        = 5
 	DefaultBurnDepositNoThreshold                                           = math.LegacyNewDecWithPrec(80, 2)
+	DefaultMinGovernorSelfDelegation                                        = math.NewInt(1000000000)
+	DefaultGovernorStatusChangePeriod                         time.Duration = time.Hour * 24 * 28 // 28 days
+	DefaultArchiveVotes                                                     = true
+	DefaultVoteArchiveRetentionPeriod                         time.Duration = time.Hour * 24 * 365 // 1 year
+	DefaultRequireMetadataHash                                              = false
+	DefaultExecutionDelay                                     time.Duration = 0 // messages are executed right away
+	DefaultLawExecutionDelay                                  time.Duration = 0
+	DefaultConstitutionAmendmentExecutionDelay                time.Duration = 0
+	DefaultProposalCancelRatio                                              = math.LegacyNewDecWithPrec(5, 1)
+	DefaultProposalCancelDestAddress                                        = ""                 // canceled deposits are burned
+	DefaultExpeditedVotingPeriod                              time.Duration = time.Hour * 24 * 7 // 7 days
+	DefaultExpeditedThreshold                                               = math.LegacyNewDecWithPrec(75, 2)
+	DefaultExpeditedMinDepositMultiplier                                    = math.LegacyNewDec(5)
+	DefaultSnapshotVotingPower                                              = false
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -116,6 +130,13 @@ func NewParams(
 	maxQuorum string, minQuorum string,
 	maxConstitutionAmendmentQuorum string, minConstitutionAmendmentQuorum string,
 	maxLawQuorum string, minLawQuorum string,
+	minGovernorSelfDelegation string, governorStatusChangePeriod time.Duration,
+	archiveVotes bool, voteArchiveRetentionPeriod time.Duration,
+	requireMetadataHash bool,
+	executionDelay, lawExecutionDelay, constitutionAmendmentExecutionDelay time.Duration,
+	proposalCancelRatio, proposalCancelDest string,
+	expeditedVotingPeriod time.Duration, expeditedThreshold, expeditedMinDepositMultiplier string,
+	snapshotVotingPower bool,
 ) Params {
 	return Params{
 		// MinDeposit:                     minDeposit, // Deprecated in favor of dynamic min deposit
@@ -138,6 +159,7 @@ func NewParams(
 			IncreaseRatio:                     minDepositIncreaseRatio,
 			DecreaseRatio:                     minDepositDecreaseRatio,
 			TargetActiveProposals:             targetActiveProposals,
+			ExpeditedMultiplier:               expeditedMinDepositMultiplier,
 		},
 		MinInitialDepositThrottler: &MinInitialDepositThrottler{
 			FloorValue:                        minInitialDepositFloor,
@@ -160,6 +182,23 @@ func NewParams(
 			Max: maxLawQuorum,
 			Min: minLawQuorum,
 		},
+		MinGovernorSelfDelegation:  minGovernorSelfDelegation,
+		GovernorStatusChangePeriod: &governorStatusChangePeriod,
+		ArchiveVotes:               archiveVotes,
+		VoteArchiveRetentionPeriod: &voteArchiveRetentionPeriod,
+		RequireMetadataHash:        requireMetadataHash,
+
+		ExecutionDelay:                      &executionDelay,
+		LawExecutionDelay:                   &lawExecutionDelay,
+		ConstitutionAmendmentExecutionDelay: &constitutionAmendmentExecutionDelay,
+
+		ProposalCancelRatio: proposalCancelRatio,
+		ProposalCancelDest:  proposalCancelDest,
+
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+		ExpeditedThreshold:    expeditedThreshold,
+
+		SnapshotVotingPower: snapshotVotingPower,
 	}
 }
 
@@ -198,6 +237,20 @@ func DefaultParams() Params {
 		DefaultMinConstitutionAmendmentQuorum.String(),
 		DefaultMaxLawQuorum.String(),
 		DefaultMinLawQuorum.String(),
+		DefaultMinGovernorSelfDelegation.String(),
+		DefaultGovernorStatusChangePeriod,
+		DefaultArchiveVotes,
+		DefaultVoteArchiveRetentionPeriod,
+		DefaultRequireMetadataHash,
+		DefaultExecutionDelay,
+		DefaultLawExecutionDelay,
+		DefaultConstitutionAmendmentExecutionDelay,
+		DefaultProposalCancelRatio.String(),
+		DefaultProposalCancelDestAddress,
+		DefaultExpeditedVotingPeriod,
+		DefaultExpeditedThreshold.String(),
+		DefaultExpeditedMinDepositMultiplier.String(),
+		DefaultSnapshotVotingPower,
 	)
 }
 
@@ -311,6 +364,27 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be at least %s: %s", minVotingPeriod.String(), p.VotingPeriod.String())
 	}
 
+	if p.ExpeditedVotingPeriod == nil {
+		return fmt.Errorf("expedited voting period must not be nil")
+	}
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+	if p.ExpeditedVotingPeriod.Nanoseconds() >= p.VotingPeriod.Nanoseconds() {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	expeditedThreshold, err := math.LegacyNewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited threshold too large: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited threshold must be strictly greater than governance threshold: %s", expeditedThreshold)
+	}
+
 	// minInitialDepositRatio, err := math.LegacyNewDecFromStr(p.MinInitialDepositRatio)
 	// if err != nil {
 	// 	return fmt.Errorf("invalid mininum initial deposit ratio of proposal: %w", err)
@@ -406,6 +480,14 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("minimum deposit decrease ratio too large: %s", minDepositDecreaseRatio)
 	}
 
+	expeditedMultiplier, err := math.LegacyNewDecFromStr(p.MinDepositThrottler.ExpeditedMultiplier)
+	if err != nil {
+		return fmt.Errorf("invalid expedited minimum deposit multiplier: %w", err)
+	}
+	if expeditedMultiplier.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited minimum deposit multiplier must be greater than or equal to 1: %s", expeditedMultiplier)
+	}
+
 	if p.MinInitialDepositThrottler == nil {
 		return fmt.Errorf("min initial deposit throttler must not be nil")
 	}
@@ -477,6 +559,63 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("burnDepositNoThreshold too large: %s", burnDepositNoThreshold)
 	}
 
+	minGovernorSelfDelegation, ok := math.NewIntFromString(p.MinGovernorSelfDelegation)
+	if !ok {
+		return fmt.Errorf("invalid minimum governor self delegation: %s", p.MinGovernorSelfDelegation)
+	}
+	if minGovernorSelfDelegation.IsNegative() {
+		return fmt.Errorf("minimum governor self delegation must be non-negative: %s", minGovernorSelfDelegation)
+	}
+
+	if p.GovernorStatusChangePeriod == nil {
+		return fmt.Errorf("governor status change period must not be nil")
+	}
+	if p.GovernorStatusChangePeriod.Seconds() <= 0 {
+		return fmt.Errorf("governor status change period must be positive: %s", p.GovernorStatusChangePeriod)
+	}
+
+	if p.VoteArchiveRetentionPeriod == nil {
+		return fmt.Errorf("vote archive retention period must not be nil")
+	}
+	if p.VoteArchiveRetentionPeriod.Seconds() < 0 {
+		return fmt.Errorf("vote archive retention period must not be negative: %s", p.VoteArchiveRetentionPeriod)
+	}
+
+	// the delays are checked in a fixed order, so that the returned error is
+	// deterministic.
+	for _, delay := range []struct {
+		label string
+		value *time.Duration
+	}{
+		{"execution delay", p.ExecutionDelay},
+		{"law execution delay", p.LawExecutionDelay},
+		{"constitution amendment execution delay", p.ConstitutionAmendmentExecutionDelay},
+	} {
+		if delay.value == nil {
+			return fmt.Errorf("%s must not be nil", delay.label)
+		}
+		if delay.value.Seconds() < 0 {
+			return fmt.Errorf("%s must not be negative: %s", delay.label, delay.value)
+		}
+	}
+
+	proposalCancelRatio, err := math.LegacyNewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must be positive: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("proposal cancel ratio is too large: %s", proposalCancelRatio)
+	}
+
+	if len(p.ProposalCancelDest) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
 	return nil
 }
 
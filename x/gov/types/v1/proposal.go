@@ -22,6 +22,8 @@ const (
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
 	StatusVetoed        = ProposalStatus_PROPOSAL_STATUS_VETOED
+
+	StatusPassedPendingExecution = ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION
 )
 
 // ProposalKinds is a bitmask representing which messages are listed in a
@@ -79,7 +81,15 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, p.Messages); err != nil {
+		return err
+	}
+	for _, opt := range p.Options {
+		if err := opt.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Proposals is an array of proposal
@@ -141,7 +151,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusPassed ||
 		status == StatusRejected ||
 		status == StatusFailed ||
-		status == StatusVetoed {
+		status == StatusVetoed ||
+		status == StatusPassedPendingExecution {
 		return true
 	}
 	return false
@@ -25,7 +25,7 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOption
 
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
-	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
+	return v.ProposalId == 0 || v.Voter == "" || (len(v.Options) == 0 && len(v.Choices) == 0)
 }
 
 // Votes is a collection of Vote objects
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"slices"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,15 +14,19 @@ import (
 var _ sdk.AnteDecorator = ValidateFeeDecorator{}
 
 type ValidateFeeDecorator struct {
-	k PhotonKeeper
+	k   PhotonKeeper
+	fdr FeeDenomResolver
 }
 
-func NewValidateFeeDecorator(k PhotonKeeper) ValidateFeeDecorator {
-	return ValidateFeeDecorator{k: k}
+// NewValidateFeeDecorator returns a ValidateFeeDecorator. fdr resolves the
+// denoms allowed to pay fees in addition to photon, it can be nil.
+func NewValidateFeeDecorator(k PhotonKeeper, fdr FeeDenomResolver) ValidateFeeDecorator {
+	return ValidateFeeDecorator{k: k, fdr: fdr}
 }
 
 // AnteHandle implements the sdk.AnteDecorator interface.
-// It returns an error if the tx fee denom is not photon, with some exceptions:
+// It returns an error if a tx fee denom is not photon or one of the extra
+// denoms of the fee denom resolver, with some exceptions:
 //   - tx has no fees or 0 fees.
 //   - tx messages' type URLs match the `TxFeeExceptions` field of the
 //     [types.Params].
@@ -40,17 +46,36 @@ func (vfd ValidateFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return next(ctx, tx, simulate)
 	}
 
-	if len(feeCoins) > 1 {
-		return ctx, types.ErrTooManyFeeCoins
-	}
-	if feeDenom := feeCoins[0].Denom; feeDenom != types.Denom {
-		// feeDenom not allowed
-		return ctx, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom %s not allowed; only fee denom %s is allowed", feeDenom, types.Denom)
+	var extraDenoms []string
+	for _, coin := range feeCoins {
+		if coin.Denom == types.Denom {
+			// feeDenom photon is allowed
+			continue
+		}
+		if extraDenoms == nil {
+			// only resolve the extra denoms if a fee is not paid in photon
+			denoms, err := vfd.extraDenoms(ctx)
+			if err != nil {
+				return ctx, err
+			}
+			extraDenoms = denoms
+		}
+		if !slices.Contains(extraDenoms, coin.Denom) {
+			// feeDenom not allowed
+			return ctx, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom %s not allowed; only fee denom %s is allowed", coin.Denom, types.Denom)
+		}
 	}
-	// feeDenom photon is allowed
 	return next(ctx, tx, simulate)
 }
 
+// extraDenoms returns the denoms allowed to pay fees in addition to photon.
+func (vfd ValidateFeeDecorator) extraDenoms(ctx sdk.Context) ([]string, error) {
+	if vfd.fdr == nil {
+		return []string{}, nil
+	}
+	return vfd.fdr.ExtraDenoms(ctx)
+}
+
 // AllowsAnyTxFee returns true if all tx messages type URL are presents in
 // txFeeExceptions, or if it starts with a wildcard "*".
 func AllowsAnyTxFee(tx sdk.Tx, txFeeExceptions []string) bool {
@@ -23,16 +23,18 @@ import (
 )
 
 type mocks struct {
-	ctx          sdk.Context
-	PhotonKeeper *MockPhotonKeeper
+	ctx              sdk.Context
+	PhotonKeeper     *MockPhotonKeeper
+	FeeDenomResolver *MockFeeDenomResolver
 }
 
 func setupMocks(t *testing.T) mocks {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	return mocks{
-		ctx:          sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()),
-		PhotonKeeper: NewMockPhotonKeeper(ctrl),
+		ctx:              sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()),
+		PhotonKeeper:     NewMockPhotonKeeper(ctrl),
+		FeeDenomResolver: NewMockFeeDenomResolver(ctrl),
 	}
 }
 
@@ -96,6 +98,8 @@ func TestValidateFeeDecorator(t *testing.T) {
 			setup: func(m mocks) {
 				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
 					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return([]string{"ibc/ATOM"}, nil)
 			},
 			expectedError: fmt.Sprintf(
 				"fee denom %s not allowed; only fee denom %s is allowed: invalid fee token",
@@ -114,6 +118,8 @@ func TestValidateFeeDecorator(t *testing.T) {
 			setup: func(m mocks) {
 				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
 					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return([]string{"ibc/ATOM"}, nil)
 			},
 			expectedError: fmt.Sprintf(
 				"fee denom %s not allowed; only fee denom %s is allowed: invalid fee token",
@@ -135,8 +141,63 @@ func TestValidateFeeDecorator(t *testing.T) {
 			setup: func(m mocks) {
 				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
 					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return([]string{"ibc/ATOM"}, nil)
 			},
-			expectedError: "too many fee coins, only accepts fees in one denom",
+			expectedError: fmt.Sprintf(
+				"fee denom %s not allowed; only fee denom %s is allowed: invalid fee token",
+				"ulight",
+				types.Denom,
+			),
+		},
+		{
+			name: "ok: MsgUpdateParams fee whitelisted denom",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&types.MsgUpdateParams{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ibc/ATOM", 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return([]string{"ibc/ATOM"}, nil)
+			},
+		},
+		{
+			name: "ok: MsgUpdateParams fee uphoton and whitelisted denom",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&types.MsgUpdateParams{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(
+					sdk.NewInt64Coin("ibc/ATOM", 1),
+					sdk.NewInt64Coin(types.Denom, 1),
+				))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return([]string{"ibc/ATOM"}, nil)
+			},
+		},
+		{
+			name: "fail: MsgUpdateParams fee denom resolver returns an error",
+			tx: func() sdk.Tx {
+				txBuilder := txConfig.NewTxBuilder()
+				txBuilder.SetMsgs(&types.MsgUpdateParams{})
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ibc/ATOM", 1)))
+				return txBuilder.GetTx()
+			},
+			setup: func(m mocks) {
+				m.PhotonKeeper.EXPECT().GetParams(m.ctx).
+					Return(types.DefaultParams())
+				m.FeeDenomResolver.EXPECT().ExtraDenoms(m.ctx).
+					Return(nil, fmt.Errorf("OUPS"))
+			},
+			expectedError: "OUPS",
 		},
 	}
 	for _, tt := range tests {
@@ -153,7 +214,7 @@ func TestValidateFeeDecorator(t *testing.T) {
 				tt.setup(m)
 			}
 
-			vfd := ante.NewValidateFeeDecorator(m.PhotonKeeper)
+			vfd := ante.NewValidateFeeDecorator(m.PhotonKeeper, m.FeeDenomResolver)
 			_, err := vfd.AnteHandle(m.ctx, tt.tx(), false, next)
 
 			if tt.expectedError != "" {
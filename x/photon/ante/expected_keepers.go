@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	photontypes "github.com/Hikari-Chain/hikari-chain/x/photon/types"
@@ -10,3 +12,9 @@ import (
 type PhotonKeeper interface {
 	GetParams(ctx sdk.Context) photontypes.Params
 }
+
+// FeeDenomResolver defines the expected resolver of the denoms that are
+// allowed to pay fees in addition to photon.
+type FeeDenomResolver interface {
+	ExtraDenoms(ctx context.Context) ([]string, error)
+}
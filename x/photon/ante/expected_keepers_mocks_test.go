@@ -5,6 +5,7 @@
 package ante_test
 
 import (
+	context "context"
 	reflect "reflect"
 
 	types "github.com/Hikari-Chain/hikari-chain/x/photon/types"
@@ -48,3 +49,41 @@ func (mr *MockPhotonKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockPhotonKeeper)(nil).GetParams), ctx)
 }
+
+// MockFeeDenomResolver is a mock of FeeDenomResolver interface.
+type MockFeeDenomResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeDenomResolverMockRecorder
+}
+
+// MockFeeDenomResolverMockRecorder is the mock recorder for MockFeeDenomResolver.
+type MockFeeDenomResolverMockRecorder struct {
+	mock *MockFeeDenomResolver
+}
+
+// NewMockFeeDenomResolver creates a new mock instance.
+func NewMockFeeDenomResolver(ctrl *gomock.Controller) *MockFeeDenomResolver {
+	mock := &MockFeeDenomResolver{ctrl: ctrl}
+	mock.recorder = &MockFeeDenomResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeDenomResolver) EXPECT() *MockFeeDenomResolverMockRecorder {
+	return m.recorder
+}
+
+// ExtraDenoms mocks base method.
+func (m *MockFeeDenomResolver) ExtraDenoms(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtraDenoms", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtraDenoms indicates an expected call of ExtraDenoms.
+func (mr *MockFeeDenomResolverMockRecorder) ExtraDenoms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtraDenoms", reflect.TypeOf((*MockFeeDenomResolver)(nil).ExtraDenoms), ctx)
+}